@@ -6,12 +6,16 @@
 package assistant_deployment_api
 
 import (
+	"context"
+
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	"github.com/rapidaai/pkg/storages"
+	"github.com/rapidaai/pkg/utils"
 
 	storage_files "github.com/rapidaai/pkg/storages/file-storage"
 	"github.com/rapidaai/protos"
@@ -23,14 +27,18 @@ type assistantDeploymentApi struct {
 	postgres          connectors.PostgresConnector
 	deploymentService internal_services.AssistantDeploymentService
 	storage           storages.Storage
+	registrations     internal_type.RegistrationSyncer
 }
 
 type assistantDeploymentGrpcApi struct {
 	assistantDeploymentApi
 }
 
+// NewAssistantDeploymentGRPCApi creates the deployment api, registrations is
+// nil when the SIP server is not running.
 func NewAssistantDeploymentGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 	postgres connectors.PostgresConnector,
+	registrations internal_type.RegistrationSyncer,
 ) protos.AssistantDeploymentServiceServer {
 	return &assistantDeploymentGrpcApi{
 		assistantDeploymentApi{
@@ -39,6 +47,20 @@ func NewAssistantDeploymentGRPCApi(config *config.AssistantConfig, logger common
 			postgres:          postgres,
 			deploymentService: internal_assistant_service.NewAssistantDeploymentService(config, logger, postgres),
 			storage:           storage_files.NewStorage(config.AssetStoreConfig, logger),
+			registrations:     registrations,
 		},
 	}
 }
+
+// syncRegistrations refreshes the SIP registrations in the background after a
+// phone deployment was created, other instances pick it up on their next sync.
+func (deploymentApi *assistantDeploymentApi) syncRegistrations() {
+	if deploymentApi.registrations == nil {
+		return
+	}
+	utils.Go(context.Background(), func() {
+		if err := deploymentApi.registrations.SyncRegistrations(context.Background()); err != nil {
+			deploymentApi.logger.Errorf("unable to sync sip registrations %v", err)
+		}
+	})
+}
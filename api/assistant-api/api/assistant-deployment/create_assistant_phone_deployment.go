@@ -52,5 +52,6 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantPhoneDeployment(ctx
 			"Please provider valid a valid request to create assistant phone deployment.",
 		)
 	}
+	deploymentApi.syncRegistrations()
 	return utils.Success[assistant_api.GetAssistantPhoneDeploymentResponse](wpDeployment)
 }
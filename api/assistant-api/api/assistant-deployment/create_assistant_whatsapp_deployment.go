@@ -40,6 +40,7 @@ func (deploymentApi *assistantDeploymentApi) CreateAssistantWhatsappDeployment(c
 		&deployment.GetWhatsapp().IdealTimeoutMessage,
 		&deployment.GetWhatsapp().MaxSessionDuration,
 		deployment.GetWhatsapp().GetWhatsappProviderName(),
+		deployment.GetWhatsapp().GetInputAudio(),
 		deployment.GetWhatsapp().GetWhatsappOptions(),
 	)
 
@@ -6,13 +6,18 @@
 package assistant_api
 
 import (
+	"context"
+
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
 	internal_knowledge_service "github.com/rapidaai/api/assistant-api/internal/services/knowledge"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
@@ -28,8 +33,12 @@ type assistantApi struct {
 	conversactionService      internal_services.AssistantConversationService
 	assistantWebhookService   internal_services.AssistantWebhookService
 	assistantAnalysisService  internal_services.AssistantAnalysisService
+	assistantModeratorService internal_services.AssistantModeratorService
+	assistantEvaluatorService internal_services.AssistantEvaluatorService
 	assistantToolService      internal_services.AssistantToolService
 	assistantKnowledgeService internal_services.AssistantKnowledgeService
+	webhookQueue              internal_webhook.Store
+	registrations             internal_type.RegistrationSyncer
 }
 
 type assistantGrpcApi struct {
@@ -41,11 +50,11 @@ func NewAssistantGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 	redis connectors.RedisConnector,
 	opensearch connectors.OpenSearchConnector,
 	vectordb connectors.VectorConnector,
-
+	registrations internal_type.RegistrationSyncer,
 ) protos.AssistantServiceServer {
 	var knowledgeDocSvc internal_services.KnowledgeDocumentService
-	if opensearch != nil {
-		knowledgeDocSvc = internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, opensearch)
+	if vectordb != nil {
+		knowledgeDocSvc = internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, vectordb)
 	}
 	return &assistantGrpcApi{
 		assistantApi{
@@ -60,8 +69,25 @@ func NewAssistantGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 			conversactionService:      internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantWebhookService:   internal_assistant_service.NewAssistantWebhookService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantAnalysisService:  internal_assistant_service.NewAssistantAnalysisService(logger, postgres),
+			assistantModeratorService: internal_assistant_service.NewAssistantModeratorService(logger, postgres),
+			assistantEvaluatorService: internal_assistant_service.NewAssistantEvaluatorService(logger, postgres),
 			assistantToolService:      internal_assistant_service.NewAssistantToolService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 			assistantKnowledgeService: internal_assistant_service.NewAssistantKnowledgeService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
+			webhookQueue:              internal_webhook.NewStore(postgres, logger),
+			registrations:             registrations,
 		},
 	}
 }
+
+// syncRegistrations drops the SIP registrations of deleted assistants in the
+// background, other instances drop them on their next sync.
+func (assistantApi *assistantApi) syncRegistrations() {
+	if assistantApi.registrations == nil {
+		return
+	}
+	utils.Go(context.Background(), func() {
+		if err := assistantApi.registrations.SyncRegistrations(context.Background()); err != nil {
+			assistantApi.logger.Errorf("unable to sync sip registrations %v", err)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// CreateAssistantEvaluator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) CreateAssistantEvaluator(ctx context.Context, cawr *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for CreateAssistantEvaluator")
+		return exceptions.AuthenticationError[protos.GetAssistantEvaluatorResponse]()
+	}
+	wl, err := assistantApi.assistantEvaluatorService.Create(
+		ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetType(),
+		cawr.GetName(),
+		cawr.GetOptions())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantEvaluatorResponse](err.Error())
+	}
+	out := &protos.AssistantEvaluator{}
+	err = utils.Cast(wl, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant evaluator to the response object")
+	}
+	return utils.Success[protos.GetAssistantEvaluatorResponse, *protos.AssistantEvaluator](out)
+}
@@ -32,6 +32,7 @@ func (assistantApi *assistantGrpcApi) DeleteAssistant(ctx context.Context, cer *
 			"Unable to update assistant, please try again in sometime",
 		)
 	}
+	assistantApi.syncRegistrations()
 	out := &assistant_api.Assistant{}
 	err = utils.Cast(assistant, out)
 	if err != nil {
@@ -0,0 +1,36 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// DeleteAssistantEvaluator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) DeleteAssistantEvaluator(ctx context.Context, cer *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for DeleteAssistantEvaluator")
+		return exceptions.AuthenticationError[protos.GetAssistantEvaluatorResponse]()
+	}
+	evaluator, err := assistantApi.assistantEvaluatorService.Delete(ctx,
+		iAuth,
+		cer.GetId(), cer.GetAssistantId())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantEvaluatorResponse]("Unable to delete assistant evaluator.")
+	}
+	out := &protos.AssistantEvaluator{}
+	err = utils.Cast(evaluator, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant evaluator to the response object")
+	}
+	return utils.Success[protos.GetAssistantEvaluatorResponse, *protos.AssistantEvaluator](out)
+}
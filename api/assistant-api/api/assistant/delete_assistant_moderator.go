@@ -0,0 +1,36 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// DeleteAssistantModerator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) DeleteAssistantModerator(ctx context.Context, cer *protos.DeleteAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for DeleteAssistantModerator")
+		return exceptions.AuthenticationError[protos.GetAssistantModeratorResponse]()
+	}
+	moderator, err := assistantApi.assistantModeratorService.Delete(ctx,
+		iAuth,
+		cer.GetId(), cer.GetAssistantId())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantModeratorResponse]("Unable to delete assistant moderator.")
+	}
+	out := &protos.AssistantModerator{}
+	err = utils.Cast(moderator, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant moderator to the response object")
+	}
+	return utils.Success[protos.GetAssistantModeratorResponse, *protos.AssistantModerator](out)
+}
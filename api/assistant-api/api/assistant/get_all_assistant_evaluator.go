@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAllAssistantEvaluator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) GetAllAssistantEvaluator(ctx context.Context, cawr *protos.GetAllAssistantEvaluatorRequest) (*protos.GetAllAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAllAssistantEvaluator")
+		return exceptions.AuthenticationError[protos.GetAllAssistantEvaluatorResponse]()
+	}
+	cnt, evaluators, err := assistantApi.assistantEvaluatorService.GetAll(ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetCriterias(),
+		cawr.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAllAssistantEvaluatorResponse]("Unable to get the assistant evaluators.")
+	}
+	out := []*protos.AssistantEvaluator{}
+	err = utils.Cast(evaluators, &out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast assistant evaluators %v", err)
+	}
+	return utils.PaginatedSuccess[protos.GetAllAssistantEvaluatorResponse, []*protos.AssistantEvaluator](
+		uint32(cnt),
+		cawr.GetPaginate().GetPage(),
+		out)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAllAssistantModerator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) GetAllAssistantModerator(ctx context.Context, cawr *protos.GetAllAssistantModeratorRequest) (*protos.GetAllAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for GetAllAssistantModerator")
+		return exceptions.AuthenticationError[protos.GetAllAssistantModeratorResponse]()
+	}
+	cnt, moderators, err := assistantApi.assistantModeratorService.GetAll(ctx,
+		iAuth,
+		cawr.GetAssistantId(),
+		cawr.GetCriterias(),
+		cawr.GetPaginate())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAllAssistantModeratorResponse]("Unable to get the assistant moderators.")
+	}
+	out := []*protos.AssistantModerator{}
+	err = utils.Cast(moderators, &out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast assistant moderators %v", err)
+	}
+	return utils.PaginatedSuccess[protos.GetAllAssistantModeratorResponse, []*protos.AssistantModerator](
+		uint32(cnt),
+		cawr.GetPaginate().GetPage(),
+		out)
+}
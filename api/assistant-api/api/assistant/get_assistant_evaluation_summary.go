@@ -0,0 +1,52 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetAssistantEvaluationSummary returns the evaluator scores of the assistant
+// aggregated per assistant version.
+func (assistantApi *assistantGrpcApi) GetAssistantEvaluationSummary(ctx context.Context, cepm *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for get assistant evaluation summary")
+		return utils.Error[protos.GetAssistantEvaluationSummaryResponse](
+			errors.New("unauthenticated request for get assistant evaluation summary"),
+			"Please provider valid service credentials to perform GetAssistantEvaluationSummary, read docs @ docs.rapida.ai",
+		)
+	}
+	summaries, err := assistantApi.conversactionService.GetAllEvaluationSummary(ctx, iAuth, cepm.GetAssistantId())
+	if err != nil {
+		return utils.Error[protos.GetAssistantEvaluationSummaryResponse](
+			err,
+			"Unable to get the evaluation summary for given assistant id.",
+		)
+	}
+	out := make([]*protos.AssistantEvaluationSummary, 0, len(summaries))
+	for _, s := range summaries {
+		out = append(out, &protos.AssistantEvaluationSummary{
+			AssistantProviderModelId: s.AssistantProviderModelId,
+			Version:                  s.Version(),
+			Name:                     s.Name,
+			Evaluator:                s.Evaluator,
+			Count:                    s.Count,
+			AverageScore:             s.AverageScore,
+			PassRate:                 s.PassRate,
+		})
+	}
+	return &protos.GetAssistantEvaluationSummaryResponse{
+		Data:    out,
+		Success: true,
+		Code:    200,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+	"errors"
+
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// RedeliverAssistantWebhookLog queues a dead webhook delivery again with a
+// fresh retry budget. Failed deliveries are listed through
+// GetAllAssistantWebhookLog with status FAILED.
+func (assistantApi *assistantGrpcApi) RedeliverAssistantWebhookLog(ctx context.Context, cepm *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for RedeliverAssistantWebhookLog")
+		return utils.Error[protos.GetAssistantWebhookLogResponse](
+			errors.New("unauthenticated request for redeliver assistant webhook log"),
+			"Please provider valid service credentials to perform RedeliverAssistantWebhookLog, read docs @ docs.rapida.ai",
+		)
+	}
+	_, err := assistantApi.webhookQueue.Redeliver(ctx, *iAuth.GetCurrentOrganizationId(), *iAuth.GetCurrentProjectId(), cepm.GetId())
+	if errors.Is(err, internal_webhook.ErrDeliveryNotFound) {
+		return utils.ErrorWithCode[protos.GetAssistantWebhookLogResponse](404, err, "No failed delivery for the webhook log.")
+	}
+	if err != nil {
+		assistantApi.logger.Errorf("unable to redeliver webhook log %d: %v", cepm.GetId(), err)
+		return utils.Error[protos.GetAssistantWebhookLogResponse](err, "Unable to redeliver the webhook, please try again.")
+	}
+
+	lg, err := assistantApi.assistantWebhookService.GetLog(ctx, iAuth, cepm.GetProjectId(), cepm.GetId())
+	if err != nil {
+		return utils.Error[protos.GetAssistantWebhookLogResponse](err, "Unable to get the webhook log for given id.")
+	}
+	wl := &protos.AssistantWebhookLog{}
+	if err := utils.Cast(lg, wl); err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant webhooklog to the response object")
+	}
+	return utils.Success[protos.GetAssistantWebhookLogResponse, *protos.AssistantWebhookLog](wl)
+}
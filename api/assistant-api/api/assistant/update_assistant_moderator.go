@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_api
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/exceptions"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// UpdateAssistantModerator implements protos.AssistantServiceServer.
+func (assistantApi *assistantGrpcApi) UpdateAssistantModerator(ctx context.Context, cawr *protos.UpdateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasProject() {
+		assistantApi.logger.Errorf("unauthenticated request for UpdateAssistantModerator")
+		return exceptions.AuthenticationError[protos.GetAssistantModeratorResponse]()
+	}
+	wl, err := assistantApi.assistantModeratorService.Update(
+		ctx,
+		iAuth,
+		cawr.GetId(),
+		cawr.GetAssistantId(),
+		cawr.GetStage(),
+		cawr.GetType(),
+		cawr.GetName(),
+		cawr.GetOptions())
+	if err != nil {
+		return exceptions.BadRequestError[protos.GetAssistantModeratorResponse](err.Error())
+	}
+	out := &protos.AssistantModerator{}
+	err = utils.Cast(wl, out)
+	if err != nil {
+		assistantApi.logger.Errorf("unable to cast the assistant moderator to the response object")
+	}
+	return utils.Success[protos.GetAssistantModeratorResponse, *protos.AssistantModerator](out)
+}
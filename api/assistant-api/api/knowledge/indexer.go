@@ -33,7 +33,7 @@ type indexerGrpcApi struct {
 func NewDocumentGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 	postgres connectors.PostgresConnector,
 	redis connectors.RedisConnector,
-	opensearch connectors.OpenSearchConnector,
+	vectordb connectors.VectorConnector,
 ) knowledge_api.DocumentServiceServer {
 	return &indexerGrpcApi{
 		indexerApi{
@@ -42,7 +42,7 @@ func NewDocumentGRPCApi(config *config.AssistantConfig, logger commons.Logger,
 			postgres:                 postgres,
 			redis:                    redis,
 			knowledgeService:         internal_knowledge_service.NewKnowledgeService(config, logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
-			knowledgeDocumentService: internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, opensearch),
+			knowledgeDocumentService: internal_knowledge_service.NewKnowledgeDocumentService(config, logger, postgres, vectordb),
 			indexerServiceClient:     document_client.NewIndexerServiceClient(&config.AppConfig, logger, redis),
 		},
 	}
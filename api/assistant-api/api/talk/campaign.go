@@ -0,0 +1,383 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package assistant_talk_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	internal_campaign "github.com/rapidaai/api/assistant-api/internal/campaign"
+	channel_telephony "github.com/rapidaai/api/assistant-api/internal/channel/telephony"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newCampaign creates an unsaved campaign of an assistant, its calls are
+// placed with the credential of the assistant's phone deployment.
+func newCampaign(auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, version, name, fromNumber string) (*internal_campaign.Campaign, error) {
+	if auth.GetCurrentOrganizationId() == nil || auth.GetCurrentProjectId() == nil {
+		return nil, fmt.Errorf("campaign requires a project scope")
+	}
+	if !assistant.IsPhoneDeploymentEnable() {
+		return nil, fmt.Errorf("phone deployment not enabled for assistant %d", assistant.Id)
+	}
+	credentialID, err := assistant.AssistantPhoneDeployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential ID: %w", err)
+	}
+	return &internal_campaign.Campaign{
+		OrganizationID: *auth.GetCurrentOrganizationId(),
+		ProjectID:      *auth.GetCurrentProjectId(),
+		AssistantID:    assistant.Id,
+		Version:        version,
+		CredentialID:   credentialID,
+		Name:           name,
+		FromNumber:     fromNumber,
+	}, nil
+}
+
+// newCampaignContact creates an unsaved contact for an outbound call.
+func newCampaignContact(call *channel_telephony.OutboundCall, timezone string) (*internal_campaign.Contact, error) {
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("invalid timezone of %s: %w", call.ToNumber, err)
+		}
+	}
+	contact := &internal_campaign.Contact{
+		PhoneNumber:    call.ToNumber,
+		Timezone:       timezone,
+		ConversationID: call.ConversationID,
+	}
+	for _, v := range []struct {
+		in  map[string]interface{}
+		out *string
+	}{
+		{call.Args, &contact.Arguments},
+		{call.Options, &contact.Options},
+		{call.Metadata, &contact.Metadata},
+	} {
+		if len(v.in) == 0 {
+			continue
+		}
+		b, err := json.Marshal(v.in)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contact %s: %w", call.ToNumber, err)
+		}
+		*v.out = string(b)
+	}
+	return contact, nil
+}
+
+func campaignContacts(requests []*protos.CampaignContact) ([]*internal_campaign.Contact, error) {
+	contacts := make([]*internal_campaign.Contact, 0, len(requests))
+	for _, r := range requests {
+		if r == nil || utils.IsEmpty(r.GetPhoneNumber()) {
+			return nil, fmt.Errorf("contact is missing phoneNumber")
+		}
+		call := &channel_telephony.OutboundCall{ToNumber: r.GetPhoneNumber()}
+		for _, v := range []struct {
+			in  map[string]*anypb.Any
+			out *map[string]interface{}
+		}{
+			{r.GetArgs(), &call.Args},
+			{r.GetOptions(), &call.Options},
+			{r.GetMetadata(), &call.Metadata},
+		} {
+			m, err := utils.AnyMapToInterfaceMap(v.in)
+			if err != nil {
+				return nil, fmt.Errorf("invalid contact %s: %w", r.GetPhoneNumber(), err)
+			}
+			*v.out = m
+		}
+		contact, err := newCampaignContact(call, r.GetTimezone())
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+	return contacts, nil
+}
+
+// campaignScope returns the authenticated principle of a campaign request,
+// campaigns always belong to a project.
+func campaignScope[R any](ctx context.Context) (types.SimplePrinciple, *R, error) {
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		out, err := utils.AuthenticateError[R]()
+		return nil, out, err
+	}
+	if auth.GetCurrentOrganizationId() == nil || auth.GetCurrentProjectId() == nil {
+		out, err := utils.ErrorWithCode[R](400, fmt.Errorf("missing project scope"), "Campaigns require a project scope.")
+		return nil, out, err
+	}
+	return auth, nil, nil
+}
+
+// campaignError maps the errors of the campaign store to a response.
+func campaignError[R any](cApi *ConversationGrpcApi, err error, message string) (*R, error) {
+	switch {
+	case errors.Is(err, internal_campaign.ErrCampaignNotFound):
+		return utils.ErrorWithCode[R](404, err, "Campaign not found, please check and try again.")
+	case errors.Is(err, internal_campaign.ErrInvalidTransition):
+		return utils.ErrorWithCode[R](409, err, err.Error())
+	case errors.Is(err, internal_campaign.ErrInvalidCredentialLimit):
+		return utils.ErrorWithCode[R](400, err, err.Error())
+	default:
+		cApi.logger.Errorf("%s: %v", message, err)
+		return utils.ErrorWithCode[R](500, err, message+", please try again.")
+	}
+}
+
+// campaignResponse converts a campaign and its optional progress.
+func campaignResponse(campaign *internal_campaign.Campaign, progress *internal_campaign.Progress) *protos.GetCampaignResponse {
+	out := &protos.GetCampaignResponse{
+		Code:    200,
+		Success: true,
+		Data: &protos.Campaign{
+			Id:           campaign.Id,
+			Status:       campaign.Status,
+			AssistantId:  campaign.AssistantID,
+			Version:      campaign.Version,
+			CredentialId: campaign.CredentialID,
+			Name:         campaign.Name,
+			FromNumber:   campaign.FromNumber,
+			Timezone:     campaign.Timezone,
+			WindowStart:  campaign.WindowStart,
+			WindowEnd:    campaign.WindowEnd,
+			MaxAttempts:  campaign.MaxAttempts,
+			RetryRules:   campaign.GetRetryRules(),
+			StartAt:      timestamppb.New(campaign.StartAt),
+			CreatedDate:  timestamppb.New(campaign.CreatedDate),
+		},
+	}
+	if !campaign.UpdatedDate.IsZero() {
+		out.Data.UpdatedDate = timestamppb.New(campaign.UpdatedDate)
+	}
+	if progress != nil {
+		out.Progress = &protos.CampaignProgress{
+			Total:    progress.Total,
+			Attempts: progress.Attempts,
+			Statuses: progress.Statuses,
+		}
+	}
+	return out
+}
+
+// CreateCampaign implements protos.TalkServiceServer. It creates an outbound
+// call campaign of an assistant, its contacts are dialed by the campaign
+// engine within the calling window.
+func (cApi *ConversationGrpcApi) CreateCampaign(ctx context.Context, ir *protos.CreateCampaignRequest) (*protos.GetCampaignResponse, error) {
+	auth, out, err := campaignScope[protos.GetCampaignResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	if utils.IsEmpty(ir.GetName()) {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, fmt.Errorf("missing name"), "Please provide the campaign name.")
+	}
+	if _, err := internal_campaign.ParseWindow(ir.GetWindowStart(), ir.GetWindowEnd()); err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, err, err.Error())
+	}
+	if ir.GetTimezone() != "" {
+		if _, err := time.LoadLocation(ir.GetTimezone()); err != nil {
+			return utils.ErrorWithCode[protos.GetCampaignResponse](400, err, fmt.Sprintf("Invalid timezone %s.", ir.GetTimezone()))
+		}
+	}
+	contacts, err := campaignContacts(ir.GetContacts())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, err, err.Error())
+	}
+
+	assistant, err := cApi.assistantService.Get(ctx, auth, ir.GetAssistantId(), utils.GetVersionDefinition(ir.GetVersion()), &internal_services.GetAssistantOption{InjectPhoneDeployment: true})
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, err, "Invalid assistant id, please check and try again.")
+	}
+	campaign, err := newCampaign(auth, assistant, ir.GetVersion(), ir.GetName(), ir.GetFromNumber())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, err, err.Error())
+	}
+	campaign.Timezone = ir.GetTimezone()
+	campaign.WindowStart = ir.GetWindowStart()
+	campaign.WindowEnd = ir.GetWindowEnd()
+	campaign.MaxAttempts = ir.GetMaxAttempts()
+	if ir.GetRetryRules() != nil {
+		campaign.SetRetryRules(ir.GetRetryRules())
+	}
+	if ir.GetStartAt() != nil {
+		campaign.StartAt = ir.GetStartAt().AsTime()
+	}
+	if err := cApi.campaignStore.CreateCampaign(ctx, campaign, contacts); err != nil {
+		return campaignError[protos.GetCampaignResponse](cApi, err, "Unable to create campaign")
+	}
+	return campaignResponse(campaign, nil), nil
+}
+
+// GetCampaign implements protos.TalkServiceServer. It returns a campaign with
+// the count of its contacts by status.
+func (cApi *ConversationGrpcApi) GetCampaign(ctx context.Context, ir *protos.GetCampaignRequest) (*protos.GetCampaignResponse, error) {
+	auth, out, err := campaignScope[protos.GetCampaignResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	campaign, err := cApi.campaignStore.GetCampaign(ctx, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), ir.GetCampaignId())
+	if err != nil {
+		return campaignError[protos.GetCampaignResponse](cApi, err, "Unable to get campaign")
+	}
+	progress, err := cApi.campaignStore.Progress(ctx, campaign.Id)
+	if err != nil {
+		return campaignError[protos.GetCampaignResponse](cApi, err, "Unable to get campaign progress")
+	}
+	return campaignResponse(campaign, progress), nil
+}
+
+// AddCampaignContacts implements protos.TalkServiceServer. It adds contacts
+// to a campaign, a completed campaign runs again for them.
+func (cApi *ConversationGrpcApi) AddCampaignContacts(ctx context.Context, ir *protos.AddCampaignContactsRequest) (*protos.GetCampaignResponse, error) {
+	auth, out, err := campaignScope[protos.GetCampaignResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	if len(ir.GetContacts()) == 0 {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, fmt.Errorf("missing contacts"), "Please provide the contacts to add.")
+	}
+	contacts, err := campaignContacts(ir.GetContacts())
+	if err != nil {
+		return utils.ErrorWithCode[protos.GetCampaignResponse](400, err, err.Error())
+	}
+	campaign, err := cApi.campaignStore.GetCampaign(ctx, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), ir.GetCampaignId())
+	if err != nil {
+		return campaignError[protos.GetCampaignResponse](cApi, err, "Unable to get campaign")
+	}
+	if err := cApi.campaignStore.AddContacts(ctx, campaign, contacts); err != nil {
+		return campaignError[protos.GetCampaignResponse](cApi, err, "Unable to add campaign contacts")
+	}
+	progress, err := cApi.campaignStore.Progress(ctx, campaign.Id)
+	if err != nil {
+		return campaignError[protos.GetCampaignResponse](cApi, err, "Unable to get campaign progress")
+	}
+	return campaignResponse(campaign, progress), nil
+}
+
+// PauseCampaign implements protos.TalkServiceServer. It stops placing the
+// calls of a campaign, calls in flight finish.
+func (cApi *ConversationGrpcApi) PauseCampaign(ctx context.Context, ir *protos.UpdateCampaignStatusRequest) (*protos.UpdateCampaignStatusResponse, error) {
+	return cApi.transitionCampaign(ctx, ir.GetCampaignId(), internal_campaign.StatusPaused)
+}
+
+// ResumeCampaign implements protos.TalkServiceServer. It places the calls of
+// a paused campaign again.
+func (cApi *ConversationGrpcApi) ResumeCampaign(ctx context.Context, ir *protos.UpdateCampaignStatusRequest) (*protos.UpdateCampaignStatusResponse, error) {
+	return cApi.transitionCampaign(ctx, ir.GetCampaignId(), internal_campaign.StatusRunning)
+}
+
+// CancelCampaign implements protos.TalkServiceServer. It stops a campaign for
+// good and cancels its pending contacts.
+func (cApi *ConversationGrpcApi) CancelCampaign(ctx context.Context, ir *protos.UpdateCampaignStatusRequest) (*protos.UpdateCampaignStatusResponse, error) {
+	return cApi.transitionCampaign(ctx, ir.GetCampaignId(), internal_campaign.StatusCancelled)
+}
+
+func (cApi *ConversationGrpcApi) transitionCampaign(ctx context.Context, campaignId uint64, to string) (*protos.UpdateCampaignStatusResponse, error) {
+	auth, out, err := campaignScope[protos.UpdateCampaignStatusResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	campaign, err := cApi.campaignStore.Transition(ctx, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), campaignId, to)
+	switch {
+	case errors.Is(err, internal_campaign.ErrCampaignNotFound):
+		return utils.ErrorWithCode[protos.UpdateCampaignStatusResponse](404, err, "Campaign not found, please check and try again.")
+	case errors.Is(err, internal_campaign.ErrInvalidTransition):
+		return utils.ErrorWithCode[protos.UpdateCampaignStatusResponse](409, err, fmt.Sprintf("Campaign can not be moved to %s.", to))
+	case err != nil:
+		cApi.logger.Errorf("unable to update campaign %d: %+v", campaignId, err)
+		return utils.ErrorWithCode[protos.UpdateCampaignStatusResponse](500, err, "Unable to update campaign, please try again.")
+	}
+	return &protos.UpdateCampaignStatusResponse{
+		Code:       200,
+		Success:    true,
+		CampaignId: campaign.Id,
+		Status:     campaign.Status,
+	}, nil
+}
+
+// AddDoNotCallNumbers implements protos.TalkServiceServer. It adds numbers
+// to the do-not-call list of the project, their pending campaign contacts
+// are suppressed.
+func (cApi *ConversationGrpcApi) AddDoNotCallNumbers(ctx context.Context, ir *protos.DoNotCallRequest) (*protos.DoNotCallResponse, error) {
+	auth, out, err := campaignScope[protos.DoNotCallResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	if len(ir.GetPhoneNumbers()) == 0 {
+		return utils.ErrorWithCode[protos.DoNotCallResponse](400, fmt.Errorf("missing phoneNumbers"), "Please provide the numbers to suppress.")
+	}
+	if err := cApi.campaignStore.SuppressNumbers(ctx, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), ir.GetPhoneNumbers(), ir.GetReason()); err != nil {
+		return campaignError[protos.DoNotCallResponse](cApi, err, "Unable to suppress numbers")
+	}
+	return &protos.DoNotCallResponse{
+		Code:         200,
+		Success:      true,
+		PhoneNumbers: ir.GetPhoneNumbers(),
+	}, nil
+}
+
+// RemoveDoNotCallNumbers implements protos.TalkServiceServer. It removes
+// numbers from the do-not-call list of the project.
+func (cApi *ConversationGrpcApi) RemoveDoNotCallNumbers(ctx context.Context, ir *protos.DoNotCallRequest) (*protos.DoNotCallResponse, error) {
+	auth, out, err := campaignScope[protos.DoNotCallResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	for _, phoneNumber := range ir.GetPhoneNumbers() {
+		if err := cApi.campaignStore.UnsuppressNumber(ctx, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), phoneNumber); err != nil {
+			return campaignError[protos.DoNotCallResponse](cApi, err, "Unable to unsuppress number")
+		}
+	}
+	return &protos.DoNotCallResponse{
+		Code:         200,
+		Success:      true,
+		PhoneNumbers: ir.GetPhoneNumbers(),
+	}, nil
+}
+
+// SetCredentialLimit implements protos.TalkServiceServer. It sets the
+// concurrency and calls per second campaigns place calls with through a
+// provider credential.
+func (cApi *ConversationGrpcApi) SetCredentialLimit(ctx context.Context, ir *protos.CredentialLimitRequest) (*protos.CredentialLimitResponse, error) {
+	auth, out, err := campaignScope[protos.CredentialLimitResponse](ctx)
+	if auth == nil {
+		return out, err
+	}
+	if ir.GetMaxConcurrentCalls() == 0 {
+		return utils.ErrorWithCode[protos.CredentialLimitResponse](400, internal_campaign.ErrInvalidCredentialLimit, "maxConcurrentCalls must be positive.")
+	}
+	if ir.GetCallsPerSecond() <= 0 {
+		return utils.ErrorWithCode[protos.CredentialLimitResponse](400, internal_campaign.ErrInvalidCredentialLimit, "callsPerSecond must be positive.")
+	}
+	limit := &internal_campaign.CredentialLimit{
+		OrganizationID:     *auth.GetCurrentOrganizationId(),
+		ProjectID:          *auth.GetCurrentProjectId(),
+		CredentialID:       ir.GetCredentialId(),
+		MaxConcurrentCalls: ir.GetMaxConcurrentCalls(),
+		CallsPerSecond:     ir.GetCallsPerSecond(),
+	}
+	if err := cApi.campaignStore.SetCredentialLimit(ctx, limit); err != nil {
+		return campaignError[protos.CredentialLimitResponse](cApi, err, "Unable to set credential limit")
+	}
+	return &protos.CredentialLimitResponse{
+		Code:               200,
+		Success:            true,
+		CredentialId:       limit.CredentialID,
+		MaxConcurrentCalls: limit.MaxConcurrentCalls,
+		CallsPerSecond:     limit.CallsPerSecond,
+	}, nil
+}
@@ -46,6 +46,23 @@ func (cApi *ConversationApi) CallbackByContext(c *gin.Context) {
 	c.Status(http.StatusCreated)
 }
 
+// TransferDestination is fetched by the provider flow after the media stream
+// of a transferred call ended, it answers with the destination the assistant
+// transferred the call to. The call is resolved from the provider call id.
+// Route: GET /:telephony/transfer
+func (cApi *ConversationApi) TransferDestination(c *gin.Context) {
+	callSid := c.Query("CallSid")
+	if callSid == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing CallSid"})
+		return
+	}
+	if err := cApi.inboundDispatcher.HandleTransferDestination(c, c.Param("telephony"), callSid); err != nil {
+		cApi.logger.Errorf("transfer destination failed for call %s: %v", callSid, err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "No transfer destination"})
+		return
+	}
+}
+
 // CallReciever handles incoming calls for the given assistant.
 // The telephony provider sends a webhook when an inbound call arrives.
 // This handler creates a conversation, saves a CallContext to Postgres, and returns
@@ -108,7 +125,8 @@ func (cApi *ConversationApi) CallTalkerByContext(c *gin.Context) {
 	}
 
 	streamer, err := telephony.Telephony(cc.Provider).NewStreamer(cApi.logger, cc, vaultCred, telephony.StreamerOption{
-		WebSocketConn: websocketConnection,
+		WebSocketConn:    websocketConnection,
+		CallContextStore: cApi.callContextStore,
 	})
 	if err != nil {
 		cApi.logger.Errorf("error creating streamer for context %s: %v", contextID, err)
@@ -116,7 +134,7 @@ func (cApi *ConversationApi) CallTalkerByContext(c *gin.Context) {
 		return
 	}
 
-	talker, err := internal_adapter.GetTalker(utils.PhoneCall, c, cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.redis, cApi.storage, streamer)
+	talker, err := internal_adapter.GetTalker(utils.PhoneCall, c, cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.vectordb, cApi.redis, cApi.storage, streamer)
 	if err != nil {
 		cApi.logger.Errorf("error creating talker for context %s: %v", contextID, err)
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid talker"})
@@ -8,8 +8,12 @@ package assistant_talk_api
 import (
 	"context"
 	"fmt"
+	"time"
 
 	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	internal_campaign "github.com/rapidaai/api/assistant-api/internal/campaign"
+	channel_telephony "github.com/rapidaai/api/assistant-api/internal/channel/telephony"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
@@ -153,23 +157,123 @@ func (cApi *ConversationGrpcApi) CreatePhoneCall(ctx context.Context, ir *protos
 	return utils.Success[protos.CreatePhoneCallResponse, *protos.AssistantConversation](out)
 }
 
-// InitiateBulkAssistantTalk implements protos.TalkServiceServer.
+// CreateBulkPhoneCall implements protos.TalkServiceServer. The calls are
+// stored as a campaign per assistant and from number and are placed by the
+// campaign engine, paced by the limits of the provider credential. The
+// conversation of every call is created right away and returned, numbers on
+// the do-not-call list are skipped.
 func (cApi *ConversationGrpcApi) CreateBulkPhoneCall(ctx context.Context, ir *protos.CreateBulkPhoneCallRequest) (*protos.CreateBulkPhoneCallResponse, error) {
-	_, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	auth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
 	if !isAuthenticated {
 		cApi.logger.Errorf("unable to resolve the authentication object, please check the parameter for authentication")
 		return utils.AuthenticateError[protos.CreateBulkPhoneCallResponse]()
 	}
+	if auth.GetCurrentOrganizationId() == nil || auth.GetCurrentProjectId() == nil {
+		return utils.ErrorWithCode[protos.CreateBulkPhoneCallResponse](200, fmt.Errorf("missing project scope"), "Bulk phone calls require a project scope.")
+	}
 
-	out := make([]*protos.AssistantConversation, 0)
+	numbers := make([]string, 0, len(ir.GetPhoneCalls()))
 	for _, v := range ir.GetPhoneCalls() {
-		resp, err := cApi.CreatePhoneCall(ctx, v)
+		numbers = append(numbers, v.GetToNumber())
+	}
+	suppressed, err := cApi.campaignStore.Suppressed(ctx, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId(), numbers)
+	if err != nil {
+		cApi.logger.Errorf("unable to read do-not-call list %+v", err)
+		return utils.ErrorWithCode[protos.CreateBulkPhoneCallResponse](500, err, "Unable to create bulk phone calls, please try again.")
+	}
+
+	type bulkCampaign struct {
+		assistant     *internal_assistant_entity.Assistant
+		campaign      *internal_campaign.Campaign
+		contacts      []*internal_campaign.Contact
+		conversations []*protos.AssistantConversation
+	}
+	campaigns := make(map[string]*bulkCampaign)
+	order := make([]*bulkCampaign, 0)
+	for _, v := range ir.GetPhoneCalls() {
+		toNumber := v.GetToNumber()
+		if utils.IsEmpty(toNumber) {
+			cApi.logger.Errorf("skipping bulk phone call without to_phone")
+			continue
+		}
+		call, err := outboundCall(v)
+		if err != nil {
+			cApi.logger.Errorf("skipping bulk phone call to %s: %+v", toNumber, err)
+			continue
+		}
+
+		key := fmt.Sprintf("%d/%s/%s", v.GetAssistant().GetAssistantId(), v.GetAssistant().GetVersion(), v.GetFromNumber())
+		bc, ok := campaigns[key]
+		if !ok {
+			assistant, err := cApi.assistantService.Get(ctx, auth, v.GetAssistant().GetAssistantId(), utils.GetVersionDefinition(v.GetAssistant().GetVersion()), &internal_services.GetAssistantOption{InjectPhoneDeployment: true})
+			if err != nil {
+				cApi.logger.Errorf("skipping bulk phone call to %s, invalid assistant: %+v", toNumber, err)
+				continue
+			}
+			campaign, err := newCampaign(auth, assistant, v.GetAssistant().GetVersion(), fmt.Sprintf("bulk call %s", time.Now().Format(time.RFC3339)), v.GetFromNumber())
+			if err != nil {
+				cApi.logger.Errorf("skipping bulk phone call to %s: %+v", toNumber, err)
+				continue
+			}
+			bc = &bulkCampaign{assistant: assistant, campaign: campaign}
+			campaigns[key] = bc
+			order = append(order, bc)
+		}
+
+		if !suppressed[toNumber] {
+			conversation, err := cApi.outboundDispatcher.CreateConversation(ctx, auth, bc.assistant, call)
+			if err != nil {
+				cApi.logger.Errorf("skipping bulk phone call to %s: %+v", toNumber, err)
+				continue
+			}
+			call.ConversationID = conversation.Id
+			out := &protos.AssistantConversation{}
+			if err := utils.Cast(conversation, out); err != nil {
+				cApi.logger.Errorf("unable to cast assistant conversation %v", err)
+			}
+			bc.conversations = append(bc.conversations, out)
+		}
+		contact, err := newCampaignContact(call, "")
 		if err != nil {
-			cApi.logger.Errorf("error while making call %+v", err)
+			cApi.logger.Errorf("skipping bulk phone call to %s: %+v", toNumber, err)
+			continue
 		}
-		if resp.GetData() != nil {
-			out = append(out, resp.GetData())
+		bc.contacts = append(bc.contacts, contact)
+	}
+
+	out := make([]*protos.AssistantConversation, 0)
+	for _, bc := range order {
+		if err := cApi.campaignStore.CreateCampaign(ctx, bc.campaign, bc.contacts); err != nil {
+			cApi.logger.Errorf("unable to create campaign for bulk phone calls %+v", err)
+			for _, conversation := range bc.conversations {
+				cApi.assistantConversationService.ApplyConversationMetrics(ctx, auth, bc.assistant.Id, conversation.GetId(), []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)})
+			}
+			continue
 		}
+		out = append(out, bc.conversations...)
 	}
 	return utils.Success[protos.CreateBulkPhoneCallResponse, []*protos.AssistantConversation](out)
 }
+
+// outboundCall reads the arguments, options and metadata of a phone call request.
+func outboundCall(ir *protos.CreatePhoneCallRequest) (*channel_telephony.OutboundCall, error) {
+	mtd, err := utils.AnyMapToInterfaceMap(ir.GetMetadata())
+	if err != nil {
+		return nil, fmt.Errorf("illegal metadata: %w", err)
+	}
+	args, err := utils.AnyMapToInterfaceMap(ir.GetArgs())
+	if err != nil {
+		return nil, fmt.Errorf("illegal arguments: %w", err)
+	}
+	opts, err := utils.AnyMapToInterfaceMap(ir.GetOptions())
+	if err != nil {
+		return nil, fmt.Errorf("illegal options: %w", err)
+	}
+	return &channel_telephony.OutboundCall{
+		ToNumber:   ir.GetToNumber(),
+		FromNumber: ir.GetFromNumber(),
+		Args:       args,
+		Options:    opts,
+		Metadata:   mtd,
+	}, nil
+}
@@ -11,9 +11,12 @@ import (
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
 	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	internal_campaign "github.com/rapidaai/api/assistant-api/internal/campaign"
 	internal_grpc "github.com/rapidaai/api/assistant-api/internal/channel/grpc"
 	channel_telephony "github.com/rapidaai/api/assistant-api/internal/channel/telephony"
 	internal_webrtc "github.com/rapidaai/api/assistant-api/internal/channel/webrtc"
+	channel_whatsapp "github.com/rapidaai/api/assistant-api/internal/channel/whatsapp"
+	whatsapp_voice "github.com/rapidaai/api/assistant-api/internal/channel/whatsapp/voice"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
 	sip_infra "github.com/rapidaai/api/assistant-api/sip/infra"
@@ -33,11 +36,14 @@ type ConversationApi struct {
 	postgres   connectors.PostgresConnector
 	redis      connectors.RedisConnector
 	opensearch connectors.OpenSearchConnector
+	vectordb   connectors.VectorConnector
 	storage    storages.Storage
 
 	callContextStore             callcontext.Store
+	campaignStore                internal_campaign.Store
 	outboundDispatcher           *channel_telephony.OutboundDispatcher
 	inboundDispatcher            *channel_telephony.InboundDispatcher
+	whatsappDispatcher           *channel_whatsapp.Dispatcher
 	assistantConversationService internal_services.AssistantConversationService
 	assistantService             internal_services.AssistantService
 	vaultClient                  web_client.VaultClient
@@ -54,6 +60,7 @@ func newConversationApiCore(cfg *config.AssistantConfig, logger commons.Logger,
 	postgres connectors.PostgresConnector,
 	redis connectors.RedisConnector,
 	opensearch connectors.OpenSearchConnector,
+	vectordb connectors.VectorConnector,
 	sipServer *sip_infra.Server,
 ) *ConversationApi {
 	store := callcontext.NewStore(postgres, logger)
@@ -72,13 +79,15 @@ func newConversationApiCore(cfg *config.AssistantConfig, logger commons.Logger,
 		TelephonyOpt:        channel_telephony.TelephonyOption{SIPServer: sipServer},
 	}
 
-	return &ConversationApi{
+	cApi := &ConversationApi{
 		cfg:                          cfg,
 		logger:                       logger,
 		postgres:                     postgres,
 		redis:                        redis,
 		opensearch:                   opensearch,
+		vectordb:                     vectordb,
 		callContextStore:             store,
+		campaignStore:                internal_campaign.NewStore(postgres, logger),
 		outboundDispatcher:           channel_telephony.NewOutboundDispatcher(telephonyDeps),
 		inboundDispatcher:            channel_telephony.NewInboundDispatcher(telephonyDeps),
 		assistantConversationService: conversationService,
@@ -87,6 +96,17 @@ func newConversationApiCore(cfg *config.AssistantConfig, logger commons.Logger,
 		vaultClient:                  vaultClient,
 		authClient:                   web_client.NewAuthenticator(&cfg.AppConfig, logger, redis),
 	}
+	cApi.whatsappDispatcher = channel_whatsapp.NewDispatcher(channel_whatsapp.DispatcherDeps{
+		Cfg:                 cfg,
+		Logger:              logger,
+		Store:               channel_whatsapp.NewSessionStore(redis),
+		VaultClient:         vaultClient,
+		DeploymentService:   internal_assistant_service.NewAssistantDeploymentService(cfg, logger, postgres),
+		ConversationService: conversationService,
+		Transcriber:         whatsapp_voice.NewTranscriber(logger, vaultClient),
+		NewTalker:           cApi.newWhatsappTalker,
+	})
+	return cApi
 }
 
 func NewConversationGRPCApi(config *config.AssistantConfig, logger commons.Logger,
@@ -96,7 +116,7 @@ func NewConversationGRPCApi(config *config.AssistantConfig, logger commons.Logge
 	vectordb connectors.VectorConnector,
 	sipServer *sip_infra.Server,
 ) assistant_api.TalkServiceServer {
-	return &ConversationGrpcApi{*newConversationApiCore(config, logger, postgres, redis, opensearch, sipServer)}
+	return &ConversationGrpcApi{*newConversationApiCore(config, logger, postgres, redis, opensearch, vectordb, sipServer)}
 }
 
 func NewWebRtcApi(config *config.AssistantConfig, logger commons.Logger,
@@ -106,7 +126,7 @@ func NewWebRtcApi(config *config.AssistantConfig, logger commons.Logger,
 	vectordb connectors.VectorConnector,
 	sipServer *sip_infra.Server,
 ) assistant_api.WebRTCServer {
-	return &ConversationGrpcApi{*newConversationApiCore(config, logger, postgres, redis, opensearch, sipServer)}
+	return &ConversationGrpcApi{*newConversationApiCore(config, logger, postgres, redis, opensearch, vectordb, sipServer)}
 }
 
 func NewConversationApi(config *config.AssistantConfig, logger commons.Logger,
@@ -116,7 +136,7 @@ func NewConversationApi(config *config.AssistantConfig, logger commons.Logger,
 	vectordb connectors.VectorConnector,
 	sipServer *sip_infra.Server,
 ) *ConversationApi {
-	return newConversationApiCore(config, logger, postgres, redis, opensearch, sipServer)
+	return newConversationApiCore(config, logger, postgres, redis, opensearch, vectordb, sipServer)
 }
 
 // AssistantTalk handles incoming assistant talk requests.
@@ -151,6 +171,7 @@ func (cApi *ConversationGrpcApi) AssistantTalk(stream assistant_api.TalkService_
 		cApi.logger,
 		cApi.postgres,
 		cApi.opensearch,
+		cApi.vectordb,
 		cApi.redis,
 		cApi.storage,
 		streamer,
@@ -187,6 +208,7 @@ func (cApi *ConversationGrpcApi) WebTalk(stream assistant_api.WebRTC_WebTalkServ
 		cApi.logger,
 		cApi.postgres,
 		cApi.opensearch,
+		cApi.vectordb,
 		cApi.redis,
 		cApi.storage,
 		streamer,
@@ -6,75 +6,74 @@
 package assistant_talk_api
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
-	"net/url"
-	"strings"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rapidaai/pkg/commons"
+	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
+	channel_whatsapp "github.com/rapidaai/api/assistant-api/internal/channel/whatsapp"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
 )
 
-// WhatsappReciever handles incoming WhatsApp messages and sends a response.
+// newWhatsappTalker creates the text conversation of a WhatsApp session.
+func (cApi *ConversationApi) newWhatsappTalker(ctx context.Context, streamer internal_type.Streamer) (channel_whatsapp.Talker, error) {
+	return internal_adapter.GetTalker(utils.Whatsapp, ctx, cApi.cfg, cApi.logger, cApi.postgres, cApi.opensearch, cApi.vectordb, cApi.redis, cApi.storage, streamer)
+}
+
+// WhatsappReciever handles incoming WhatsApp messages for the given assistant.
+// The messages of a sender are routed into the conversation of their session
+// and replies are sent back through the provider of the WhatsApp deployment.
 // @Router /v1/whatsapp/:assistantId [post]
 // @Summary Recieve whatsapp message and respond
 // @Produce json
 // @Success 200 {object} commons.Response
 // @Failure 500 {object} commons.Response
 func (cApi *ConversationApi) WhatsappReciever(c *gin.Context) {
-	assistantId := c.Param("assistantId") // Extract assistantId from URL
-	from := c.PostForm("From")            // Sender's WhatsApp number
-	body := c.PostForm("Body")            // Message content
-	// Logging the received message
-	fmt.Printf("Assistant ID: %s, Received message from %s: %s\n", assistantId, from, body)
-
-	// Responding with a simple text message
-	responseMessage := "Hello! Thanks for your message. We'll get back to you shortly."
-
-	// Call a function to send WhatsApp message using Twilio
-	err := sendWhatsAppMessage(from, responseMessage)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, commons.Response{
-			Code:    400,
-			Success: false,
-			Data:    &commons.ErrorMessage{},
-		})
+	assistantId, ok := cApi.whatsappRequest(c)
+	if !ok {
 		return
 	}
-
-	c.JSON(http.StatusOK, commons.Response{
-		Code:    200,
-		Success: true,
-	})
+	if err := cApi.whatsappDispatcher.HandleMessage(c, assistantId); err != nil {
+		cApi.logger.Errorf("failed to handle whatsapp message for assistant %d: %v", assistantId, err)
+		if errors.Is(err, channel_whatsapp.ErrInvalidSignature) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Invalid signature"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unable to process message"})
+	}
 }
 
-// Helper function to send WhatsApp message using Twilio
-func sendWhatsAppMessage(to, body string) error {
-	accountSid := "YOUR_TWILIO_ACCOUNT_SID"
-	authToken := "YOUR_TWILIO_AUTH_TOKEN"
-	from := "whatsapp:+YOUR_TWILIO_NUMBER"
-
-	msgData := url.Values{}
-	msgData.Set("To", to)
-	msgData.Set("From", from)
-	msgData.Set("Body", body)
-	msgDataReader := *strings.NewReader(msgData.Encode())
-
-	client := &http.Client{}
-	req, _ := http.NewRequest("POST", "https://api.twilio.com/2010-04-01/Accounts/"+accountSid+"/Messages.json", &msgDataReader)
-	req.SetBasicAuth(accountSid, authToken)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
-
-	resp, err := client.Do(req)
+// WhatsappVerification answers the webhook subscription challenge of the
+// provider of the WhatsApp deployment.
+// @Router /v1/whatsapp/:assistantId [get]
+// @Summary Verify whatsapp webhook subscription
+// @Produce plain
+// @Success 200 {string} string
+// @Failure 403 {object} commons.Response
+func (cApi *ConversationApi) WhatsappVerification(c *gin.Context) {
+	assistantId, ok := cApi.whatsappRequest(c)
+	if !ok {
+		return
+	}
+	challenge, err := cApi.whatsappDispatcher.HandleChallenge(c, assistantId)
 	if err != nil {
-		return err
+		cApi.logger.Errorf("failed to verify whatsapp webhook for assistant %d: %v", assistantId, err)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Invalid verification"})
+		return
 	}
-	defer resp.Body.Close()
+	c.String(http.StatusOK, challenge)
+}
 
-	if resp.StatusCode >= 300 {
-		return fmt.Errorf("failed to send message, status code: %d", resp.StatusCode)
+// whatsappRequest returns the assistant of a webhook request. The webhook url
+// carries no api key, requests are authenticated by the provider signature.
+func (cApi *ConversationApi) whatsappRequest(c *gin.Context) (uint64, bool) {
+	assistantId, err := strconv.ParseUint(c.Param("assistantId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assistant ID"})
+		return 0, false
 	}
-
-	return nil
+	return assistantId, true
 }
@@ -0,0 +1,88 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package assistant_campaign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	internal_campaign "github.com/rapidaai/api/assistant-api/internal/campaign"
+	internal_telephony "github.com/rapidaai/api/assistant-api/internal/channel/telephony"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	sip_infra "github.com/rapidaai/api/assistant-api/sip/infra"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// NewCampaignEngine creates the engine placing the calls of outbound
+// campaigns through the telephony OutboundDispatcher. sipServer is the
+// shared SIP server, nil when SIP is not configured.
+func NewCampaignEngine(cfg *config.AssistantConfig, logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+	opensearch connectors.OpenSearchConnector,
+	sipServer *sip_infra.Server,
+) *internal_campaign.Engine {
+	assistantService := internal_assistant_service.NewAssistantService(cfg, logger, postgres, opensearch)
+	deps := internal_telephony.TelephonyDispatcherDeps{
+		Cfg:                 cfg,
+		Logger:              logger,
+		Store:               callcontext.NewStore(postgres, logger),
+		VaultClient:         web_client.NewVaultClientGRPC(&cfg.AppConfig, logger, redis),
+		AssistantService:    assistantService,
+		ConversationService: internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(cfg.AssetStoreConfig, logger)),
+		TelephonyOpt:        internal_telephony.TelephonyOption{SIPServer: sipServer},
+	}
+	return internal_campaign.NewEngine(logger, internal_campaign.NewStore(postgres, logger), &telephonyDialer{
+		assistantService: assistantService,
+		outbound:         internal_telephony.NewOutboundDispatcher(deps),
+	})
+}
+
+// telephonyDialer places campaign calls like CreatePhoneCall does, with the
+// assistant's phone deployment.
+type telephonyDialer struct {
+	assistantService internal_services.AssistantService
+	outbound         *internal_telephony.OutboundDispatcher
+}
+
+func (d *telephonyDialer) Queue(ctx context.Context, c *internal_campaign.Campaign, contact *internal_campaign.Contact) (string, uint64, error) {
+	auth := c.ToAuth()
+	assistant, err := d.assistantService.Get(ctx, auth, c.AssistantID, utils.GetVersionDefinition(c.Version), &internal_services.GetAssistantOption{InjectPhoneDeployment: true})
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to load assistant %d: %w", c.AssistantID, err)
+	}
+	call := &internal_telephony.OutboundCall{
+		ToNumber:   contact.PhoneNumber,
+		FromNumber: c.FromNumber,
+	}
+	// the first attempt uses the conversation created with the contact
+	if contact.Attempt == 1 {
+		call.ConversationID = contact.ConversationID
+	}
+	if err := json.Unmarshal([]byte(contact.Arguments), &call.Args); err != nil {
+		return "", 0, fmt.Errorf("invalid arguments of contact %d: %w", contact.Id, err)
+	}
+	if err := json.Unmarshal([]byte(contact.Options), &call.Options); err != nil {
+		return "", 0, fmt.Errorf("invalid options of contact %d: %w", contact.Id, err)
+	}
+	if err := json.Unmarshal([]byte(contact.Metadata), &call.Metadata); err != nil {
+		return "", 0, fmt.Errorf("invalid metadata of contact %d: %w", contact.Id, err)
+	}
+	return d.outbound.Queue(ctx, auth, assistant, call)
+}
+
+func (d *telephonyDialer) Dispatch(ctx context.Context, contextID string) error {
+	return d.outbound.Dispatch(ctx, contextID)
+}
@@ -6,6 +6,7 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"os"
 
@@ -25,6 +26,13 @@ type SIPConfig struct {
 	RTPPortRangeEnd   int    `mapstructure:"rtp_port_range_end"`
 }
 
+// Knowledge vector backends selectable with vector_store
+const (
+	VectorStoreOpenSearch = "opensearch"
+	VectorStorePgVector   = "pgvector"
+	VectorStoreWeaviate   = "weaviate"
+)
+
 type AudioSocketConfig struct {
 	Host string `mapstructure:"host"`
 	Port int    `mapstructure:"port"`
@@ -36,10 +44,13 @@ type AssistantConfig struct {
 	RedisConfig         configs.RedisConfig       `mapstructure:"redis" validate:"required"`
 	OpenSearchConfig    *configs.OpenSearchConfig `mapstructure:"opensearch"`
 	WeaviateConfig      configs.WeaviateConfig    `mapstructure:"weaviate"`
+	VectorStore         string                    `mapstructure:"vector_store" validate:"omitempty,oneof=opensearch pgvector weaviate"` // knowledge vector backend, opensearch by default
+	PgVectorConfig      *configs.PostgresConfig   `mapstructure:"pgvector"`                                                             // separate pgvector database, defaults to postgres
 	AssetStoreConfig    configs.AssetStoreConfig  `mapstructure:"asset_store" validate:"required"`
 	PublicAssistantHost string                    `mapstructure:"public_assistant_host" validate:"required"`
 	SIPConfig           *SIPConfig                `mapstructure:"sip"`
 	AudioSocketConfig   *AudioSocketConfig        `mapstructure:"audiosocket"`
+	RateLimitConfig     *configs.RateLimitConfig  `mapstructure:"rate_limit"`
 }
 
 // reading config and intializing configs for application
@@ -79,6 +90,12 @@ func GetApplicationConfig(v *viper.Viper) (*AssistantConfig, error) {
 		(config.OpenSearchConfig.Host == "" || config.OpenSearchConfig.Schema == "") {
 		config.OpenSearchConfig = nil
 	}
+	if config.PgVectorConfig != nil && config.PgVectorConfig.Host == "" {
+		config.PgVectorConfig = nil
+	}
+	if config.VectorStore == "" {
+		config.VectorStore = VectorStoreOpenSearch
+	}
 	// valdating the app config
 	validate := validator.New()
 	err = validate.Struct(&config)
@@ -86,5 +103,8 @@ func GetApplicationConfig(v *viper.Viper) (*AssistantConfig, error) {
 		log.Printf("%+v\n", err)
 		return nil, err
 	}
+	if config.VectorStore == VectorStoreWeaviate && config.WeaviateConfig.Host == "" {
+		return nil, errors.New("weaviate host is required when vector_store is weaviate")
+	}
 	return &config, nil
 }
@@ -153,3 +153,84 @@ func TestGetApplicationConfig(t *testing.T) {
 		t.Errorf("Expected AssetStoreConfig.StorageType to be 'local', but got %v", appConfig.AssetStoreConfig.StorageType)
 	}
 }
+
+func minimalViperConfig() *viper.Viper {
+	vConfig := viper.NewWithOptions(viper.KeyDelimiter("__"))
+	vConfig.Set("SERVICE_NAME", "assistant-api")
+	vConfig.Set("HOST", "0.0.0.0")
+	vConfig.Set("PORT", 9007)
+	vConfig.Set("LOG_LEVEL", "debug")
+	vConfig.Set("SECRET", "rpd_pks")
+	vConfig.Set("ENV", "development")
+	vConfig.Set("INTEGRATION_HOST", "localhost:9004")
+	vConfig.Set("ENDPOINT_HOST", "localhost:9005")
+	vConfig.Set("ASSISTANT_HOST", "localhost:9007")
+	vConfig.Set("WEB_HOST", "localhost:9001")
+	vConfig.Set("UI_HOST", "http://localhost:3000")
+
+	vConfig.Set("POSTGRES__HOST", "localhost")
+	vConfig.Set("POSTGRES__DB_NAME", "assistant_db")
+	vConfig.Set("POSTGRES__MAX_OPEN_CONNECTION", 50)
+	vConfig.Set("POSTGRES__MAX_IDEAL_CONNECTION", 25)
+	vConfig.Set("POSTGRES__SSL_MODE", "disable")
+	vConfig.Set("REDIS__HOST", "127.0.0.1")
+	vConfig.Set("REDIS__PORT", 6379)
+	vConfig.Set("REDIS__MAX_CONNECTION", 10)
+	vConfig.Set("ASSET_STORE__STORAGE_TYPE", "local")
+	vConfig.Set("ASSET_STORE__STORAGE_PATH_PREFIX", os.TempDir())
+	vConfig.Set("PUBLIC_ASSISTANT_HOST", "assistant.example.com")
+	return vConfig
+}
+
+func TestGetApplicationConfig_VectorStore(t *testing.T) {
+	appConfig, err := GetApplicationConfig(minimalViperConfig())
+	if err != nil {
+		t.Fatalf("GetApplicationConfig returned an error: %v", err)
+	}
+	if appConfig.VectorStore != VectorStoreOpenSearch {
+		t.Errorf("Expected default VectorStore to be %q, but got %q", VectorStoreOpenSearch, appConfig.VectorStore)
+	}
+
+	vConfig := minimalViperConfig()
+	vConfig.Set("VECTOR_STORE", "pgvector")
+	vConfig.Set("PGVECTOR__HOST", "vectors.internal")
+	vConfig.Set("PGVECTOR__DB_NAME", "vector_db")
+	vConfig.Set("PGVECTOR__MAX_OPEN_CONNECTION", 10)
+	vConfig.Set("PGVECTOR__MAX_IDEAL_CONNECTION", 5)
+	vConfig.Set("PGVECTOR__SSL_MODE", "disable")
+	appConfig, err = GetApplicationConfig(vConfig)
+	if err != nil {
+		t.Fatalf("GetApplicationConfig returned an error: %v", err)
+	}
+	if appConfig.VectorStore != VectorStorePgVector {
+		t.Errorf("Expected VectorStore to be %q, but got %q", VectorStorePgVector, appConfig.VectorStore)
+	}
+	if appConfig.PgVectorConfig == nil || appConfig.PgVectorConfig.DBName != "vector_db" {
+		t.Errorf("Expected PgVectorConfig.DBName to be 'vector_db', but got %+v", appConfig.PgVectorConfig)
+	}
+
+	vConfig = minimalViperConfig()
+	vConfig.Set("VECTOR_STORE", "milvus")
+	if _, err := GetApplicationConfig(vConfig); err == nil {
+		t.Errorf("Expected an error for an unknown vector store")
+	}
+}
+
+func TestGetApplicationConfig_Weaviate(t *testing.T) {
+	vConfig := minimalViperConfig()
+	vConfig.Set("VECTOR_STORE", "weaviate")
+	if _, err := GetApplicationConfig(vConfig); err == nil {
+		t.Errorf("Expected an error for weaviate without a host")
+	}
+
+	vConfig.Set("WEAVIATE__HOST", "weaviate.internal:8080")
+	vConfig.Set("WEAVIATE__SCHEME", "http")
+	vConfig.Set("WEAVIATE__AUTH__API_KEY", "secret")
+	appConfig, err := GetApplicationConfig(vConfig)
+	if err != nil {
+		t.Fatalf("GetApplicationConfig returned an error: %v", err)
+	}
+	if appConfig.WeaviateConfig.Host != "weaviate.internal:8080" || appConfig.WeaviateConfig.Auth.ApiKey != "secret" {
+		t.Errorf("Expected WeaviateConfig to be read, but got %+v", appConfig.WeaviateConfig)
+	}
+}
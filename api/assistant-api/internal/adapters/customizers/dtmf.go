@@ -0,0 +1,134 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_customizers
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	DefaultDTMFTerminator        = "#"
+	DefaultDTMFInterDigitTimeout = 3 * time.Second
+)
+
+// DTMFCollector accumulates key presses into a single input. Collection ends
+// when the terminator key is pressed, max digits is reached or no key is
+// pressed for the inter-digit timeout, whichever comes first.
+type DTMFCollector interface {
+	// Collect adds a key press and reports whether it started a new
+	// collection, i.e. it is the first digit of the input.
+	Collect(digit string) bool
+	Reset()
+}
+
+type DTMFConfig struct {
+	// Terminator ends collection and is not part of the collected input,
+	// empty disables the terminator.
+	Terminator string
+
+	// MaxDigits ends collection once reached, 0 means unlimited.
+	MaxDigits int
+
+	// InterDigitTimeout ends collection after a pause between key presses.
+	InterDigitTimeout time.Duration
+}
+
+// NewDTMFConfig reads digit collection settings from deployment options:
+// dtmf.terminator, dtmf.max_digits and dtmf.inter_digit_timeout (milliseconds).
+func NewDTMFConfig(opts utils.Option) DTMFConfig {
+	cfg := DTMFConfig{
+		Terminator:        DefaultDTMFTerminator,
+		InterDigitTimeout: DefaultDTMFInterDigitTimeout,
+	}
+	if opts == nil {
+		return cfg
+	}
+	if terminator, err := opts.GetString("dtmf.terminator"); err == nil {
+		cfg.Terminator = strings.TrimSpace(terminator)
+	}
+	if maxDigits, err := opts.GetUint64("dtmf.max_digits"); err == nil {
+		cfg.MaxDigits = int(maxDigits)
+	}
+	if timeout, err := opts.GetUint64("dtmf.inter_digit_timeout"); err == nil && timeout > 0 {
+		cfg.InterDigitTimeout = time.Duration(timeout) * time.Millisecond
+	}
+	return cfg
+}
+
+type dtmfCollector struct {
+	cfg        DTMFConfig
+	onComplete func(digits string)
+
+	mu     sync.Mutex
+	digits strings.Builder
+	timer  *time.Timer
+}
+
+// NewDTMFCollector returns a collector calling onComplete with the collected
+// digits each time a collection ends.
+func NewDTMFCollector(cfg DTMFConfig, onComplete func(digits string)) DTMFCollector {
+	return &dtmfCollector{cfg: cfg, onComplete: onComplete}
+}
+
+func (dc *dtmfCollector) Collect(digit string) bool {
+	dc.mu.Lock()
+	if dc.timer != nil {
+		dc.timer.Stop()
+		dc.timer = nil
+	}
+	if dc.cfg.Terminator != "" && digit == dc.cfg.Terminator {
+		digits := dc.flush()
+		dc.mu.Unlock()
+		dc.complete(digits)
+		return false
+	}
+	started := dc.digits.Len() == 0
+	dc.digits.WriteString(digit)
+	if dc.cfg.MaxDigits > 0 && dc.digits.Len() >= dc.cfg.MaxDigits {
+		digits := dc.flush()
+		dc.mu.Unlock()
+		dc.complete(digits)
+		return started
+	}
+	if dc.cfg.InterDigitTimeout > 0 {
+		dc.timer = time.AfterFunc(dc.cfg.InterDigitTimeout, func() {
+			dc.mu.Lock()
+			digits := dc.flush()
+			dc.mu.Unlock()
+			dc.complete(digits)
+		})
+	}
+	dc.mu.Unlock()
+	return started
+}
+
+func (dc *dtmfCollector) Reset() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if dc.timer != nil {
+		dc.timer.Stop()
+		dc.timer = nil
+	}
+	dc.digits.Reset()
+}
+
+// flush must be called with mu held.
+func (dc *dtmfCollector) flush() string {
+	digits := dc.digits.String()
+	dc.digits.Reset()
+	return digits
+}
+
+func (dc *dtmfCollector) complete(digits string) {
+	if digits == "" {
+		return
+	}
+	dc.onComplete(digits)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_adapter_request_customizers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func collect(cfg DTMFConfig, digits ...string) <-chan string {
+	out := make(chan string, len(digits)+1)
+	collector := NewDTMFCollector(cfg, func(d string) { out <- d })
+	for _, d := range digits {
+		collector.Collect(d)
+	}
+	return out
+}
+
+func TestDTMFCollector_Terminator(t *testing.T) {
+	out := collect(DTMFConfig{Terminator: "#", InterDigitTimeout: time.Minute}, "1", "2", "3", "#")
+	assert.Equal(t, "123", <-out)
+	assert.Len(t, out, 0)
+}
+
+func TestDTMFCollector_TerminatorOnlyIsIgnored(t *testing.T) {
+	out := collect(DTMFConfig{Terminator: "#", InterDigitTimeout: time.Minute}, "#")
+	assert.Len(t, out, 0)
+}
+
+func TestDTMFCollector_MaxDigits(t *testing.T) {
+	out := collect(DTMFConfig{Terminator: "#", MaxDigits: 2, InterDigitTimeout: time.Minute}, "4", "5", "6", "7")
+	assert.Equal(t, "45", <-out)
+	assert.Equal(t, "67", <-out)
+}
+
+func TestDTMFCollector_InterDigitTimeout(t *testing.T) {
+	out := collect(DTMFConfig{Terminator: "#", InterDigitTimeout: 20 * time.Millisecond}, "9", "*")
+	select {
+	case digits := <-out:
+		assert.Equal(t, "9*", digits)
+	case <-time.After(time.Second):
+		t.Fatal("collection did not complete after inter-digit timeout")
+	}
+}
+
+func TestDTMFCollector_StartedOnFirstDigit(t *testing.T) {
+	collector := NewDTMFCollector(DTMFConfig{Terminator: "#", MaxDigits: 2, InterDigitTimeout: time.Minute}, func(string) {})
+	assert.False(t, collector.Collect("#"), "terminator alone starts nothing")
+	assert.True(t, collector.Collect("1"))
+	assert.False(t, collector.Collect("2"))
+	assert.True(t, collector.Collect("3"), "max digits ended the previous collection")
+	assert.False(t, collector.Collect("#"))
+	assert.True(t, collector.Collect("4"))
+	collector.Reset()
+	assert.True(t, collector.Collect("5"))
+}
+
+func TestDTMFCollector_Reset(t *testing.T) {
+	out := make(chan string, 1)
+	collector := NewDTMFCollector(DTMFConfig{InterDigitTimeout: 20 * time.Millisecond}, func(d string) { out <- d })
+	collector.Collect("1")
+	collector.Reset()
+	select {
+	case digits := <-out:
+		t.Fatalf("unexpected collection %q after reset", digits)
+	case <-time.After(60 * time.Millisecond):
+	}
+}
+
+func TestNewDTMFConfig(t *testing.T) {
+	cfg := NewDTMFConfig(nil)
+	assert.Equal(t, DefaultDTMFTerminator, cfg.Terminator)
+	assert.Equal(t, 0, cfg.MaxDigits)
+	assert.Equal(t, DefaultDTMFInterDigitTimeout, cfg.InterDigitTimeout)
+
+	cfg = NewDTMFConfig(utils.Option{
+		"dtmf.terminator":          "*",
+		"dtmf.max_digits":          "4",
+		"dtmf.inter_digit_timeout": "1500",
+	})
+	assert.Equal(t, "*", cfg.Terminator)
+	assert.Equal(t, 4, cfg.MaxDigits)
+	assert.Equal(t, 1500*time.Millisecond, cfg.InterDigitTimeout)
+}
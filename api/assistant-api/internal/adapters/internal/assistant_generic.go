@@ -12,7 +12,9 @@ import (
 
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_evaluation "github.com/rapidaai/api/assistant-api/internal/evaluation"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -36,6 +38,11 @@ func (gr *genericRequestor) GetSpeechToTextTransformer() (
 			return a.AssistantPhoneDeployment.InputAudio, nil
 		}
 
+	case utils.Whatsapp:
+		if a := gr.assistant; a != nil && a.AssistantWhatsappDeployment != nil && a.AssistantWhatsappDeployment.InputAudio != nil {
+			return a.AssistantWhatsappDeployment.InputAudio, nil
+		}
+
 	case utils.SDK:
 		if a := gr.assistant; a != nil && a.AssistantApiDeployment != nil && a.AssistantApiDeployment.InputAudio != nil {
 			return a.AssistantApiDeployment.InputAudio, nil
@@ -96,6 +103,8 @@ func (gr *genericRequestor) GetAssistant(
 		InjectTool:          true,
 		InjectAnalysis:      true,
 		InjectWebhook:       true,
+		InjectModerator:     true,
+		InjectEvaluator:     true,
 		InjectConversations: false,
 	}
 	switch gr.source {
@@ -200,6 +209,11 @@ func (tc *genericRequestor) onAddMetadata(ctx context.Context, metadata ...*prot
 }
 
 func (tc *genericRequestor) onAddMetrics(ctx context.Context, metrics ...*protos.Metric) error {
+	// once the conversation ends the time it streamed audio counts towards
+	// the voice minutes of the project
+	if !limiters.ConversationUsage(metrics).IsZero() {
+		tc.limiter.Record(ctx, tc.auth, limiters.Usage{Duration: tc.audioTime()})
+	}
 	dbCtx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
 	defer cancel()
 	_, err := tc.conversationService.ApplyConversationMetrics(
@@ -215,6 +229,31 @@ func (tc *genericRequestor) onAddMetrics(ctx context.Context, metrics ...*protos
 	return err
 }
 
+// onAddEvaluations stores the evaluator scores of the conversation with the
+// assistant provider model it ran on.
+func (tc *genericRequestor) onAddEvaluations(ctx context.Context, scores []*internal_evaluation.Score) error {
+	evaluations := make([]*internal_conversation_entity.AssistantConversationEvaluation, 0, len(scores))
+	for _, score := range scores {
+		evaluations = append(evaluations, &internal_conversation_entity.AssistantConversationEvaluation{
+			AssistantId:              tc.assistant.Id,
+			AssistantProviderModelId: tc.assistantConversation.AssistantProviderModelId,
+			AssistantConversationId:  tc.assistantConversation.Id,
+			Name:                     score.Name,
+			Evaluator:                score.Evaluator,
+			Score:                    score.Score,
+			Passed:                   score.Passed,
+			Reason:                   score.Reason,
+		})
+	}
+	dbCtx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+	defer cancel()
+	if err := tc.conversationService.ApplyConversationEvaluations(dbCtx, tc.auth, evaluations); err != nil {
+		tc.logger.Errorf("unable to flush evaluations for conversation %+v", err)
+		return err
+	}
+	return nil
+}
+
 func (deb *genericRequestor) onMessageMetric(ctx context.Context, messageId string, metrics []*protos.Metric) error {
 	dbCtx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
 	defer cancel()
@@ -224,3 +263,17 @@ func (deb *genericRequestor) onMessageMetric(ctx context.Context, messageId stri
 	}
 	return nil
 }
+
+func (deb *genericRequestor) onMessageMetadata(ctx context.Context, messageId string, metadata []*protos.Metadata) error {
+	dbCtx, cancel := context.WithTimeout(context.Background(), dbWriteTimeout)
+	defer cancel()
+	mtd := make(map[string]interface{}, len(metadata))
+	for _, m := range metadata {
+		mtd[m.GetKey()] = m.GetValue()
+	}
+	if _, err := deb.conversationService.ApplyMessageMetadata(dbCtx, deb.Auth(), deb.Conversation().Id, messageId, mtd); err != nil {
+		deb.logger.Errorf("error updating metadata for message: %v", err)
+		return err
+	}
+	return nil
+}
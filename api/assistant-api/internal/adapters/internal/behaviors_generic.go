@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/utils"
@@ -54,6 +55,7 @@ func (r *genericRequestor) GetBehavior() (*internal_assistant_entity.AssistantDe
 // InitializeBehavior sets up the initial behavior configuration including greeting,
 // idle timeout, and max session duration timers.
 func (r *genericRequestor) initializeBehavior(ctx context.Context) error {
+	r.initializeDTMF(ctx)
 	behavior, err := r.GetBehavior()
 	if err != nil {
 		r.logger.Errorf("error while fetching deployment behavior: %v", err)
@@ -65,6 +67,24 @@ func (r *genericRequestor) initializeBehavior(ctx context.Context) error {
 	return nil
 }
 
+// initializeDTMF sets up digit collection, configured through the phone
+// deployment options. Collected digits are handed to the executor as the
+// user's input.
+func (r *genericRequestor) initializeDTMF(ctx context.Context) {
+	var opts utils.Option
+	if r.source == utils.PhoneCall && r.assistant != nil && r.assistant.AssistantPhoneDeployment != nil {
+		opts = r.assistant.AssistantPhoneDeployment.GetOptions()
+	}
+	r.dtmf = internal_adapter_request_customizers.NewDTMFCollector(
+		internal_adapter_request_customizers.NewDTMFConfig(opts),
+		func(digits string) {
+			if err := r.OnPacket(ctx, internal_type.DTMFInputPacket{ContextID: r.messaging.GetID(), Digits: digits}); err != nil {
+				r.logger.Errorf("error while sending dtmf input: %v", err)
+			}
+		},
+	)
+}
+
 // initializeGreeting sends the greeting message if configured.
 func (r *genericRequestor) initializeGreeting(ctx context.Context, behavior *internal_assistant_entity.AssistantDeploymentBehavior) {
 	if behavior.Greeting == nil {
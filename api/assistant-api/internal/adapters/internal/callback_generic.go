@@ -9,10 +9,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	internal_adapter_request_customizers "github.com/rapidaai/api/assistant-api/internal/adapters/customizers"
 	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_moderation "github.com/rapidaai/api/assistant-api/internal/moderation"
 	internal_adapter_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
@@ -163,11 +165,59 @@ func (talking *genericRequestor) callDirective(ctx context.Context, vl internal_
 			talking.logger.Errorf("error notifying end conversation action: %v", err)
 		}
 		return nil
+	case protos.ConversationDirective_TRANSFER_CONVERSATION:
+		transferer, ok := talking.streamer.(internal_type.CallTransferer)
+		if !ok {
+			// channels without a transferable call leg hand the directive to the client
+			if err := talking.Notify(ctx, &protos.ConversationDirective{Id: vl.ContextID, Type: vl.Directive, Args: anyArgs, Time: timestamppb.Now()}); err != nil {
+				talking.logger.Errorf("error notifying transfer conversation action: %v", err)
+			}
+			talking.recordTransfer(ctx, vl, nil, errors.New("transfer is not supported by the channel"))
+			return nil
+		}
+		utils.Go(ctx, func() {
+			to, _ := utils.Option(vl.Arguments).GetString("transfer_to")
+			info, err := transferer.TransferCall(ctx, to, utils.Option(vl.Arguments))
+			if err != nil {
+				talking.logger.Errorf("error transferring call to %s: %v", to, err)
+			}
+			// the streamer context ends with the transferred call leg
+			talking.recordTransfer(context.Background(), vl, info, err)
+		})
+		return nil
 	default:
 	}
 	return nil
 }
 
+// recordTransfer stores the outcome of a call transfer as conversation metadata.
+func (talking *genericRequestor) recordTransfer(ctx context.Context, vl internal_type.DirectivePacket, info *internal_type.CallInfo, err error) {
+	to, _ := utils.Option(vl.Arguments).GetString("transfer_to")
+	metadata := []*protos.Metadata{{Key: "telephony.transfer.to", Value: to}}
+	if err != nil {
+		metadata = append(metadata,
+			&protos.Metadata{Key: "telephony.transfer.status", Value: "FAILED"},
+			&protos.Metadata{Key: "telephony.transfer.error", Value: err.Error()},
+		)
+	} else if info != nil {
+		metadata = append(metadata, &protos.Metadata{Key: "telephony.transfer.status", Value: info.Status})
+	}
+	if info != nil {
+		if info.StatusInfo.Event != "" {
+			metadata = append(metadata, &protos.Metadata{Key: "telephony.transfer.event", Value: info.StatusInfo.Event})
+		}
+		for k, v := range info.Extra {
+			metadata = append(metadata, &protos.Metadata{Key: k, Value: v})
+		}
+	}
+	if err := talking.OnPacket(ctx, internal_type.ConversationMetadataPacket{
+		ContextID: talking.Conversation().Id,
+		Metadata:  metadata,
+	}); err != nil {
+		talking.logger.Errorf("error recording call transfer: %v", err)
+	}
+}
+
 /**/
 func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_type.Packet) error {
 	for _, p := range pkts {
@@ -247,6 +297,21 @@ func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				}
 			}
 
+			continue
+		case internal_type.FillerPacket:
+			// filler goes straight to speaking, the turn stays with the executor
+			if vl.ContextID != talking.messaging.GetID() {
+				continue
+			}
+			if err := talking.callSpeaking(ctx, internal_type.LLMResponseDeltaPacket{ContextID: vl.ContextID, Text: vl.Text}); err != nil {
+				talking.logger.Errorf("speaking error: %v", err)
+			}
+			// flush the text to speech so the filler is heard while the turn is busy
+			if talking.textToSpeechTransformer != nil && talking.messaging.GetMode().Audio() {
+				if err := talking.textToSpeechTransformer.Transform(ctx, internal_type.LLMResponseDonePacket{ContextID: vl.ContextID}); err != nil {
+					talking.logger.Errorf("speak: failed to flush filler to text to speech transformer error: %v", err)
+				}
+			}
 			continue
 		case internal_type.InterruptionPacket:
 			ctx, span, _ := talking.Tracer().StartSpan(ctx, utils.AssistantUtteranceStage)
@@ -265,6 +330,7 @@ func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				if err := talking.messaging.Transition(internal_adapter_request_customizers.Interrupted); err != nil {
 					continue
 				}
+				talking.turnStats.onInterruption()
 
 				// Truncate system audio in the recorder to mirror the streamer's
 				// ClearOutputBuffer — audio buffered beyond this moment was never
@@ -341,24 +407,36 @@ func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 
 			// stop idle timeout as bot has started responding
 			talking.stopIdleTimeoutTimer()
+			talking.turnStats.onEndOfSpeech(vl.ContextID)
 
 			if err := talking.messaging.Transition(internal_adapter_request_customizers.LLMGenerating); err != nil {
 				talking.logger.Errorf("messaging transition error: %v", err)
 			}
 
+			// the transcript is moderated before it reaches the llm
+			speech := vl.Speech
+			decision := talking.moderation.Moderate(ctx, internal_moderation.StageInput, vl.Speech)
+			if decision != nil && decision.Action == internal_moderation.ActionRedact {
+				speech = decision.Text
+			}
+
 			if err := talking.Notify(ctx,
 				&protos.ConversationUserMessage{Id: vl.ContextID, Message: &protos.ConversationUserMessage_Text{Text: vl.Speech}, Completed: true, Time: timestamppb.New(time.Now())}); err != nil {
 				talking.logger.Tracef(ctx, "might be returing processing the duplicate message so cut it out.")
 				continue
 			}
 			utils.Go(ctx, func() {
-				if err := talking.onCreateMessage(ctx, internal_type.UserTextPacket{ContextID: vl.ContextID, Text: vl.Speech}); err != nil {
+				if err := talking.onCreateMessage(ctx, internal_type.UserTextPacket{ContextID: vl.ContextID, Text: speech}); err != nil {
 					talking.logger.Errorf("Error in onCreateMessage: %v", err)
 				}
 			})
 
+			if decision != nil && talking.onModeratedInput(ctx, vl.ContextID, decision) {
+				continue
+			}
+
 			//
-			if err := talking.assistantExecutor.Execute(ctx, talking, internal_type.UserTextPacket{ContextID: vl.ContextID, Text: vl.Speech}); err != nil {
+			if err := talking.assistantExecutor.Execute(ctx, talking, internal_type.UserTextPacket{ContextID: vl.ContextID, Text: speech}); err != nil {
 				talking.logger.Errorf("assistant executor error: %v", err)
 				talking.OnError(ctx)
 				continue
@@ -407,6 +485,59 @@ func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 
 			continue
 
+		case internal_type.DTMFPacket:
+			// the first key press of an input barges in like speech does
+			if talking.dtmf != nil && talking.dtmf.Collect(vl.Digit) {
+				talking.OnPacket(ctx, internal_type.InterruptionPacket{ContextID: vl.ContextID, Source: internal_type.InterruptionSourceWord})
+			}
+			continue
+
+		case internal_type.DTMFInputPacket:
+			ctx, span, _ := talking.Tracer().StartSpan(ctx, utils.AssistantUtteranceStage)
+			span.EndSpan(ctx,
+				utils.AssistantUtteranceStage,
+				internal_telemetry.KV{K: "activity_type", V: internal_telemetry.StringValue("DTMFInputActivity")},
+				internal_telemetry.KV{K: "digits", V: internal_telemetry.StringValue(vl.Digits)},
+			)
+
+			talking.stopIdleTimeoutTimer()
+			talking.turnStats.onEndOfSpeech(vl.ContextID)
+
+			if err := talking.messaging.Transition(internal_adapter_request_customizers.LLMGenerating); err != nil {
+				talking.logger.Errorf("messaging transition error: %v", err)
+			}
+
+			// key presses are not moderated, they carry no free text
+			if err := talking.Notify(ctx,
+				&protos.ConversationUserMessage{Id: vl.ContextID, Message: &protos.ConversationUserMessage_Text{Text: vl.Digits}, Completed: true, Time: timestamppb.New(time.Now())}); err != nil {
+				talking.logger.Tracef(ctx, "might be returing processing the duplicate message so cut it out.")
+				continue
+			}
+			utils.Go(ctx, func() {
+				if err := talking.onCreateMessage(ctx, internal_type.UserTextPacket{ContextID: vl.ContextID, Text: vl.Digits}); err != nil {
+					talking.logger.Errorf("Error in onCreateMessage: %v", err)
+				}
+			})
+
+			if err := talking.assistantExecutor.Execute(ctx, talking, internal_type.UserTextPacket{ContextID: vl.ContextID, Text: vl.Digits}); err != nil {
+				talking.logger.Errorf("assistant executor error: %v", err)
+				talking.OnError(ctx)
+				continue
+			}
+
+		case internal_type.SendDTMFPacket:
+			sender, ok := talking.streamer.(internal_type.DTMFSender)
+			if !ok {
+				talking.logger.Warnf("sending dtmf is not supported by the channel")
+				continue
+			}
+			utils.Go(ctx, func() {
+				if err := sender.SendDTMF(ctx, vl.Digits); err != nil {
+					talking.logger.Errorf("error sending dtmf %s: %v", vl.Digits, err)
+				}
+			})
+			continue
+
 		case internal_type.DirectivePacket:
 			talking.callDirective(ctx, vl)
 			continue
@@ -443,6 +574,15 @@ func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				}
 			})
 			continue
+		case internal_type.MessageMetadataPacket:
+			utils.Go(ctx, func() {
+				if len(vl.Metadata) > 0 {
+					if err := talking.onMessageMetadata(ctx, vl.ContextID, vl.Metadata); err != nil {
+						talking.logger.Errorf("Error in onUpdate Message Metadata: %v", err)
+					}
+				}
+			})
+			continue
 		case internal_type.TextToSpeechEndPacket:
 			// might be stale packet
 			if vl.ContextID != talking.messaging.GetID() {
@@ -472,6 +612,14 @@ func (talking *genericRequestor) OnPacket(ctx context.Context, pkts ...internal_
 				talking.logger.Tracef(ctx, "error while outputing chunk to the user: %w", err)
 			}
 
+			if firstAudio, ok := talking.turnStats.onAudio(vl.ContextID); ok {
+				talking.OnPacket(ctx, internal_type.MessageMetricPacket{ContextID: vl.ContextID, Metrics: []*protos.Metric{{
+					Name:        type_enums.TIME_TO_FIRST_AUDIO.String(),
+					Value:       fmt.Sprintf("%d", firstAudio.Milliseconds()),
+					Description: "Milliseconds from the end of user speech to the first audio of the response",
+				}}})
+			}
+
 			// for recording puposes
 			if err := talking.callRecording(ctx, vl); err != nil {
 				talking.logger.Errorf("recorder error: %v", err)
@@ -0,0 +1,151 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package adapter_internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	internal_evaluation "github.com/rapidaai/api/assistant-api/internal/evaluation"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// turnStats measures the time from the end of the user speech to the first
+// audio of the response, and counts the interruptions of the assistant.
+type turnStats struct {
+	mu            sync.Mutex
+	speechEndedAt map[string]time.Time
+	firstAudio    []time.Duration
+	interruptions int
+}
+
+func newTurnStats() *turnStats {
+	return &turnStats{speechEndedAt: make(map[string]time.Time)}
+}
+
+func (s *turnStats) onEndOfSpeech(contextID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.speechEndedAt[contextID] = time.Now()
+}
+
+// onAudio returns the time to first audio of the context on its first audio
+// chunk.
+func (s *turnStats) onAudio(contextID string) (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	endedAt, ok := s.speechEndedAt[contextID]
+	if !ok {
+		return 0, false
+	}
+	delete(s.speechEndedAt, contextID)
+	d := time.Since(endedAt)
+	s.firstAudio = append(s.firstAudio, d)
+	return d, true
+}
+
+func (s *turnStats) onInterruption() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.interruptions++
+}
+
+// Metrics returns the conversation metrics, the time to first audio is only
+// reported when a response was spoken.
+func (s *turnStats) Metrics() []*protos.Metric {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	metrics := []*protos.Metric{{
+		Name:        type_enums.INTERRUPTION_COUNT.String(),
+		Value:       fmt.Sprintf("%d", s.interruptions),
+		Description: "Number of times the user interrupted the assistant",
+	}}
+	if len(s.firstAudio) == 0 {
+		return metrics
+	}
+	var total, max time.Duration
+	for _, d := range s.firstAudio {
+		total += d
+		if d > max {
+			max = d
+		}
+	}
+	return append(metrics,
+		&protos.Metric{
+			Name:        type_enums.TIME_TO_FIRST_AUDIO.String(),
+			Value:       fmt.Sprintf("%d", (total / time.Duration(len(s.firstAudio))).Milliseconds()),
+			Description: "Average milliseconds from the end of user speech to the first audio of the response",
+		},
+		&protos.Metric{
+			Name:        type_enums.TIME_TO_FIRST_AUDIO_MAX.String(),
+			Value:       fmt.Sprintf("%d", max.Milliseconds()),
+			Description: "Slowest milliseconds from the end of user speech to the first audio of the response",
+		},
+	)
+}
+
+// initializeEvaluation builds the evaluators of the assistant. An evaluator
+// that cannot be created is skipped, evaluation stays nil when there is none.
+func (r *genericRequestor) initializeEvaluation(ctx context.Context) *internal_evaluation.Evaluation {
+	checks := make([]*internal_evaluation.Check, 0, len(r.assistant.AssistantEvaluators))
+	for _, e := range r.assistant.AssistantEvaluators {
+		opts := utils.Option(e.GetOptions())
+		var credential *protos.VaultCredential
+		if credentialId, err := opts.GetUint64(internal_evaluation.OptionsKeyCredentialId); err == nil {
+			credential, err = r.VaultCaller().GetCredential(ctx, r.Auth(), credentialId)
+			if err != nil {
+				r.logger.Errorf("unable to find credential for evaluator %s: %v", e.GetName(), err)
+				continue
+			}
+		}
+		evaluator, err := internal_evaluation.GetEvaluator(r.logger, e.GetType(), r.IntegrationCaller(), r.Auth(), credential, opts)
+		if err != nil {
+			r.logger.Errorf("unable to create evaluator %s: %v", e.GetName(), err)
+			continue
+		}
+		checks = append(checks, &internal_evaluation.Check{Name: e.GetName(), Evaluator: evaluator})
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+	return internal_evaluation.NewEvaluation(r.logger, checks...)
+}
+
+// evaluateConversation stores the turn metrics of the conversation and the
+// scores of its evaluators, as conversation metrics and as evaluations of
+// the assistant version so they can be compared across versions.
+func (r *genericRequestor) evaluateConversation(ctx context.Context) {
+	start := time.Now()
+	metrics := r.turnStats.Metrics()
+	var scores []*internal_evaluation.Score
+	if evaluation := r.initializeEvaluation(ctx); evaluation.Enabled() {
+		in := &internal_type.EvaluationInput{
+			Messages: r.GetHistories(),
+			Metrics:  make(map[string]string, len(metrics)),
+		}
+		for _, m := range metrics {
+			in.Metrics[m.GetName()] = m.GetValue()
+		}
+		scores = evaluation.Evaluate(ctx, in)
+		for _, score := range scores {
+			metrics = append(metrics, score.Metrics()...)
+		}
+	}
+	if err := r.onAddMetrics(ctx, metrics...); err != nil {
+		r.logger.Errorf("unable to store conversation evaluation: %v", err)
+	}
+	if len(scores) > 0 {
+		if err := r.onAddEvaluations(ctx, scores); err != nil {
+			r.logger.Errorf("unable to store conversation evaluation scores: %v", err)
+		}
+	}
+	r.logger.Benchmark("genericRequestor.evaluateConversation", time.Since(start))
+}
@@ -8,6 +8,7 @@ package adapter_internal
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,13 +27,16 @@ import (
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
+	internal_moderation "github.com/rapidaai/api/assistant-api/internal/moderation"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
 	internal_knowledge_service "github.com/rapidaai/api/assistant-api/internal/services/knowledge"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
 	endpoint_client "github.com/rapidaai/pkg/clients/endpoint"
 	integration_client "github.com/rapidaai/pkg/clients/integration"
 	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/parsers"
 
 	//
@@ -57,6 +61,7 @@ type genericRequestor struct {
 	webhookService       internal_services.AssistantWebhookService
 	knowledgeService     internal_services.KnowledgeService
 	assistantToolService internal_services.AssistantToolService
+	webhookQueue         internal_webhook.Store
 
 	//
 	opensearch    connectors.OpenSearchConnector
@@ -74,6 +79,7 @@ type genericRequestor struct {
 
 	// io related
 	messaging internal_adapter_request_customizers.Messaging
+	dtmf      internal_adapter_request_customizers.DTMFCollector
 
 	// listening
 	speechToTextTransformer internal_type.SpeechToTextTransformer
@@ -93,6 +99,24 @@ type genericRequestor struct {
 	// executor
 	assistantExecutor internal_agent_executor.AssistantExecutor
 
+	// moderation, nil when the assistant has no moderator
+	moderation       *internal_moderation.Moderation
+	outputModeration *outputModeration
+
+	// latency and interruptions of the turns, scored once the call ends
+	turnStats *turnStats
+
+	// limits the concurrent voice sessions of the deployment and meters the
+	// minutes the session streamed audio, deployment is set while a session
+	// slot is held and sessionRenewal stops the renewal of its lease
+	limiter        limiters.Limiter
+	sessionId      string
+	deployment     string
+	sessionRenewal chan struct{}
+	audioMu        sync.Mutex
+	audioSince     time.Time
+	audioElapsed   time.Duration
+
 	// states
 	assistant             *internal_assistant_entity.Assistant
 	assistantConversation *internal_conversation_entity.AssistantConversation
@@ -113,7 +137,7 @@ func NewGenericRequestor(
 	ctx context.Context,
 	config *config.AssistantConfig,
 	logger commons.Logger, source utils.RapidaSource,
-	postgres connectors.PostgresConnector, opensearch connectors.OpenSearchConnector,
+	postgres connectors.PostgresConnector, opensearch connectors.OpenSearchConnector, vectordb connectors.VectorConnector,
 	redis connectors.RedisConnector, storage storages.Storage, streamer internal_type.Streamer,
 ) *genericRequestor {
 	return &genericRequestor{
@@ -127,11 +151,14 @@ func NewGenericRequestor(
 		conversationService:  internal_assistant_service.NewAssistantConversationService(logger, postgres, storage),
 		webhookService:       internal_assistant_service.NewAssistantWebhookService(logger, postgres, storage),
 		assistantToolService: internal_assistant_service.NewAssistantToolService(logger, postgres, storage),
+		webhookQueue:         internal_webhook.NewStore(postgres, logger),
 		templateParser:       parsers.NewPongo2StringTemplateParser(logger),
+		limiter:              limiters.NewLimiter(logger, redis, config.RateLimitConfig),
+		sessionId:            uuid.NewString(),
 		//
 
 		opensearch:    opensearch,
-		vectordb:      opensearch,
+		vectordb:      vectordb,
 		queryEmbedder: internal_agent_embeddings.NewQueryEmbedding(logger, config, redis),
 		textReranker:  internal_agent_rerankers.NewTextReranker(logger, config, redis),
 
@@ -152,6 +179,7 @@ func NewGenericRequestor(
 
 		//
 		histories: make([]internal_type.MessagePacket, 0),
+		turnStats: newTurnStats(),
 		metadata:  make(map[string]interface{}),
 		args:      make(map[string]interface{}),
 		options:   make(map[string]interface{}),
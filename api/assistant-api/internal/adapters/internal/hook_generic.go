@@ -10,15 +10,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
-	"strconv"
 	"strings"
-	"time"
 
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
 	endpoint_client_builders "github.com/rapidaai/pkg/clients/endpoint/builders"
-	"github.com/rapidaai/pkg/clients/rest"
-	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
@@ -76,6 +73,9 @@ func (md *genericRequestor) OnEndConversation(ctx context.Context) error {
 			}
 		}
 	})
+	utils.Go(ctx, func() {
+		md.evaluateConversation(ctx)
+	})
 	return nil
 }
 func (hk *genericRequestor) Analysis(ctx context.Context, endpointId uint64, endpointVersion string, arguments map[string]interface{}) (map[string]interface{}, error) {
@@ -106,68 +106,30 @@ func (hk *genericRequestor) Analysis(ctx context.Context, endpointId uint64, end
 	return nil, fmt.Errorf("empty response from endpoint")
 }
 
+// Webhook queues the event for delivery, the webhook dispatcher sends it and
+// retries with backoff so a slow or failing receiver never holds the
+// conversation.
 func (md *genericRequestor) Webhook(ctx context.Context, event string, arguments map[string]interface{}, webhook *internal_assistant_entity.AssistantWebhook) {
-	utils.Go(ctx, func() {
-		startTime := time.Now()
-		var res *rest.APIResponse
-		var err error
-		var statusCode int
-
-		retryCount := uint32(0)
-		maxRetryCount := webhook.GetMaxRetryCount()
-		retryStatusCodes := webhook.GetRetryStatusCode()
-
-		for retryCount <= maxRetryCount {
-			res, err = md.webhook(ctx,
-				webhook.GetTimeoutSecond(),
-				webhook.GetUrl(),
-				webhook.GetMethod(),
-				webhook.GetHeaders(),
-				arguments,
-			)
-
-			if err != nil {
-				md.logger.Error("Webhook execution failed", "error", err)
-				statusCode = 500
-			} else {
-				statusCode = res.StatusCode
-				if !slices.Contains(retryStatusCodes, strconv.Itoa(statusCode)) {
-					break
-				}
-			}
-
-			retryCount++
-			if retryCount <= maxRetryCount {
-				time.Sleep(time.Second * 2)
-			}
-		}
-
-		c, serializeErr := utils.Serialize(arguments)
-		if serializeErr != nil {
-			md.logger.Error("Failed to serialize arguments", "error", serializeErr)
-		}
-
-		v, err := res.ToJSON()
-		if err != nil {
-			md.logger.Error("Failed to convert response to JSON", "error", err)
-		}
-		logErr := md.CreateWebhookLog(
-			ctx,
-			webhook.Id,
-			webhook.HttpUrl,
-			webhook.HttpMethod,
-			event,
-			int64(statusCode),
-			int64(time.Since(startTime)),
-			uint32(retryCount),
-			type_enums.RECORD_COMPLETE,
-			c,
-			v,
-		)
-		if logErr != nil {
-			md.logger.Error("Failed to create webhook log", "error", logErr)
-		}
-	})
+	payload, err := json.Marshal(arguments)
+	if err != nil {
+		md.logger.Errorf("unable to serialize webhook %d payload: %v", webhook.Id, err)
+		return
+	}
+	var conversationId uint64
+	if md.assistantConversation != nil {
+		conversationId = md.assistantConversation.Id
+	}
+	if err := md.webhookQueue.Enqueue(ctx, &internal_webhook.Delivery{
+		WebhookID:      webhook.Id,
+		AssistantID:    md.assistant.Id,
+		ConversationID: conversationId,
+		ProjectID:      md.assistant.ProjectId,
+		OrganizationID: md.assistant.OrganizationId,
+		Event:          event,
+		Payload:        string(payload),
+	}); err != nil {
+		md.logger.Errorf("unable to queue webhook %d for %s: %v", webhook.Id, event, err)
+	}
 }
 
 func (md *genericRequestor) SimplifyHistory(msgs []internal_type.MessagePacket) []map[string]string {
@@ -263,17 +225,3 @@ func (ae *genericRequestor) analyze(ctx context.Context, endpointDef *protos.End
 		),
 	)
 }
-
-func (aw *genericRequestor) webhook(ctx context.Context, timeout uint32, baseUrl string, method string, headers map[string]string, body map[string]interface{}) (*rest.APIResponse, error) {
-	client := rest.NewRestClientWithConfig(baseUrl, headers, timeout)
-	switch method {
-	case "POST":
-		return client.Post(ctx, "", body, headers)
-	case "PUT":
-		return client.Put(ctx, "", body, headers)
-	case "PATCH":
-		return client.Patch(ctx, "", body, headers)
-	default:
-		return client.Get(ctx, "", body, headers)
-	}
-}
@@ -14,6 +14,7 @@ import (
 	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
 	internal_denoiser "github.com/rapidaai/api/assistant-api/internal/denoiser"
 	internal_end_of_speech "github.com/rapidaai/api/assistant-api/internal/end_of_speech"
+	internal_moderation "github.com/rapidaai/api/assistant-api/internal/moderation"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
 	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
@@ -263,7 +264,17 @@ func (spk *genericRequestor) onAssembleSentence(ctx context.Context) {
 			if !ok {
 				return
 			}
-			spk.callSpeaking(ctx, result)
+			if !spk.moderation.Enabled(internal_moderation.StageOutput) {
+				spk.callSpeaking(ctx, result)
+				continue
+			}
+			moderated, end := spk.moderateOutput(ctx, result)
+			if moderated != nil {
+				spk.callSpeaking(ctx, moderated)
+			}
+			if end {
+				spk.endConversationOnModeration(ctx, result.ContextId())
+			}
 		}
 	}
 }
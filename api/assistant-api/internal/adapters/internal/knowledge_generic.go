@@ -12,6 +12,7 @@ import (
 	"time"
 
 	internal_agent_embeddings "github.com/rapidaai/api/assistant-api/internal/agent/embedding"
+	internal_agent_rerankers "github.com/rapidaai/api/assistant-api/internal/agent/reranker"
 	internal_knowledge_gorm "github.com/rapidaai/api/assistant-api/internal/entity/knowledges"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/connectors"
@@ -22,11 +23,24 @@ import (
 const (
 	defaultTopK           = 4
 	defaultScoreThreshold = 0.5
+
+	// defaultRerankFactor is how many candidates per requested result are
+	// fetched for reranking when no candidate count is configured.
+	defaultRerankFactor = 4
 )
 
+// knowledgeRerankLog records the rerank stage of a retrieval in the knowledge log.
+type knowledgeRerankLog struct {
+	Provider   string    `json:"provider"`
+	Candidates int       `json:"candidates"`
+	Scores     []float64 `json:"scores,omitempty"`
+	Latency    int64     `json:"latency"`
+	Error      string    `json:"error,omitempty"`
+}
+
 func (kr *genericRequestor) RetrieveToolKnowledge(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, messageId string, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
 	start := time.Now()
-	result, err := kr.retrieve(ctx, knowledge, query, filter, kc)
+	result, rerank, err := kr.retrieve(ctx, knowledge, query, filter, kc)
 	utils.Go(context.Background(), func() {
 		request, _ := json.Marshal(map[string]interface{}{
 			"query":  query,
@@ -40,6 +54,7 @@ func (kr *genericRequestor) RetrieveToolKnowledge(ctx context.Context, knowledge
 		} else {
 			response, _ = json.Marshal(map[string]interface{}{
 				"result": result,
+				"rerank": rerank,
 			})
 		}
 		kr.CreateKnowledgeLog(
@@ -64,14 +79,71 @@ func (kr *genericRequestor) RetrieveToolKnowledge(ctx context.Context, knowledge
 
 }
 
-func (kr *genericRequestor) retrieve(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, error) {
-	if kr.vectordb == nil {
-		return nil, fmt.Errorf("knowledge retrieval is not available: vector database is not configured")
-	}
+// retrieve searches the knowledge and, when kc.Rerank is set, reranks an
+// over-fetched candidate set down to TopK.
+func (kr *genericRequestor) retrieve(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption) ([]internal_type.KnowledgeContextResult, *knowledgeRerankLog, error) {
 	topK := int(defaultTopK)
 	if kc.TopK != 0 {
 		topK = int(kc.TopK)
 	}
+	if kc.Rerank == nil {
+		results, err := kr.search(ctx, knowledge, query, filter, kc, topK)
+		return results, nil, err
+	}
+
+	candidates := topK * defaultRerankFactor
+	if kc.Rerank.Candidates != 0 {
+		candidates = max(int(kc.Rerank.Candidates), topK)
+	}
+	results, err := kr.search(ctx, knowledge, query, filter, kc, candidates)
+	if err != nil || len(results) == 0 {
+		return results, nil, err
+	}
+	results, rerank := kr.rerank(ctx, knowledge, query, results, kc.Rerank, topK)
+	return results, rerank, nil
+}
+
+// rerank orders results by the reranking model's relevance and keeps the
+// topK best. If the reranker fails the search order is kept, a missing
+// rerank should not fail the tool call.
+func (kr *genericRequestor) rerank(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, query string, results []internal_type.KnowledgeContextResult, opts *internal_type.KnowledgeRerankOption, topK int) ([]internal_type.KnowledgeContextResult, *knowledgeRerankLog) {
+	start := time.Now()
+	rerank := &knowledgeRerankLog{Provider: opts.ModelProviderName, Candidates: len(results)}
+
+	contents := make([]string, len(results))
+	for i, r := range results {
+		contents[i] = r.Content
+	}
+	ranked, err := kr.textReranker.Rerank(ctx, kr.Auth(), &internal_agent_rerankers.RerankingOption{
+		ProviderCredential: opts.ProviderCredential,
+		ModelProviderName:  opts.ModelProviderName,
+		Options:            opts.Options,
+	}, contents, query, map[string]string{
+		"knowledge_id": fmt.Sprintf("%d", knowledge.Id),
+	})
+	rerank.Latency = int64(time.Since(start))
+	if err != nil {
+		kr.logger.Warnw("knowledge rerank failed, keeping search order", "knowledgeId", knowledge.Id, "error", err)
+		rerank.Error = err.Error()
+		return results[:min(topK, len(results))], rerank
+	}
+
+	reranked := make([]internal_type.KnowledgeContextResult, 0, min(topK, len(ranked)))
+	for _, r := range ranked {
+		rerank.Scores = append(rerank.Scores, r.Score)
+		if len(reranked) < topK {
+			result := results[r.Index]
+			result.RerankScore = r.Score
+			reranked = append(reranked, result)
+		}
+	}
+	return reranked, rerank
+}
+
+func (kr *genericRequestor) search(ctx context.Context, knowledge *internal_knowledge_gorm.Knowledge, query string, filter map[string]interface{}, kc *internal_type.KnowledgeRetrieveOption, topK int) ([]internal_type.KnowledgeContextResult, error) {
+	if kr.vectordb == nil {
+		return nil, fmt.Errorf("knowledge retrieval is not available: vector database is not configured")
+	}
 	minScore := float32(defaultScoreThreshold)
 	if kc.ScoreThreshold != 0 {
 		minScore = float32(kc.ScoreThreshold)
@@ -0,0 +1,124 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package adapter_internal
+
+import (
+	"context"
+	"strings"
+
+	internal_moderation "github.com/rapidaai/api/assistant-api/internal/moderation"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// outputModeration tracks the moderated response of the current context so
+// the completed message matches what was spoken.
+type outputModeration struct {
+	contextID string
+	decision  *internal_moderation.Decision
+	stopped   bool
+	spoken    []string
+}
+
+// initializeModeration builds the moderation rules of the assistant. A
+// moderator that cannot be created is skipped, moderation stays nil when
+// there is no rule so the pipeline does not pay for it.
+func (r *genericRequestor) initializeModeration(ctx context.Context) error {
+	rules := make([]*internal_moderation.Rule, 0, len(r.assistant.AssistantModerators))
+	for _, m := range r.assistant.AssistantModerators {
+		opts := utils.Option(m.GetOptions())
+		var credential *protos.VaultCredential
+		if credentialId, err := opts.GetUint64(internal_moderation.OptionsKeyCredentialId); err == nil {
+			credential, err = r.VaultCaller().GetCredential(ctx, r.Auth(), credentialId)
+			if err != nil {
+				r.logger.Errorf("unable to find credential for moderator %s: %v", m.GetName(), err)
+				continue
+			}
+		}
+		moderator, err := internal_moderation.GetModerator(r.logger, m.GetType(), r.IntegrationCaller(), r.Auth(), credential, opts)
+		if err != nil {
+			r.logger.Errorf("unable to create moderator %s: %v", m.GetName(), err)
+			continue
+		}
+		rule, err := internal_moderation.NewRule(m.GetStage(), moderator, opts)
+		if err != nil {
+			r.logger.Errorf("unable to configure moderator %s: %v", m.GetName(), err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) > 0 {
+		r.moderation = internal_moderation.NewModeration(r.logger, rules...)
+	}
+	return nil
+}
+
+// onModeratedInput handles a flagged user transcript and reports whether the
+// turn is over. Redacted text continues to the LLM, blocked text is answered
+// with the canned response.
+func (r *genericRequestor) onModeratedInput(ctx context.Context, contextID string, decision *internal_moderation.Decision) bool {
+	r.OnPacket(ctx, internal_type.MessageMetadataPacket{ContextID: contextID, Metadata: decision.Metadata()})
+	switch decision.Action {
+	case internal_moderation.ActionRedact:
+		return false
+	case internal_moderation.ActionEndConversation:
+		r.OnPacket(ctx, internal_type.StaticPacket{ContextID: contextID, Text: decision.Text})
+		r.endConversationOnModeration(ctx, contextID)
+	default:
+		r.OnPacket(ctx, internal_type.StaticPacket{ContextID: contextID, Text: decision.Text})
+	}
+	return true
+}
+
+// moderateOutput moderates an assembled sentence before it is spoken. It
+// returns the packet to speak, nil to drop it, and whether the conversation
+// ends once the packet is spoken.
+func (r *genericRequestor) moderateOutput(ctx context.Context, pkt internal_type.LLMPacket) (internal_type.LLMPacket, bool) {
+	if r.outputModeration == nil || r.outputModeration.contextID != pkt.ContextId() {
+		r.outputModeration = &outputModeration{contextID: pkt.ContextId()}
+	}
+	state := r.outputModeration
+
+	switch res := pkt.(type) {
+	case internal_type.LLMResponseDeltaPacket:
+		if state.stopped {
+			return nil, false
+		}
+		if decision := r.moderation.Moderate(ctx, internal_moderation.StageOutput, res.Text); decision != nil {
+			if state.decision == nil {
+				state.decision = decision
+				r.OnPacket(ctx, internal_type.MessageMetadataPacket{ContextID: res.ContextID, Metadata: decision.Metadata()})
+			}
+			// the rest of a blocked response is not spoken
+			state.stopped = decision.Action != internal_moderation.ActionRedact
+			res.Text = decision.Text
+		}
+		if strings.TrimSpace(res.Text) == "" {
+			return nil, false
+		}
+		state.spoken = append(state.spoken, res.Text)
+		return res, false
+	case internal_type.LLMResponseDonePacket:
+		if state.decision == nil {
+			return res, false
+		}
+		res.Text = strings.Join(state.spoken, " ")
+		return res, state.decision.Action == internal_moderation.ActionEndConversation
+	default:
+		return pkt, false
+	}
+}
+
+func (r *genericRequestor) endConversationOnModeration(ctx context.Context, contextID string) {
+	r.OnPacket(ctx, internal_type.DirectivePacket{
+		ContextID: contextID,
+		Directive: protos.ConversationDirective_END_CONVERSATION,
+		Arguments: map[string]interface{}{
+			"reason": "content moderation",
+		},
+	})
+}
@@ -12,6 +12,7 @@ package adapter_internal
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -19,11 +20,14 @@ import (
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
 	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_telemetry "github.com/rapidaai/api/assistant-api/internal/telemetry"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -110,6 +114,7 @@ func (r *genericRequestor) Disconnect(ctx context.Context) {
 	// Phase 6: Close assistant executor and stop timers
 	r.closeExecutor(ctx)
 	r.stopTimers()
+	r.releaseSession(ctx)
 	r.logger.Benchmark("session.Disconnect", time.Since(startTime))
 }
 
@@ -156,14 +161,104 @@ func (r *genericRequestor) Connect(
 		return err
 	}
 
+	if err := r.acquireSession(ctx, auth, assistant, config.GetStreamMode()); err != nil {
+		return err
+	}
+
 	// Route to appropriate session handler based on conversation ID presence
 	if conversationID := config.GetAssistantConversationId(); conversationID > 0 {
 		span.AddAttributes(ctx, internal_telemetry.KV{K: "conversation_initiation", V: internal_telemetry.StringValue("resume")}, internal_telemetry.KV{K: "conversation_id", V: internal_telemetry.IntValue(conversationID)})
-		return r.resumeSession(ctx, config, assistant)
+		err = r.resumeSession(ctx, config, assistant)
+	} else {
+		span.AddAttributes(ctx, internal_telemetry.KV{K: "conversation_initiation", V: internal_telemetry.StringValue("new")})
+		err = r.createSession(ctx, config, assistant)
 	}
+	if err != nil {
+		r.releaseSession(ctx)
+	}
+	return err
+}
 
-	span.AddAttributes(ctx, internal_telemetry.KV{K: "conversation_initiation", V: internal_telemetry.StringValue("new")})
-	return r.createSession(ctx, config, assistant)
+// acquireSession refuses sessions of projects that used up a monthly quota,
+// audio sessions also hold a slot of the concurrent voice sessions of the
+// assistant deployment.
+func (r *genericRequestor) acquireSession(ctx context.Context, auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, mode protos.StreamMode) error {
+	if err := r.limiter.CheckQuota(ctx, auth); err != nil {
+		r.logger.Warnf("refused session of assistant %d: %v", assistant.Id, err)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if mode != protos.StreamMode_STREAM_MODE_AUDIO {
+		return nil
+	}
+	return r.startAudio(ctx, assistant.Id)
+}
+
+// startAudio holds a slot of the concurrent voice sessions of the assistant
+// deployment, a deployment is the assistant on a channel, and starts metering
+// the audio time of the session.
+func (r *genericRequestor) startAudio(ctx context.Context, assistantId uint64) error {
+	if r.deployment != "" {
+		return nil
+	}
+	deployment := fmt.Sprintf("%d:%s", assistantId, r.source.Get())
+	if err := r.limiter.AcquireSession(ctx, deployment, r.sessionId); err != nil {
+		r.logger.Warnf("refused voice session of assistant %d: %v", assistantId, err)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	r.deployment = deployment
+	r.renewSession(ctx, deployment, r.sessionId)
+	r.audioMu.Lock()
+	r.audioSince = time.Now()
+	r.audioMu.Unlock()
+	return nil
+}
+
+// audioTime is the time the session streamed audio, counted towards the
+// voice minutes of the project.
+func (r *genericRequestor) audioTime() time.Duration {
+	r.audioMu.Lock()
+	defer r.audioMu.Unlock()
+	if r.audioSince.IsZero() {
+		return r.audioElapsed
+	}
+	return r.audioElapsed + time.Since(r.audioSince)
+}
+
+// renewSession renews the lease of the session slot while the session is
+// live, a slot of a session that stops renewing frees once its lease ends.
+func (r *genericRequestor) renewSession(ctx context.Context, deployment, sessionId string) {
+	done := make(chan struct{})
+	r.sessionRenewal = done
+	utils.Go(ctx, func() {
+		ticker := time.NewTicker(limiters.SessionRenewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.limiter.RenewSession(ctx, deployment, sessionId)
+			}
+		}
+	})
+}
+
+// releaseSession frees the session slot held by the requestor, if any, and
+// stops metering its audio time.
+func (r *genericRequestor) releaseSession(ctx context.Context) {
+	if r.deployment == "" {
+		return
+	}
+	r.audioMu.Lock()
+	r.audioElapsed += time.Since(r.audioSince)
+	r.audioSince = time.Time{}
+	r.audioMu.Unlock()
+	close(r.sessionRenewal)
+	r.sessionRenewal = nil
+	r.limiter.ReleaseSession(ctx, r.deployment, r.sessionId)
+	r.deployment = ""
 }
 
 // persistRecording saves the audio recording asynchronously.
@@ -215,6 +310,9 @@ func (r *genericRequestor) stopTimers() {
 	if r.maxSessionTimer != nil {
 		r.maxSessionTimer.Stop()
 	}
+	if r.dtmf != nil {
+		r.dtmf.Reset()
+	}
 }
 
 // =============================================================================
@@ -254,6 +352,13 @@ func (r *genericRequestor) resumeSession(
 		return nil
 	})
 
+	errGroup.Go(func() error {
+		if err := r.initializeModeration(ctx); err != nil {
+			r.logger.Errorf("unable to initialize moderation with error %v", err)
+		}
+		return nil
+	})
+
 	// tts is important and speed up the first message
 	errGroup.Go(func() error {
 		switch config.StreamMode {
@@ -373,6 +478,13 @@ func (r *genericRequestor) createSession(
 		return nil
 	})
 
+	errGroup.Go(func() error {
+		if err := r.initializeModeration(ctx); err != nil {
+			r.logger.Errorf("unable to initialize moderation with error %v", err)
+		}
+		return nil
+	})
+
 	utils.Go(ctx, func() {
 		switch config.StreamMode {
 		case protos.StreamMode_STREAM_MODE_AUDIO:
@@ -73,6 +73,7 @@ func (t *genericRequestor) Talk(_ context.Context, auth types.SimplePrinciple) e
 				case protos.StreamMode_STREAM_MODE_TEXT:
 					// Switching to text mode — tear down audio subsystems
 					// only if they are currently active.
+					t.releaseSession(t.streamer.Context())
 					if t.speechToTextTransformer != nil {
 						utils.Go(t.streamer.Context(), func() {
 							t.disconnectSpeechToText(t.streamer.Context())
@@ -85,6 +86,11 @@ func (t *genericRequestor) Talk(_ context.Context, auth types.SimplePrinciple) e
 					}
 					t.messaging.SwitchMode(type_enums.TextMode)
 				case protos.StreamMode_STREAM_MODE_AUDIO:
+					// a session refused a voice slot stays in text mode
+					if err := t.startAudio(t.streamer.Context(), t.assistant.Id); err != nil {
+						t.logger.Warnf("talk: unable to switch to audio mode: %v", err)
+						break
+					}
 					// Switching to audio mode — only initialize subsystems
 					// that are not already running.
 					if t.textToSpeechTransformer == nil {
@@ -117,6 +123,13 @@ func (t *genericRequestor) Talk(_ context.Context, auth types.SimplePrinciple) e
 				}
 			}
 
+		case *protos.ConversationDTMF:
+			if initialized {
+				if err := t.OnPacket(t.streamer.Context(), internal_type.DTMFPacket{Digit: payload.GetDigit(), Duration: payload.GetDuration()}); err != nil {
+					t.logger.Errorf("error while accepting dtmf input: %v", err)
+				}
+			}
+
 		case *protos.ConversationMetadata:
 			if initialized {
 				if err := t.OnPacket(t.streamer.Context(),
@@ -18,7 +18,7 @@ import (
 	"github.com/rapidaai/pkg/utils"
 )
 
-func GetTalker(source utils.RapidaSource, ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, opensearch connectors.OpenSearchConnector, redis connectors.RedisConnector, storage storages.Storage, streamer internal_type.Streamer,
+func GetTalker(source utils.RapidaSource, ctx context.Context, cfg *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, opensearch connectors.OpenSearchConnector, vectordb connectors.VectorConnector, redis connectors.RedisConnector, storage storages.Storage, streamer internal_type.Streamer,
 ) (internal_type.Talking, error) {
-	return adapter_internal.NewGenericRequestor(ctx, cfg, logger, source, postgres, opensearch, redis, storage, streamer), nil
+	return adapter_internal.NewGenericRequestor(ctx, cfg, logger, source, postgres, opensearch, vectordb, redis, storage, streamer), nil
 }
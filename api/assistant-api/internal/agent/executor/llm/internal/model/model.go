@@ -272,9 +272,15 @@ func (executor *modelAssistantExecutor) buildChatRequest(communication internal_
 // executeToolCalls handles tool execution and recursive chat
 func (executor *modelAssistantExecutor) executeToolCalls(ctx context.Context, communication internal_type.Communication, contextID string, output *protos.Message, histories []*protos.Message,
 ) error {
+	mark := len(executor.history)
 	toolExecution := executor.toolExecutor.ExecuteAll(ctx, contextID, output.GetAssistant().GetToolCalls(), communication)
+	// fillers spoken while the tools ran go after the tool results, the
+	// results must directly follow the tool calls
+	spoken := append([]*protos.Message{}, executor.history[mark:]...)
+	executor.history = executor.history[:mark]
 	// histories = append(histories, output, toolExecution)
 	err := executor.chat(ctx, communication, contextID, toolExecution, histories...)
+	executor.history = append(executor.history, spoken...)
 	return err
 }
 
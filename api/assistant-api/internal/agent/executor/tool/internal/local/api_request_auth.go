@@ -0,0 +1,128 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	apiRequestAuthOAuth2ClientCredentials = "oauth2_client_credentials"
+
+	// tokenRequestTimeout bounds a token request, tokens outlive the call
+	// that fetched them so the request is not tied to its context.
+	tokenRequestTimeout = 10 * time.Second
+)
+
+// oauth2TokenSources caches token sources across conversations, a token is
+// fetched once and refreshed shortly before it expires.
+var oauth2TokenSources sync.Map
+
+// apiRequestAuth authorizes the requests of an api request tool.
+type apiRequestAuth interface {
+	// Headers returns the headers to add to a request.
+	Headers() (map[string]string, error)
+	// Invalidate drops cached credentials after the api rejected them.
+	Invalidate()
+}
+
+// oauth2ClientCredentials is enabled with tool.auth.type set to
+// oauth2_client_credentials:
+//
+//	tool.auth.credential_id  vault credential holding client_id and client_secret
+//	tool.auth.token_url      token endpoint of the authorization server
+//	tool.auth.scopes         optional, comma separated
+//	tool.auth.audience       optional, sent as the audience parameter
+type oauth2ClientCredentials struct {
+	key    string
+	config *clientcredentials.Config
+}
+
+func newApiRequestAuth(ctx context.Context, opts utils.Option, communication internal_type.Communication) (apiRequestAuth, error) {
+	authType, _ := opts.GetString("tool.auth.type")
+	switch authType {
+	case "":
+		return nil, nil
+	case apiRequestAuthOAuth2ClientCredentials:
+	default:
+		return nil, fmt.Errorf("tool.auth.type %s is not supported", authType)
+	}
+
+	credentialId, err := opts.GetUint64("tool.auth.credential_id")
+	if err != nil {
+		return nil, fmt.Errorf("tool.auth.credential_id is not a valid number: %v", err)
+	}
+	tokenURL, err := opts.GetString("tool.auth.token_url")
+	if err != nil || tokenURL == "" {
+		return nil, fmt.Errorf("tool.auth.token_url is required for %s", authType)
+	}
+	credential, err := communication.VaultCaller().GetCredential(ctx, communication.Auth(), credentialId)
+	if err != nil {
+		return nil, err
+	}
+	values := credential.GetValue().AsMap()
+	clientID, _ := values["client_id"].(string)
+	clientSecret, _ := values["client_secret"].(string)
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("credential %d must have client_id and client_secret", credentialId)
+	}
+
+	config := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+	if scopes, _ := opts.GetString("tool.auth.scopes"); scopes != "" {
+		for _, scope := range strings.Split(scopes, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				config.Scopes = append(config.Scopes, scope)
+			}
+		}
+	}
+	if audience, _ := opts.GetString("tool.auth.audience"); audience != "" {
+		config.EndpointParams = url.Values{"audience": {audience}}
+	}
+	return &oauth2ClientCredentials{key: tokenSourceKey(config), config: config}, nil
+}
+
+// tokenSourceKey identifies a token source, a rotated secret gets a new one.
+func tokenSourceKey(config *clientcredentials.Config) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{
+		config.TokenURL, config.ClientID, config.ClientSecret,
+		strings.Join(config.Scopes, " "), config.EndpointParams.Encode(),
+	}, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+func (o *oauth2ClientCredentials) Headers() (map[string]string, error) {
+	source, ok := oauth2TokenSources.Load(o.key)
+	if !ok {
+		tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Timeout: tokenRequestTimeout})
+		source, _ = oauth2TokenSources.LoadOrStore(o.key, o.config.TokenSource(tokenCtx))
+	}
+	token, err := source.(oauth2.TokenSource).Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get oauth2 token: %w", err)
+	}
+	return map[string]string{"Authorization": token.Type() + " " + token.AccessToken}, nil
+}
+
+func (o *oauth2ClientCredentials) Invalidate() {
+	oauth2TokenSources.Delete(o.key)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func newTestTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *int32) {
+	issued := new(int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		assert.Equal(t, "orders.read", r.Form.Get("scope"))
+		n := atomic.AddInt32(issued, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, issued
+}
+
+func newTestClientCredentials(tokenURL, secret string) *oauth2ClientCredentials {
+	config := &clientcredentials.Config{
+		ClientID:     "client",
+		ClientSecret: secret,
+		TokenURL:     tokenURL,
+		Scopes:       []string{"orders.read"},
+	}
+	return &oauth2ClientCredentials{key: tokenSourceKey(config), config: config}
+}
+
+func TestOAuth2ClientCredentials_CachesToken(t *testing.T) {
+	server, issued := newTestTokenServer(t, 3600)
+	auth := newTestClientCredentials(server.URL, "secret-cache")
+
+	for i := 0; i < 3; i++ {
+		headers, err := auth.Headers()
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer token-1", headers["Authorization"])
+	}
+	// another tool with the same client shares the token
+	headers, err := newTestClientCredentials(server.URL, "secret-cache").Headers()
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", headers["Authorization"])
+	assert.Equal(t, int32(1), atomic.LoadInt32(issued))
+}
+
+func TestOAuth2ClientCredentials_Refresh(t *testing.T) {
+	// tokens within the expiry delta are refreshed on use
+	server, issued := newTestTokenServer(t, 1)
+	auth := newTestClientCredentials(server.URL, "secret-refresh")
+
+	first, err := auth.Headers()
+	require.NoError(t, err)
+	second, err := auth.Headers()
+	require.NoError(t, err)
+	assert.NotEqual(t, first["Authorization"], second["Authorization"])
+	assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+}
+
+func TestOAuth2ClientCredentials_Invalidate(t *testing.T) {
+	server, issued := newTestTokenServer(t, 3600)
+	auth := newTestClientCredentials(server.URL, "secret-invalidate")
+
+	_, err := auth.Headers()
+	require.NoError(t, err)
+	auth.Invalidate()
+	headers, err := auth.Headers()
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-2", headers["Authorization"])
+	assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+}
+
+func TestOAuth2ClientCredentials_TokenError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestClientCredentials(server.URL, "secret-error").Headers()
+	assert.ErrorContains(t, err, "invalid_client")
+}
@@ -8,7 +8,10 @@ package internal_tool_local
 import (
 	"context"
 	"fmt"
+	"math"
+	"net/http"
 	"strings"
+	"time"
 
 	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
 	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
@@ -17,35 +20,40 @@ import (
 	"github.com/rapidaai/pkg/commons"
 )
 
+const defaultApiRequestTimeout = 15
+
 type apiRequestToolCaller struct {
 	toolCaller
 	apiRequestHeader    map[string]string
 	apiRequestParameter map[string]string
 	apiMethod           string
 	apiEndpoint         string
+	auth                apiRequestAuth
 }
 
 func (afkTool *apiRequestToolCaller) Call(ctx context.Context, contextID, toolId string, args map[string]interface{}, communication internal_type.Communication) internal_tool.ToolCallResult {
-	client := rest.NewRestClientWithConfig(afkTool.apiEndpoint, afkTool.apiRequestHeader, 15)
-	var output *rest.APIResponse
-	var err error
-
+	timeout := uint32(defaultApiRequestTimeout)
+	// a tool timeout policy bounds the call with a deadline
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = uint32(math.Ceil(time.Until(deadline).Seconds()))
+	}
+	client := rest.NewRestClientWithConfig(afkTool.apiEndpoint, afkTool.apiRequestHeader, timeout)
 	body := afkTool.parse(
 		afkTool.apiRequestParameter,
 		args,
 		communication,
 	)
-	switch afkTool.apiMethod {
-	case "POST":
-		output, err = client.Post(ctx, "", body, afkTool.apiRequestHeader)
-	case "PUT":
-		output, err = client.Put(ctx, "", body, afkTool.apiRequestHeader)
-	case "PATCH":
-		output, err = client.Patch(ctx, "", body, afkTool.apiRequestHeader)
-	default:
-		output, err = client.Get(ctx, "", body, afkTool.apiRequestHeader)
-	}
 
+	output, err := afkTool.request(ctx, client, body)
+	if err == nil && output.StatusCode == http.StatusUnauthorized && afkTool.auth != nil {
+		// the token may have been revoked before it expired, retry once with a fresh one
+		afkTool.auth.Invalidate()
+		output, err = afkTool.request(ctx, client, body)
+	}
+	if err != nil {
+		afkTool.logger.Errorf("error while calling api request tool %s: %v", afkTool.Name(), err)
+		return internal_tool.Result(fmt.Sprintf("request failed: %v", err), false)
+	}
 	v, err := output.ToMap()
 	if err != nil {
 		return internal_tool.Result("Unable to get result", true)
@@ -53,6 +61,33 @@ func (afkTool *apiRequestToolCaller) Call(ctx context.Context, contextID, toolId
 	return internal_tool.JustResult(v)
 }
 
+func (afkTool *apiRequestToolCaller) request(ctx context.Context, client *rest.RestClient, body map[string]interface{}) (*rest.APIResponse, error) {
+	headers := afkTool.apiRequestHeader
+	if afkTool.auth != nil {
+		authHeaders, err := afkTool.auth.Headers()
+		if err != nil {
+			return nil, err
+		}
+		headers = make(map[string]string, len(afkTool.apiRequestHeader)+len(authHeaders))
+		for k, v := range afkTool.apiRequestHeader {
+			headers[k] = v
+		}
+		for k, v := range authHeaders {
+			headers[k] = v
+		}
+	}
+	switch afkTool.apiMethod {
+	case "POST":
+		return client.Post(ctx, "", body, headers)
+	case "PUT":
+		return client.Put(ctx, "", body, headers)
+	case "PATCH":
+		return client.Patch(ctx, "", body, headers)
+	default:
+		return client.Get(ctx, "", body, headers)
+	}
+}
+
 func NewApiRequestToolCaller(ctx context.Context, logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communcation internal_type.Communication) (internal_tool.ToolCaller, error) {
 	opts := toolOptions.GetOptions()
 	endpoint, err := opts.GetString("tool.endpoint")
@@ -71,6 +106,10 @@ func NewApiRequestToolCaller(ctx context.Context, logger commons.Logger, toolOpt
 	if err != nil {
 		logger.Infof("ignoring headers for api requests.")
 	}
+	auth, err := newApiRequestAuth(ctx, opts, communcation)
+	if err != nil {
+		return nil, fmt.Errorf("tool.auth is not valid: %v", err)
+	}
 	return &apiRequestToolCaller{
 		toolCaller: toolCaller{
 			logger:      logger,
@@ -80,6 +119,7 @@ func NewApiRequestToolCaller(ctx context.Context, logger commons.Logger, toolOpt
 		apiRequestParameter: parameters,
 		apiEndpoint:         endpoint,
 		apiMethod:           method,
+		auth:                auth,
 	}, nil
 }
 
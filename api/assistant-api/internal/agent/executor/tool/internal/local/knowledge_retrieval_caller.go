@@ -26,6 +26,7 @@ type knowledgeRetrievalToolCaller struct {
 	scoreThreshold     float64
 	knowledge          *internal_knowledge_gorm.Knowledge
 	providerCredential *protos.VaultCredential
+	rerank             *internal_type.KnowledgeRerankOption
 }
 
 func (tc *knowledgeRetrievalToolCaller) argument(input map[string]interface{}) (*string, map[string]interface{}, error) {
@@ -53,6 +54,7 @@ func (afkTool *knowledgeRetrievalToolCaller) Call(ctx context.Context, contextID
 				RetrievalMethod:             afkTool.searchType,
 				TopK:                        afkTool.topK,
 				ScoreThreshold:              float32(afkTool.scoreThreshold),
+				Rerank:                      afkTool.rerank,
 			})
 
 		if len(knowledges) == 0 || err != nil {
@@ -120,6 +122,12 @@ func NewKnowledgeRetrievalToolCaller(
 		logger.Errorf("error while getting provider model credentials %v for embedding provide model id %d", err, knowledge.EmbeddingModelProviderName)
 		return nil, err
 	}
+
+	rerank, err := rerankOption(ctx, opts, communcation)
+	if err != nil {
+		logger.Errorf("error while getting reranker for knowledge retrieval %v", err)
+		return nil, err
+	}
 	return &knowledgeRetrievalToolCaller{
 		toolCaller: toolCaller{
 			logger:      logger,
@@ -130,5 +138,40 @@ func NewKnowledgeRetrievalToolCaller(
 		scoreThreshold:     scoreThreshold,
 		providerCredential: providerCredential,
 		knowledge:          knowledge,
+		rerank:             rerank,
 	}, nil
 }
+
+// rerankOption reads the optional rerank stage from the tool options,
+// it is enabled by setting tool.rerank.provider. Reranker model
+// parameters are given as tool.rerank.model.* and passed on as model.*.
+func rerankOption(ctx context.Context, opts utils.Option, communcation internal_type.Communication) (*internal_type.KnowledgeRerankOption, error) {
+	provider, err := opts.GetString("tool.rerank.provider")
+	if err != nil || provider == "" {
+		return nil, nil
+	}
+
+	credentialId, err := opts.GetUint64("tool.rerank.credential_id")
+	if err != nil {
+		return nil, fmt.Errorf("tool.rerank.credential_id is not a valid number: %v", err)
+	}
+	credential, err := communcation.VaultCaller().GetCredential(ctx, communcation.Auth(), credentialId)
+	if err != nil {
+		return nil, err
+	}
+
+	rerank := &internal_type.KnowledgeRerankOption{
+		ProviderCredential: credential,
+		ModelProviderName:  provider,
+		Options:            map[string]interface{}{},
+	}
+	if candidates, err := opts.GetUint32("tool.rerank.candidates"); err == nil {
+		rerank.Candidates = candidates
+	}
+	for k, v := range opts {
+		if name, ok := strings.CutPrefix(k, "tool.rerank.model."); ok {
+			rerank.Options["model."+name] = v
+		}
+	}
+	return rerank, nil
+}
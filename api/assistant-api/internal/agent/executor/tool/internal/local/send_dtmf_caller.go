@@ -0,0 +1,62 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+)
+
+// dtmfDigits are the keys that can be sent, separators the model may use for
+// readability (spaces, dashes) are dropped.
+const dtmfDigits = "0123456789*#ABCD"
+
+type sendDTMFCaller struct {
+	toolCaller
+}
+
+func (afkTool *sendDTMFCaller) Call(ctx context.Context, contextID, toolId string, args map[string]interface{}, communication internal_type.Communication) internal_tool.ToolCallResult {
+	digits, err := afkTool.digits(args)
+	if err != nil {
+		return internal_tool.Result(err.Error(), false)
+	}
+	communication.OnPacket(ctx, internal_type.SendDTMFPacket{Digits: digits, ContextID: contextID})
+	return internal_tool.Result(fmt.Sprintf("Sent digits %s.", digits), true)
+}
+
+func (afkTool *sendDTMFCaller) digits(args map[string]interface{}) (string, error) {
+	requested, _ := args["digits"].(string)
+	var digits strings.Builder
+	for _, d := range strings.ToUpper(requested) {
+		switch {
+		case strings.ContainsRune(dtmfDigits, d):
+			digits.WriteRune(d)
+		case d == ' ' || d == '-':
+		default:
+			return "", fmt.Errorf("invalid digit %q, only 0-9, *, # and A-D can be sent", d)
+		}
+	}
+	if digits.Len() == 0 {
+		return "", fmt.Errorf("digits are required")
+	}
+	return digits.String(), nil
+}
+
+func NewSendDTMFCaller(ctx context.Context, logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communcation internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	return &sendDTMFCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool_local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	internal_tool "github.com/rapidaai/api/assistant-api/internal/agent/executor/tool/internal"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+type transferCallCaller struct {
+	toolCaller
+	// destinations is the allowlist of numbers / SIP URIs the call may be
+	// transferred to, the first one is used when the model does not pick one.
+	destinations []string
+	callerID     string
+}
+
+func (afkTool *transferCallCaller) Call(ctx context.Context, contextID, toolId string, args map[string]interface{}, communication internal_type.Communication) internal_tool.ToolCallResult {
+	to, err := afkTool.destination(args)
+	if err != nil {
+		return internal_tool.Result(err.Error(), false)
+	}
+	arguments := map[string]interface{}{"transfer_to": to}
+	if afkTool.callerID != "" {
+		arguments["caller_id"] = afkTool.callerID
+	}
+	if reason, ok := args["reason"].(string); ok && reason != "" {
+		arguments["reason"] = reason
+	}
+	communication.OnPacket(ctx, internal_type.DirectivePacket{Directive: protos.ConversationDirective_TRANSFER_CONVERSATION, Arguments: arguments, ContextID: contextID})
+	return internal_tool.Result("Transferring the call.", true)
+}
+
+// destination resolves the requested transfer target against the allowlist.
+func (afkTool *transferCallCaller) destination(args map[string]interface{}) (string, error) {
+	requested, _ := args["transfer_to"].(string)
+	requested = strings.TrimSpace(requested)
+	if requested == "" {
+		return afkTool.destinations[0], nil
+	}
+	for _, d := range afkTool.destinations {
+		if d == requested {
+			return d, nil
+		}
+	}
+	return "", fmt.Errorf("transfer destination %s is not allowed", requested)
+}
+
+func NewTransferCallCaller(ctx context.Context, logger commons.Logger, toolOptions *internal_assistant_entity.AssistantTool, communcation internal_type.Communication,
+) (internal_tool.ToolCaller, error) {
+	opts := toolOptions.GetOptions()
+	transferTo, err := opts.GetString("tool.transfer_to")
+	if err != nil {
+		return nil, fmt.Errorf("tool.transfer_to is not a valid string: %v", err)
+	}
+	var destinations []string
+	for _, d := range strings.Split(transferTo, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			destinations = append(destinations, d)
+		}
+	}
+	if len(destinations) == 0 {
+		return nil, fmt.Errorf("tool.transfer_to requires at least one destination")
+	}
+	callerID, _ := opts.GetString("tool.caller_id")
+	return &transferCallCaller{
+		toolCaller: toolCaller{
+			logger:      logger,
+			toolOptions: toolOptions,
+		},
+		destinations: destinations,
+		callerID:     callerID,
+	}, nil
+}
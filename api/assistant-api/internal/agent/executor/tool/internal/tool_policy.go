@@ -0,0 +1,143 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/parsers"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// defaultFillerAfter is how long a tool may run before the filler is spoken.
+	defaultFillerAfter = time.Second
+)
+
+// ToolPolicy shapes how a tool call runs and what the LLM gets back. It is
+// read from the tool options:
+//
+//	tool.timeout            seconds before the call is abandoned
+//	tool.filler.message     spoken while the call runs longer than tool.filler.after
+//	tool.filler.after       milliseconds, 1000 by default
+//	tool.response.path      gjson path selecting part of a successful response
+//	tool.response.template  pongo2 template rendered with the response as `response`
+//	tool.error.template     pongo2 template rendered with `error` on failure
+type ToolPolicy struct {
+	logger         commons.Logger
+	templateParser parsers.StringTemplateParser
+
+	Timeout          time.Duration
+	FillerMessage    string
+	FillerAfter      time.Duration
+	ResponsePath     string
+	ResponseTemplate string
+	ErrorTemplate    string
+}
+
+// NewToolPolicy reads the policy of a tool, unset or empty options keep the
+// tool's own behavior.
+func NewToolPolicy(logger commons.Logger, opts utils.Option) (*ToolPolicy, error) {
+	policy := &ToolPolicy{
+		logger:         logger,
+		templateParser: parsers.NewPongo2StringTemplateParser(logger),
+		FillerAfter:    defaultFillerAfter,
+	}
+	if v, _ := opts.GetString("tool.timeout"); strings.TrimSpace(v) != "" {
+		seconds, err := opts.GetFloat64("tool.timeout")
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("tool.timeout is not a valid number of seconds: %v", opts["tool.timeout"])
+		}
+		policy.Timeout = time.Duration(seconds * float64(time.Second))
+	}
+	if v, _ := opts.GetString("tool.filler.after"); strings.TrimSpace(v) != "" {
+		ms, err := opts.GetFloat64("tool.filler.after")
+		if err != nil || ms < 0 {
+			return nil, fmt.Errorf("tool.filler.after is not a valid number of milliseconds: %v", opts["tool.filler.after"])
+		}
+		policy.FillerAfter = time.Duration(ms) * time.Millisecond
+	}
+	policy.FillerMessage, _ = opts.GetString("tool.filler.message")
+	policy.ResponsePath, _ = opts.GetString("tool.response.path")
+	policy.ResponseTemplate, _ = opts.GetString("tool.response.template")
+	policy.ErrorTemplate, _ = opts.GetString("tool.error.template")
+	return policy, nil
+}
+
+// Run calls the tool under the policy. filler is shared by the calls of one
+// turn so parallel tools speak the filler once.
+func (p *ToolPolicy) Run(ctx context.Context, contextID string, communication internal_type.Communication, filler *sync.Once,
+	call func(ctx context.Context) ToolCallResult) ToolCallResult {
+	if p == nil {
+		return call(ctx)
+	}
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+	if strings.TrimSpace(p.FillerMessage) != "" {
+		if filler == nil {
+			filler = &sync.Once{}
+		}
+		timer := time.AfterFunc(p.FillerAfter, func() {
+			filler.Do(func() {
+				communication.OnPacket(ctx, internal_type.FillerPacket{ContextID: contextID, Text: p.FillerMessage})
+			})
+		})
+		defer timer.Stop()
+	}
+
+	result := call(ctx)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		result = Result(fmt.Sprintf("tool did not respond within %s", p.Timeout), false)
+	}
+	return p.Shape(result)
+}
+
+// Shape applies the response projection to a successful result and the
+// error template to a failed one.
+func (p *ToolPolicy) Shape(result ToolCallResult) ToolCallResult {
+	if result["status"] == "FAIL" {
+		if p.ErrorTemplate == "" {
+			return result
+		}
+		return Result(p.templateParser.Parse(p.ErrorTemplate, map[string]interface{}{"error": result["error"]}), false)
+	}
+	if p.ResponsePath == "" && p.ResponseTemplate == "" {
+		return result
+	}
+
+	var response interface{} = map[string]interface{}(result)
+	if p.ResponsePath != "" {
+		raw, err := json.Marshal(result)
+		if err != nil {
+			return result
+		}
+		selected := gjson.GetBytes(raw, p.ResponsePath)
+		if !selected.Exists() {
+			p.logger.Warnf("tool response path %s did not match, passing the full response", p.ResponsePath)
+			return result
+		}
+		response = selected.Value()
+	}
+	if p.ResponseTemplate != "" {
+		return Result(p.templateParser.Parse(p.ResponseTemplate, map[string]interface{}{"response": response}), true)
+	}
+	if data, ok := response.(map[string]interface{}); ok {
+		return JustResult(data)
+	}
+	return JustResult(map[string]interface{}{"data": response, "status": "SUCCESS"})
+}
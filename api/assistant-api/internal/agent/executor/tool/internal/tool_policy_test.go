@@ -0,0 +1,153 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_tool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type packetRecorder struct {
+	internal_type.Communication
+	mu      sync.Mutex
+	packets []internal_type.Packet
+}
+
+func (r *packetRecorder) OnPacket(ctx context.Context, pkts ...internal_type.Packet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.packets = append(r.packets, pkts...)
+	return nil
+}
+
+func (r *packetRecorder) recorded() []internal_type.Packet {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]internal_type.Packet{}, r.packets...)
+}
+
+func newTestPolicy(t *testing.T, opts utils.Option) *ToolPolicy {
+	logger, _ := commons.NewApplicationLogger()
+	policy, err := NewToolPolicy(logger, opts)
+	require.NoError(t, err)
+	return policy
+}
+
+func TestNewToolPolicy(t *testing.T) {
+	policy := newTestPolicy(t, utils.Option{
+		"tool.timeout":        "2.5",
+		"tool.filler.message": "One moment please.",
+		"tool.filler.after":   "300",
+	})
+	assert.Equal(t, 2500*time.Millisecond, policy.Timeout)
+	assert.Equal(t, 300*time.Millisecond, policy.FillerAfter)
+	assert.Equal(t, "One moment please.", policy.FillerMessage)
+
+	assert.Equal(t, defaultFillerAfter, newTestPolicy(t, utils.Option{}).FillerAfter)
+	// empty values saved by the form are unset
+	assert.Zero(t, newTestPolicy(t, utils.Option{"tool.timeout": "", "tool.filler.after": " "}).Timeout)
+
+	logger, _ := commons.NewApplicationLogger()
+	_, err := NewToolPolicy(logger, utils.Option{"tool.timeout": "soon"})
+	assert.Error(t, err)
+}
+
+func TestToolPolicy_Nil(t *testing.T) {
+	var policy *ToolPolicy
+	result := policy.Run(context.Background(), "ctx", nil, nil, func(ctx context.Context) ToolCallResult {
+		return JustResult(map[string]interface{}{"raw": true})
+	})
+	assert.Equal(t, ToolCallResult{"raw": true}, result)
+}
+
+func TestToolPolicy_Timeout(t *testing.T) {
+	policy := newTestPolicy(t, utils.Option{
+		"tool.timeout":        "0.05",
+		"tool.error.template": "Sorry, {{ error }}",
+	})
+	result := policy.Run(context.Background(), "ctx", nil, nil, func(ctx context.Context) ToolCallResult {
+		<-ctx.Done()
+		return Result(ctx.Err().Error(), false)
+	})
+	assert.Equal(t, "FAIL", result["status"])
+	assert.Equal(t, "Sorry, tool did not respond within 50ms", result["error"])
+}
+
+func TestToolPolicy_Filler(t *testing.T) {
+	policy := newTestPolicy(t, utils.Option{
+		"tool.filler.message": "Let me check that.",
+		"tool.filler.after":   "10",
+	})
+	recorder := &packetRecorder{}
+	filler := &sync.Once{}
+
+	slow := func(ctx context.Context) ToolCallResult {
+		time.Sleep(50 * time.Millisecond)
+		return Result("done", true)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			policy.Run(context.Background(), "ctx-1", recorder, filler, slow)
+		}()
+	}
+	wg.Wait()
+	// parallel calls of a turn speak once
+	packets := recorder.recorded()
+	require.Len(t, packets, 1)
+	assert.Equal(t, internal_type.FillerPacket{ContextID: "ctx-1", Text: "Let me check that."}, packets[0])
+
+	// fast calls stay silent
+	recorder = &packetRecorder{}
+	policy.Run(context.Background(), "ctx-2", recorder, &sync.Once{}, func(ctx context.Context) ToolCallResult {
+		return Result("done", true)
+	})
+	time.Sleep(30 * time.Millisecond)
+	assert.Empty(t, recorder.recorded())
+}
+
+func TestToolPolicy_Shape(t *testing.T) {
+	response := JustResult(map[string]interface{}{
+		"order": map[string]interface{}{"id": "A1", "status": "shipped", "items": []interface{}{
+			map[string]interface{}{"name": "lamp"}, map[string]interface{}{"name": "desk"},
+		}},
+		"debug": "large payload",
+	})
+
+	// path selecting an object keeps it as the result
+	shaped := newTestPolicy(t, utils.Option{"tool.response.path": "order"}).Shape(response)
+	assert.Equal(t, "shipped", shaped["status"])
+	assert.NotContains(t, shaped, "debug")
+
+	// path selecting a value wraps it
+	shaped = newTestPolicy(t, utils.Option{"tool.response.path": "order.items.#.name"}).Shape(response)
+	assert.Equal(t, ToolCallResult{"data": []interface{}{"lamp", "desk"}, "status": "SUCCESS"}, shaped)
+
+	// template renders the selected value
+	shaped = newTestPolicy(t, utils.Option{
+		"tool.response.path":     "order",
+		"tool.response.template": "Order {{ response.id }} is {{ response.status }}.",
+	}).Shape(response)
+	assert.Equal(t, ToolCallResult{"data": "Order A1 is shipped.", "status": "SUCCESS"}, shaped)
+
+	// unmatched path passes the response through
+	shaped = newTestPolicy(t, utils.Option{"tool.response.path": "missing"}).Shape(response)
+	assert.Equal(t, response, shaped)
+
+	// failures are not projected
+	failed := Result("not found", false)
+	assert.Equal(t, failed, newTestPolicy(t, utils.Option{"tool.response.path": "order"}).Shape(failed))
+}
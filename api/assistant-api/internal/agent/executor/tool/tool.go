@@ -29,6 +29,7 @@ import (
 type toolExecutor struct {
 	logger                 commons.Logger
 	tools                  map[string]internal_tool.ToolCaller
+	policies               map[string]*internal_tool.ToolPolicy
 	availableToolFunctions []*protos.FunctionDefinition
 	mcpClients             []*internal_tool_mcp.Client
 }
@@ -38,13 +39,17 @@ func NewToolExecutor(logger commons.Logger) internal_agent_executor.ToolExecutor
 		logger:                 logger,
 		mcpClients:             make([]*internal_tool_mcp.Client, 0),
 		tools:                  make(map[string]internal_tool.ToolCaller),
+		policies:               make(map[string]*internal_tool.ToolPolicy),
 		availableToolFunctions: make([]*protos.FunctionDefinition, 0),
 	}
 }
 
-// registerTool safely registers a tool caller and its definition
-func (executor *toolExecutor) registerTool(caller internal_tool.ToolCaller, def *protos.FunctionDefinition) {
+// registerTool safely registers a tool caller, its definition and its execution policy
+func (executor *toolExecutor) registerTool(caller internal_tool.ToolCaller, def *protos.FunctionDefinition, policy *internal_tool.ToolPolicy) {
 	executor.tools[caller.Name()] = caller
+	if policy != nil {
+		executor.policies[caller.Name()] = policy
+	}
 	executor.availableToolFunctions = append(executor.availableToolFunctions, def)
 }
 
@@ -65,6 +70,10 @@ func (executor *toolExecutor) initializeLocalTool(ctx context.Context, logger co
 		return internal_tool_local.NewEndpointToolCaller(ctx, logger, toolOpts, communication)
 	case "end_of_conversation":
 		return internal_tool_local.NewEndOfConversationCaller(ctx, logger, toolOpts, communication)
+	case "transfer_call":
+		return internal_tool_local.NewTransferCallCaller(ctx, logger, toolOpts, communication)
+	case "send_dtmf":
+		return internal_tool_local.NewSendDTMFCaller(ctx, logger, toolOpts, communication)
 	default:
 		return nil, errors.New("illegal tool action provided")
 	}
@@ -79,6 +88,11 @@ func (executor *toolExecutor) initializeTools(ctx context.Context, tools []*inte
 			if err != nil {
 				continue
 			}
+			policy, err := internal_tool.NewToolPolicy(executor.logger, tool.GetOptions())
+			if err != nil {
+				executor.logger.Errorf("Ignoring execution policy for mcp tool %s: %v", tool.Name, err)
+				policy = nil
+			}
 			executor.mcpClients = append(executor.mcpClients, client)
 			definitions, err := client.ListTools(ctx)
 			if err != nil {
@@ -87,7 +101,7 @@ func (executor *toolExecutor) initializeTools(ctx context.Context, tools []*inte
 			for i, def := range definitions {
 				caller := internal_tool_mcp.NewMCPToolCaller(executor.logger, client, tool.Id+uint64(i), def.Name, def)
 				tracer.AddAttributes(ctx, internal_adapter_telemetry.KV{K: caller.Name(), V: internal_adapter_telemetry.StringValue(caller.ExecutionMethod())})
-				executor.registerTool(caller, def)
+				executor.registerTool(caller, def, policy)
 			}
 		default:
 			caller, err := executor.initializeLocalTool(ctx, executor.logger, tool, communication)
@@ -102,8 +116,17 @@ func (executor *toolExecutor) initializeTools(ctx context.Context, tools []*inte
 				continue
 			}
 
+			var policy *internal_tool.ToolPolicy
+			switch tool.ExecutionMethod {
+			case "api_request", "endpoint_request":
+				if policy, err = internal_tool.NewToolPolicy(executor.logger, tool.GetOptions()); err != nil {
+					executor.logger.Errorf("Ignoring execution policy for tool %s: %v", tool.Name, err)
+					policy = nil
+				}
+			}
+
 			tracer.AddAttributes(ctx, internal_adapter_telemetry.KV{K: caller.Name(), V: internal_adapter_telemetry.StringValue(caller.ExecutionMethod())})
-			executor.registerTool(caller, def)
+			executor.registerTool(caller, def, policy)
 		}
 
 	}
@@ -121,7 +144,7 @@ func (executor *toolExecutor) GetFunctionDefinitions() []*protos.FunctionDefinit
 	return executor.availableToolFunctions
 }
 
-func (executor *toolExecutor) execute(ctx context.Context, contextID string, call *protos.ToolCall, communication internal_type.Communication) *protos.ToolMessage_Tool {
+func (executor *toolExecutor) execute(ctx context.Context, contextID string, call *protos.ToolCall, communication internal_type.Communication, filler *sync.Once) *protos.ToolMessage_Tool {
 	ctx, span, _ := communication.Tracer().StartSpan(ctx, utils.AssistantToolExecuteStage, internal_adapter_telemetry.MessageKV(contextID))
 	defer span.EndSpan(ctx, utils.AssistantToolExecuteStage)
 
@@ -142,7 +165,9 @@ func (executor *toolExecutor) execute(ctx context.Context, contextID string, cal
 		ContextID: contextID,
 		Arguments: arguments,
 	})
-	output := funC.Call(ctx, contextID, call.GetId(), arguments, communication)
+	output := executor.policies[funC.Name()].Run(ctx, contextID, communication, filler, func(ctx context.Context) internal_tool.ToolCallResult {
+		return funC.Call(ctx, contextID, call.GetId(), arguments, communication)
+	})
 	communication.OnPacket(ctx, internal_type.LLMToolResultPacket{
 		ToolID:    call.GetId(),
 		Name:      call.GetFunction().GetName(),
@@ -160,12 +185,14 @@ func (executor *toolExecutor) ExecuteAll(ctx context.Context, contextID string,
 	// Use mutex-protected slices for concurrent writes
 	result := make([]*protos.ToolMessage_Tool, 0, len(calls))
 	var wg sync.WaitGroup
+	// one filler per turn however many tools are slow
+	filler := &sync.Once{}
 	for _, xt := range calls {
 		xtCopy := xt
 		wg.Add(1)
 		utils.Go(context.Background(), func() {
 			defer wg.Done()
-			result = append(result, executor.execute(ctx, contextID, xtCopy, communication))
+			result = append(result, executor.execute(ctx, contextID, xtCopy, communication, filler))
 		})
 	}
 	wg.Wait()
@@ -7,6 +7,7 @@ package internal_agent_rerankers
 
 import (
 	"context"
+	"sort"
 
 	"github.com/rapidaai/api/assistant-api/config"
 	integration_client "github.com/rapidaai/pkg/clients/integration"
@@ -33,35 +34,43 @@ func NewTextReranker(logger commons.Logger, cfg *config.AssistantConfig, redis c
 func (qe *textReranker) Rerank(ctx context.Context,
 	auth types.SimplePrinciple,
 	config *RerankingOption,
-	in []string, query string, additionalData map[string]string) (map[int32]string, error) {
+	in []string, query string, additionalData map[string]string) ([]Ranked[string], error) {
 
 	contents := make(map[int32]string)
 	for idx, s := range in {
 		contents[int32(idx)] = s
 	}
 
-	res, err := qe.integrationCaller.Reranking(ctx,
-		auth,
-		config.ModelProviderName,
-		qe.inputBuilder.Reranking(
-			qe.
-				inputBuilder.
-				Credential(config.ProviderCredential.GetId(), config.ProviderCredential.GetValue()),
-			qe.
-				inputBuilder.
-				Options(config.Options, nil),
-			additionalData,
-			contents,
-		))
+	request := qe.inputBuilder.Reranking(
+		qe.
+			inputBuilder.
+			Credential(config.ProviderCredential.GetId(), config.ProviderCredential.GetValue()),
+		qe.
+			inputBuilder.
+			Options(config.Options, nil),
+		additionalData,
+		contents,
+	)
+	request.Query = query
+
+	res, err := qe.integrationCaller.Reranking(ctx, auth, config.ModelProviderName, request)
 	if err != nil {
-		qe.logger.Errorf("Error while building embedding request for text query %v", err)
+		qe.logger.Errorf("Error while reranking for text query %v", err)
 		return nil, err
 	}
 
 	reranked := res.GetData()
-	output := make(map[int32]string, len(reranked))
+	output := make([]Ranked[string], 0, len(reranked))
 	for _, rk := range reranked {
-		output[rk.GetIndex()] = rk.GetContent()
+		if rk == nil || rk.GetIndex() < 0 || int(rk.GetIndex()) >= len(in) {
+			continue
+		}
+		output = append(output, Ranked[string]{
+			Index: rk.GetIndex(),
+			Value: in[rk.GetIndex()],
+			Score: rk.GetRelevanceScore(),
+		})
 	}
+	sort.SliceStable(output, func(i, j int) bool { return output[i].Score > output[j].Score })
 	return output, nil
 }
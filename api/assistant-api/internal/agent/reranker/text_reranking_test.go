@@ -0,0 +1,81 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_agent_rerankers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	protos "github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIntegrationClient struct {
+	integration_client.IntegrationServiceClient
+	provider string
+	request  *protos.RerankingRequest
+	response *protos.RerankingResponse
+	err      error
+}
+
+func (f *fakeIntegrationClient) Reranking(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.RerankingRequest) (*protos.RerankingResponse, error) {
+	f.provider = providerName
+	f.request = in
+	return f.response, f.err
+}
+
+func newTestReranker(client *fakeIntegrationClient) *textReranker {
+	logger, _ := commons.NewApplicationLogger()
+	return &textReranker{
+		logger:            logger,
+		integrationCaller: client,
+		inputBuilder:      integration_client_builders.NewRerankingInputBuilder(logger),
+	}
+}
+
+func TestTextReranker_OrdersByScore(t *testing.T) {
+	client := &fakeIntegrationClient{response: &protos.RerankingResponse{
+		Success: true,
+		Data: []*protos.Reranking{
+			{Index: 2, RelevanceScore: 0.4},
+			{Index: 0, RelevanceScore: 0.9},
+			// out of range indexes are ignored
+			{Index: 7, RelevanceScore: 0.8},
+		},
+	}}
+	rr := newTestReranker(client)
+
+	ranked, err := rr.Rerank(context.Background(), nil, &RerankingOption{
+		ProviderCredential: &protos.VaultCredential{Id: 11},
+		ModelProviderName:  "cohere",
+		Options:            map[string]interface{}{"model.name": "rerank-v3.5"},
+	}, []string{"a", "b", "c"}, "which one", map[string]string{"knowledge_id": "1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Ranked[string]{
+		{Index: 0, Value: "a", Score: 0.9},
+		{Index: 2, Value: "c", Score: 0.4},
+	}, ranked)
+
+	assert.Equal(t, "cohere", client.provider)
+	assert.Equal(t, "which one", client.request.GetQuery())
+	assert.Equal(t, map[int32]string{0: "a", 1: "b", 2: "c"}, client.request.GetContent())
+	assert.Equal(t, uint64(11), client.request.GetCredential().GetId())
+	assert.Contains(t, client.request.GetModelParameters(), "model.name")
+}
+
+func TestTextReranker_Error(t *testing.T) {
+	rr := newTestReranker(&fakeIntegrationClient{err: errors.New("unavailable")})
+
+	_, err := rr.Rerank(context.Background(), nil, &RerankingOption{ModelProviderName: "cohere"}, []string{"a"}, "q", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2023-2026 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_audio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rapidaai/protos"
+)
+
+const (
+	// DTMFToneDuration and DTMFPauseDuration (milliseconds) are comfortably
+	// above the 40ms minimum most IVRs need to detect a key.
+	DTMFToneDuration  = 100
+	DTMFPauseDuration = 100
+
+	// dtmfAmplitude keeps the sum of both tones well below clipping.
+	dtmfAmplitude = 0.35 * math.MaxInt16
+)
+
+// dtmfFrequencies maps each key to its (low, high) frequency pair in Hz.
+var dtmfFrequencies = map[rune][2]float64{
+	'1': {697, 1209}, '2': {697, 1336}, '3': {697, 1477}, 'A': {697, 1633},
+	'4': {770, 1209}, '5': {770, 1336}, '6': {770, 1477}, 'B': {770, 1633},
+	'7': {852, 1209}, '8': {852, 1336}, '9': {852, 1477}, 'C': {852, 1633},
+	'*': {941, 1209}, '0': {941, 1336}, '#': {941, 1477}, 'D': {941, 1633},
+}
+
+// GenerateDTMF renders digits as in-band DTMF tones in the given linear16
+// config, each key is a DTMFToneDuration tone followed by DTMFPauseDuration
+// of silence. Used on transports which can only carry audio.
+func GenerateDTMF(digits string, cfg *protos.AudioConfig) ([]byte, error) {
+	if cfg.GetAudioFormat() != protos.AudioConfig_LINEAR16 || cfg.GetSampleRate() == 0 {
+		return nil, fmt.Errorf("dtmf tones require a linear16 audio config")
+	}
+	channels := int(cfg.GetChannels())
+	if channels == 0 {
+		channels = 1
+	}
+	rate := float64(cfg.GetSampleRate())
+	toneSamples := int(cfg.GetSampleRate()) * DTMFToneDuration / 1000
+	pauseSamples := int(cfg.GetSampleRate()) * DTMFPauseDuration / 1000
+	frame := 2 * channels
+
+	digits = strings.ToUpper(digits)
+	out := make([]byte, 0, len(digits)*(toneSamples+pauseSamples)*frame)
+	for _, d := range digits {
+		freq, ok := dtmfFrequencies[d]
+		if !ok {
+			return nil, fmt.Errorf("invalid DTMF digit %q", d)
+		}
+		tone := make([]byte, (toneSamples+pauseSamples)*frame)
+		for i := 0; i < toneSamples; i++ {
+			t := float64(i) / rate
+			sample := int16(dtmfAmplitude * (math.Sin(2*math.Pi*freq[0]*t) + math.Sin(2*math.Pi*freq[1]*t)) / 2)
+			for c := 0; c < channels; c++ {
+				binary.LittleEndian.PutUint16(tone[i*frame+c*2:], uint16(sample))
+			}
+		}
+		out = append(out, tone...)
+	}
+	return out, nil
+}
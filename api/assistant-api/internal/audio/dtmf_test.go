@@ -0,0 +1,57 @@
+// Copyright (c) 2023-2026 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_audio
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// goertzel returns the power of freq in 16-bit little endian samples.
+func goertzel(pcm []byte, rate, freq float64) float64 {
+	coeff := 2 * math.Cos(2*math.Pi*freq/rate)
+	var s1, s2 float64
+	for i := 0; i+1 < len(pcm); i += 2 {
+		s := float64(int16(binary.LittleEndian.Uint16(pcm[i:]))) + coeff*s1 - s2
+		s2, s1 = s1, s
+	}
+	return s1*s1 + s2*s2 - coeff*s1*s2
+}
+
+func TestGenerateDTMF(t *testing.T) {
+	cfg := NewLinear16khzMonoAudioConfig()
+	pcm, err := GenerateDTMF("5#", cfg)
+	require.NoError(t, err)
+
+	perKey := BytesPerMs(cfg) * (DTMFToneDuration + DTMFPauseDuration)
+	require.Len(t, pcm, 2*perKey)
+
+	tone := pcm[:BytesPerMs(cfg)*DTMFToneDuration]
+	// '5' is 770Hz + 1336Hz
+	assert.Greater(t, goertzel(tone, 16000, 770), 100*goertzel(tone, 16000, 941))
+	assert.Greater(t, goertzel(tone, 16000, 1336), 100*goertzel(tone, 16000, 1477))
+
+	pause := pcm[len(tone):perKey]
+	for _, b := range pause {
+		assert.Zero(t, b)
+	}
+
+	tone = pcm[perKey : perKey+len(tone)]
+	// '#' is 941Hz + 1477Hz
+	assert.Greater(t, goertzel(tone, 16000, 941), 100*goertzel(tone, 16000, 770))
+	assert.Greater(t, goertzel(tone, 16000, 1477), 100*goertzel(tone, 16000, 1336))
+}
+
+func TestGenerateDTMF_Invalid(t *testing.T) {
+	_, err := GenerateDTMF("12x", NewLinear16khzMonoAudioConfig())
+	assert.Error(t, err)
+	_, err = GenerateDTMF("1", NewMulaw8khzMonoAudioConfig())
+	assert.Error(t, err)
+}
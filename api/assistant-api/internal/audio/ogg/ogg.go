@@ -0,0 +1,112 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+// Package internal_audio_ogg reads Ogg encapsulated Opus audio, the format of
+// voice notes. Page checksums are not verified.
+package internal_audio_ogg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	pageHeaderSize = 27
+	continuedFlag  = 0x01
+)
+
+var (
+	capturePattern = []byte("OggS")
+	opusHead       = []byte("OpusHead")
+	opusTags       = []byte("OpusTags")
+
+	// ErrNotOpus is returned for an Ogg stream that does not carry Opus.
+	ErrNotOpus = errors.New("ogg stream is not opus")
+)
+
+// Packets returns the packets of the first logical stream of an Ogg file,
+// joining packets that span pages.
+func Packets(data []byte) ([][]byte, error) {
+	var (
+		packets [][]byte
+		packet  []byte
+		serial  uint32
+		first   = true
+	)
+	for len(data) > 0 {
+		if len(data) < pageHeaderSize || !bytes.Equal(data[:4], capturePattern) {
+			return nil, fmt.Errorf("invalid ogg page header")
+		}
+		headerType := data[5]
+		pageSerial := binary.LittleEndian.Uint32(data[14:18])
+		segments := int(data[26])
+		if len(data) < pageHeaderSize+segments {
+			return nil, fmt.Errorf("truncated ogg segment table")
+		}
+		table := data[pageHeaderSize : pageHeaderSize+segments]
+		size := 0
+		for _, s := range table {
+			size += int(s)
+		}
+		body := data[pageHeaderSize+segments:]
+		if len(body) < size {
+			return nil, fmt.Errorf("truncated ogg page")
+		}
+		data = body[size:]
+
+		if first {
+			serial, first = pageSerial, false
+		}
+		if pageSerial != serial {
+			continue
+		}
+		if headerType&continuedFlag == 0 && len(packet) > 0 {
+			// the previous page ended in an unfinished packet
+			packet = nil
+		}
+		for _, s := range table {
+			packet = append(packet, body[:s]...)
+			body = body[s:]
+			if s < 255 {
+				packets = append(packets, packet)
+				packet = nil
+			}
+		}
+	}
+	return packets, nil
+}
+
+// OpusHeader is the identification header of an Opus stream.
+type OpusHeader struct {
+	Channels   int
+	PreSkip    int
+	SampleRate uint32
+}
+
+// Opus returns the identification header and the audio packets of an Ogg
+// Opus file.
+func Opus(data []byte) (*OpusHeader, [][]byte, error) {
+	packets, err := Packets(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(packets) == 0 || len(packets[0]) < 19 || !bytes.HasPrefix(packets[0], opusHead) {
+		return nil, nil, ErrNotOpus
+	}
+	head := packets[0]
+	header := &OpusHeader{
+		Channels:   int(head[9]),
+		PreSkip:    int(binary.LittleEndian.Uint16(head[10:12])),
+		SampleRate: binary.LittleEndian.Uint32(head[12:16]),
+	}
+	audio := packets[1:]
+	if len(audio) > 0 && bytes.HasPrefix(audio[0], opusTags) {
+		audio = audio[1:]
+	}
+	return header, audio, nil
+}
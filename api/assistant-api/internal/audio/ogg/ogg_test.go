@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_audio_ogg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// page encodes an Ogg page holding the lacing values and body of segments.
+func page(serial uint32, headerType byte, table []byte, body []byte) []byte {
+	header := make([]byte, pageHeaderSize)
+	copy(header, capturePattern)
+	header[5] = headerType
+	binary.LittleEndian.PutUint32(header[14:18], serial)
+	header[26] = byte(len(table))
+	out := append(header, table...)
+	return append(out, body...)
+}
+
+// lacing returns the segment table of a packet that ends on its page.
+func lacing(n int) []byte {
+	var table []byte
+	for ; n >= 255; n -= 255 {
+		table = append(table, 255)
+	}
+	return append(table, byte(n))
+}
+
+func opusHeadPacket(channels byte, preSkip uint16) []byte {
+	head := append([]byte{}, opusHead...)
+	head = append(head, 1, channels)
+	head = binary.LittleEndian.AppendUint16(head, preSkip)
+	head = binary.LittleEndian.AppendUint32(head, 48000)
+	return append(head, 0, 0, 0)
+}
+
+func TestPackets_JoinsPacketSpanningPages(t *testing.T) {
+	long := bytes.Repeat([]byte{7}, 300)
+	data := page(1, 0, []byte{255}, long[:255])
+	data = append(data, page(1, continuedFlag, []byte{45, 3}, append(long[255:], 1, 2, 3))...)
+
+	packets, err := Packets(data)
+	require.NoError(t, err)
+	require.Len(t, packets, 2)
+	assert.Equal(t, long, packets[0])
+	assert.Equal(t, []byte{1, 2, 3}, packets[1])
+}
+
+func TestPackets_ReadsFirstStreamOnly(t *testing.T) {
+	data := page(1, 0, []byte{2}, []byte{1, 1})
+	data = append(data, page(2, 0, []byte{2}, []byte{2, 2})...)
+	data = append(data, page(1, 0, []byte{1}, []byte{3})...)
+
+	packets, err := Packets(data)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{1, 1}, {3}}, packets)
+}
+
+func TestPackets_RejectsTruncatedPage(t *testing.T) {
+	data := page(1, 0, []byte{10}, []byte{1, 2, 3})
+	_, err := Packets(data)
+	assert.Error(t, err)
+
+	_, err = Packets([]byte("not an ogg file at all, clearly"))
+	assert.Error(t, err)
+}
+
+func TestOpus_SkipsHeaders(t *testing.T) {
+	head := opusHeadPacket(2, 312)
+	tags := append(append([]byte{}, opusTags...), 0, 0, 0, 0)
+	data := page(9, 0, lacing(len(head)), head)
+	data = append(data, page(9, 0, lacing(len(tags)), tags)...)
+	data = append(data, page(9, 0, []byte{3, 2}, []byte{1, 2, 3, 4, 5})...)
+
+	header, audio, err := Opus(data)
+	require.NoError(t, err)
+	assert.Equal(t, &OpusHeader{Channels: 2, PreSkip: 312, SampleRate: 48000}, header)
+	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5}}, audio)
+}
+
+func TestOpus_RejectsOtherCodecs(t *testing.T) {
+	vorbis := []byte("\x01vorbis-identification-header")
+	_, _, err := Opus(page(1, 0, lacing(len(vorbis)), vorbis))
+	assert.ErrorIs(t, err, ErrNotOpus)
+}
@@ -37,6 +37,11 @@ type Store interface {
 	// The row must remain readable for the full lifetime of the context.
 	Get(ctx context.Context, contextID string) (*CallContext, error)
 
+	// GetByChannelUUID retrieves the latest call context of the provider for
+	// the given provider call identifier (e.g. Exotel CallSid). Used by provider
+	// callbacks that only carry their own call identifier.
+	GetByChannelUUID(ctx context.Context, provider, channelUUID string) (*CallContext, error)
+
 	// Claim atomically transitions a call context from "pending" or "queued"
 	// to "claimed". Inbound contexts start as "pending"; outbound contexts
 	// start as "queued" (set by the outbound call creator). Only one concurrent
@@ -55,8 +60,14 @@ type Store interface {
 	// callbacks from the telephony provider can still resolve the context.
 	Complete(ctx context.Context, contextID string) error
 
+	// Conclude moves an outbound call context that never connected media,
+	// still "queued", to a final status such as busy or no-answer. A context
+	// that was claimed is left alone.
+	Conclude(ctx context.Context, contextID, status string) error
+
 	// UpdateField sets a single column on an existing call context.
-	// Used to patch the channel UUID after the telephony provider returns it.
+	// Used to patch the channel UUID after the telephony provider returns it,
+	// or to record the transfer destination of the call.
 	UpdateField(ctx context.Context, contextID, field, value string) error
 }
 
@@ -111,6 +122,22 @@ func (s *postgresStore) Get(ctx context.Context, contextID string) (*CallContext
 	return &cc, nil
 }
 
+// GetByChannelUUID retrieves the most recent call context of the provider
+// carrying the given provider call identifier.
+func (s *postgresStore) GetByChannelUUID(ctx context.Context, provider, channelUUID string) (*CallContext, error) {
+	if channelUUID == "" {
+		return nil, fmt.Errorf("channel uuid is required")
+	}
+	db := s.postgres.DB(ctx)
+	var cc CallContext
+	if err := db.Where("provider = ? AND channel_uuid = ?", provider, channelUUID).
+		Order("created_date DESC").
+		First(&cc).Error; err != nil {
+		return nil, fmt.Errorf("call context not found for %s call %s: %w", provider, channelUUID, err)
+	}
+	return &cc, nil
+}
+
 // Claim atomically transitions a call context from "pending" or "queued" to "claimed"
 // using an atomic UPDATE ... WHERE status IN ('pending','queued'). Only one concurrent
 // caller can win. The context remains in the database so event callbacks can still read it.
@@ -174,6 +201,24 @@ func (s *postgresStore) Complete(ctx context.Context, contextID string) error {
 	return nil
 }
 
+// Conclude sets the final status of a call context that is still queued.
+func (s *postgresStore) Conclude(ctx context.Context, contextID, status string) error {
+	db := s.postgres.DB(ctx)
+	result := db.Model(&CallContext{}).
+		Where("context_id = ? AND status = ?", contextID, StatusQueued).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"updated_date": time.Now(),
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to conclude call context %s: %w", contextID, result.Error)
+	}
+
+	s.logger.Debugf("concluded call context: contextId=%s, status=%s, updated=%d", contextID, status, result.RowsAffected)
+	return nil
+}
+
 // UpdateField sets a single column on an existing call context row.
 func (s *postgresStore) UpdateField(ctx context.Context, contextID, field, value string) error {
 	db := s.postgres.DB(ctx)
@@ -183,6 +228,7 @@ func (s *postgresStore) UpdateField(ctx context.Context, contextID, field, value
 		"channel_uuid": true,
 		"status":       true,
 		"provider":     true,
+		"transfer_to":  true,
 	}
 	if !allowed[field] {
 		return fmt.Errorf("field %q is not updatable on call context", field)
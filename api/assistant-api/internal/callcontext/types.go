@@ -7,6 +7,7 @@
 package internal_callcontext
 
 import (
+	"strings"
 	"time"
 
 	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
@@ -22,8 +23,26 @@ const (
 	StatusClaimed   = "claimed"   // Media connection established (AudioSocket/WebSocket)
 	StatusCompleted = "completed" // Call ended normally
 	StatusFailed    = "failed"    // Call setup or execution failed
+	StatusBusy      = "busy"      // Outbound: callee was busy
+	StatusNoAnswer  = "no-answer" // Outbound: callee did not answer
 )
 
+// UnansweredStatus maps a provider status event of an outbound call that
+// never connected to the final call context status, it reports false for
+// any other event.
+func UnansweredStatus(event string) (string, bool) {
+	switch strings.ToLower(event) {
+	case "busy":
+		return StatusBusy, true
+	case "no-answer", "no_answer", "noanswer", "unanswered", "timeout":
+		return StatusNoAnswer, true
+	case "failed", "rejected", "canceled", "cancelled":
+		return StatusFailed, true
+	default:
+		return "", false
+	}
+}
+
 // CallContext holds all the information needed to resolve a call session.
 // It bridges the gap between the HTTP call-setup request (inbound webhook or outbound gRPC)
 // and the AudioSocket/WebSocket connection that follows.
@@ -56,6 +75,11 @@ type CallContext struct {
 	// Asterisk channel ID, SIP Call-ID, etc.). Stored so that any telephony operation
 	// (transfer, disconnect, hold) can reference the live call on the provider.
 	ChannelUUID string `json:"channelUuid" gorm:"column:channel_uuid;type:varchar(200);not null;default:''"`
+
+	// TransferTo is the destination the assistant handed the call over to, for
+	// providers that route the call onwards from their own flow (Exotel) once
+	// the media stream ends.
+	TransferTo string `json:"transferTo" gorm:"column:transfer_to;type:varchar(200);not null;default:''"`
 }
 
 func (CallContext) TableName() string {
@@ -0,0 +1,353 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_campaign
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	"github.com/rapidaai/pkg/commons"
+)
+
+const (
+	defaultTickInterval = time.Second
+	defaultBatchSize    = 50
+
+	// limits of a credential that has no CredentialLimit
+	defaultMaxConcurrentCalls = 10
+	defaultCallsPerSecond     = 1.0
+
+	// ringTimeout is how long a queued call may wait for the callee to
+	// answer before the attempt counts as unanswered.
+	ringTimeout = 90 * time.Second
+	// dialLease is how long a dialing contact may go without a call context,
+	// an attempt interrupted by a shutdown fails once its lease is over.
+	dialLease = 2 * time.Minute
+)
+
+// Dialer places the calls of campaign contacts.
+type Dialer interface {
+	// Queue stores the call context of the current attempt of a contact and
+	// returns its context id and conversation id.
+	Queue(ctx context.Context, c *Campaign, contact *Contact) (string, uint64, error)
+
+	// Dispatch places the call of a queued call context.
+	Dispatch(ctx context.Context, contextID string) error
+}
+
+// Engine places the calls of running campaigns. Every tick, under a lock
+// shared by all replicas, it settles the dialing contacts whose call ended,
+// then claims the due contacts within their calling window up to the
+// concurrency and calls-per-second limits of each credential. Claimed
+// contacts are dialed once the tick commits.
+type Engine struct {
+	logger commons.Logger
+	store  Store
+	dialer Dialer
+
+	tickInterval time.Duration
+	batchSize    int
+	now          func() time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEngine creates a campaign engine dialing through dialer.
+func NewEngine(logger commons.Logger, store Store, dialer Dialer) *Engine {
+	return &Engine{
+		logger:       logger,
+		store:        store,
+		dialer:       dialer,
+		tickInterval: defaultTickInterval,
+		batchSize:    defaultBatchSize,
+		now:          time.Now,
+	}
+}
+
+// Connect starts placing the calls of running campaigns.
+func (e *Engine) Connect(ctx context.Context) error {
+	ctx, e.cancel = context.WithCancel(context.Background())
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.tickInterval)
+		defer ticker.Stop()
+		for {
+			e.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	e.logger.Infof("campaign engine started, ticking every %s", e.tickInterval)
+	return nil
+}
+
+// Disconnect stops placing calls and waits for the calls being dialed.
+func (e *Engine) Disconnect(ctx context.Context) error {
+	if e.cancel == nil {
+		return nil
+	}
+	e.cancel()
+	e.wg.Wait()
+	return nil
+}
+
+type dial struct {
+	campaign *Campaign
+	contact  *Contact
+}
+
+func (e *Engine) tick(ctx context.Context) {
+	var dials []dial
+	acquired, err := e.store.Schedule(ctx, func(s Store) error {
+		if err := e.reconcile(ctx, s); err != nil {
+			return err
+		}
+		if err := s.CompleteFinished(ctx); err != nil {
+			return err
+		}
+		var err error
+		dials, err = e.plan(ctx, s)
+		return err
+	})
+	if err != nil {
+		e.logger.Errorf("unable to schedule campaign calls: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	for _, d := range dials {
+		e.wg.Add(1)
+		go func(d dial) {
+			defer e.wg.Done()
+			e.dial(ctx, d.campaign, d.contact)
+		}(d)
+	}
+}
+
+// reconcile settles the dialing contacts whose call reached a final status.
+func (e *Engine) reconcile(ctx context.Context, s Store) error {
+	calls, err := s.Dialing(ctx)
+	if err != nil || len(calls) == 0 {
+		return err
+	}
+	ids := make([]uint64, 0, len(calls))
+	for _, call := range calls {
+		ids = append(ids, call.CampaignID)
+	}
+	campaigns, err := s.Campaigns(ctx, ids)
+	if err != nil {
+		return err
+	}
+	now := e.now()
+	for _, call := range calls {
+		status, done := outcome(call, now)
+		if !done {
+			continue
+		}
+		contact := &call.Contact
+		e.conclude(campaigns[contact.CampaignID], contact, status, now)
+		if err := s.UpdateContact(ctx, contact); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outcome returns the final status of the current attempt of a dialing
+// contact, false while the call is still ringing or in progress.
+func outcome(call *Call, now time.Time) (string, bool) {
+	switch call.CallStatus {
+	case "":
+		if call.LastDialedAt != nil && now.Sub(*call.LastDialedAt) < dialLease {
+			return "", false
+		}
+		return callcontext.StatusFailed, true
+	case callcontext.StatusQueued, callcontext.StatusPending:
+		if now.Sub(call.CallCreatedDate) < ringTimeout {
+			return "", false
+		}
+		return callcontext.StatusNoAnswer, true
+	case callcontext.StatusClaimed:
+		return "", false
+	default:
+		return call.CallStatus, true
+	}
+}
+
+// conclude moves a contact out of dialing with the final status of its
+// attempt, back to pending when the campaign retries that status.
+func (e *Engine) conclude(c *Campaign, contact *Contact, status string, now time.Time) {
+	contact.LastStatus = status
+	if status == callcontext.StatusCompleted {
+		contact.Status = ContactCompleted
+		return
+	}
+	if c == nil {
+		contact.Status = ContactFailed
+		return
+	}
+	delay, retry := c.GetRetryRules()[status]
+	switch {
+	case !retry || contact.Attempt >= c.MaxAttempts:
+		contact.Status = ContactFailed
+	case c.Status == StatusCancelled:
+		contact.Status = ContactCancelled
+	default:
+		contact.Status = ContactPending
+		contact.CallContextID = ""
+		contact.NextAttemptAt = now.Add(time.Duration(delay) * time.Second)
+	}
+	e.logger.Debugf("campaign %d contact %d attempt %d ended %s, contact is %s",
+		c.Id, contact.Id, contact.Attempt, status, contact.Status)
+}
+
+// plan claims the due contacts of running campaigns that can be called now.
+func (e *Engine) plan(ctx context.Context, s Store) ([]dial, error) {
+	campaigns, err := s.Running(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := e.now()
+	budgets := make(map[uint64]int)
+	var dials []dial
+	for _, c := range campaigns {
+		budget, ok := budgets[c.CredentialID]
+		if !ok {
+			if budget, err = e.budget(ctx, s, c, now); err != nil {
+				return nil, err
+			}
+		}
+		if budget <= 0 {
+			budgets[c.CredentialID] = budget
+			continue
+		}
+		window, err := ParseWindow(c.WindowStart, c.WindowEnd)
+		if err != nil {
+			e.logger.Warnf("campaign %d has an invalid calling window: %v", c.Id, err)
+			continue
+		}
+		due, err := s.Due(ctx, c.Id, now, e.batchSize)
+		if err != nil {
+			return nil, err
+		}
+		numbers := make([]string, 0, len(due))
+		for _, contact := range due {
+			numbers = append(numbers, contact.PhoneNumber)
+		}
+		suppressed, err := s.Suppressed(ctx, c.OrganizationID, c.ProjectID, numbers)
+		if err != nil {
+			return nil, err
+		}
+
+		// contacts outside their window or suppressed are settled even when
+		// the credential has no call left
+		for _, contact := range due {
+			switch open, next := window.Next(now, e.location(contact, c)); {
+			case suppressed[contact.PhoneNumber]:
+				contact.Status = ContactSuppressed
+			case !open:
+				contact.NextAttemptAt = next.In(now.Location())
+			case budget <= 0:
+				continue
+			default:
+				dialed := now
+				contact.Status = ContactDialing
+				contact.Attempt++
+				contact.CredentialID = c.CredentialID
+				contact.CallContextID = ""
+				contact.LastDialedAt = &dialed
+				dials = append(dials, dial{campaign: c, contact: contact})
+				budget--
+			}
+			if err := s.UpdateContact(ctx, contact); err != nil {
+				return nil, err
+			}
+		}
+		budgets[c.CredentialID] = budget
+	}
+	return dials, nil
+}
+
+// budget is the number of calls that can be placed now with the credential
+// of a campaign, the smaller of its free concurrent calls and of the calls
+// left in the current calls-per-second window.
+func (e *Engine) budget(ctx context.Context, s Store, c *Campaign, now time.Time) (int, error) {
+	maxConcurrent, cps := defaultMaxConcurrentCalls, defaultCallsPerSecond
+	limit, err := s.GetCredentialLimit(ctx, c.OrganizationID, c.ProjectID, c.CredentialID)
+	if err != nil {
+		return 0, err
+	}
+	if limit != nil {
+		maxConcurrent = int(limit.MaxConcurrentCalls)
+		if limit.CallsPerSecond > 0 {
+			cps = limit.CallsPerSecond
+		}
+	}
+	dialing, err := s.CountDialing(ctx, c.CredentialID)
+	if err != nil {
+		return 0, err
+	}
+	window, calls := rateWindow(cps)
+	dialed, err := s.CountDialedSince(ctx, c.CredentialID, now.Add(-window))
+	if err != nil {
+		return 0, err
+	}
+	return min(maxConcurrent-int(dialing), calls-int(dialed)), nil
+}
+
+// rateWindow returns the window calls per second are counted over and the
+// calls allowed in it, a second or the time one call takes when slower.
+func rateWindow(cps float64) (time.Duration, int) {
+	if cps >= 1 {
+		return time.Second, int(math.Floor(cps))
+	}
+	return time.Duration(float64(time.Second) / cps), 1
+}
+
+// location is the time zone of a contact, the campaign's when it has none.
+func (e *Engine) location(contact *Contact, c *Campaign) *time.Location {
+	name := contact.Timezone
+	if name == "" {
+		name = c.Timezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		e.logger.Warnf("campaign %d contact %d has an unknown timezone %q, using UTC", c.Id, contact.Id, name)
+		return time.UTC
+	}
+	return loc
+}
+
+// dial queues and places the call of a claimed contact.
+func (e *Engine) dial(ctx context.Context, c *Campaign, contact *Contact) {
+	contextID, conversationID, err := e.dialer.Queue(ctx, c, contact)
+	if err != nil {
+		e.logger.Errorf("unable to queue call of campaign %d contact %d: %v", c.Id, contact.Id, err)
+		e.conclude(c, contact, callcontext.StatusFailed, e.now())
+		if err := e.store.UpdateContact(ctx, contact); err != nil {
+			e.logger.Errorf("unable to update campaign contact %d: %v", contact.Id, err)
+		}
+		return
+	}
+	if err := e.store.SetCall(ctx, contact.Id, contextID, conversationID); err != nil {
+		e.logger.Errorf("unable to set call of campaign contact %d: %v", contact.Id, err)
+	}
+	// a failed dispatch marks the call context failed, the attempt is
+	// settled on a later tick
+	if err := e.dialer.Dispatch(ctx, contextID); err != nil {
+		e.logger.Warnf("unable to dispatch call of campaign %d contact %d: %v", c.Id, contact.Id, err)
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	Store
+	mu        sync.Mutex
+	campaigns []*Campaign
+	contacts  []*Contact
+	// call status and created date by call context id
+	calls      map[string]*Call
+	suppressed map[string]bool
+	limit      *CredentialLimit
+}
+
+func (s *memoryStore) Schedule(ctx context.Context, fn func(Store) error) (bool, error) {
+	return true, fn(s)
+}
+
+func (s *memoryStore) Running(ctx context.Context) ([]*Campaign, error) {
+	var out []*Campaign
+	for _, c := range s.campaigns {
+		if c.Status == StatusRunning {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) Campaigns(ctx context.Context, ids []uint64) (map[uint64]*Campaign, error) {
+	out := make(map[uint64]*Campaign)
+	for _, c := range s.campaigns {
+		out[c.Id] = c
+	}
+	return out, nil
+}
+
+func (s *memoryStore) Dialing(ctx context.Context) ([]*Call, error) {
+	var out []*Call
+	for _, c := range s.contacts {
+		if c.Status != ContactDialing {
+			continue
+		}
+		call := &Call{Contact: *c}
+		if cc, ok := s.calls[c.CallContextID]; ok {
+			call.CallStatus, call.CallCreatedDate = cc.CallStatus, cc.CallCreatedDate
+		}
+		out = append(out, call)
+	}
+	return out, nil
+}
+
+func (s *memoryStore) Due(ctx context.Context, campaignId uint64, now time.Time, limit int) ([]*Contact, error) {
+	var out []*Contact
+	for _, c := range s.contacts {
+		if c.CampaignID == campaignId && c.Status == ContactPending && !c.NextAttemptAt.After(now) && len(out) < limit {
+			copied := *c
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (s *memoryStore) Suppressed(ctx context.Context, organizationId, projectId uint64, numbers []string) (map[string]bool, error) {
+	return s.suppressed, nil
+}
+
+func (s *memoryStore) GetCredentialLimit(ctx context.Context, organizationId, projectId, credentialId uint64) (*CredentialLimit, error) {
+	return s.limit, nil
+}
+
+func (s *memoryStore) CountDialing(ctx context.Context, credentialId uint64) (int64, error) {
+	var n int64
+	for _, c := range s.contacts {
+		if c.CredentialID == credentialId && c.Status == ContactDialing {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *memoryStore) CountDialedSince(ctx context.Context, credentialId uint64, since time.Time) (int64, error) {
+	var n int64
+	for _, c := range s.contacts {
+		if c.CredentialID == credentialId && c.LastDialedAt != nil && c.LastDialedAt.After(since) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *memoryStore) UpdateContact(ctx context.Context, contact *Contact) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, c := range s.contacts {
+		if c.Id == contact.Id {
+			copied := *contact
+			s.contacts[i] = &copied
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) SetCall(ctx context.Context, contactId uint64, contextID string, conversationID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.contacts {
+		if c.Id == contactId {
+			c.CallContextID, c.ConversationID = contextID, conversationID
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) CompleteFinished(ctx context.Context) error {
+	return nil
+}
+
+func (s *memoryStore) contact(id uint64) *Contact {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.contacts {
+		if c.Id == id {
+			return c
+		}
+	}
+	return nil
+}
+
+type fakeDialer struct {
+	mu         sync.Mutex
+	queued     []string
+	dispatched []string
+	err        error
+}
+
+func (d *fakeDialer) Queue(ctx context.Context, c *Campaign, contact *Contact) (string, uint64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return "", 0, d.err
+	}
+	d.queued = append(d.queued, contact.PhoneNumber)
+	return fmt.Sprintf("ctx-%d", contact.Id), contact.Id + 1000, nil
+}
+
+func (d *fakeDialer) Dispatch(ctx context.Context, contextID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dispatched = append(d.dispatched, contextID)
+	return nil
+}
+
+var testNow = time.Date(2025, 3, 4, 15, 0, 0, 0, time.UTC)
+
+func newTestEngine(t *testing.T, campaign *Campaign, contacts ...*Contact) (*Engine, *memoryStore, *fakeDialer) {
+	logger, _ := commons.NewApplicationLogger()
+	store := &memoryStore{
+		campaigns:  []*Campaign{campaign},
+		contacts:   contacts,
+		calls:      make(map[string]*Call),
+		suppressed: make(map[string]bool),
+	}
+	dialer := &fakeDialer{}
+	e := NewEngine(logger, store, dialer)
+	e.now = func() time.Time { return testNow }
+	return e, store, dialer
+}
+
+func testCampaign() *Campaign {
+	c := &Campaign{Id: 1, Status: StatusRunning, CredentialID: 9, Timezone: "UTC", MaxAttempts: 3}
+	c.SetRetryRules(defaultRetryRules)
+	return c
+}
+
+func pendingContacts(n int) []*Contact {
+	out := make([]*Contact, 0, n)
+	for i := 1; i <= n; i++ {
+		out = append(out, &Contact{
+			Id:            uint64(i),
+			CampaignID:    1,
+			Status:        ContactPending,
+			PhoneNumber:   fmt.Sprintf("+1555000%04d", i),
+			NextAttemptAt: testNow.Add(-time.Minute),
+		})
+	}
+	return out
+}
+
+func TestEngineRespectsCredentialLimits(t *testing.T) {
+	e, store, dialer := newTestEngine(t, testCampaign(), pendingContacts(5)...)
+	store.limit = &CredentialLimit{CredentialID: 9, MaxConcurrentCalls: 2, CallsPerSecond: 10}
+
+	e.tick(context.Background())
+	e.wg.Wait()
+	assert.Len(t, dialer.queued, 2)
+	assert.ElementsMatch(t, []string{"ctx-1", "ctx-2"}, dialer.dispatched)
+	assert.Equal(t, ContactDialing, store.contact(1).Status)
+	assert.Equal(t, uint32(1), store.contact(1).Attempt)
+	assert.Equal(t, "ctx-1", store.contact(1).CallContextID)
+	assert.Equal(t, uint64(1001), store.contact(1).ConversationID)
+	assert.Equal(t, ContactPending, store.contact(3).Status)
+
+	// both calls are still in flight, nothing more is dialed
+	e.tick(context.Background())
+	e.wg.Wait()
+	assert.Len(t, dialer.queued, 2)
+}
+
+func TestEngineRespectsCallsPerSecond(t *testing.T) {
+	e, store, dialer := newTestEngine(t, testCampaign(), pendingContacts(5)...)
+	store.limit = &CredentialLimit{CredentialID: 9, MaxConcurrentCalls: 100, CallsPerSecond: 0.5}
+
+	e.tick(context.Background())
+	e.wg.Wait()
+	assert.Len(t, dialer.queued, 1)
+
+	e.now = func() time.Time { return testNow.Add(time.Second) }
+	e.tick(context.Background())
+	e.wg.Wait()
+	assert.Len(t, dialer.queued, 1)
+
+	e.now = func() time.Time { return testNow.Add(2 * time.Second) }
+	e.tick(context.Background())
+	e.wg.Wait()
+	assert.Len(t, dialer.queued, 2)
+}
+
+func TestEngineCallingWindowAndSuppression(t *testing.T) {
+	campaign := testCampaign()
+	campaign.WindowStart, campaign.WindowEnd = "09:00", "17:00"
+	contacts := pendingContacts(3)
+	// 15:00 UTC is 00:00 in Tokyo
+	contacts[1].Timezone = "Asia/Tokyo"
+	e, store, dialer := newTestEngine(t, campaign, contacts...)
+	store.suppressed[contacts[2].PhoneNumber] = true
+
+	e.tick(context.Background())
+	e.wg.Wait()
+	assert.Equal(t, []string{contacts[0].PhoneNumber}, dialer.queued)
+
+	tokyo := store.contact(2)
+	assert.Equal(t, ContactPending, tokyo.Status)
+	assert.True(t, tokyo.NextAttemptAt.Equal(time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)), "next attempt %s", tokyo.NextAttemptAt)
+	assert.Equal(t, ContactSuppressed, store.contact(3).Status)
+}
+
+func TestEngineRetriesByCallStatus(t *testing.T) {
+	dialed := testNow.Add(-time.Minute)
+	dialing := func(id uint64, attempt uint32) *Contact {
+		return &Contact{Id: id, CampaignID: 1, Status: ContactDialing, Attempt: attempt, CredentialID: 9,
+			CallContextID: fmt.Sprintf("ctx-%d", id), LastDialedAt: &dialed, NextAttemptAt: dialed}
+	}
+	e, store, _ := newTestEngine(t, testCampaign(),
+		dialing(1, 1), dialing(2, 1), dialing(3, 3), dialing(4, 1), dialing(5, 1), dialing(6, 1), dialing(7, 1))
+	store.calls["ctx-1"] = &Call{CallStatus: callcontext.StatusCompleted}
+	store.calls["ctx-2"] = &Call{CallStatus: callcontext.StatusBusy}
+	store.calls["ctx-3"] = &Call{CallStatus: callcontext.StatusBusy}
+	store.calls["ctx-4"] = &Call{CallStatus: callcontext.StatusFailed}
+	store.calls["ctx-5"] = &Call{CallStatus: callcontext.StatusQueued, CallCreatedDate: testNow.Add(-2 * ringTimeout)}
+	store.calls["ctx-6"] = &Call{CallStatus: callcontext.StatusClaimed, CallCreatedDate: testNow.Add(-time.Hour)}
+	store.calls["ctx-7"] = &Call{CallStatus: callcontext.StatusQueued, CallCreatedDate: testNow.Add(-time.Second)}
+
+	require.NoError(t, e.reconcile(context.Background(), store))
+
+	assert.Equal(t, ContactCompleted, store.contact(1).Status)
+
+	busy := store.contact(2)
+	assert.Equal(t, ContactPending, busy.Status)
+	assert.Equal(t, callcontext.StatusBusy, busy.LastStatus)
+	assert.Empty(t, busy.CallContextID)
+	assert.True(t, busy.NextAttemptAt.Equal(testNow.Add(300*time.Second)))
+
+	// attempts exhausted
+	assert.Equal(t, ContactFailed, store.contact(3).Status)
+	// no retry rule for failed calls
+	assert.Equal(t, ContactFailed, store.contact(4).Status)
+
+	unanswered := store.contact(5)
+	assert.Equal(t, ContactPending, unanswered.Status)
+	assert.Equal(t, callcontext.StatusNoAnswer, unanswered.LastStatus)
+	assert.True(t, unanswered.NextAttemptAt.Equal(testNow.Add(900*time.Second)))
+
+	// in progress and ringing calls are left alone
+	assert.Equal(t, ContactDialing, store.contact(6).Status)
+	assert.Equal(t, ContactDialing, store.contact(7).Status)
+}
+
+func TestEngineCancelledCampaignDoesNotRetry(t *testing.T) {
+	campaign := testCampaign()
+	campaign.Status = StatusCancelled
+	dialed := testNow.Add(-time.Minute)
+	e, store, _ := newTestEngine(t, campaign, &Contact{Id: 1, CampaignID: 1, Status: ContactDialing, Attempt: 1,
+		CallContextID: "ctx-1", LastDialedAt: &dialed})
+	store.calls["ctx-1"] = &Call{CallStatus: callcontext.StatusBusy}
+
+	require.NoError(t, e.reconcile(context.Background(), store))
+	assert.Equal(t, ContactCancelled, store.contact(1).Status)
+}
+
+func TestEngineFailsContactWhenQueueFails(t *testing.T) {
+	e, store, dialer := newTestEngine(t, testCampaign(), pendingContacts(1)...)
+	dialer.err = errors.New("assistant not found")
+
+	e.tick(context.Background())
+	e.wg.Wait()
+	contact := store.contact(1)
+	assert.Equal(t, ContactFailed, contact.Status)
+	assert.Equal(t, callcontext.StatusFailed, contact.LastStatus)
+	assert.Empty(t, dialer.dispatched)
+}
+
+func TestRateWindow(t *testing.T) {
+	window, calls := rateWindow(5)
+	assert.Equal(t, time.Second, window)
+	assert.Equal(t, 5, calls)
+
+	window, calls = rateWindow(0.25)
+	assert.Equal(t, 4*time.Second, window)
+	assert.Equal(t, 1, calls)
+}
+
+func TestSetCredentialLimitRejectsLimitsThatNeverCall(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	store := NewStore(nil, logger)
+
+	for _, l := range []*CredentialLimit{
+		{CredentialID: 7, MaxConcurrentCalls: 0, CallsPerSecond: 1},
+		{CredentialID: 7, MaxConcurrentCalls: 2, CallsPerSecond: 0},
+	} {
+		err := store.SetCredentialLimit(context.Background(), l)
+		assert.ErrorIs(t, err, ErrInvalidCredentialLimit)
+	}
+}
+
+func TestCampaignToAuthIsProjectServiceScope(t *testing.T) {
+	auth := (&Campaign{OrganizationID: 1, ProjectID: 2}).ToAuth()
+	assert.Empty(t, auth.GetCurrentToken())
+	assert.Equal(t, uint64(1), *auth.GetCurrentOrganizationId())
+	assert.Equal(t, uint64(2), *auth.GetCurrentProjectId())
+}
@@ -0,0 +1,479 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCampaignNotFound       = errors.New("campaign not found")
+	ErrInvalidTransition      = errors.New("invalid campaign transition")
+	ErrInvalidCredentialLimit = errors.New("invalid credential limit")
+)
+
+// scheduleLockKey is the Postgres advisory lock held while campaign calls
+// are planned, so a single replica paces the calls of a credential.
+const scheduleLockKey = 7316203541
+
+// Store persists campaigns, their contacts, the do-not-call list and the
+// credential limits in Postgres.
+type Store interface {
+	// CreateCampaign stores a campaign with its contacts, contacts on the
+	// do-not-call list are stored as suppressed.
+	CreateCampaign(ctx context.Context, c *Campaign, contacts []*Contact) error
+
+	// AddContacts appends contacts to a campaign that is not over.
+	AddContacts(ctx context.Context, c *Campaign, contacts []*Contact) error
+
+	// GetCampaign returns a campaign of the project.
+	GetCampaign(ctx context.Context, organizationId, projectId, campaignId uint64) (*Campaign, error)
+
+	// Transition moves a campaign of the project to running, paused or
+	// cancelled. Cancelling also cancels its pending contacts.
+	Transition(ctx context.Context, organizationId, projectId, campaignId uint64, to string) (*Campaign, error)
+
+	// Progress counts the contacts of a campaign by status.
+	Progress(ctx context.Context, campaignId uint64) (*Progress, error)
+
+	// SuppressNumbers adds numbers to the do-not-call list of the project.
+	SuppressNumbers(ctx context.Context, organizationId, projectId uint64, numbers []string, reason string) error
+
+	// UnsuppressNumber removes a number from the do-not-call list of the project.
+	UnsuppressNumber(ctx context.Context, organizationId, projectId uint64, number string) error
+
+	// Suppressed returns the numbers that are on the do-not-call list of the project.
+	Suppressed(ctx context.Context, organizationId, projectId uint64, numbers []string) (map[string]bool, error)
+
+	// SetCredentialLimit creates or replaces the limits of a credential.
+	SetCredentialLimit(ctx context.Context, l *CredentialLimit) error
+
+	// GetCredentialLimit returns the limits of a credential, nil when the
+	// credential uses the default limits.
+	GetCredentialLimit(ctx context.Context, organizationId, projectId, credentialId uint64) (*CredentialLimit, error)
+
+	// Schedule runs fn with a store bound to a transaction holding the
+	// schedule lock, it reports false without running fn when another
+	// replica holds the lock.
+	Schedule(ctx context.Context, fn func(Store) error) (bool, error)
+
+	// Running returns the running campaigns.
+	Running(ctx context.Context) ([]*Campaign, error)
+
+	// Campaigns returns campaigns by id.
+	Campaigns(ctx context.Context, ids []uint64) (map[uint64]*Campaign, error)
+
+	// Dialing returns the dialing contacts with the status of their call.
+	Dialing(ctx context.Context) ([]*Call, error)
+
+	// Due returns up to limit pending contacts of a campaign whose next
+	// attempt is due.
+	Due(ctx context.Context, campaignId uint64, now time.Time, limit int) ([]*Contact, error)
+
+	// CountDialing counts the calls in flight with a credential.
+	CountDialing(ctx context.Context, credentialId uint64) (int64, error)
+
+	// CountDialedSince counts the calls placed with a credential after a time.
+	CountDialedSince(ctx context.Context, credentialId uint64, since time.Time) (int64, error)
+
+	// UpdateContact saves the state of a contact.
+	UpdateContact(ctx context.Context, c *Contact) error
+
+	// SetCall records the call context and conversation of the current
+	// attempt of a dialing contact.
+	SetCall(ctx context.Context, contactId uint64, contextID string, conversationID uint64) error
+
+	// CompleteFinished completes the running campaigns with no pending or
+	// dialing contact.
+	CompleteFinished(ctx context.Context) error
+}
+
+type postgresStore struct {
+	postgres connectors.PostgresConnector
+	logger   commons.Logger
+	tx       *gorm.DB
+}
+
+// NewStore creates a new campaign store backed by Postgres.
+func NewStore(postgres connectors.PostgresConnector, logger commons.Logger) Store {
+	return &postgresStore{
+		postgres: postgres,
+		logger:   logger,
+	}
+}
+
+func (s *postgresStore) db(ctx context.Context) *gorm.DB {
+	if s.tx != nil {
+		return s.tx.WithContext(ctx)
+	}
+	return s.postgres.DB(ctx)
+}
+
+func (s *postgresStore) CreateCampaign(ctx context.Context, c *Campaign, contacts []*Contact) error {
+	err := s.db(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(c).Error; err != nil {
+			return err
+		}
+		return s.insertContacts(tx, c, contacts)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create campaign %s: %w", c.Name, err)
+	}
+	s.logger.Infof("created campaign: id=%d, assistant=%d, contacts=%d", c.Id, c.AssistantID, len(contacts))
+	return nil
+}
+
+func (s *postgresStore) AddContacts(ctx context.Context, c *Campaign, contacts []*Contact) error {
+	err := s.db(ctx).Transaction(func(tx *gorm.DB) error {
+		// completed campaigns run again for the new contacts
+		result := tx.Model(&Campaign{}).
+			Where("id = ? AND status IN ?", c.Id, []string{StatusRunning, StatusPaused, StatusCompleted}).
+			Updates(map[string]interface{}{
+				"status":       gorm.Expr("CASE WHEN status = ? THEN ? ELSE status END", StatusCompleted, StatusRunning),
+				"updated_date": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: campaign %d is %s", ErrInvalidTransition, c.Id, c.Status)
+		}
+		return s.insertContacts(tx, c, contacts)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add contacts to campaign %d: %w", c.Id, err)
+	}
+	return nil
+}
+
+func (s *postgresStore) insertContacts(tx *gorm.DB, c *Campaign, contacts []*Contact) error {
+	if len(contacts) == 0 {
+		return nil
+	}
+	numbers := make([]string, 0, len(contacts))
+	for _, contact := range contacts {
+		numbers = append(numbers, contact.PhoneNumber)
+	}
+	suppressed, err := suppressed(tx, c.OrganizationID, c.ProjectID, numbers)
+	if err != nil {
+		return err
+	}
+	for _, contact := range contacts {
+		contact.CampaignID = c.Id
+		if contact.NextAttemptAt.IsZero() {
+			contact.NextAttemptAt = c.StartAt
+		}
+		if suppressed[contact.PhoneNumber] {
+			contact.Status = ContactSuppressed
+		}
+	}
+	return tx.CreateInBatches(contacts, 500).Error
+}
+
+func (s *postgresStore) GetCampaign(ctx context.Context, organizationId, projectId, campaignId uint64) (*Campaign, error) {
+	var c Campaign
+	err := s.db(ctx).
+		Where("id = ? AND organization_id = ? AND project_id = ?", campaignId, organizationId, projectId).
+		First(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %d", ErrCampaignNotFound, campaignId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get campaign %d: %w", campaignId, err)
+	}
+	return &c, nil
+}
+
+func (s *postgresStore) Transition(ctx context.Context, organizationId, projectId, campaignId uint64, to string) (*Campaign, error) {
+	var from []string
+	switch to {
+	case StatusPaused:
+		from = []string{StatusRunning}
+	case StatusRunning:
+		from = []string{StatusPaused}
+	case StatusCancelled:
+		from = []string{StatusRunning, StatusPaused}
+	default:
+		return nil, fmt.Errorf("%w: to %s", ErrInvalidTransition, to)
+	}
+
+	c, err := s.GetCampaign(ctx, organizationId, projectId, campaignId)
+	if err != nil {
+		return nil, err
+	}
+	err = s.db(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&Campaign{}).
+			Where("id = ? AND status IN ?", campaignId, from).
+			Updates(map[string]interface{}{"status": to, "updated_date": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: campaign %d is %s", ErrInvalidTransition, campaignId, c.Status)
+		}
+		if to != StatusCancelled {
+			return nil
+		}
+		return tx.Model(&Contact{}).
+			Where("campaign_id = ? AND status = ?", campaignId, ContactPending).
+			Updates(map[string]interface{}{"status": ContactCancelled, "updated_date": now}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to move campaign %d to %s: %w", campaignId, to, err)
+	}
+	c.Status = to
+	s.logger.Infof("campaign %d is %s", campaignId, to)
+	return c, nil
+}
+
+func (s *postgresStore) Progress(ctx context.Context, campaignId uint64) (*Progress, error) {
+	var rows []struct {
+		Status   string
+		Count    int64
+		Attempts int64
+	}
+	if err := s.db(ctx).Model(&Contact{}).
+		Select("status, COUNT(*) AS count, COALESCE(SUM(attempt), 0) AS attempts").
+		Where("campaign_id = ?", campaignId).
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count contacts of campaign %d: %w", campaignId, err)
+	}
+	p := &Progress{Statuses: make(map[string]int64, len(rows))}
+	for _, r := range rows {
+		p.Statuses[r.Status] = r.Count
+		p.Total += r.Count
+		p.Attempts += r.Attempts
+	}
+	return p, nil
+}
+
+func (s *postgresStore) SuppressNumbers(ctx context.Context, organizationId, projectId uint64, numbers []string, reason string) error {
+	if len(numbers) == 0 {
+		return nil
+	}
+	rows := make([]*DoNotCall, 0, len(numbers))
+	for _, n := range numbers {
+		rows = append(rows, &DoNotCall{OrganizationID: organizationId, ProjectID: projectId, PhoneNumber: n, Reason: reason})
+	}
+	err := s.db(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "phone_number"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reason"}),
+		}).Create(&rows).Error; err != nil {
+			return err
+		}
+		// contacts waiting for a call are suppressed right away
+		return tx.Model(&Contact{}).
+			Where("status = ? AND phone_number IN ? AND campaign_id IN (?)", ContactPending, numbers,
+				tx.Model(&Campaign{}).Select("id").Where("organization_id = ? AND project_id = ?", organizationId, projectId)).
+			Updates(map[string]interface{}{"status": ContactSuppressed, "updated_date": time.Now()}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to suppress numbers: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresStore) UnsuppressNumber(ctx context.Context, organizationId, projectId uint64, number string) error {
+	if err := s.db(ctx).
+		Where("organization_id = ? AND project_id = ? AND phone_number = ?", organizationId, projectId, number).
+		Delete(&DoNotCall{}).Error; err != nil {
+		return fmt.Errorf("failed to unsuppress number: %w", err)
+	}
+	return nil
+}
+
+func (s *postgresStore) Suppressed(ctx context.Context, organizationId, projectId uint64, numbers []string) (map[string]bool, error) {
+	out, err := suppressed(s.db(ctx), organizationId, projectId, numbers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read do-not-call list: %w", err)
+	}
+	return out, nil
+}
+
+func suppressed(db *gorm.DB, organizationId, projectId uint64, numbers []string) (map[string]bool, error) {
+	out := make(map[string]bool)
+	if len(numbers) == 0 {
+		return out, nil
+	}
+	var found []string
+	if err := db.Model(&DoNotCall{}).
+		Where("organization_id = ? AND project_id = ? AND phone_number IN ?", organizationId, projectId, numbers).
+		Pluck("phone_number", &found).Error; err != nil {
+		return nil, err
+	}
+	for _, n := range found {
+		out[n] = true
+	}
+	return out, nil
+}
+
+func (s *postgresStore) SetCredentialLimit(ctx context.Context, l *CredentialLimit) error {
+	if l.MaxConcurrentCalls == 0 {
+		return fmt.Errorf("%w: max concurrent calls of credential %d must be positive", ErrInvalidCredentialLimit, l.CredentialID)
+	}
+	if l.CallsPerSecond <= 0 {
+		return fmt.Errorf("%w: calls per second of credential %d must be positive", ErrInvalidCredentialLimit, l.CredentialID)
+	}
+	l.UpdatedDate = time.Now()
+	if err := s.db(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "credential_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_concurrent_calls", "calls_per_second", "updated_date"}),
+	}).Create(l).Error; err != nil {
+		return fmt.Errorf("failed to set limits of credential %d: %w", l.CredentialID, err)
+	}
+	return nil
+}
+
+func (s *postgresStore) GetCredentialLimit(ctx context.Context, organizationId, projectId, credentialId uint64) (*CredentialLimit, error) {
+	var l CredentialLimit
+	err := s.db(ctx).
+		Where("organization_id = ? AND project_id = ? AND credential_id = ?", organizationId, projectId, credentialId).
+		First(&l).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limits of credential %d: %w", credentialId, err)
+	}
+	return &l, nil
+}
+
+func (s *postgresStore) Schedule(ctx context.Context, fn func(Store) error) (bool, error) {
+	acquired := false
+	err := s.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", scheduleLockKey).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+		return fn(&postgresStore{postgres: s.postgres, logger: s.logger, tx: tx})
+	})
+	return acquired, err
+}
+
+func (s *postgresStore) Running(ctx context.Context) ([]*Campaign, error) {
+	var out []*Campaign
+	if err := s.db(ctx).Where("status = ?", StatusRunning).Order("created_date").Find(&out).Error; err != nil {
+		return nil, fmt.Errorf("failed to list running campaigns: %w", err)
+	}
+	return out, nil
+}
+
+func (s *postgresStore) Campaigns(ctx context.Context, ids []uint64) (map[uint64]*Campaign, error) {
+	out := make(map[uint64]*Campaign, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var campaigns []*Campaign
+	if err := s.db(ctx).Where("id IN ?", ids).Find(&campaigns).Error; err != nil {
+		return nil, fmt.Errorf("failed to get campaigns: %w", err)
+	}
+	for _, c := range campaigns {
+		out[c.Id] = c
+	}
+	return out, nil
+}
+
+func (s *postgresStore) Dialing(ctx context.Context) ([]*Call, error) {
+	var out []*Call
+	if err := s.db(ctx).
+		Table("assistant_campaign_contacts AS c").
+		Select("c.*, COALESCE(cc.status, '') AS call_status, COALESCE(cc.created_date, c.updated_date) AS call_created_date").
+		Joins("LEFT JOIN call_contexts AS cc ON cc.context_id = c.call_context_id AND c.call_context_id <> ''").
+		Where("c.status = ?", ContactDialing).
+		Scan(&out).Error; err != nil {
+		return nil, fmt.Errorf("failed to list dialing contacts: %w", err)
+	}
+	return out, nil
+}
+
+func (s *postgresStore) Due(ctx context.Context, campaignId uint64, now time.Time, limit int) ([]*Contact, error) {
+	var out []*Contact
+	if err := s.db(ctx).
+		Where("campaign_id = ? AND status = ? AND next_attempt_at <= ?", campaignId, ContactPending, now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&out).Error; err != nil {
+		return nil, fmt.Errorf("failed to list due contacts of campaign %d: %w", campaignId, err)
+	}
+	return out, nil
+}
+
+func (s *postgresStore) CountDialing(ctx context.Context, credentialId uint64) (int64, error) {
+	var n int64
+	if err := s.db(ctx).Model(&Contact{}).
+		Where("credential_id = ? AND status = ?", credentialId, ContactDialing).
+		Count(&n).Error; err != nil {
+		return 0, fmt.Errorf("failed to count calls of credential %d: %w", credentialId, err)
+	}
+	return n, nil
+}
+
+func (s *postgresStore) CountDialedSince(ctx context.Context, credentialId uint64, since time.Time) (int64, error) {
+	var n int64
+	if err := s.db(ctx).Model(&Contact{}).
+		Where("credential_id = ? AND last_dialed_at > ?", credentialId, since).
+		Count(&n).Error; err != nil {
+		return 0, fmt.Errorf("failed to count calls of credential %d: %w", credentialId, err)
+	}
+	return n, nil
+}
+
+func (s *postgresStore) UpdateContact(ctx context.Context, c *Contact) error {
+	c.UpdatedDate = time.Now()
+	if err := s.db(ctx).Model(&Contact{}).Where("id = ?", c.Id).Updates(map[string]interface{}{
+		"status":                    c.Status,
+		"attempt":                   c.Attempt,
+		"credential_id":             c.CredentialID,
+		"assistant_conversation_id": c.ConversationID,
+		"call_context_id":           c.CallContextID,
+		"last_status":               c.LastStatus,
+		"next_attempt_at":           c.NextAttemptAt,
+		"last_dialed_at":            c.LastDialedAt,
+		"updated_date":              c.UpdatedDate,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to update campaign contact %d: %w", c.Id, err)
+	}
+	return nil
+}
+
+func (s *postgresStore) SetCall(ctx context.Context, contactId uint64, contextID string, conversationID uint64) error {
+	if err := s.db(ctx).Model(&Contact{}).
+		Where("id = ? AND status = ?", contactId, ContactDialing).
+		Updates(map[string]interface{}{
+			"call_context_id":           contextID,
+			"assistant_conversation_id": conversationID,
+			"updated_date":              time.Now(),
+		}).Error; err != nil {
+		return fmt.Errorf("failed to set call of campaign contact %d: %w", contactId, err)
+	}
+	return nil
+}
+
+func (s *postgresStore) CompleteFinished(ctx context.Context) error {
+	if err := s.db(ctx).Model(&Campaign{}).
+		Where("status = ? AND NOT EXISTS (?)", StatusRunning,
+			s.db(ctx).Model(&Contact{}).Select("1").
+				Where("assistant_campaign_contacts.campaign_id = assistant_campaigns.id AND assistant_campaign_contacts.status IN ?",
+					[]string{ContactPending, ContactDialing})).
+		Updates(map[string]interface{}{"status": StatusCompleted, "updated_date": time.Now()}).Error; err != nil {
+		return fmt.Errorf("failed to complete campaigns: %w", err)
+	}
+	return nil
+}
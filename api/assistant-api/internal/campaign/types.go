@@ -0,0 +1,261 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_campaign
+
+import (
+	"encoding/json"
+	"time"
+
+	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Campaign status constants.
+const (
+	StatusRunning   = "running"   // Contacts are dialed as their attempts come due
+	StatusPaused    = "paused"    // No new call is placed, calls in flight finish
+	StatusCancelled = "cancelled" // Stopped for good, pending contacts are cancelled
+	StatusCompleted = "completed" // Every contact reached a final status
+)
+
+// Contact status constants.
+const (
+	ContactPending    = "pending"    // Waiting for its next attempt
+	ContactDialing    = "dialing"    // Call placed, waiting for its call context to end
+	ContactCompleted  = "completed"  // Call connected and ended
+	ContactFailed     = "failed"     // Attempts exhausted or final status not retried
+	ContactSuppressed = "suppressed" // Number is on the do-not-call list
+	ContactCancelled  = "cancelled"  // Campaign cancelled before the contact was reached
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultTimezone    = "UTC"
+)
+
+// defaultRetryRules retries busy and unanswered calls, in seconds.
+var defaultRetryRules = RetryRules{
+	callcontext.StatusBusy:     300,
+	callcontext.StatusNoAnswer: 900,
+}
+
+// RetryRules maps the final call context status of an attempt to the delay
+// in seconds before the next attempt. A status without a rule is final.
+type RetryRules map[string]int64
+
+// Campaign is a list of contacts an assistant calls.
+//
+// Stored in Postgres (assistant_campaigns table). Calls are placed with the
+// credential of the assistant's phone deployment, resolved when the
+// campaign is created, and are paced by the limits of that credential.
+type Campaign struct {
+	Id             uint64 `json:"id" gorm:"type:bigint;primaryKey;<-:create"`
+	Status         string `json:"status" gorm:"column:status;type:varchar(20);not null;default:running"`
+	OrganizationID uint64 `json:"organizationId" gorm:"column:organization_id;type:bigint;not null"`
+	ProjectID      uint64 `json:"projectId" gorm:"column:project_id;type:bigint;not null"`
+	AssistantID    uint64 `json:"assistantId" gorm:"column:assistant_id;type:bigint;not null"`
+	// Version of the assistant, empty for the latest.
+	Version      string `json:"version" gorm:"column:version;type:varchar(50);not null;default:''"`
+	CredentialID uint64 `json:"credentialId" gorm:"column:credential_id;type:bigint;not null"`
+	Name         string `json:"name" gorm:"column:name;type:varchar(200);not null"`
+	FromNumber   string `json:"fromNumber" gorm:"column:from_number;type:varchar(50);not null;default:''"`
+
+	// Timezone of contacts that have none, an IANA name.
+	Timezone string `json:"timezone" gorm:"column:timezone;type:varchar(64);not null;default:'UTC'"`
+	// WindowStart and WindowEnd bound the local time, "15:04", calls are
+	// placed in. The window wraps midnight when it ends before it starts,
+	// calls are placed at any time when both are empty.
+	WindowStart string `json:"windowStart" gorm:"column:window_start;type:varchar(5);not null;default:''"`
+	WindowEnd   string `json:"windowEnd" gorm:"column:window_end;type:varchar(5);not null;default:''"`
+
+	MaxAttempts uint32 `json:"maxAttempts" gorm:"column:max_attempts;type:int;not null;default:3"`
+	// RetryRules is the JSON encoded RetryRules of the campaign.
+	RetryRules string `json:"retryRules" gorm:"column:retry_rules;type:text;not null;default:'{}'"`
+
+	StartAt     time.Time `json:"startAt" gorm:"column:start_at;type:timestamp;not null"`
+	CreatedDate time.Time `json:"createdDate" gorm:"type:timestamp;not null;default:NOW();<-:create"`
+	UpdatedDate time.Time `json:"updatedDate" gorm:"type:timestamp;default:null"`
+}
+
+func (Campaign) TableName() string {
+	return "assistant_campaigns"
+}
+
+func (c *Campaign) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.Id <= 0 {
+		c.Id = gorm_generator.ID()
+	}
+	if c.CreatedDate.IsZero() {
+		c.CreatedDate = time.Now()
+	}
+	if c.StartAt.IsZero() {
+		c.StartAt = c.CreatedDate
+	}
+	if c.Status == "" {
+		c.Status = StatusRunning
+	}
+	if c.Timezone == "" {
+		c.Timezone = defaultTimezone
+	}
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.RetryRules == "" {
+		c.SetRetryRules(defaultRetryRules)
+	}
+	return nil
+}
+
+// GetRetryRules decodes the retry rules, a malformed value retries nothing.
+func (c *Campaign) GetRetryRules() RetryRules {
+	rules := RetryRules{}
+	_ = json.Unmarshal([]byte(c.RetryRules), &rules)
+	return rules
+}
+
+func (c *Campaign) SetRetryRules(rules RetryRules) {
+	if rules == nil {
+		rules = RetryRules{}
+	}
+	b, _ := json.Marshal(rules)
+	c.RetryRules = string(b)
+}
+
+// ToAuth returns a service scope principle of the campaign's project for the
+// calls placed by the engine. No token of the creator is kept, service calls
+// are signed with the service secret when they are made.
+func (c *Campaign) ToAuth() types.SimplePrinciple {
+	return &types.ServiceScope{
+		ProjectId:      utils.Ptr(c.ProjectID),
+		OrganizationId: utils.Ptr(c.OrganizationID),
+	}
+}
+
+// Contact is a number a campaign calls, with the arguments, options and
+// metadata of its conversation.
+//
+// Stored in Postgres (assistant_campaign_contacts table). A contact moves
+// pending -> dialing when a call is placed, and from dialing to completed,
+// failed, or back to pending with a later next_attempt_at when the final
+// status of the call has a retry rule.
+type Contact struct {
+	Id          uint64 `json:"id" gorm:"type:bigint;primaryKey;<-:create"`
+	CampaignID  uint64 `json:"campaignId" gorm:"column:campaign_id;type:bigint;not null"`
+	Status      string `json:"status" gorm:"column:status;type:varchar(20);not null;default:pending"`
+	PhoneNumber string `json:"phoneNumber" gorm:"column:phone_number;type:varchar(50);not null"`
+	// Timezone of the contact, the campaign timezone when empty.
+	Timezone string `json:"timezone" gorm:"column:timezone;type:varchar(64);not null;default:''"`
+
+	// Arguments, Options and Metadata are JSON encoded maps applied to the
+	// conversation of every attempt.
+	Arguments string `json:"arguments" gorm:"column:arguments;type:text;not null;default:'{}'"`
+	Options   string `json:"options" gorm:"column:options;type:text;not null;default:'{}'"`
+	Metadata  string `json:"metadata" gorm:"column:metadata;type:text;not null;default:'{}'"`
+
+	Attempt        uint32     `json:"attempt" gorm:"column:attempt;type:int;not null;default:0"`
+	CredentialID   uint64     `json:"credentialId" gorm:"column:credential_id;type:bigint;not null;default:0"`
+	ConversationID uint64     `json:"conversationId" gorm:"column:assistant_conversation_id;type:bigint;not null;default:0"`
+	CallContextID  string     `json:"callContextId" gorm:"column:call_context_id;type:varchar(36);not null;default:''"`
+	LastStatus     string     `json:"lastStatus" gorm:"column:last_status;type:varchar(20);not null;default:''"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt" gorm:"column:next_attempt_at;type:timestamp;not null"`
+	LastDialedAt   *time.Time `json:"lastDialedAt" gorm:"column:last_dialed_at;type:timestamp;default:null"`
+	CreatedDate    time.Time  `json:"createdDate" gorm:"type:timestamp;not null;default:NOW();<-:create"`
+	UpdatedDate    time.Time  `json:"updatedDate" gorm:"type:timestamp;default:null"`
+}
+
+func (Contact) TableName() string {
+	return "assistant_campaign_contacts"
+}
+
+func (c *Contact) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.Id <= 0 {
+		c.Id = gorm_generator.ID()
+	}
+	if c.CreatedDate.IsZero() {
+		c.CreatedDate = time.Now()
+	}
+	if c.NextAttemptAt.IsZero() {
+		c.NextAttemptAt = c.CreatedDate
+	}
+	if c.Status == "" {
+		c.Status = ContactPending
+	}
+	for _, v := range []*string{&c.Arguments, &c.Options, &c.Metadata} {
+		if *v == "" {
+			*v = "{}"
+		}
+	}
+	return nil
+}
+
+// Call is a dialing contact with the status of the call context of its
+// current attempt, empty when the call was never queued.
+type Call struct {
+	Contact
+	CallStatus      string    `gorm:"column:call_status"`
+	CallCreatedDate time.Time `gorm:"column:call_created_date"`
+}
+
+// DoNotCall is a number that is never called by the campaigns of a project.
+type DoNotCall struct {
+	Id             uint64    `json:"id" gorm:"type:bigint;primaryKey;<-:create"`
+	OrganizationID uint64    `json:"organizationId" gorm:"column:organization_id;type:bigint;not null"`
+	ProjectID      uint64    `json:"projectId" gorm:"column:project_id;type:bigint;not null"`
+	PhoneNumber    string    `json:"phoneNumber" gorm:"column:phone_number;type:varchar(50);not null"`
+	Reason         string    `json:"reason" gorm:"column:reason;type:text;not null;default:''"`
+	CreatedDate    time.Time `json:"createdDate" gorm:"type:timestamp;not null;default:NOW();<-:create"`
+}
+
+func (DoNotCall) TableName() string {
+	return "assistant_do_not_call_numbers"
+}
+
+func (d *DoNotCall) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.Id <= 0 {
+		d.Id = gorm_generator.ID()
+	}
+	if d.CreatedDate.IsZero() {
+		d.CreatedDate = time.Now()
+	}
+	return nil
+}
+
+// CredentialLimit paces the campaign calls placed with a provider credential.
+type CredentialLimit struct {
+	Id                 uint64    `json:"id" gorm:"type:bigint;primaryKey;<-:create"`
+	OrganizationID     uint64    `json:"organizationId" gorm:"column:organization_id;type:bigint;not null"`
+	ProjectID          uint64    `json:"projectId" gorm:"column:project_id;type:bigint;not null"`
+	CredentialID       uint64    `json:"credentialId" gorm:"column:credential_id;type:bigint;not null"`
+	MaxConcurrentCalls uint32    `json:"maxConcurrentCalls" gorm:"column:max_concurrent_calls;type:int;not null"`
+	CallsPerSecond     float64   `json:"callsPerSecond" gorm:"column:calls_per_second;type:double precision;not null"`
+	CreatedDate        time.Time `json:"createdDate" gorm:"type:timestamp;not null;default:NOW();<-:create"`
+	UpdatedDate        time.Time `json:"updatedDate" gorm:"type:timestamp;default:null"`
+}
+
+func (CredentialLimit) TableName() string {
+	return "assistant_campaign_credential_limits"
+}
+
+func (l *CredentialLimit) BeforeCreate(tx *gorm.DB) (err error) {
+	if l.Id <= 0 {
+		l.Id = gorm_generator.ID()
+	}
+	if l.CreatedDate.IsZero() {
+		l.CreatedDate = time.Now()
+	}
+	return nil
+}
+
+// Progress counts the contacts of a campaign by status.
+type Progress struct {
+	Total    int64            `json:"total"`
+	Attempts int64            `json:"attempts"`
+	Statuses map[string]int64 `json:"statuses"`
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_campaign
+
+import (
+	"fmt"
+	"time"
+)
+
+const windowLayout = "15:04"
+
+// Window is the local time of day calls are placed in.
+type Window struct {
+	start, end time.Duration
+	always     bool
+}
+
+// ParseWindow parses a "15:04" start and end, both empty means calls are
+// placed at any time.
+func ParseWindow(start, end string) (*Window, error) {
+	if start == "" && end == "" {
+		return &Window{always: true}, nil
+	}
+	s, err := time.Parse(windowLayout, start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window start %q: %w", start, err)
+	}
+	e, err := time.Parse(windowLayout, end)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window end %q: %w", end, err)
+	}
+	if s.Equal(e) {
+		return nil, fmt.Errorf("window start and end are both %s", start)
+	}
+	return &Window{
+		start: time.Duration(s.Hour())*time.Hour + time.Duration(s.Minute())*time.Minute,
+		end:   time.Duration(e.Hour())*time.Hour + time.Duration(e.Minute())*time.Minute,
+	}, nil
+}
+
+// Next reports whether t is within the window in loc, and otherwise the
+// time the window opens next.
+func (w *Window) Next(t time.Time, loc *time.Location) (bool, time.Time) {
+	if w.always {
+		return true, t
+	}
+	local := t.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	// time since midnight in wall clock, so days with a dst change still
+	// open at the configured local time
+	offset := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second + time.Duration(local.Nanosecond())
+
+	open := offset >= w.start && offset < w.end
+	if w.end < w.start {
+		open = offset >= w.start || offset < w.end
+	}
+	if open {
+		return true, t
+	}
+	next := wallClock(midnight, w.start)
+	if offset >= w.start {
+		next = wallClock(midnight.AddDate(0, 0, 1), w.start)
+	}
+	return false, next
+}
+
+func wallClock(day time.Time, d time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(d/time.Hour), int(d%time.Hour/time.Minute), 0, 0, day.Location())
+}
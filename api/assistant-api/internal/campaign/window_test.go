@@ -0,0 +1,65 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_campaign
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	w, err := ParseWindow("", "")
+	require.NoError(t, err)
+	open, _ := w.Next(time.Now(), time.UTC)
+	assert.True(t, open)
+
+	_, err = ParseWindow("09:00", "")
+	assert.Error(t, err)
+	_, err = ParseWindow("9am", "17:00")
+	assert.Error(t, err)
+	_, err = ParseWindow("09:00", "09:00")
+	assert.Error(t, err)
+}
+
+func TestWindowNext(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	day, err := ParseWindow("09:00", "18:00")
+	require.NoError(t, err)
+	night, err := ParseWindow("22:00", "06:00")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		window *Window
+		at     time.Time
+		open   bool
+		next   time.Time
+	}{
+		{"within the day", day, time.Date(2025, 3, 4, 12, 0, 0, 0, ny), true, time.Time{}},
+		{"before the day", day, time.Date(2025, 3, 4, 7, 30, 0, 0, ny), false, time.Date(2025, 3, 4, 9, 0, 0, 0, ny)},
+		{"after the day", day, time.Date(2025, 3, 4, 18, 0, 0, 0, ny), false, time.Date(2025, 3, 5, 9, 0, 0, 0, ny)},
+		{"late night", night, time.Date(2025, 3, 4, 23, 0, 0, 0, ny), true, time.Time{}},
+		{"early morning", night, time.Date(2025, 3, 4, 5, 0, 0, 0, ny), true, time.Time{}},
+		{"between nights", night, time.Date(2025, 3, 4, 12, 0, 0, 0, ny), false, time.Date(2025, 3, 4, 22, 0, 0, 0, ny)},
+		// clocks move forward on 2025-03-09, the window still opens at 09:00 local
+		{"over dst", day, time.Date(2025, 3, 8, 20, 0, 0, 0, ny), false, time.Date(2025, 3, 9, 9, 0, 0, 0, ny)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the engine passes times in its own zone
+			open, next := tt.window.Next(tt.at.UTC(), ny)
+			assert.Equal(t, tt.open, open)
+			if !tt.open {
+				assert.True(t, tt.next.Equal(next), "next %s, want %s", next, tt.next)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/rapidaai/api/assistant-api/config"
 	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
@@ -54,32 +55,37 @@ func NewInboundDispatcher(deps TelephonyDispatcherDeps) *InboundDispatcher {
 // HandleStatusCallback resolves the telephony provider and processes a status callback
 // webhook. It builds telemetry (metric + event) from the StatusInfo returned by the provider.
 func (d *InboundDispatcher) HandleStatusCallback(c *gin.Context, provider string, auth types.SimplePrinciple, assistantId, conversationId uint64) error {
+	_, err := d.handleStatusCallback(c, provider, auth, assistantId, conversationId)
+	return err
+}
+
+func (d *InboundDispatcher) handleStatusCallback(c *gin.Context, provider string, auth types.SimplePrinciple, assistantId, conversationId uint64) (*internal_type.StatusInfo, error) {
 	tel, err := GetTelephony(Telephony(provider), d.cfg, d.logger, d.telephonyOpt)
 	if err != nil {
-		return fmt.Errorf("invalid telephony provider %s: %w", provider, err)
+		return nil, fmt.Errorf("invalid telephony provider %s: %w", provider, err)
 	}
 
 	statusInfo, err := tel.StatusCallback(c, auth, assistantId, conversationId)
 	if err != nil {
-		return fmt.Errorf("status callback failed: %w", err)
+		return nil, fmt.Errorf("status callback failed: %w", err)
 	}
 	if statusInfo == nil {
-		return nil
+		return nil, nil
 	}
 
 	// Build telemetry from StatusInfo — the dispatcher owns telemetry construction.
 	metric := types.NewMetric("STATUS", statusInfo.Event, utils.Ptr("Status of conversation"))
 	if _, err := d.conversationService.ApplyConversationMetrics(c, auth, assistantId, conversationId, []*types.Metric{metric}); err != nil {
 		d.logger.Errorf("failed to apply conversation metrics in callback: %v", err)
-		return fmt.Errorf("failed to process metrics: %w", err)
+		return nil, fmt.Errorf("failed to process metrics: %w", err)
 	}
 
 	event := types.NewEvent(statusInfo.Event, statusInfo.Payload)
 	if _, err := d.conversationService.ApplyConversationTelephonyEvent(c, auth, provider, assistantId, conversationId, []*types.Event{event}); err != nil {
 		d.logger.Errorf("failed to apply telephony events in callback: %v", err)
-		return fmt.Errorf("failed to process events: %w", err)
+		return nil, fmt.Errorf("failed to process events: %w", err)
 	}
-	return nil
+	return statusInfo, nil
 }
 
 // HandleStatusCallbackByContext resolves a call context from Postgres using the contextId and
@@ -94,7 +100,19 @@ func (d *InboundDispatcher) HandleStatusCallbackByContext(c *gin.Context, contex
 	}
 
 	auth := cc.ToAuth()
-	return d.HandleStatusCallback(c, cc.Provider, auth, cc.AssistantID, cc.ConversationID)
+	statusInfo, err := d.handleStatusCallback(c, cc.Provider, auth, cc.AssistantID, cc.ConversationID)
+	if err != nil || statusInfo == nil {
+		return err
+	}
+
+	// an outbound call that ends before media connects never claims its
+	// context, record why so campaigns can retry busy or unanswered calls
+	if status, ok := callcontext.UnansweredStatus(statusInfo.Event); ok && cc.Direction == "outbound" {
+		if err := d.store.Conclude(c, contextID, status); err != nil {
+			d.logger.Warnf("failed to conclude call context %s with %s: %v", contextID, status, err)
+		}
+	}
+	return nil
 }
 
 // HandleReceiveCall processes an inbound call webhook. It resolves the telephony provider,
@@ -255,6 +273,24 @@ func (d *InboundDispatcher) ResolveCallSessionByContext(ctx context.Context, con
 	return cc, vaultCred, nil
 }
 
+// HandleTransferDestination answers the provider flow asking where the call
+// identified by channelUUID was transferred to once its media stream ended.
+func (d *InboundDispatcher) HandleTransferDestination(c *gin.Context, provider, channelUUID string) error {
+	tel, err := GetTelephony(Telephony(provider), d.cfg, d.logger, d.telephonyOpt)
+	if err != nil {
+		return fmt.Errorf("telephony provider %s not connected: %w", provider, err)
+	}
+	resolver, ok := tel.(internal_type.TransferDestinationResolver)
+	if !ok {
+		return fmt.Errorf("telephony provider %s does not fetch transfer destinations", provider)
+	}
+	cc, err := d.store.GetByChannelUUID(c, provider, channelUUID)
+	if err != nil {
+		return err
+	}
+	return resolver.TransferDestination(c, cc.TransferTo)
+}
+
 // CompleteCallSession marks a call context as completed. Should be called
 // when the call/session ends (talker exits).
 func (d *InboundDispatcher) CompleteCallSession(ctx context.Context, contextID string) {
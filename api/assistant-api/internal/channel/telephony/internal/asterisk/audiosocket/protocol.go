@@ -18,6 +18,7 @@ const (
 	FrameTypeHangup  byte = 0x00
 	FrameTypeUUID    byte = 0x01
 	FrameTypeSilence byte = 0x02
+	FrameTypeDTMF    byte = 0x03
 	FrameTypeAudio   byte = 0x10
 	FrameTypeError   byte = 0xFF
 )
@@ -17,9 +17,11 @@ import (
 	"sync"
 
 	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	internal_asterisk_telephony "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/asterisk"
 	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/base"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
@@ -164,6 +166,11 @@ func (as *Streamer) Recv() (internal_type.Stream, error) {
 			if audioRequest != nil {
 				return audioRequest, nil
 			}
+		case FrameTypeDTMF:
+			// payload is the single ASCII digit
+			if len(frame.Payload) > 0 {
+				return as.CreateDTMFRequest(string(frame.Payload[:1]), 0), nil
+			}
 		case FrameTypeSilence:
 			// Silence frame, no action needed
 		case FrameTypeHangup:
@@ -200,6 +207,23 @@ func (as *Streamer) Send(response internal_type.Stream) error {
 	return nil
 }
 
+// TransferCall redirects the Asterisk channel through ARI and closes the
+// AudioSocket connection without sending a hangup frame.
+func (as *Streamer) TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*internal_type.CallInfo, error) {
+	// app config is only used for call setup, transfer works on the vault credential alone
+	telephony, _ := internal_asterisk_telephony.NewAsteriskTelephony(nil, as.Logger)
+	info, err := telephony.TransferCall(as.CallContext().ToAuth(), as.ChannelUUID, toPhone, as.VaultCredential(), as.TransferOption(opts))
+	if err != nil {
+		return info, err
+	}
+	return info, as.close()
+}
+
+// SendDTMF plays the digits into the Asterisk channel through ARI.
+func (as *Streamer) SendDTMF(ctx context.Context, digits string) error {
+	return internal_asterisk_telephony.SendDTMF(as.ChannelUUID, digits, as.VaultCredential())
+}
+
 func (as *Streamer) close() error {
 	if as.outputCancel != nil {
 		as.outputCancel()
@@ -11,7 +11,7 @@ import "encoding/json"
 // AsteriskMediaEvent represents media events from Asterisk WebSocket
 // Based on chan_websocket protocol from Asterisk
 type AsteriskMediaEvent struct {
-	// Event type: MEDIA_START, MEDIA_STOP, MEDIA_XON, MEDIA_XOFF, MEDIA_BUFFERING_COMPLETED, etc.
+	// Event type: MEDIA_START, MEDIA_STOP, MEDIA_XON, MEDIA_XOFF, MEDIA_BUFFERING_COMPLETED, DTMF_END, etc.
 	Event string `json:"event,omitempty"`
 
 	// Command type for JSON mode: START_MEDIA_BUFFERING, STOP_MEDIA_BUFFERING, MARK_MEDIA, HANGUP
@@ -25,6 +25,9 @@ type AsteriskMediaEvent struct {
 
 	// Correlation ID for tracking
 	CorrelationID string `json:"correlation_id,omitempty"`
+	// Digit and duration of a DTMF_END event
+	Digit    string `json:"digit,omitempty"`
+	Duration int    `json:"duration_ms,omitempty"`
 
 	// Raw message for non-JSON text messages
 	RawMessage string `json:"-"`
@@ -42,6 +45,15 @@ func ParseAsteriskEvent(data string) (*AsteriskMediaEvent, error) {
 	if v, ok := params["channel"]; ok {
 		event.Channel = v
 	}
+	if v, ok := params["digit"]; ok {
+		event.Digit = v
+	}
+	if v, ok := params["duration_ms"]; ok {
+		var duration int
+		if _, err := parseIntFromString(v, &duration); err == nil {
+			event.Duration = duration
+		}
+	}
 	if v, ok := params["optimal_frame_size"]; ok {
 		var size int
 		if _, err := parseIntFromString(v, &size); err == nil {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rapidaai/api/assistant-api/config"
@@ -137,24 +138,7 @@ func (apt *asteriskTelephony) OutboundCall(
 		return info, fmt.Errorf("missing ari_url in vault credential")
 	}
 
-	// Build the endpoint in Technology/Resource format.
-	// Default technology is PJSIP; can be overridden via vault credential or deployment opts.
-	endpointTech := "PJSIP"
-	if tech, ok := credMap["endpoint_technology"].(string); ok && tech != "" {
-		endpointTech = tech
-	}
-	if tech, err := opts.GetString("endpoint_technology"); err == nil && tech != "" {
-		endpointTech = tech
-	}
-
-	// Build endpoint: Technology/trunk/number or Technology/number
-	endpoint := fmt.Sprintf("%s/%s", endpointTech, toPhone)
-	if trunk, ok := credMap["trunk"].(string); ok && trunk != "" {
-		endpoint = fmt.Sprintf("%s/%s/%s", endpointTech, trunk, toPhone)
-	}
-	if trunk, err := opts.GetString("trunk"); err == nil && trunk != "" {
-		endpoint = fmt.Sprintf("%s/%s/%s", endpointTech, trunk, toPhone)
-	}
+	endpoint := apt.endpoint(credMap, opts, toPhone)
 
 	// Resolve caller ID
 	callerId := fromPhone
@@ -279,6 +263,148 @@ func (apt *asteriskTelephony) OutboundCall(
 	return info, nil
 }
 
+// endpoint builds the dial endpoint in Technology/Resource format
+// (Technology/trunk/number or Technology/number). Default technology is PJSIP;
+// technology and trunk can be overridden via vault credential or deployment opts.
+func (apt *asteriskTelephony) endpoint(credMap map[string]interface{}, opts utils.Option, toPhone string) string {
+	endpointTech := "PJSIP"
+	if tech, ok := credMap["endpoint_technology"].(string); ok && tech != "" {
+		endpointTech = tech
+	}
+	if tech, err := opts.GetString("endpoint_technology"); err == nil && tech != "" {
+		endpointTech = tech
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", endpointTech, toPhone)
+	if trunk, ok := credMap["trunk"].(string); ok && trunk != "" {
+		endpoint = fmt.Sprintf("%s/%s/%s", endpointTech, trunk, toPhone)
+	}
+	if trunk, err := opts.GetString("trunk"); err == nil && trunk != "" {
+		endpoint = fmt.Sprintf("%s/%s/%s", endpointTech, trunk, toPhone)
+	}
+	return endpoint
+}
+
+// TransferCall redirects the channel to the destination via ARI
+// POST /ari/channels/{channelId}/redirect. The destination is used as is when
+// it is already in Technology/Resource format, otherwise it is dialed through
+// the configured technology and trunk like an outbound call.
+//
+// ARI only redirects channels that are currently in a Stasis application.
+func (apt *asteriskTelephony) TransferCall(
+	auth types.SimplePrinciple,
+	channelUUID string,
+	toPhone string,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option,
+) (*internal_type.CallInfo, error) {
+	info := &internal_type.CallInfo{Provider: asteriskProvider, ChannelUUID: channelUUID}
+	if channelUUID == "" {
+		info.Status = "FAILED"
+		info.ErrorMessage = "missing channel id for transfer"
+		return info, fmt.Errorf("missing channel id for transfer")
+	}
+	if vaultCredential == nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = "Missing vault credential for Asterisk ARI"
+		return info, fmt.Errorf("missing vault credential for Asterisk ARI")
+	}
+
+	credMap := vaultCredential.GetValue().AsMap()
+	ariBaseURL, _ := credMap["ari_url"].(string)
+	if ariBaseURL == "" {
+		info.Status = "FAILED"
+		info.ErrorMessage = "Missing ari_url in vault credential"
+		return info, fmt.Errorf("missing ari_url in vault credential")
+	}
+
+	endpoint := toPhone
+	if !strings.Contains(toPhone, "/") {
+		endpoint = apt.endpoint(credMap, opts, toPhone)
+	}
+
+	params := url.Values{}
+	params.Set("endpoint", endpoint)
+	ariURL := fmt.Sprintf("%s/ari/channels/%s/redirect?%s", ariBaseURL, url.PathEscape(channelUUID), params.Encode())
+	req, err := http.NewRequest("POST", ariURL, nil)
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("request creation error: %s", err.Error())
+		return info, err
+	}
+	user, _ := credMap["ari_user"].(string)
+	password, _ := credMap["ari_password"].(string)
+	req.SetBasicAuth(user, password)
+
+	apt.logger.Infof("ARI transfer: channel=%s, endpoint=%s", channelUUID, endpoint)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("ARI request error: %s", err.Error())
+		return info, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		var ariResp map[string]interface{}
+		errMsg := fmt.Sprintf("ARI returned status %d", resp.StatusCode)
+		if decodeErr := json.NewDecoder(resp.Body).Decode(&ariResp); decodeErr == nil {
+			if msg, ok := ariResp["message"]; ok {
+				errMsg = fmt.Sprintf("ARI returned status %d: %v", resp.StatusCode, msg)
+			}
+		}
+		info.Status = "FAILED"
+		info.ErrorMessage = errMsg
+		apt.logger.Errorf("ARI transfer failed: %s", errMsg)
+		return info, fmt.Errorf("%s", errMsg)
+	}
+
+	info.Status = "SUCCESS"
+	info.StatusInfo = internal_type.StatusInfo{Event: "channel_redirected", Payload: map[string]string{"channel": channelUUID, "endpoint": endpoint}}
+	return info, nil
+}
+
+// SendDTMF plays the digits into the channel via ARI
+// POST /ari/channels/{channelId}/dtmf, shared by the AudioSocket and
+// WebSocket streamers.
+func SendDTMF(channelUUID string, digits string, vaultCredential *protos.VaultCredential) error {
+	if channelUUID == "" {
+		return fmt.Errorf("missing channel id for dtmf")
+	}
+	if vaultCredential == nil {
+		return fmt.Errorf("missing vault credential for Asterisk ARI")
+	}
+	credMap := vaultCredential.GetValue().AsMap()
+	ariBaseURL, _ := credMap["ari_url"].(string)
+	if ariBaseURL == "" {
+		return fmt.Errorf("missing ari_url in vault credential")
+	}
+
+	params := url.Values{}
+	params.Set("dtmf", digits)
+	ariURL := fmt.Sprintf("%s/ari/channels/%s/dtmf?%s", ariBaseURL, url.PathEscape(channelUUID), params.Encode())
+	req, err := http.NewRequest("POST", ariURL, nil)
+	if err != nil {
+		return err
+	}
+	user, _ := credMap["ari_user"].(string)
+	password, _ := credMap["ari_password"].(string)
+	req.SetBasicAuth(user, password)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("ARI returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
 // InboundCall handles inbound call setup for Asterisk.
 // Returns the contextId as plain text — Asterisk dialplan uses this as the AudioSocket UUID
 // so the AudioSocket server can resolve the full call context from Postgres.
@@ -16,10 +16,12 @@ import (
 
 	"github.com/gorilla/websocket"
 	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	internal_asterisk_telephony "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/asterisk"
 	internal_asterisk "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/asterisk/internal"
 	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/base"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
@@ -138,6 +140,10 @@ func (aws *asteriskWebsocketStreamer) Recv() (internal_type.Stream, error) {
 			aws.audioProcessor.SetXOFF()
 		case "MEDIA_BUFFERING_COMPLETED":
 			aws.setMediaBuffering(false)
+		case "DTMF_END":
+			if event.Digit != "" {
+				return aws.CreateDTMFRequest(event.Digit, uint32(event.Duration)), nil
+			}
 		default:
 			// Handle JSON command responses
 			if event.Command != "" {
@@ -320,6 +326,35 @@ func (aws *asteriskWebsocketStreamer) hangupViaARI() error {
 	return nil
 }
 
+// TransferCall redirects the Asterisk channel through ARI and releases the
+// media WebSocket without hanging up.
+func (aws *asteriskWebsocketStreamer) TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*internal_type.CallInfo, error) {
+	channelID := aws.channelName
+	if channelID == "" {
+		channelID = aws.ChannelUUID
+	}
+	// app config is only used for call setup, transfer works on the vault credential alone
+	telephony, _ := internal_asterisk_telephony.NewAsteriskTelephony(nil, aws.Logger)
+	info, err := telephony.TransferCall(aws.CallContext().ToAuth(), channelID, toPhone, aws.VaultCredential(), aws.TransferOption(opts))
+	if err != nil {
+		return info, err
+	}
+	aws.stopAudioProcessing()
+	if err := aws.Cancel(); err != nil {
+		aws.Logger.Errorf("Error disconnecting after transfer: %v", err)
+	}
+	return info, nil
+}
+
+// SendDTMF plays the digits into the Asterisk channel through ARI.
+func (aws *asteriskWebsocketStreamer) SendDTMF(ctx context.Context, digits string) error {
+	channelID := aws.channelName
+	if channelID == "" {
+		channelID = aws.ChannelUUID
+	}
+	return internal_asterisk_telephony.SendDTMF(channelID, digits, aws.VaultCredential())
+}
+
 func (tws *asteriskWebsocketStreamer) Cancel() error {
 	if tws.connection != nil {
 		tws.connection.Close()
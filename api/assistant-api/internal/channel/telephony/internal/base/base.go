@@ -17,6 +17,7 @@ import (
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // TelephonyOption configures a BaseTelephonyStreamer.
@@ -168,6 +169,30 @@ func (base *BaseTelephonyStreamer) CreateVoiceRequest(audioData []byte) *protos.
 	}
 }
 
+// CreateDTMFRequest wraps a key press reported by the provider for downstream
+// digit collection. Duration is in milliseconds, 0 when unknown.
+func (base *BaseTelephonyStreamer) CreateDTMFRequest(digit string, duration uint32) *protos.ConversationDTMF {
+	return &protos.ConversationDTMF{
+		Digit:    digit,
+		Duration: duration,
+		Time:     timestamppb.Now(),
+	}
+}
+
+// CreateDTMFResponse renders digits as in-band DTMF tones in the internal
+// Rapida format, for providers whose media stream can only carry audio.
+func (base *BaseTelephonyStreamer) CreateDTMFResponse(digits string) (*protos.ConversationAssistantMessage, error) {
+	tones, err := internal_audio.GenerateDTMF(digits, RAPIDA_AUDIO_CONFIG)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.ConversationAssistantMessage{
+		Message:   &protos.ConversationAssistantMessage_Audio{Audio: tones},
+		Completed: true,
+		Time:      timestamppb.Now(),
+	}, nil
+}
+
 // GetAssistantDefinition returns the protobuf assistant definition.
 func (base *BaseTelephonyStreamer) GetAssistantDefinition() *protos.AssistantDefinition {
 	return &protos.AssistantDefinition{
@@ -211,6 +236,20 @@ func (base *BaseTelephonyStreamer) SourceAudioConfig() *protos.AudioConfig {
 	return base.sourceAudioConfig
 }
 
+// TransferOption returns options for transferring the live call. For outbound
+// calls the number the call was placed from is used as caller id unless
+// "caller_id" is already provided.
+func (base *BaseTelephonyStreamer) TransferOption(opts utils.Option) utils.Option {
+	out := utils.Option{}
+	for k, v := range opts {
+		out[k] = v
+	}
+	if _, err := out.GetString("caller_id"); err != nil && base.callCtx.FromNumber != "" {
+		out["caller_id"] = base.callCtx.FromNumber
+	}
+	return out
+}
+
 // CreateConnectionRequest builds the initial ConversationInitialization message.
 func (base *BaseTelephonyStreamer) CreateConnectionRequest() *protos.ConversationInitialization {
 	return &protos.ConversationInitialization{
@@ -9,6 +9,12 @@ type ExotelMediaEvent struct {
 	Event     string       `json:"event"`
 	StreamSid string       `json:"stream_sid"`
 	Media     *ExotelMedia `json:"media,omitempty"`
+	Dtmf      *ExotelDtmf  `json:"dtmf,omitempty"`
+}
+
+type ExotelDtmf struct {
+	Digit    string `json:"digit"`
+	Duration string `json:"duration"`
 }
 
 type ExotelMedia struct {
@@ -23,3 +29,13 @@ type MakeCallResponse struct {
 		ConversationUuid *string `json:"ParentCallSid"` // Use pointers for nullable fields
 	} `json:"Call"`
 }
+
+// ConnectDestination is the response of the dynamic url of a connect applet.
+type ConnectDestination struct {
+	FetchAfterAttempt bool           `json:"fetch_after_attempt"`
+	Destination       ConnectNumbers `json:"destination"`
+}
+
+type ConnectNumbers struct {
+	Numbers []string `json:"numbers"`
+}
@@ -6,6 +6,7 @@
 package internal_exotel_telephony
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/rapidaai/api/assistant-api/config"
+	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
 	internal_exotel "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/exotel/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 
@@ -30,6 +32,7 @@ const exotelProvider = "exotel"
 type exotelTelephony struct {
 	logger commons.Logger
 	appCfg *config.AssistantConfig
+	store  callcontext.Store
 }
 
 func NewExotelTelephony(config *config.AssistantConfig, logger commons.Logger) (internal_type.Telephony, error) {
@@ -220,3 +223,41 @@ func (tpc *exotelTelephony) ReceiveCall(c *gin.Context) (*internal_type.CallInfo
 	}
 	return info, nil
 }
+
+// TransferCall hands a voicebot call over to toPhone. Exotel has no API to
+// redirect a live call, the destination is recorded on the call context and
+// once the stream ends the connect applet following the voicebot in the flow
+// fetches it from TransferDestination.
+func (tpc *exotelTelephony) TransferCall(auth types.SimplePrinciple, channelUUID string, toPhone string, vaultCredential *protos.VaultCredential, opts utils.Option) (*internal_type.CallInfo, error) {
+	info := &internal_type.CallInfo{Provider: exotelProvider, ChannelUUID: channelUUID}
+	contextID, _ := opts.GetString("rapida.context_id")
+	if tpc.store == nil || contextID == "" {
+		info.Status = "FAILED"
+		info.ErrorMessage = "transfer requires the call context of the live call"
+		return info, fmt.Errorf("exotel transfer without call context")
+	}
+	if err := tpc.store.UpdateField(context.Background(), contextID, "transfer_to", toPhone); err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("failed to record transfer destination: %s", err.Error())
+		return info, err
+	}
+	info.Status = "SUCCESS"
+	info.StatusInfo = internal_type.StatusInfo{
+		Event:   "transferred",
+		Payload: map[string]interface{}{"to": toPhone, "call_sid": channelUUID},
+	}
+	return info, nil
+}
+
+// TransferDestination answers the dynamic url of the connect applet with the
+// destination recorded by TransferCall.
+func (tpc *exotelTelephony) TransferDestination(c *gin.Context, transferTo string) error {
+	if transferTo == "" {
+		return fmt.Errorf("call was not transferred")
+	}
+	c.JSON(http.StatusOK, internal_exotel.ConnectDestination{
+		FetchAfterAttempt: false,
+		Destination:       internal_exotel.ConnectNumbers{Numbers: []string{transferTo}},
+	})
+	return nil
+}
@@ -6,6 +6,7 @@
 package internal_exotel_telephony
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -13,7 +14,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/rapidaai/api/assistant-api/config"
+	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -249,3 +252,47 @@ func TestReceiveCall_CallInfoStructure(t *testing.T) {
 	assert.Equal(t, "webhook", callInfo.StatusInfo.Event)
 	assert.NotNil(t, callInfo.StatusInfo.Payload)
 }
+
+type fakeCallContextStore struct {
+	callcontext.Store
+	updates map[string]string
+}
+
+func (f *fakeCallContextStore) UpdateField(ctx context.Context, contextID, field, value string) error {
+	f.updates[contextID+"."+field] = value
+	return nil
+}
+
+// TestTransferCall checks that the destination is recorded on the call
+// context for the connect applet, and refused without one.
+func TestTransferCall(t *testing.T) {
+	store := &fakeCallContextStore{updates: map[string]string{}}
+	tpc := &exotelTelephony{store: store}
+
+	info, err := tpc.TransferCall(nil, "exotel-call-sid-12345", "+911234567890", nil, utils.Option{"rapida.context_id": "ctx-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "exotel", info.Provider)
+	assert.Equal(t, "SUCCESS", info.Status)
+	assert.Equal(t, "exotel-call-sid-12345", info.ChannelUUID)
+	assert.Equal(t, "+911234567890", store.updates["ctx-1.transfer_to"])
+
+	info, err = tpc.TransferCall(nil, "exotel-call-sid-12345", "+911234567890", nil, utils.Option{})
+	require.Error(t, err)
+	assert.Equal(t, "FAILED", info.Status)
+	assert.NotEmpty(t, info.ErrorMessage)
+}
+
+func TestTransferDestination(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tpc := &exotelTelephony{}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	require.NoError(t, tpc.TransferDestination(c, "+911234567890"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"fetch_after_attempt":false,"destination":{"numbers":["+911234567890"]}}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	assert.Error(t, tpc.TransferDestination(c, ""))
+}
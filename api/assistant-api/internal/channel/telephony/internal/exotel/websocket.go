@@ -8,8 +8,10 @@ package internal_exotel_telephony
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"strconv"
 
 	"github.com/gorilla/websocket"
 	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
@@ -18,6 +20,7 @@ import (
 	internal_exotel "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/exotel/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
@@ -29,9 +32,11 @@ type exotelWebsocketStreamer struct {
 
 	connection *websocket.Conn
 	streamID   string
+	store      callcontext.Store
 }
 
 func NewExotelWebsocketStreamer(logger commons.Logger, connection *websocket.Conn, cc *callcontext.CallContext, vaultCred *protos.VaultCredential,
+	store callcontext.Store,
 ) internal_type.Streamer {
 	return &exotelWebsocketStreamer{
 		BaseTelephonyStreamer: internal_telephony_base.NewBaseTelephonyStreamer(
@@ -40,6 +45,7 @@ func NewExotelWebsocketStreamer(logger commons.Logger, connection *websocket.Con
 		),
 		streamID:   "",
 		connection: connection,
+		store:      store,
 	}
 }
 
@@ -74,7 +80,11 @@ func (exotel *exotelWebsocketStreamer) Recv() (internal_type.Stream, error) {
 		}
 		return msg, err
 	case "dtmf":
-		return nil, nil
+		if mediaEvent.Dtmf == nil || mediaEvent.Dtmf.Digit == "" {
+			return nil, nil
+		}
+		duration, _ := strconv.ParseUint(mediaEvent.Dtmf.Duration, 10, 32)
+		return exotel.CreateDTMFRequest(mediaEvent.Dtmf.Digit, uint32(duration)), nil
 	case "stop":
 		exotel.Cancel()
 		return nil, io.EOF
@@ -193,7 +203,34 @@ func (exo *exotelWebsocketStreamer) handleError(message string, err error) error
 	return err
 }
 
+// TransferCall records the destination on the call context and ends the
+// stream, the voicebot applet then hands the call to the connect applet of the
+// flow which dials the destination.
+func (exo *exotelWebsocketStreamer) TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*internal_type.CallInfo, error) {
+	tpc := &exotelTelephony{logger: exo.Logger, store: exo.store}
+	transferOpts := exo.TransferOption(opts)
+	transferOpts["rapida.context_id"] = exo.CallContext().ContextID
+	info, err := tpc.TransferCall(exo.CallContext().ToAuth(), exo.ChannelUUID, toPhone, exo.VaultCredential(), transferOpts)
+	if err != nil {
+		return info, err
+	}
+	return info, exo.Cancel()
+}
+
+// SendDTMF plays the digits in-band on the media stream, the voicebot applet
+// has no way to send key presses out of band.
+func (exo *exotelWebsocketStreamer) SendDTMF(ctx context.Context, digits string) error {
+	tones, err := exo.CreateDTMFResponse(digits)
+	if err != nil {
+		return err
+	}
+	return exo.Send(tones)
+}
+
 func (tws *exotelWebsocketStreamer) Cancel() error {
+	if tws.connection == nil {
+		return nil
+	}
 	tws.connection.Close()
 	tws.connection = nil
 	return nil
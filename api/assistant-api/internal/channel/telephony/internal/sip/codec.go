@@ -0,0 +1,176 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_sip_telephony
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gotranspile/g722"
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	sip_infra "github.com/rapidaai/api/assistant-api/sip/infra"
+	"github.com/rapidaai/protos"
+	"github.com/zaf/g711"
+	"gopkg.in/hraban/opus.v2"
+)
+
+const (
+	opusBitrate         = 32000
+	opusMaxFrameSamples = 5760 // 120ms at 48kHz, the longest Opus frame
+)
+
+// rtpCodec converts between the RTP payloads of one negotiated codec and
+// the audio it carries. Encoders and decoders keep state across packets, so
+// each direction of a call uses its own instance.
+type rtpCodec interface {
+	// AudioConfig is the format Decode produces and Encode consumes.
+	AudioConfig() *protos.AudioConfig
+	Decode(payload []byte) ([]byte, error)
+	// Encode encodes one 20ms frame.
+	Encode(frame []byte) ([]byte, error)
+}
+
+// codecAudioConfig is the audio format exchanged with codec: G.711 stays
+// µ-law 8kHz, wideband codecs are linear16 at their sampling rate.
+func codecAudioConfig(codec *sip_infra.Codec) *protos.AudioConfig {
+	switch {
+	case codec.Is(sip_infra.CodecG722):
+		return internal_audio.NewLinear16khzMonoAudioConfig()
+	case codec.Is(sip_infra.CodecOpus):
+		return internal_audio.NewLinear48khzMonoAudioConfig()
+	}
+	return internal_audio.NewMulaw8khzMonoAudioConfig()
+}
+
+func newRTPCodec(codec *sip_infra.Codec) (rtpCodec, error) {
+	switch {
+	case codec.Is(sip_infra.CodecG722):
+		return &g722Codec{
+			encoder: g722.NewEncoder(g722.Rate64000, 0),
+			decoder: g722.NewDecoder(g722.Rate64000, 0),
+		}, nil
+	case codec.Is(sip_infra.CodecOpus):
+		return newOpusRTPCodec()
+	case codec.Is(sip_infra.CodecPCMA):
+		return g711Codec{alaw: true}, nil
+	}
+	return g711Codec{}, nil
+}
+
+// g711Codec exchanges µ-law, transcoding when PCMA is negotiated.
+type g711Codec struct {
+	alaw bool
+}
+
+func (c g711Codec) AudioConfig() *protos.AudioConfig {
+	return internal_audio.NewMulaw8khzMonoAudioConfig()
+}
+
+func (c g711Codec) Decode(payload []byte) ([]byte, error) {
+	if c.alaw {
+		return g711.Alaw2Ulaw(payload), nil
+	}
+	return payload, nil
+}
+
+func (c g711Codec) Encode(frame []byte) ([]byte, error) {
+	if c.alaw {
+		return mulawToAlaw(frame), nil
+	}
+	return frame, nil
+}
+
+// g722Codec runs G.722 at 64 kbit/s over linear16 16kHz.
+type g722Codec struct {
+	encoder *g722.Encoder
+	decoder *g722.Decoder
+}
+
+func (c *g722Codec) AudioConfig() *protos.AudioConfig {
+	return internal_audio.NewLinear16khzMonoAudioConfig()
+}
+
+func (c *g722Codec) Decode(payload []byte) ([]byte, error) {
+	samples := make([]int16, len(payload)*2)
+	n := c.decoder.Decode(samples, payload)
+	return samplesToPCM(samples[:n]), nil
+}
+
+func (c *g722Codec) Encode(frame []byte) ([]byte, error) {
+	samples := pcmToSamples(frame)
+	out := make([]byte, (len(samples)+1)/2)
+	n := c.encoder.Encode(out, samples)
+	return out[:n], nil
+}
+
+// opusRTPCodec runs mono Opus over linear16 48kHz. Stereo streams from the
+// peer are downmixed by the decoder.
+type opusRTPCodec struct {
+	encoder *opus.Encoder
+	decoder *opus.Decoder
+}
+
+func newOpusRTPCodec() (*opusRTPCodec, error) {
+	enc, err := opus.NewEncoder(int(sip_infra.CodecOpus.SampleRate), 1, opus.AppVoIP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Opus encoder: %w", err)
+	}
+	enc.SetBitrate(opusBitrate)
+	enc.SetInBandFEC(true)
+	enc.SetPacketLossPerc(5)
+
+	dec, err := opus.NewDecoder(int(sip_infra.CodecOpus.SampleRate), 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Opus decoder: %w", err)
+	}
+	return &opusRTPCodec{encoder: enc, decoder: dec}, nil
+}
+
+func (c *opusRTPCodec) AudioConfig() *protos.AudioConfig {
+	return internal_audio.NewLinear48khzMonoAudioConfig()
+}
+
+func (c *opusRTPCodec) Decode(payload []byte) ([]byte, error) {
+	samples := make([]int16, opusMaxFrameSamples)
+	n, err := c.decoder.Decode(payload, samples)
+	if err != nil {
+		return nil, fmt.Errorf("Opus decode failed (payload=%d bytes): %w", len(payload), err)
+	}
+	return samplesToPCM(samples[:n]), nil
+}
+
+// Encode pads a short trailing frame to 20ms, Opus only takes whole frames.
+func (c *opusRTPCodec) Encode(frame []byte) ([]byte, error) {
+	samples := pcmToSamples(frame)
+	if frameSamples := int(sip_infra.CodecOpus.SampleRate) / 50; len(samples) < frameSamples {
+		samples = append(samples, make([]int16, frameSamples-len(samples))...)
+	}
+	out := make([]byte, 1000)
+	n, err := c.encoder.Encode(samples, out)
+	if err != nil {
+		return nil, fmt.Errorf("Opus encode failed: %w", err)
+	}
+	return out[:n], nil
+}
+
+// pcmToSamples reads little-endian linear16.
+func pcmToSamples(pcm []byte) []int16 {
+	samples := make([]int16, len(pcm)/2)
+	for i := range samples {
+		samples[i] = int16(binary.LittleEndian.Uint16(pcm[i*2:]))
+	}
+	return samples
+}
+
+// samplesToPCM writes little-endian linear16.
+func samplesToPCM(samples []int16) []byte {
+	pcm := make([]byte, len(samples)*2)
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(pcm[i*2:], uint16(sample))
+	}
+	return pcm
+}
@@ -9,6 +9,7 @@ package internal_sip_telephony
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"sync"
 	"sync/atomic"
@@ -20,6 +21,7 @@ import (
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	sip_infra "github.com/rapidaai/api/assistant-api/sip/infra"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 	"github.com/zaf/g711"
 )
@@ -35,10 +37,6 @@ var (
 	// RAPIDA_AUDIO_CONFIG is the internal Rapida audio format (LINEAR16 16kHz mono).
 	// TTS engines produce audio in this format.
 	RAPIDA_AUDIO_CONFIG = internal_audio.NewLinear16khzMonoAudioConfig()
-
-	// MULAW_8K_AUDIO_CONFIG is the SIP/RTP native audio format (µ-law 8kHz mono).
-	// Audio must be converted to this format before sending via RTP.
-	MULAW_8K_AUDIO_CONFIG = internal_audio.NewMulaw8khzMonoAudioConfig()
 )
 
 // Streamer implements the TelephonyStreamer interface using native SIP signaling and RTP.
@@ -84,15 +82,23 @@ func NewStreamer(ctx context.Context,
 ) (internal_type.Streamer, error) {
 	streamerCtx, cancel := context.WithCancel(ctx)
 
-	// Default codec — overridden below when an existing session carries a
+	// Default codec — overridden when an existing session carries a
 	// negotiated codec.
 	pcmu := sip_infra.CodecPCMU
 	codec := &pcmu
+	if sipSession != nil {
+		if negotiated := sipSession.GetNegotiatedCodec(); negotiated != nil {
+			codec = negotiated
+		}
+	}
 
+	// The pipeline side runs at the negotiated codec's native format: µ-law
+	// 8kHz for G.711, linear16 16kHz for G.722 and 48kHz for Opus. Recv and
+	// sendAudio resample between it and RAPIDA_AUDIO_CONFIG.
 	s := &Streamer{
 		BaseTelephonyStreamer: internal_telephony_base.NewBaseTelephonyStreamer(
 			logger, cc, vaultCred,
-			internal_telephony_base.WithSourceAudioConfig(internal_audio.NewMulaw8khzMonoAudioConfig()),
+			internal_telephony_base.WithSourceAudioConfig(codecAudioConfig(codec)),
 		),
 		config: config,
 		codec:  codec,
@@ -116,15 +122,12 @@ func NewStreamer(ctx context.Context,
 			return nil, sip_infra.NewSIPError("NewStreamer", sipSession.GetCallID(), "session has no RTP handler", sip_infra.ErrRTPNotInitialized)
 		}
 
-		if negotiated := sipSession.GetNegotiatedCodec(); negotiated != nil {
-			s.codec = negotiated
-		}
-
 		s.session = sipSession
 		s.rtpHandler = rtpHandler
 
 		logger.Info("NewStreamer: Starting forwardIncomingAudio goroutine")
 		go s.forwardIncomingAudio()
+		go s.forwardSessionEvents(sipSession)
 		go s.runRTPWriter()
 
 		localIP, localPort := rtpHandler.LocalAddr()
@@ -196,6 +199,7 @@ func (s *Streamer) handleInvite(session *sip_infra.Session, fromURI, toURI strin
 		LocalPort:   rtpPort,
 		PayloadType: codec.PayloadType,
 		ClockRate:   codec.ClockRate,
+		Codec:       codec,
 		Logger:      s.Logger,
 	})
 	if err != nil {
@@ -210,7 +214,7 @@ func (s *Streamer) handleInvite(session *sip_infra.Session, fromURI, toURI strin
 	// Update session with local RTP address
 	localIP, localPort := rtpHandler.LocalAddr()
 	session.SetLocalRTP(localIP, localPort)
-	session.SetNegotiatedCodec(codec.Name, int(codec.ClockRate))
+	session.SetNegotiatedCodec(codec)
 	session.SetRTPHandler(rtpHandler)
 
 	// Start RTP processing
@@ -218,6 +222,7 @@ func (s *Streamer) handleInvite(session *sip_infra.Session, fromURI, toURI strin
 
 	// Start audio forwarding and RTP writer
 	go s.forwardIncomingAudio()
+	go s.forwardSessionEvents(session)
 	go s.runRTPWriter()
 
 	s.Logger.Infow("SIP call established",
@@ -240,12 +245,13 @@ func (s *Streamer) handleError(session *sip_infra.Session, err error) {
 		"error", err)
 }
 
-// forwardIncomingAudio reads RTP audio packets, transcodes from A-law to
-// µ-law when PCMA is negotiated, and buffers the resulting µ-law audio for
-// Recv(). Performing the A-law→µ-law conversion here (close to the source)
-// keeps Recv() simple and ensures the inputBuffer always contains µ-law data.
+// forwardIncomingAudio reads RTP audio packets, decodes them with the
+// current codec and buffers the audio for Recv() in the streamer's source
+// format. Decoding here (close to the source) keeps Recv() simple; when a
+// re-INVITE switches to a codec with another native format, the decoded
+// audio is resampled so the inputBuffer format never changes mid-call.
 //
-// Audio flow: RTP packets → [A-law→µ-law if PCMA] → inputBuffer → Recv()
+// Audio flow: RTP packets → decode (A-law→µ-law, G.722, Opus) → [resample] → inputBuffer → Recv()
 func (s *Streamer) forwardIncomingAudio() {
 	s.mu.RLock()
 	rtpHandler := s.rtpHandler
@@ -264,19 +270,21 @@ func (s *Streamer) forwardIncomingAudio() {
 	default:
 	}
 
+	var decoder rtpCodec
+	var decoderCodec sip_infra.Codec
 	for {
 		select {
 		case <-s.ctx.Done():
 			return
-		case audioData, ok := <-rtpHandler.AudioIn():
+		case payload, ok := <-rtpHandler.AudioIn():
 			if !ok {
 				return
 			}
 
-			// Transcode A-law → µ-law if PCMA codec is negotiated, so the
-			// inputBuffer always holds µ-law samples regardless of codec.
-			if codec := rtpHandler.GetCodec(); codec != nil && codec.Name == "PCMA" {
-				audioData = g711.Alaw2Ulaw(audioData)
+			audioData, err := s.decodePayload(rtpHandler.GetCodec(), &decoder, &decoderCodec, payload)
+			if err != nil {
+				s.Logger.Debugw("forwardIncomingAudio: dropping undecodable payload", "error", err)
+				continue
 			}
 			s.WithInputBuffer(func(buf *bytes.Buffer) {
 				buf.Write(audioData)
@@ -285,15 +293,73 @@ func (s *Streamer) forwardIncomingAudio() {
 	}
 }
 
+// decodePayload decodes an RTP payload to the streamer's source format. The
+// decoder is rebuilt whenever the RTP handler's codec changes.
+func (s *Streamer) decodePayload(codec *sip_infra.Codec, decoder *rtpCodec, current *sip_infra.Codec, payload []byte) ([]byte, error) {
+	if codec == nil {
+		codec = s.codec
+	}
+	if *decoder == nil || *codec != *current {
+		next, err := newRTPCodec(codec)
+		if err != nil {
+			return nil, err
+		}
+		*decoder, *current = next, *codec
+	}
+	audio, err := (*decoder).Decode(payload)
+	if err != nil {
+		return nil, err
+	}
+	return s.Resampler().Resample(audio, (*decoder).AudioConfig(), s.SourceAudioConfig())
+}
+
+// encodeFrame encodes one 20ms frame in the streamer's source format for
+// the RTP handler's current codec, rebuilding the encoder when it changes.
+func (s *Streamer) encodeFrame(codec *sip_infra.Codec, encoder *rtpCodec, current *sip_infra.Codec, frame []byte) ([]byte, error) {
+	if codec == nil {
+		codec = s.codec
+	}
+	if *encoder == nil || *codec != *current {
+		next, err := newRTPCodec(codec)
+		if err != nil {
+			return nil, err
+		}
+		*encoder, *current = next, *codec
+	}
+	audio, err := s.Resampler().Resample(frame, s.SourceAudioConfig(), (*encoder).AudioConfig())
+	if err != nil {
+		return nil, err
+	}
+	return (*encoder).Encode(audio)
+}
+
+// forwardSessionEvents turns DTMF session events (RFC 4733 telephone-events
+// and SIP INFO) into DTMF input for Recv. Other session events are dropped.
+func (s *Streamer) forwardSessionEvents(session *sip_infra.Session) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case event, ok := <-session.Events():
+			if !ok {
+				return
+			}
+			if dtmf, ok := sip_infra.DTMFFromEvent(event); ok {
+				s.PushInput(s.CreateDTMFRequest(dtmf.Digit, uint32(dtmf.Duration)))
+			}
+		}
+	}
+}
+
 func (s *Streamer) Context() context.Context {
 	return s.ctx
 }
 
 // Recv returns the next audio chunk for STT processing.
-// forwardIncomingAudio already transcodes PCMA→PCMU, so the inputBuffer
-// always contains µ-law samples by the time Recv reads them.
+// forwardIncomingAudio already decodes the codec, so the inputBuffer holds
+// audio in the streamer's source format by the time Recv reads it.
 //
-// Audio flow: inputBuffer (µ-law 8kHz) → Resample → LINEAR16 16kHz → STT
+// Audio flow: inputBuffer (µ-law 8kHz, or linear16 for wideband) → Resample → LINEAR16 16kHz → STT
 func (s *Streamer) Recv() (internal_type.Stream, error) {
 	if s.closed.Load() {
 		return nil, io.EOF
@@ -306,7 +372,8 @@ func (s *Streamer) Recv() (internal_type.Stream, error) {
 	}
 
 	// Use the input buffer threshold from BaseTelephonyStreamer, which is
-	// derived from the source audio config (µ-law 8kHz → 8 bytes/ms × 60ms = 480 bytes).
+	// derived from the source audio config (µ-law 8kHz → 8 bytes/ms × 60ms = 480 bytes,
+	// linear16 16kHz → 1920 bytes).
 	bufferThreshold := s.InputBufferThreshold()
 
 	// Use a reusable timer instead of time.After to avoid creating a new
@@ -334,6 +401,13 @@ func (s *Streamer) Recv() (internal_type.Stream, error) {
 			return nil, io.EOF
 		}
 
+		// DTMF and other non-audio input queued by forwardSessionEvents
+		select {
+		case msg := <-s.InputCh:
+			return msg, nil
+		default:
+		}
+
 		var audioData []byte
 		s.WithInputBuffer(func(buf *bytes.Buffer) {
 			if buf.Len() >= bufferThreshold {
@@ -347,7 +421,7 @@ func (s *Streamer) Recv() (internal_type.Stream, error) {
 			// s.Logger.Debug("Recv: Sending audio to STT",
 			// 	"audio_size", len(audioData))
 
-			// Resample µ-law 8kHz → LINEAR16 16kHz and wrap for STT
+			// Resample to LINEAR16 16kHz and wrap for STT
 			return s.CreateVoiceRequest(audioData), nil
 		}
 
@@ -392,23 +466,17 @@ func (s *Streamer) sendAudio(audioData []byte) error {
 		return sip_infra.ErrRTPNotInitialized
 	}
 
-	// Get the current codec from the RTP handler (may have been updated by re-INVITE)
-	codec := rtpHandler.GetCodec()
-
-	// TTS produces LINEAR16 16kHz audio. Resample to µ-law 8kHz for RTP transmission.
-	outData, err := s.Resampler().Resample(audioData, RAPIDA_AUDIO_CONFIG, MULAW_8K_AUDIO_CONFIG)
+	// TTS produces LINEAR16 16kHz audio. Resample to the source format, the
+	// codec encodes each 20ms frame in runRTPWriter.
+	outData, err := s.Resampler().Resample(audioData, RAPIDA_AUDIO_CONFIG, s.SourceAudioConfig())
 	if err != nil {
 		s.Logger.Error("sendAudio: failed to resample audio", "error", err)
 		return err
 	}
 
-	if codec != nil && codec.Name == "PCMA" {
-		outData = mulawToAlaw(outData)
-	}
-
 	// Use BaseStreamer output buffer for consistent 20ms chunking.
 	// BufferAndSendOutput accumulates audio and pushes 20ms frames to OutputCh.
-	// runRTPWriter goroutine reads from OutputCh and forwards to RTP handler.
+	// runRTPWriter goroutine encodes them and forwards to the RTP handler.
 	s.BufferAndSendOutput(outData)
 	return nil
 }
@@ -418,7 +486,7 @@ func (s *Streamer) sendAudio(audioData []byte) error {
 // This prevents overwhelming the RTP handler when TTS produces bursts of audio.
 //
 // The pacing pattern matches WebRTC's runOutputWriter:
-// - Queue incoming frames in pendingAudio
+// - Encode incoming frames with the current codec and queue them in pendingAudio
 // - Send one frame per 20ms tick
 // - On FlushAudioCh, discard all queued audio
 func (s *Streamer) runRTPWriter() {
@@ -426,8 +494,11 @@ func (s *Streamer) runRTPWriter() {
 	ticker := time.NewTicker(pacingInterval)
 	defer ticker.Stop()
 
-	// pendingAudio holds 20ms PCM frames waiting for the next tick.
+	// pendingAudio holds encoded 20ms frames waiting for the next tick.
+	// Frames are encoded once, as the codec state must not see a retry.
 	var pendingAudio [][]byte
+	var encoder rtpCodec
+	var encoderCodec sip_infra.Codec
 
 	for {
 		select {
@@ -467,10 +538,21 @@ func (s *Streamer) runRTPWriter() {
 			}
 
 		case msg := <-s.OutputCh:
-			// Queue audio frame for paced sending.
+			// Encode and queue audio frame for paced sending.
 			if m, ok := msg.(*protos.ConversationAssistantMessage); ok {
 				if audio, ok := m.Message.(*protos.ConversationAssistantMessage_Audio); ok {
-					pendingAudio = append(pendingAudio, audio.Audio)
+					s.mu.RLock()
+					rtpHandler := s.rtpHandler
+					s.mu.RUnlock()
+					if rtpHandler == nil {
+						continue
+					}
+					payload, err := s.encodeFrame(rtpHandler.GetCodec(), &encoder, &encoderCodec, audio.Audio)
+					if err != nil {
+						s.Logger.Warnw("runRTPWriter: failed to encode audio frame", "error", err)
+						continue
+					}
+					pendingAudio = append(pendingAudio, payload)
 				}
 			}
 		}
@@ -488,6 +570,50 @@ func (s *Streamer) handleInterruption() error {
 	return nil
 }
 
+// SendDTMF sends the digits as RFC 4733 telephone-events on the RTP stream.
+func (s *Streamer) SendDTMF(ctx context.Context, digits string) error {
+	s.mu.RLock()
+	rtpHandler := s.rtpHandler
+	s.mu.RUnlock()
+
+	if rtpHandler == nil || !rtpHandler.IsRunning() {
+		return sip_infra.ErrRTPNotInitialized
+	}
+	return rtpHandler.SendDTMF(digits)
+}
+
+// TransferCall sends a REFER on the live dialog and, once the remote party
+// reports through NOTIFY that the target answered, hangs up this leg as the
+// transferor of a blind transfer.
+func (s *Streamer) TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*internal_type.CallInfo, error) {
+	s.mu.RLock()
+	session := s.session
+	s.mu.RUnlock()
+
+	info := &internal_type.CallInfo{Provider: sipProvider, ChannelUUID: s.ChannelUUID}
+	if session == nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = "SIP session not available"
+		return info, sip_infra.ErrSessionClosed
+	}
+	info.ChannelUUID = session.GetCallID()
+
+	referTo := sip_infra.TransferTarget(s.config, toPhone)
+	if err := session.Transfer(ctx, referTo); err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("transfer error: %s", err.Error())
+		return info, err
+	}
+	s.Logger.Infow("SIP call transferred", "call_id", info.ChannelUUID, "refer_to", referTo)
+
+	info.Status = "SUCCESS"
+	info.StatusInfo = internal_type.StatusInfo{
+		Event:   "transferred",
+		Payload: map[string]interface{}{"refer_to": referTo, "call_id": info.ChannelUUID},
+	}
+	return info, s.Close()
+}
+
 // Close closes the streamer and releases all resources.
 // For SIP calls, this sends a BYE to the remote party (via session.Disconnect)
 // before performing local cleanup, ensuring the remote PBX properly tears down
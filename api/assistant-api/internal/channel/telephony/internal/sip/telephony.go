@@ -156,6 +156,12 @@ func (t *sipTelephony) OutboundCall(
 	info := &internal_type.CallInfo{Provider: sipProvider}
 
 	cfg, err := t.parseConfig(vaultCredential)
+	if err == nil {
+		err = cfg.ApplySRTPPolicy(opts)
+	}
+	if err == nil {
+		err = cfg.ApplyCodecPreference(opts)
+	}
 	if err != nil {
 		info.Status = "FAILED"
 		info.ErrorMessage = fmt.Sprintf("config error: %s", err.Error())
@@ -268,3 +274,53 @@ func (t *sipTelephony) ReceiveCall(c *gin.Context) (*internal_type.CallInfo, err
 	}
 	return info, nil
 }
+
+// TransferCall blind-transfers the active SIP call by sending a REFER to the
+// remote party and hangs up this leg once the NOTIFY reports that the target
+// answered.
+func (t *sipTelephony) TransferCall(
+	auth types.SimplePrinciple,
+	channelUUID string,
+	toPhone string,
+	vaultCredential *protos.VaultCredential,
+	opts utils.Option,
+) (*internal_type.CallInfo, error) {
+	info := &internal_type.CallInfo{Provider: sipProvider, ChannelUUID: channelUUID}
+
+	if t.sharedServer == nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = "SIP server not initialized"
+		return info, fmt.Errorf("shared SIP server not available")
+	}
+	session, ok := t.sharedServer.GetSession(channelUUID)
+	if !ok {
+		info.Status = "FAILED"
+		info.ErrorMessage = "SIP session not found"
+		return info, sip_infra.ErrSessionNotFound
+	}
+
+	cfg, err := t.parseConfig(vaultCredential)
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("config error: %s", err.Error())
+		return info, err
+	}
+	referTo := sip_infra.TransferTarget(cfg, toPhone)
+	if err := t.sharedServer.TransferCall(context.Background(), session, referTo); err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("transfer error: %s", err.Error())
+		return info, err
+	}
+	// the disconnect callback is cleared so the streamer closing later does
+	// not send a second BYE
+	session.SetOnDisconnect(nil)
+	if err := t.sharedServer.EndCall(session); err != nil {
+		t.logger.Warnf("unable to hang up transferred call %s: %v", channelUUID, err)
+	}
+	info.Status = "SUCCESS"
+	info.StatusInfo = internal_type.StatusInfo{
+		Event:   "transferred",
+		Payload: map[string]interface{}{"refer_to": referTo, "call_id": channelUUID},
+	}
+	return info, nil
+}
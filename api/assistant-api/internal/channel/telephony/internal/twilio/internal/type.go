@@ -14,4 +14,8 @@ type TwilioMediaEvent struct {
 		Payload   string `json:"payload"`
 	} `json:"media"`
 	StreamSid string `json:"streamSid"`
+	Dtmf      struct {
+		Track string `json:"track"`
+		Digit string `json:"digit"`
+	} `json:"dtmf"`
 }
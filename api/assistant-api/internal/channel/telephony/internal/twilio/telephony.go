@@ -7,8 +7,10 @@ package internal_twilio_telephony
 
 import (
 	"fmt"
+	"html"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rapidaai/api/assistant-api/config"
@@ -193,3 +195,48 @@ func (tpc *twilioTelephony) ReceiveCall(c *gin.Context) (*internal_type.CallInfo
 	}
 	return info, nil
 }
+
+// TransferCall redirects the live call to the destination by replacing its TwiML with a <Dial>,
+// Twilio stops the media stream once the new instructions are applied.
+func (tpc *twilioTelephony) TransferCall(auth types.SimplePrinciple, channelUUID string, toPhone string, vaultCredential *protos.VaultCredential, opts utils.Option) (*internal_type.CallInfo, error) {
+	info := &internal_type.CallInfo{Provider: twilioProvider, ChannelUUID: channelUUID}
+	if channelUUID == "" {
+		info.Status = "FAILED"
+		info.ErrorMessage = "missing call sid for transfer"
+		return info, fmt.Errorf("missing call sid for transfer")
+	}
+
+	client, err := tpc.client(vaultCredential)
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("authentication error: %s", err.Error())
+		return info, err
+	}
+
+	callerID, _ := opts.GetString("caller_id")
+	params := &openapi.UpdateCallParams{}
+	params.SetTwiml(tpc.CreateTransferTwiML(toPhone, callerID))
+	resp, err := client.Api.UpdateCall(channelUUID, params)
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("API error: %s", err.Error())
+		return info, err
+	}
+
+	info.Status = "SUCCESS"
+	info.StatusInfo = internal_type.StatusInfo{Event: "transferred", Payload: resp}
+	return info, nil
+}
+
+// CreateTransferTwiML dials the destination, SIP URIs are dialed with <Sip> and everything else as a number.
+func (tpc *twilioTelephony) CreateTransferTwiML(toPhone string, callerID string) string {
+	noun := fmt.Sprintf("<Number>%s</Number>", html.EscapeString(toPhone))
+	if strings.HasPrefix(toPhone, "sip:") || strings.HasPrefix(toPhone, "sips:") {
+		noun = fmt.Sprintf("<Sip>%s</Sip>", html.EscapeString(toPhone))
+	}
+	dial := "<Dial>"
+	if callerID != "" {
+		dial = fmt.Sprintf(`<Dial callerId="%s">`, html.EscapeString(callerID))
+	}
+	return fmt.Sprintf("<Response>%s%s</Dial></Response>", dial, noun)
+}
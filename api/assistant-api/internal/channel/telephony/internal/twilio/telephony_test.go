@@ -335,3 +335,43 @@ func TestReceiveCall_CallInfoStructure(t *testing.T) {
 	assert.Equal(t, "webhook", callInfo.StatusInfo.Event)
 	assert.NotNil(t, callInfo.StatusInfo.Payload)
 }
+
+// TestCreateTransferTwiML tests the TwiML generated for call transfers
+func TestCreateTransferTwiML(t *testing.T) {
+	tpc := &twilioTelephony{}
+
+	tests := []struct {
+		name     string
+		toPhone  string
+		callerID string
+		expected string
+	}{
+		{
+			name:     "Phone number without caller id",
+			toPhone:  "+15551234567",
+			expected: "<Response><Dial><Number>+15551234567</Number></Dial></Response>",
+		},
+		{
+			name:     "Phone number with caller id",
+			toPhone:  "+15551234567",
+			callerID: "+13345895552",
+			expected: `<Response><Dial callerId="+13345895552"><Number>+15551234567</Number></Dial></Response>`,
+		},
+		{
+			name:     "SIP destination",
+			toPhone:  "sip:agent@pbx.example.com;transport=tls",
+			expected: "<Response><Dial><Sip>sip:agent@pbx.example.com;transport=tls</Sip></Dial></Response>",
+		},
+		{
+			name:     "Destination is escaped",
+			toPhone:  "<Hangup/>",
+			expected: "<Response><Dial><Number>&lt;Hangup/&gt;</Number></Dial></Response>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tpc.CreateTransferTwiML(tt.toPhone, tt.callerID))
+		})
+	}
+}
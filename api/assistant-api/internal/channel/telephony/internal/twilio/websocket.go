@@ -8,6 +8,7 @@ package internal_twilio_telephony
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -19,6 +20,7 @@ import (
 	internal_twilio "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/twilio/internal"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 	"github.com/twilio/twilio-go"
 	openapi "github.com/twilio/twilio-go/rest/api/v2010"
@@ -76,6 +78,11 @@ func (tws *twilioWebsocketStreamer) Recv() (internal_type.Stream, error) {
 			return nil, err
 		}
 		return msg, err
+	case "dtmf":
+		if mediaEvent.Dtmf.Digit == "" {
+			return nil, nil
+		}
+		return tws.CreateDTMFRequest(mediaEvent.Dtmf.Digit, 0), nil
 	case "stop":
 		tws.Logger.Info("Twilio stream stopped")
 		tws.connection.Close()
@@ -177,6 +184,29 @@ func (tws *twilioWebsocketStreamer) GetConversationUuid() string {
 	return tws.ChannelUUID
 }
 
+// TransferCall transfers the live call and releases the media stream on success.
+func (tws *twilioWebsocketStreamer) TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*internal_type.CallInfo, error) {
+	tpc := &twilioTelephony{logger: tws.Logger}
+	info, err := tpc.TransferCall(tws.CallContext().ToAuth(), tws.GetConversationUuid(), toPhone, tws.VaultCredential(), tws.TransferOption(opts))
+	if err != nil {
+		return info, err
+	}
+	if err := tws.Cancel(); err != nil {
+		tws.Logger.Errorf("Error disconnecting after transfer: %v", err)
+	}
+	return info, nil
+}
+
+// SendDTMF plays the digits in-band on the media stream. <Play digits> is not
+// used as updating the call TwiML would end the stream.
+func (tws *twilioWebsocketStreamer) SendDTMF(ctx context.Context, digits string) error {
+	tones, err := tws.CreateDTMFResponse(digits)
+	if err != nil {
+		return err
+	}
+	return tws.Send(tones)
+}
+
 func (tws *twilioWebsocketStreamer) Cancel() error {
 	if tws.connection != nil {
 		tws.connection.Close()
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rapidaai/api/assistant-api/config"
@@ -174,6 +175,71 @@ func (tpc *vonageTelephony) ReceiveCall(c *gin.Context) (*internal_type.CallInfo
 	return info, nil
 }
 
+// TransferCall moves the call leg out of the assistant conversation by transferring it
+// to an NCCO that connects the destination number. Vonage NCCO connect has no SIP URI
+// endpoint in the SDK, so only phone destinations are supported.
+func (vt *vonageTelephony) TransferCall(auth types.SimplePrinciple, channelUUID string, toPhone string, vaultCredential *protos.VaultCredential, opts utils.Option) (*internal_type.CallInfo, error) {
+	info := &internal_type.CallInfo{Provider: vonageProvider, ChannelUUID: channelUUID}
+	if channelUUID == "" {
+		info.Status = "FAILED"
+		info.ErrorMessage = "missing call uuid for transfer"
+		return info, fmt.Errorf("missing call uuid for transfer")
+	}
+	if strings.HasPrefix(toPhone, "sip:") || strings.HasPrefix(toPhone, "sips:") {
+		info.Status = "FAILED"
+		info.ErrorMessage = "sip destinations are not supported for vonage transfer"
+		return info, fmt.Errorf("sip destination %s is not supported for vonage transfer", toPhone)
+	}
+
+	cAuth, err := vt.Auth(vaultCredential)
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("authentication error: %s", err.Error())
+		return info, err
+	}
+
+	callerID, _ := opts.GetString("caller_id")
+	transferNcco := ncco.Ncco{}
+	transferNcco.AddAction(ncco.ConnectAction{
+		From:     callerID,
+		Endpoint: []ncco.Endpoint{ncco.PhoneEndpoint{Number: toPhone}},
+	})
+	result, vErr, err := vonage.NewVoiceClient(cAuth).TransferCall(vonage.TransferCallOpts{
+		Uuid: channelUUID,
+		Ncco: transferNcco,
+	})
+	if err != nil {
+		info.Status = "FAILED"
+		info.ErrorMessage = fmt.Sprintf("API error: %s", err.Error())
+		if vErr.Error != nil {
+			info.ErrorMessage = fmt.Sprintf("API error: %v", vErr.Error)
+		}
+		return info, err
+	}
+
+	info.Status = "SUCCESS"
+	info.StatusInfo = internal_type.StatusInfo{Event: "transferred", Payload: result}
+	return info, nil
+}
+
+// SendDTMF plays the digits into the live call leg.
+func (vt *vonageTelephony) SendDTMF(channelUUID string, digits string, vaultCredential *protos.VaultCredential) error {
+	if channelUUID == "" {
+		return fmt.Errorf("missing call uuid for dtmf")
+	}
+	cAuth, err := vt.Auth(vaultCredential)
+	if err != nil {
+		return err
+	}
+	if _, vErr, err := vonage.NewVoiceClient(cAuth).PlayDtmf(channelUUID, digits); err != nil {
+		if vErr.Error != nil {
+			return fmt.Errorf("API error: %v", vErr.Error)
+		}
+		return err
+	}
+	return nil
+}
+
 func (tpc *vonageTelephony) Auth(vaultCredential *protos.VaultCredential) (vonage.Auth, error) {
 	privateKey, ok := vaultCredential.GetValue().AsMap()["private_key"]
 	if !ok {
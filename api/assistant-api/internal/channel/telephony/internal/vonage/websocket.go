@@ -8,6 +8,7 @@ package internal_vonage_telephony
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -17,6 +18,7 @@ import (
 	internal_telephony_base "github.com/rapidaai/api/assistant-api/internal/channel/telephony/internal/base"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
 	protos "github.com/rapidaai/protos"
 	"github.com/vonage/vonage-go-sdk"
 )
@@ -58,6 +60,14 @@ func (vng *vonageWebsocketStreamer) Recv() (internal_type.Stream, error) {
 		case "websocket:connected":
 			return vng.CreateConnectionRequest(), nil
 
+		case "websocket:dtmf":
+			digit, _ := textEvent["digit"].(string)
+			if digit == "" {
+				return nil, nil
+			}
+			duration, _ := textEvent["duration"].(float64)
+			return vng.CreateDTMFRequest(digit, uint32(duration)), nil
+
 		case "stop":
 			return nil, io.EOF
 
@@ -193,8 +203,29 @@ func (tws *vonageWebsocketStreamer) GetConversationUuid() string {
 	return tws.ChannelUUID
 }
 
+// TransferCall transfers the live call and releases the media stream on success.
+func (vng *vonageWebsocketStreamer) TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*internal_type.CallInfo, error) {
+	vt := &vonageTelephony{logger: vng.Logger}
+	info, err := vt.TransferCall(vng.CallContext().ToAuth(), vng.GetConversationUuid(), toPhone, vng.VaultCredential(), vng.TransferOption(opts))
+	if err != nil {
+		return info, err
+	}
+	if err := vng.Cancel(); err != nil {
+		vng.Logger.Errorf("Error disconnecting after transfer: %v", err)
+	}
+	return info, nil
+}
+
+// SendDTMF plays the digits into the call through the Voice API.
+func (vng *vonageWebsocketStreamer) SendDTMF(ctx context.Context, digits string) error {
+	vt := &vonageTelephony{logger: vng.Logger}
+	return vt.SendDTMF(vng.GetConversationUuid(), digits, vng.VaultCredential())
+}
+
 func (tws *vonageWebsocketStreamer) Cancel() error {
-	tws.connection.Close()
-	tws.connection = nil
+	if tws.connection != nil {
+		tws.connection.Close()
+		tws.connection = nil
+	}
 	return nil
 }
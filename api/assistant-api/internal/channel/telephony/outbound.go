@@ -12,11 +12,14 @@ import (
 
 	"github.com/rapidaai/api/assistant-api/config"
 	callcontext "github.com/rapidaai/api/assistant-api/internal/callcontext"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
 	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
 	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
 )
 
 // OutboundDispatcher handles outbound call dispatching across all telephony
@@ -45,6 +48,103 @@ func NewOutboundDispatcher(deps TelephonyDispatcherDeps) *OutboundDispatcher {
 	}
 }
 
+// OutboundCall describes a call to place for an assistant outside of the
+// CreatePhoneCall rpc, such as a campaign contact.
+type OutboundCall struct {
+	ToNumber string
+	// FromNumber defaults to the phone of the assistant's phone deployment.
+	FromNumber string
+	// ConversationID reuses a conversation created ahead of the call, a new
+	// conversation is created when zero.
+	ConversationID uint64
+
+	Args     map[string]interface{}
+	Options  map[string]interface{}
+	Metadata map[string]interface{}
+}
+
+// CreateConversation creates the outbound conversation of a call with its
+// options, arguments and metadata.
+func (d *OutboundDispatcher) CreateConversation(ctx context.Context, auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, call *OutboundCall) (*internal_conversation_entity.AssistantConversation, error) {
+	conversation, err := d.conversationService.CreateConversation(ctx, auth, call.ToNumber, assistant.Id, assistant.AssistantProviderId, type_enums.DIRECTION_OUTBOUND, utils.PhoneCall)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create conversation: %w", err)
+	}
+	if len(call.Options) > 0 {
+		if conversation.Options, err = d.conversationService.ApplyConversationOption(ctx, auth, assistant.Id, conversation.Id, call.Options); err != nil {
+			return nil, fmt.Errorf("failed to apply conversation options: %w", err)
+		}
+	}
+	if len(call.Args) > 0 {
+		if conversation.Arguments, err = d.conversationService.ApplyConversationArgument(ctx, auth, assistant.Id, conversation.Id, call.Args); err != nil {
+			return nil, fmt.Errorf("failed to apply conversation arguments: %w", err)
+		}
+	}
+	if len(call.Metadata) > 0 {
+		if conversation.Metadatas, err = d.conversationService.ApplyConversationMetadata(ctx, auth, assistant.Id, conversation.Id, types.NewMetadataList(call.Metadata)); err != nil {
+			d.logger.Errorf("outbound dispatcher: failed to apply conversation metadata: %v", err)
+		}
+	}
+	return conversation, nil
+}
+
+// Queue stores the call context of an outbound call as "queued" and returns
+// its contextID, the call is placed by Dispatch.
+func (d *OutboundDispatcher) Queue(ctx context.Context, auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, call *OutboundCall) (string, uint64, error) {
+	if !assistant.IsPhoneDeploymentEnable() {
+		return "", 0, fmt.Errorf("phone deployment not enabled for assistant %d", assistant.Id)
+	}
+	fromPhone := call.FromNumber
+	if utils.IsEmpty(fromPhone) {
+		fromNumber, err := assistant.AssistantPhoneDeployment.GetOptions().GetString("phone")
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to get phone number: %w", err)
+		}
+		fromPhone = fromNumber
+	}
+
+	conversationID := call.ConversationID
+	if conversationID == 0 {
+		conversation, err := d.CreateConversation(ctx, auth, assistant, call)
+		if err != nil {
+			return "", 0, err
+		}
+		conversationID = conversation.Id
+	}
+
+	cc := &callcontext.CallContext{
+		AssistantID:         assistant.Id,
+		ConversationID:      conversationID,
+		AssistantProviderId: assistant.AssistantProviderId,
+		AuthToken:           auth.GetCurrentToken(),
+		AuthType:            auth.Type(),
+		Direction:           "outbound",
+		CallerNumber:        call.ToNumber,
+		CalleeNumber:        call.ToNumber,
+		FromNumber:          fromPhone,
+		Provider:            assistant.AssistantPhoneDeployment.TelephonyProvider,
+		Status:              callcontext.StatusQueued,
+	}
+	if auth.GetCurrentProjectId() != nil {
+		cc.ProjectID = *auth.GetCurrentProjectId()
+	}
+	if auth.GetCurrentOrganizationId() != nil {
+		cc.OrganizationID = *auth.GetCurrentOrganizationId()
+	}
+	contextID, err := d.store.Save(ctx, cc)
+	if err != nil {
+		d.conversationService.ApplyConversationMetrics(ctx, auth, assistant.Id, conversationID, []*types.Metric{types.NewStatusMetric(type_enums.RECORD_FAILED)})
+		return "", conversationID, fmt.Errorf("failed to save call context: %w", err)
+	}
+	d.conversationService.ApplyConversationMetadata(ctx, auth, assistant.Id, conversationID, []*types.Metadata{
+		types.NewMetadata("telephony.contextId", contextID),
+		types.NewMetadata("telephony.toPhone", call.ToNumber),
+		types.NewMetadata("telephony.fromPhone", fromPhone),
+		types.NewMetadata("telephony.provider", cc.Provider),
+	})
+	return contextID, conversationID, nil
+}
+
 // Dispatch resolves the call context for the given contextID and places the
 // outbound call. It should be called in a goroutine so the caller does not
 // block on telephony provider latency.
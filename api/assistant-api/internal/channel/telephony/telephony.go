@@ -108,13 +108,18 @@ type TelephonyDispatcherDeps struct {
 // StreamerOption carries the transport-specific parameters needed to construct a
 // streamer. Callers populate only the fields relevant to their transport:
 //
-//   - WebSocket providers (Twilio, Exotel, Vonage, Asterisk WS): set WebSocketConn
+//   - WebSocket providers (Twilio, Exotel, Vonage, Asterisk WS): set WebSocketConn,
+//     Exotel also needs CallContextStore to hand the call over on transfer
 //   - AudioSocket (Asterisk): set AudioSocketConn, AudioSocketReader, AudioSocketWriter, InitialUUID
 //   - SIP: set Ctx, SIPSession, SIPConfig
 type StreamerOption struct {
 	// WebSocket transport
 	WebSocketConn *websocket.Conn
 
+	// CallContextStore records state the provider reads back from its own flow
+	// after the stream ends (Exotel transfer destination)
+	CallContextStore callcontext.Store
+
 	// AudioSocket transport (Asterisk)
 	AudioSocketConn   net.Conn
 	AudioSocketReader *bufio.Reader
@@ -139,7 +144,7 @@ func (at Telephony) NewStreamer(
 	case Twilio:
 		return internal_twilio_telephony.NewTwilioWebsocketStreamer(logger, opt.WebSocketConn, cc, vaultCred), nil
 	case Exotel:
-		return internal_exotel_telephony.NewExotelWebsocketStreamer(logger, opt.WebSocketConn, cc, vaultCred), nil
+		return internal_exotel_telephony.NewExotelWebsocketStreamer(logger, opt.WebSocketConn, cc, vaultCred, opt.CallContextStore), nil
 	case Vonage:
 		return internal_vonage_telephony.NewVonageWebsocketStreamer(logger, opt.WebSocketConn, cc, vaultCred), nil
 	case Asterisk:
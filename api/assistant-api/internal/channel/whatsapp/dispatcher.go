@@ -0,0 +1,349 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultSessionWindow is how long a session outlives the last message of
+	// its sender, overridden by the "session.window" option in seconds.
+	defaultSessionWindow = 30 * time.Minute
+
+	sessionInboxSize     = 32
+	transcriptionTimeout = 2 * time.Minute
+)
+
+// Talker runs the conversation of a session.
+type Talker interface {
+	Talk(ctx context.Context, auth types.SimplePrinciple) error
+}
+
+// Transcriber transcribes voice notes with the input audio of a deployment.
+type Transcriber interface {
+	Transcribe(ctx context.Context, auth types.SimplePrinciple, audio *internal_assistant_entity.AssistantDeploymentAudio, media []byte, mimeType string) (string, error)
+}
+
+// DispatcherDeps holds the dependencies of the Dispatcher.
+type DispatcherDeps struct {
+	Cfg                 *config.AssistantConfig
+	Logger              commons.Logger
+	Store               SessionStore
+	VaultClient         web_client.VaultClient
+	DeploymentService   internal_services.AssistantDeploymentService
+	ConversationService internal_services.AssistantConversationService
+
+	// Transcriber transcribes voice notes, they are dropped when nil.
+	Transcriber Transcriber
+
+	// NewTalker creates the talker of a session streaming through streamer.
+	NewTalker func(ctx context.Context, streamer internal_type.Streamer) (Talker, error)
+}
+
+// Dispatcher routes inbound WhatsApp messages into text conversations. The
+// messages of a sender are delivered in order to the live session of the
+// sender, started on its first message and ended once the sender stays
+// silent for the session window. A session started within the window of the
+// previous one, after a restart or on another replica, resumes its
+// conversation.
+type Dispatcher struct {
+	DispatcherDeps
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+// session is the live conversation of a sender.
+type session struct {
+	auth       types.SimplePrinciple
+	assistant  *internal_assistant_entity.Assistant
+	sender     string
+	window     time.Duration
+	provider   Provider
+	credential *protos.VaultCredential
+
+	inbox          chan *Message
+	conversationID atomic.Uint64
+}
+
+// NewDispatcher creates a Dispatcher.
+func NewDispatcher(deps DispatcherDeps) *Dispatcher {
+	return &Dispatcher{
+		DispatcherDeps: deps,
+		sessions:       make(map[string]*session),
+	}
+}
+
+// HandleMessage verifies a webhook of the WhatsApp deployment of an assistant
+// and dispatches its messages. Webhooks carry no api key, the signature of
+// the provider authenticates them and the messages are handled in the
+// project of the assistant.
+func (d *Dispatcher) HandleMessage(c *gin.Context, assistantID uint64) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	auth, assistant, provider, credential, err := d.resolve(c, assistantID)
+	if err != nil {
+		return err
+	}
+	if err := provider.Verify(c.Request, d.publicURL(c), body, credential); err != nil {
+		return err
+	}
+	messages, err := provider.Parse(body)
+	if err != nil {
+		return err
+	}
+	for _, msg := range messages {
+		d.dispatch(auth, assistant, provider, credential, msg)
+	}
+	provider.Acknowledge(c)
+	return nil
+}
+
+// HandleChallenge answers the subscription challenge of the provider of the
+// WhatsApp deployment of an assistant.
+func (d *Dispatcher) HandleChallenge(c *gin.Context, assistantID uint64) (string, error) {
+	_, _, provider, credential, err := d.resolve(c, assistantID)
+	if err != nil {
+		return "", err
+	}
+	return provider.Challenge(c.Request.URL.Query(), credential)
+}
+
+// resolve loads the WhatsApp deployment of an assistant with its provider
+// and the vault credential of the provider, along with a service scope
+// principal of the project of the assistant.
+func (d *Dispatcher) resolve(ctx context.Context, assistantID uint64) (types.SimplePrinciple, *internal_assistant_entity.Assistant, Provider, *protos.VaultCredential, error) {
+	assistant, err := d.DeploymentService.GetAssistantWithWhatsappDeployment(ctx, assistantID)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to load assistant %d: %w", assistantID, err)
+	}
+	if !assistant.IsWhatsappDeploymentEnable() {
+		return nil, nil, nil, nil, fmt.Errorf("whatsapp deployment not enabled for assistant %d", assistantID)
+	}
+	auth := &types.ServiceScope{
+		ProjectId:      utils.Ptr(assistant.ProjectId),
+		OrganizationId: utils.Ptr(assistant.OrganizationId),
+	}
+	deployment := assistant.AssistantWhatsappDeployment
+	provider, err := GetProvider(deployment.WhatsappProvider)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	credentialID, err := deployment.GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("whatsapp deployment of assistant %d has no credential: %w", assistantID, err)
+	}
+	credential, err := d.VaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to resolve vault credential: %w", err)
+	}
+	return auth, assistant, provider, credential, nil
+}
+
+// publicURL is the url the provider called, signatures are computed over it.
+func (d *Dispatcher) publicURL(c *gin.Context) string {
+	return fmt.Sprintf("https://%s%s", d.Cfg.PublicAssistantHost, c.Request.URL.RequestURI())
+}
+
+// dispatch delivers a message to the session of its sender, starting one
+// when the sender has none.
+func (d *Dispatcher) dispatch(auth types.SimplePrinciple, assistant *internal_assistant_entity.Assistant, provider Provider, credential *protos.VaultCredential, msg *Message) {
+	key := fmt.Sprintf("%d:%s", assistant.Id, msg.From)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	s, ok := d.sessions[key]
+	if !ok {
+		s = &session{
+			auth:       auth,
+			assistant:  assistant,
+			sender:     msg.From,
+			window:     sessionWindow(assistant.AssistantWhatsappDeployment.GetOptions()),
+			provider:   provider,
+			credential: credential,
+			inbox:      make(chan *Message, sessionInboxSize),
+		}
+		d.sessions[key] = s
+		go d.run(key, s, msg)
+	}
+	select {
+	case s.inbox <- msg:
+	default:
+		d.Logger.Warnf("whatsapp session of %s is busy, dropping message %s", msg.From, msg.ID)
+	}
+}
+
+func sessionWindow(opts utils.Option) time.Duration {
+	if seconds, err := opts.GetUint64("session.window"); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return defaultSessionWindow
+}
+
+// run talks with the sender of a session until the conversation ends or the
+// session window elapses.
+func (d *Dispatcher) run(key string, s *session, first *Message) {
+	ctx := context.Background()
+	st := newStreamer(d.Logger, s.provider, s.credential, first, func(conversationID uint64) {
+		s.conversationID.Store(conversationID)
+		d.touch(ctx, s)
+	})
+	defer st.Cancel()
+
+	talker, err := d.NewTalker(st.Context(), st)
+	if err != nil {
+		d.Logger.Errorf("unable to create talker for whatsapp session of %s: %v", s.sender, err)
+		d.release(key, s, false)
+		return
+	}
+	st.PushInput(&protos.ConversationInitialization{
+		AssistantConversationId: d.resume(ctx, s),
+		Assistant: &protos.AssistantDefinition{
+			AssistantId: s.assistant.Id,
+			Version:     utils.GetVersionString(s.assistant.AssistantProviderId),
+		},
+		StreamMode:   protos.StreamMode_STREAM_MODE_TEXT,
+		UserIdentity: &protos.ConversationInitialization_Phone{Phone: &protos.PhoneIdentity{PhoneNumber: s.sender}},
+		Time:         timestamppb.Now(),
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- talker.Talk(st.Context(), s.auth)
+	}()
+
+	idle := time.NewTimer(s.window)
+	defer idle.Stop()
+	for {
+		select {
+		case msg := <-s.inbox:
+			if text := d.text(ctx, s, msg); text != "" {
+				st.push(msg, text)
+				d.touch(ctx, s)
+			}
+			idle.Reset(s.window)
+
+		case <-idle.C:
+			d.Logger.Debugf("whatsapp session of %s is idle, ending conversation %d", s.sender, s.conversationID.Load())
+			st.Close()
+			<-done
+			d.end(ctx, key, s, true)
+			return
+
+		case err := <-done:
+			if err != nil {
+				d.Logger.Errorf("whatsapp session of %s failed: %v", s.sender, err)
+			}
+			d.end(ctx, key, s, err == nil)
+			return
+		}
+	}
+}
+
+// end forgets a session that is over, a new message of its sender starts a
+// new conversation.
+func (d *Dispatcher) end(ctx context.Context, key string, s *session, redispatch bool) {
+	if err := d.Store.Delete(ctx, s.assistant.Id, s.sender); err != nil {
+		d.Logger.Warnf("unable to end whatsapp session of %s: %v", s.sender, err)
+	}
+	d.release(key, s, redispatch)
+}
+
+// release removes a session, the messages it received while ending are
+// dispatched to a new session when redispatch is set.
+func (d *Dispatcher) release(key string, s *session, redispatch bool) {
+	d.mu.Lock()
+	delete(d.sessions, key)
+	var pending []*Message
+	for len(s.inbox) > 0 {
+		pending = append(pending, <-s.inbox)
+	}
+	d.mu.Unlock()
+	if !redispatch {
+		if len(pending) > 0 {
+			d.Logger.Warnf("dropping %d whatsapp messages of %s", len(pending), s.sender)
+		}
+		return
+	}
+	for _, msg := range pending {
+		d.dispatch(s.auth, s.assistant, s.provider, s.credential, msg)
+	}
+}
+
+// resume returns the conversation of the previous session of the sender when
+// it is within its window and still exists, 0 to begin a new one.
+func (d *Dispatcher) resume(ctx context.Context, s *session) uint64 {
+	conversationID, err := d.Store.Get(ctx, s.assistant.Id, s.sender)
+	if err != nil {
+		d.Logger.Warnf("unable to read whatsapp session of %s: %v", s.sender, err)
+		return 0
+	}
+	if conversationID == 0 {
+		return 0
+	}
+	conversation, err := d.ConversationService.Get(ctx, s.auth, s.assistant.Id, conversationID, nil)
+	if err != nil || conversation == nil {
+		d.Logger.Warnf("whatsapp session of %s has no conversation %d, beginning a new one", s.sender, conversationID)
+		return 0
+	}
+	return conversationID
+}
+
+// touch extends the window of the session once its conversation is known.
+func (d *Dispatcher) touch(ctx context.Context, s *session) {
+	conversationID := s.conversationID.Load()
+	if conversationID == 0 {
+		return
+	}
+	if err := d.Store.Set(ctx, s.assistant.Id, s.sender, conversationID, s.window); err != nil {
+		d.Logger.Warnf("unable to store whatsapp session of %s: %v", s.sender, err)
+	}
+}
+
+// text returns the text of a message, the transcript of a voice note.
+func (d *Dispatcher) text(ctx context.Context, s *session, msg *Message) string {
+	if !msg.IsVoice() {
+		return strings.TrimSpace(msg.Text)
+	}
+	audio := s.assistant.AssistantWhatsappDeployment.InputAudio
+	if d.Transcriber == nil || audio == nil {
+		d.Logger.Warnf("dropping voice note %s, assistant %d has no input audio", msg.ID, s.assistant.Id)
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(ctx, transcriptionTimeout)
+	defer cancel()
+	media, err := s.provider.Media(ctx, s.credential, msg)
+	if err != nil {
+		d.Logger.Errorf("unable to download voice note %s: %v", msg.ID, err)
+		return ""
+	}
+	text, err := d.Transcriber.Transcribe(ctx, s.auth, audio, media, msg.MediaType)
+	if err != nil {
+		d.Logger.Errorf("unable to transcribe voice note %s: %v", msg.ID, err)
+		return ""
+	}
+	return strings.TrimSpace(text)
+}
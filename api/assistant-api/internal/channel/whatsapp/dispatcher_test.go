@@ -0,0 +1,401 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_conversation_entity "github.com/rapidaai/api/assistant-api/internal/entity/conversations"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// memorySessionStore keeps sessions in memory.
+type memorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]uint64
+	deleted  []string
+}
+
+func newMemorySessionStore() *memorySessionStore {
+	return &memorySessionStore{sessions: make(map[string]uint64)}
+}
+
+func (s *memorySessionStore) Get(ctx context.Context, assistantID uint64, sender string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sessions[sessionKey(assistantID, sender)], nil
+}
+
+func (s *memorySessionStore) Set(ctx context.Context, assistantID uint64, sender string, conversationID uint64, window time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[sessionKey(assistantID, sender)] = conversationID
+	return nil
+}
+
+func (s *memorySessionStore) Delete(ctx context.Context, assistantID uint64, sender string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, sessionKey(assistantID, sender))
+	s.deleted = append(s.deleted, sender)
+	return nil
+}
+
+func (s *memorySessionStore) get(assistantID uint64, sender string) (uint64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, ok := s.sessions[sessionKey(assistantID, sender)]
+	return id, ok
+}
+
+// conversations answers Get for the conversations it holds.
+type conversations struct {
+	internal_services.AssistantConversationService
+	ids map[uint64]bool
+}
+
+func (c *conversations) Get(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, assistantConversationId uint64, opts *internal_services.GetConversationOption) (*internal_conversation_entity.AssistantConversation, error) {
+	if !c.ids[assistantConversationId] {
+		return nil, errors.New("record not found")
+	}
+	return &internal_conversation_entity.AssistantConversation{}, nil
+}
+
+// talk is a conversation run by a fakeTalker.
+type talk struct {
+	resumed  uint64
+	id       uint64
+	messages []string
+	ended    bool
+}
+
+// talkers creates fake talkers and records their conversations. A talker
+// begins conversation 100+n unless it resumes one, and ends the conversation
+// when the sender writes "bye".
+type talkers struct {
+	mu    sync.Mutex
+	talks []*talk
+}
+
+func (ts *talkers) new(ctx context.Context, streamer internal_type.Streamer) (Talker, error) {
+	return &fakeTalker{talkers: ts, streamer: streamer}, nil
+}
+
+func (ts *talkers) snapshot() []talk {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	out := make([]talk, 0, len(ts.talks))
+	for _, t := range ts.talks {
+		out = append(out, talk{resumed: t.resumed, id: t.id, messages: append([]string(nil), t.messages...), ended: t.ended})
+	}
+	return out
+}
+
+type fakeTalker struct {
+	talkers  *talkers
+	streamer internal_type.Streamer
+}
+
+func (t *fakeTalker) Talk(ctx context.Context, auth types.SimplePrinciple) error {
+	current := &talk{}
+	t.talkers.mu.Lock()
+	t.talkers.talks = append(t.talkers.talks, current)
+	n := len(t.talkers.talks)
+	t.talkers.mu.Unlock()
+	defer func() {
+		t.talkers.mu.Lock()
+		current.ended = true
+		t.talkers.mu.Unlock()
+	}()
+
+	for {
+		in, err := t.streamer.Recv()
+		if err != nil {
+			return nil
+		}
+		switch in := in.(type) {
+		case *protos.ConversationInitialization:
+			id := in.GetAssistantConversationId()
+			t.talkers.mu.Lock()
+			current.resumed = id
+			if id == 0 {
+				id = uint64(100 + n)
+			}
+			current.id = id
+			t.talkers.mu.Unlock()
+			if err := t.streamer.Send(&protos.ConversationInitialization{AssistantConversationId: id}); err != nil {
+				return err
+			}
+		case *protos.ConversationUserMessage:
+			t.talkers.mu.Lock()
+			current.messages = append(current.messages, in.GetText())
+			t.talkers.mu.Unlock()
+			if in.GetText() == "bye" {
+				if err := t.streamer.Send(&protos.ConversationDirective{Type: protos.ConversationDirective_END_CONVERSATION}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func newTestDispatcher(store SessionStore, ts *talkers, conversationIDs ...uint64) *Dispatcher {
+	logger, _ := commons.NewApplicationLogger()
+	ids := make(map[uint64]bool)
+	for _, id := range conversationIDs {
+		ids[id] = true
+	}
+	return NewDispatcher(DispatcherDeps{
+		Logger:              logger,
+		Store:               store,
+		ConversationService: &conversations{ids: ids},
+		NewTalker:           ts.new,
+	})
+}
+
+func testAssistant(window string) *internal_assistant_entity.Assistant {
+	assistant := &internal_assistant_entity.Assistant{
+		AssistantWhatsappDeployment: &internal_assistant_entity.AssistantWhatsappDeployment{
+			AssistantDeploymentWhatsapp: internal_assistant_entity.AssistantDeploymentWhatsapp{
+				WhatsappProvider: metaProvider,
+				WhatsappOptions: []*internal_assistant_entity.AssistantDeploymentWhatsappOption{
+					{Metadata: gorm_model.Metadata{Key: "rapida.credential_id", Value: "9"}},
+				},
+			},
+		},
+	}
+	assistant.Id = 42
+	assistant.ProjectId = 3
+	assistant.OrganizationId = 2
+	if window != "" {
+		deployment := &assistant.AssistantWhatsappDeployment.AssistantDeploymentWhatsapp
+		deployment.WhatsappOptions = append(deployment.WhatsappOptions, &internal_assistant_entity.AssistantDeploymentWhatsappOption{
+			Metadata: gorm_model.Metadata{Key: "session.window", Value: window},
+		})
+	}
+	return assistant
+}
+
+func (d *Dispatcher) live(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, ok := d.sessions[key]
+	return ok
+}
+
+func TestDispatcher_OrdersMessagesPerSender(t *testing.T) {
+	ts := &talkers{}
+	d := newTestDispatcher(newMemorySessionStore(), ts)
+	assistant := testAssistant("")
+	provider := &recordingProvider{}
+
+	for i := 0; i < 10; i++ {
+		for _, sender := range []string{"+1", "+2"} {
+			d.dispatch(nil, assistant, provider, nil, &Message{ID: fmt.Sprintf("%s-%d", sender, i), From: sender, Text: fmt.Sprintf("%s %d", sender, i)})
+		}
+	}
+
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 2 && len(talks[0].messages) == 10 && len(talks[1].messages) == 10
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// each sender has one session, receiving its messages in order
+	for _, talk := range ts.snapshot() {
+		sender := talk.messages[0][:2]
+		for i, text := range talk.messages {
+			assert.Equal(t, fmt.Sprintf("%s %d", sender, i), text)
+		}
+	}
+}
+
+func TestDispatcher_EndsIdleSession(t *testing.T) {
+	store := newMemorySessionStore()
+	ts := &talkers{}
+	d := newTestDispatcher(store, ts)
+	assistant := testAssistant("1")
+
+	d.dispatch(nil, assistant, &recordingProvider{}, nil, &Message{ID: "m1", From: "+1", Text: "hello"})
+	require.Eventually(t, func() bool {
+		id, ok := store.get(42, "+1")
+		return ok && id == 101
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, d.live("42:+1"))
+
+	// the session ends once its window elapses without a message
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 1 && talks[0].ended && !d.live("42:+1")
+	}, 5*time.Second, 10*time.Millisecond)
+	_, ok := store.get(42, "+1")
+	assert.False(t, ok)
+
+	// the next message begins a new conversation
+	d.dispatch(nil, assistant, &recordingProvider{}, nil, &Message{ID: "m2", From: "+1", Text: "again"})
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 2 && len(talks[1].messages) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	talks := ts.snapshot()
+	assert.Equal(t, uint64(0), talks[1].resumed)
+	assert.Equal(t, uint64(102), talks[1].id)
+}
+
+func TestDispatcher_ResumesStoredConversation(t *testing.T) {
+	store := newMemorySessionStore()
+	require.NoError(t, store.Set(context.Background(), 42, "+1", 77, time.Minute))
+	require.NoError(t, store.Set(context.Background(), 42, "+2", 78, time.Minute))
+	ts := &talkers{}
+	d := newTestDispatcher(store, ts, 77)
+	assistant := testAssistant("")
+
+	d.dispatch(nil, assistant, &recordingProvider{}, nil, &Message{ID: "m1", From: "+1", Text: "hello"})
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 1 && len(talks[0].messages) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, uint64(77), ts.snapshot()[0].resumed)
+
+	// a stored conversation that no longer exists is not resumed
+	d.dispatch(nil, assistant, &recordingProvider{}, nil, &Message{ID: "m2", From: "+2", Text: "hello"})
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 2 && len(talks[1].messages) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	talks := ts.snapshot()
+	assert.Equal(t, uint64(0), talks[1].resumed)
+	id, _ := store.get(42, "+2")
+	assert.Equal(t, talks[1].id, id)
+}
+
+func TestDispatcher_EndedConversationDeletesSession(t *testing.T) {
+	store := newMemorySessionStore()
+	ts := &talkers{}
+	d := newTestDispatcher(store, ts)
+	assistant := testAssistant("")
+
+	d.dispatch(nil, assistant, &recordingProvider{}, nil, &Message{ID: "m1", From: "+1", Text: "bye"})
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 1 && talks[0].ended && !d.live("42:+1")
+	}, 5*time.Second, 10*time.Millisecond)
+	_, ok := store.get(42, "+1")
+	assert.False(t, ok)
+}
+
+func TestDispatcher_ReleaseRedispatchesPendingMessages(t *testing.T) {
+	ts := &talkers{}
+	d := newTestDispatcher(newMemorySessionStore(), ts)
+	assistant := testAssistant("")
+
+	ending := &session{
+		assistant: assistant,
+		sender:    "+1",
+		window:    time.Minute,
+		provider:  &recordingProvider{},
+		inbox:     make(chan *Message, sessionInboxSize),
+	}
+	d.sessions["42:+1"] = ending
+	ending.inbox <- &Message{ID: "m1", From: "+1", Text: "first"}
+	ending.inbox <- &Message{ID: "m2", From: "+1", Text: "second"}
+
+	// messages received while a session ends go to a new session in order
+	d.release("42:+1", ending, true)
+	require.Eventually(t, func() bool {
+		talks := ts.snapshot()
+		return len(talks) == 1 && len(talks[0].messages) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"first", "second"}, ts.snapshot()[0].messages)
+
+	// without redispatch they are dropped
+	dropped := &session{assistant: assistant, sender: "+2", inbox: make(chan *Message, sessionInboxSize)}
+	d.sessions["42:+2"] = dropped
+	dropped.inbox <- &Message{ID: "m3", From: "+2", Text: "lost"}
+	d.release("42:+2", dropped, false)
+	assert.False(t, d.live("42:+2"))
+	assert.Len(t, ts.snapshot(), 1)
+}
+
+// deployments returns the assistant it holds.
+type deployments struct {
+	internal_services.AssistantDeploymentService
+	assistant *internal_assistant_entity.Assistant
+}
+
+func (d *deployments) GetAssistantWithWhatsappDeployment(ctx context.Context, assistantId uint64) (*internal_assistant_entity.Assistant, error) {
+	if d.assistant == nil || d.assistant.Id != assistantId {
+		return nil, errors.New("record not found")
+	}
+	return d.assistant, nil
+}
+
+// vault returns a credential and records the principal it was asked with.
+type vault struct {
+	web_client.VaultClient
+	auth types.SimplePrinciple
+}
+
+func (v *vault) GetCredential(ctx context.Context, auth types.SimplePrinciple, vaultId uint64) (*protos.VaultCredential, error) {
+	v.auth = auth
+	if vaultId != 9 {
+		return nil, errors.New("record not found")
+	}
+	value, err := structpb.NewStruct(map[string]interface{}{"verify_token": "token"})
+	if err != nil {
+		return nil, err
+	}
+	return &protos.VaultCredential{Value: value}, nil
+}
+
+func TestDispatcher_AuthenticatesWebhookWithoutApiKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger, _ := commons.NewApplicationLogger()
+	vaultClient := &vault{}
+	d := NewDispatcher(DispatcherDeps{
+		Logger:            logger,
+		VaultClient:       vaultClient,
+		DeploymentService: &deployments{assistant: testAssistant("")},
+	})
+
+	challenge := func(assistantID uint64, query string) (string, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/whatsapp/42?"+query, nil)
+		return d.HandleChallenge(c, assistantID)
+	}
+
+	got, err := challenge(42, "hub.mode=subscribe&hub.verify_token=token&hub.challenge=abc")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", got)
+
+	// the credential is read in the project of the assistant
+	require.NotNil(t, vaultClient.auth)
+	assert.Equal(t, uint64(3), *vaultClient.auth.GetCurrentProjectId())
+	assert.Equal(t, uint64(2), *vaultClient.auth.GetCurrentOrganizationId())
+
+	_, err = challenge(42, "hub.mode=subscribe&hub.verify_token=other&hub.challenge=abc")
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	_, err = challenge(43, "hub.mode=subscribe&hub.verify_token=token&hub.challenge=abc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	metaProvider = "meta"
+	metaGraphURL = "https://graph.facebook.com/v20.0"
+
+	metaSignature       = "X-Hub-Signature-256"
+	metaSignaturePrefix = "sha256="
+)
+
+// metaWhatsapp receives messages from the WhatsApp Cloud API as JSON webhooks
+// signed with the app secret, credentials hold access_token, app_secret and
+// the verify_token of the webhook subscription.
+type metaWhatsapp struct {
+	client  *http.Client
+	baseURL string
+}
+
+type metaWebhook struct {
+	Entry []struct {
+		Changes []struct {
+			Value struct {
+				Metadata struct {
+					PhoneNumberID string `json:"phone_number_id"`
+				} `json:"metadata"`
+				Messages []struct {
+					ID   string `json:"id"`
+					From string `json:"from"`
+					Type string `json:"type"`
+					Text struct {
+						Body string `json:"body"`
+					} `json:"text"`
+					Audio struct {
+						ID       string `json:"id"`
+						MimeType string `json:"mime_type"`
+					} `json:"audio"`
+				} `json:"messages"`
+			} `json:"value"`
+		} `json:"changes"`
+	} `json:"entry"`
+}
+
+func (m *metaWhatsapp) Name() string {
+	return metaProvider
+}
+
+func (m *metaWhatsapp) Verify(r *http.Request, publicURL string, body []byte, credential *protos.VaultCredential) error {
+	appSecret, err := credentialValue(credential, "app_secret")
+	if err != nil {
+		return err
+	}
+	signature, ok := strings.CutPrefix(r.Header.Get(metaSignature), metaSignaturePrefix)
+	if !ok {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(metaSign(appSecret, body)), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// metaSign computes the signature of a webhook, the hex HMAC-SHA256 of its body.
+func metaSign(appSecret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Challenge echoes hub.challenge when the subscription presents the verify
+// token of the credential.
+func (m *metaWhatsapp) Challenge(query url.Values, credential *protos.VaultCredential) (string, error) {
+	verifyToken, err := credentialValue(credential, "verify_token")
+	if err != nil {
+		return "", err
+	}
+	if query.Get("hub.mode") != "subscribe" ||
+		!hmac.Equal([]byte(query.Get("hub.verify_token")), []byte(verifyToken)) {
+		return "", ErrInvalidSignature
+	}
+	return query.Get("hub.challenge"), nil
+}
+
+// Parse returns the text and audio messages of a webhook, status updates and
+// other message types are ignored.
+func (m *metaWhatsapp) Parse(body []byte) ([]*Message, error) {
+	var webhook metaWebhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook body: %w", err)
+	}
+	var messages []*Message
+	for _, entry := range webhook.Entry {
+		for _, change := range entry.Changes {
+			for _, in := range change.Value.Messages {
+				msg := &Message{
+					ID:   in.ID,
+					From: in.From,
+					To:   change.Value.Metadata.PhoneNumberID,
+				}
+				switch in.Type {
+				case "text":
+					msg.Text = in.Text.Body
+				case "audio":
+					msg.Media = in.Audio.ID
+					msg.MediaType = in.Audio.MimeType
+				default:
+					continue
+				}
+				messages = append(messages, msg)
+			}
+		}
+	}
+	return messages, nil
+}
+
+func (m *metaWhatsapp) Acknowledge(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+func (m *metaWhatsapp) Send(ctx context.Context, credential *protos.VaultCredential, to *Message, text string) error {
+	accessToken, err := credentialValue(credential, "access_token")
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"messaging_product": "whatsapp",
+		"recipient_type":    "individual",
+		"to":                to.From,
+		"type":              "text",
+		"text":              map[string]string{"body": text},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("%s/%s/messages", m.baseURL, url.PathEscape(to.To)), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send meta whatsapp message: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to send meta whatsapp message, status code: %d, %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// Media resolves the url of a media id and downloads it, both with the
+// access token.
+func (m *metaWhatsapp) Media(ctx context.Context, credential *protos.VaultCredential, msg *Message) ([]byte, error) {
+	accessToken, err := credentialValue(credential, "access_token")
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/%s", m.baseURL, url.PathEscape(msg.Media)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	data, err := download(m.client, req)
+	if err != nil {
+		return nil, err
+	}
+	var media struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &media); err != nil || media.URL == "" {
+		return nil, fmt.Errorf("unable to resolve url of media %s", msg.Media)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, media.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid meta media url: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	return download(m.client, req)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/protos"
+)
+
+var (
+	// ErrInvalidSignature is returned when a webhook is not signed by the provider.
+	ErrInvalidSignature = errors.New("invalid whatsapp webhook signature")
+
+	// ErrUnsupportedProvider is returned for a provider that is not implemented.
+	ErrUnsupportedProvider = errors.New("unsupported whatsapp provider")
+)
+
+const providerTimeout = 15 * time.Second
+
+// Message is an inbound WhatsApp message.
+type Message struct {
+	ID string
+
+	// From is the number of the sender, To identifies the business number the
+	// message was sent to, the number itself for Twilio and the phone number
+	// id for Meta. Replies are sent from To.
+	From string
+	To   string
+
+	Text string
+
+	// Media locates the attachment of the message, its url for Twilio and its
+	// media id for Meta.
+	Media     string
+	MediaType string
+}
+
+// IsVoice reports whether the message carries an audio attachment.
+func (m *Message) IsVoice() bool {
+	return m.Media != "" && strings.HasPrefix(m.MediaType, "audio/")
+}
+
+// Provider is a WhatsApp business messaging provider.
+type Provider interface {
+	Name() string
+
+	// Verify checks the signature of a webhook request received at publicURL,
+	// the public url the provider called including its query.
+	Verify(r *http.Request, publicURL string, body []byte, credential *protos.VaultCredential) error
+
+	// Challenge answers the subscription challenge of the provider.
+	Challenge(query url.Values, credential *protos.VaultCredential) (string, error)
+
+	// Parse returns the messages of a webhook request.
+	Parse(body []byte) ([]*Message, error)
+
+	// Acknowledge responds to a webhook request once it is accepted.
+	Acknowledge(c *gin.Context)
+
+	// Send replies with text to the sender of an inbound message.
+	Send(ctx context.Context, credential *protos.VaultCredential, to *Message, text string) error
+
+	// Media downloads the attachment of an inbound message.
+	Media(ctx context.Context, credential *protos.VaultCredential, msg *Message) ([]byte, error)
+}
+
+// GetProvider returns the provider of a WhatsApp deployment.
+func GetProvider(name string) (Provider, error) {
+	client := &http.Client{Timeout: providerTimeout}
+	switch name {
+	case twilioProvider:
+		return &twilioWhatsapp{client: client}, nil
+	case metaProvider:
+		return &metaWhatsapp{client: client, baseURL: metaGraphURL}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedProvider, name)
+	}
+}
+
+// credentialValue returns a string value of a vault credential.
+func credentialValue(credential *protos.VaultCredential, key string) (string, error) {
+	v, ok := credential.GetValue().AsMap()[key]
+	if !ok {
+		return "", fmt.Errorf("illegal vault config %s is not found", key)
+	}
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("illegal vault config %s is not a string", key)
+	}
+	return s, nil
+}
+
+// maxMediaSize bounds the size of downloaded attachments, voice notes are
+// limited to 16MB by WhatsApp.
+const maxMediaSize = 16 << 20
+
+func download(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download media: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download media, status code: %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMediaSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media: %w", err)
+	}
+	if len(data) > maxMediaSize {
+		return nil, fmt.Errorf("media is larger than %d bytes", maxMediaSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func credential(t *testing.T, values map[string]interface{}) *protos.VaultCredential {
+	value, err := structpb.NewStruct(values)
+	require.NoError(t, err)
+	return &protos.VaultCredential{Value: value}
+}
+
+func TestGetProvider(t *testing.T) {
+	for _, name := range []string{"twilio", "meta"} {
+		provider, err := GetProvider(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, provider.Name())
+	}
+	_, err := GetProvider("telegram")
+	assert.ErrorIs(t, err, ErrUnsupportedProvider)
+}
+
+func TestTwilio_VerifySignature(t *testing.T) {
+	provider, _ := GetProvider("twilio")
+	cred := credential(t, map[string]interface{}{"account_sid": "AC1", "account_token": "secret"})
+	publicURL := "https://assistant.example.com/v1/whatsapp/42"
+	form := url.Values{
+		"From": {"whatsapp:+14155550100"},
+		"To":   {"whatsapp:+14155550199"},
+		"Body": {"hello"},
+	}
+	body := []byte(form.Encode())
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/whatsapp/42", nil)
+	r.Header.Set(twilioSignature, twilioSign("secret", publicURL, form))
+	assert.NoError(t, provider.Verify(r, publicURL, body, cred))
+
+	// a signature of another url or token is rejected
+	r.Header.Set(twilioSignature, twilioSign("secret", "https://other.example.com/", form))
+	assert.ErrorIs(t, provider.Verify(r, publicURL, body, cred), ErrInvalidSignature)
+	r.Header.Set(twilioSignature, twilioSign("other", publicURL, form))
+	assert.ErrorIs(t, provider.Verify(r, publicURL, body, cred), ErrInvalidSignature)
+}
+
+func TestTwilio_Parse(t *testing.T) {
+	provider, _ := GetProvider("twilio")
+	form := url.Values{
+		"MessageSid":        {"SM1"},
+		"From":              {"whatsapp:+14155550100"},
+		"To":                {"whatsapp:+14155550199"},
+		"NumMedia":          {"1"},
+		"MediaUrl0":         {"https://api.twilio.com/media/ME1"},
+		"MediaContentType0": {"audio/ogg"},
+	}
+	messages, err := provider.Parse([]byte(form.Encode()))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, &Message{
+		ID:        "SM1",
+		From:      "+14155550100",
+		To:        "+14155550199",
+		Media:     "https://api.twilio.com/media/ME1",
+		MediaType: "audio/ogg",
+	}, messages[0])
+	assert.True(t, messages[0].IsVoice())
+
+	_, err = provider.Parse([]byte("Body=hello"))
+	assert.Error(t, err)
+}
+
+const metaPayload = `{"object":"whatsapp_business_account","entry":[{"changes":[{"value":{
+	"metadata":{"phone_number_id":"1001"},
+	"messages":[
+		{"id":"wamid.1","from":"14155550100","type":"text","text":{"body":"hi"}},
+		{"id":"wamid.2","from":"14155550100","type":"audio","audio":{"id":"m1","mime_type":"audio/ogg; codecs=opus"}},
+		{"id":"wamid.3","from":"14155550100","type":"sticker"}
+	]}}]}]}`
+
+func TestMeta_VerifySignature(t *testing.T) {
+	provider, _ := GetProvider("meta")
+	cred := credential(t, map[string]interface{}{"app_secret": "secret"})
+	body := []byte(metaPayload)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/whatsapp/42", nil)
+	r.Header.Set(metaSignature, metaSignaturePrefix+metaSign("secret", body))
+	assert.NoError(t, provider.Verify(r, "", body, cred))
+
+	r.Header.Set(metaSignature, metaSignaturePrefix+metaSign("secret", []byte("{}")))
+	assert.ErrorIs(t, provider.Verify(r, "", body, cred), ErrInvalidSignature)
+	r.Header.Del(metaSignature)
+	assert.ErrorIs(t, provider.Verify(r, "", body, cred), ErrInvalidSignature)
+}
+
+func TestMeta_Parse(t *testing.T) {
+	provider, _ := GetProvider("meta")
+	messages, err := provider.Parse([]byte(metaPayload))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, &Message{ID: "wamid.1", From: "14155550100", To: "1001", Text: "hi"}, messages[0])
+	assert.Equal(t, "m1", messages[1].Media)
+	assert.True(t, messages[1].IsVoice())
+}
+
+func TestMeta_Challenge(t *testing.T) {
+	provider, _ := GetProvider("meta")
+	cred := credential(t, map[string]interface{}{"verify_token": "token"})
+
+	challenge, err := provider.Challenge(url.Values{
+		"hub.mode":         {"subscribe"},
+		"hub.verify_token": {"token"},
+		"hub.challenge":    {"1158201444"},
+	}, cred)
+	require.NoError(t, err)
+	assert.Equal(t, "1158201444", challenge)
+
+	_, err = provider.Challenge(url.Values{
+		"hub.mode":         {"subscribe"},
+		"hub.verify_token": {"other"},
+		"hub.challenge":    {"1158201444"},
+	}, cred)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestMeta_Media(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/m1":
+			w.Write([]byte(`{"url":"` + server.URL + `/download/m1"}`))
+		case "/download/m1":
+			w.Write([]byte("voice"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := &metaWhatsapp{client: server.Client(), baseURL: server.URL}
+	cred := credential(t, map[string]interface{}{"access_token": "access"})
+	data, err := provider.Media(context.Background(), cred, &Message{Media: "m1"})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("voice"), data)
+
+	_, err = provider.Media(context.Background(), cred, &Message{Media: "m2"})
+	assert.Error(t, err)
+}
+
+// recordingProvider records the replies sent through it.
+type recordingProvider struct {
+	Provider
+	mu      sync.Mutex
+	replies []string
+}
+
+func (p *recordingProvider) Send(ctx context.Context, credential *protos.VaultCredential, to *Message, text string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.replies = append(p.replies, to.From+":"+text)
+	return nil
+}
+
+func TestStreamer_SendsCompletedReplies(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	provider := &recordingProvider{}
+	var conversationID uint64
+	s := newStreamer(logger, provider, nil, &Message{From: "+1"}, func(id uint64) { conversationID = id })
+
+	require.NoError(t, s.Send(&protos.ConversationInitialization{AssistantConversationId: 7}))
+	assert.Equal(t, uint64(7), conversationID)
+
+	// a greeting completes its deltas with an empty message
+	for _, delta := range []string{"Hello", " there"} {
+		require.NoError(t, s.Send(&protos.ConversationAssistantMessage{Id: "g", Message: &protos.ConversationAssistantMessage_Text{Text: delta}}))
+	}
+	require.NoError(t, s.Send(&protos.ConversationAssistantMessage{Id: "g", Completed: true}))
+
+	// an llm response completes with its full text
+	s.push(&Message{From: "+2"}, "question")
+	require.NoError(t, s.Send(&protos.ConversationAssistantMessage{Id: "r", Message: &protos.ConversationAssistantMessage_Text{Text: "Ans"}}))
+	require.NoError(t, s.Send(&protos.ConversationAssistantMessage{Id: "r", Completed: true, Message: &protos.ConversationAssistantMessage_Text{Text: "Answer"}}))
+
+	assert.Equal(t, []string{"+1:Hello there", "+2:Answer"}, provider.replies)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/redis/go-redis/v9"
+)
+
+// SessionStore maps the senders of an assistant to the conversation of their
+// session, so a sender writing again within the session window resumes it on
+// any replica.
+type SessionStore interface {
+	// Get returns the conversation of the session of a sender, 0 when the
+	// sender has no session.
+	Get(ctx context.Context, assistantID uint64, sender string) (uint64, error)
+
+	// Set stores the conversation of the session of a sender until window
+	// elapses.
+	Set(ctx context.Context, assistantID uint64, sender string, conversationID uint64, window time.Duration) error
+
+	// Delete ends the session of a sender.
+	Delete(ctx context.Context, assistantID uint64, sender string) error
+}
+
+type redisSessionStore struct {
+	redis connectors.RedisConnector
+}
+
+// NewSessionStore creates a SessionStore keeping sessions in redis.
+func NewSessionStore(redis connectors.RedisConnector) SessionStore {
+	return &redisSessionStore{redis: redis}
+}
+
+func sessionKey(assistantID uint64, sender string) string {
+	return fmt.Sprintf("whatsapp:session:%d:%s", assistantID, sender)
+}
+
+func (s *redisSessionStore) Get(ctx context.Context, assistantID uint64, sender string) (uint64, error) {
+	raw, err := s.redis.GetConnection().Get(ctx, sessionKey(assistantID, sender)).Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(raw, 10, 64)
+}
+
+func (s *redisSessionStore) Set(ctx context.Context, assistantID uint64, sender string, conversationID uint64, window time.Duration) error {
+	return s.redis.GetConnection().Set(ctx, sessionKey(assistantID, sender), strconv.FormatUint(conversationID, 10), window).Err()
+}
+
+func (s *redisSessionStore) Delete(ctx context.Context, assistantID uint64, sender string) error {
+	return s.redis.GetConnection().Del(ctx, sessionKey(assistantID, sender)).Err()
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	channel_base "github.com/rapidaai/api/assistant-api/internal/channel/base"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+)
+
+// streamer carries a text conversation with one sender. Inbound messages are
+// pushed as user messages, completed assistant messages are sent back to the
+// sender through the provider.
+type streamer struct {
+	channel_base.BaseStreamer
+
+	provider   Provider
+	credential *protos.VaultCredential
+
+	// onConversation is called with the id of the conversation once the
+	// session is connected.
+	onConversation func(conversationID uint64)
+
+	// replyTo is the latest inbound message, replies go to its sender from
+	// the number it was sent to.
+	mu      sync.Mutex
+	replyTo *Message
+
+	// deltas buffers the streamed text of assistant messages by id.
+	deltas map[string]*strings.Builder
+
+	// disconnected is only read and written by Recv.
+	disconnected bool
+}
+
+func newStreamer(logger commons.Logger, provider Provider, credential *protos.VaultCredential, replyTo *Message, onConversation func(uint64)) *streamer {
+	return &streamer{
+		BaseStreamer:   channel_base.NewBaseStreamer(logger),
+		provider:       provider,
+		credential:     credential,
+		replyTo:        replyTo,
+		onConversation: onConversation,
+		deltas:         make(map[string]*strings.Builder),
+	}
+}
+
+// push delivers the text of an inbound message to the conversation.
+func (s *streamer) push(msg *Message, text string) {
+	s.mu.Lock()
+	s.replyTo = msg
+	s.mu.Unlock()
+	s.PushInput(&protos.ConversationUserMessage{
+		Message: &protos.ConversationUserMessage_Text{Text: text},
+	})
+}
+
+// Recv ends the stream after a disconnection, the conversation is
+// disconnected once the Talk loop reads io.EOF.
+func (s *streamer) Recv() (internal_type.Stream, error) {
+	if s.disconnected {
+		return nil, io.EOF
+	}
+	msg, err := s.BaseStreamer.Recv()
+	if _, ok := msg.(*protos.ConversationDisconnection); ok {
+		s.disconnected = true
+	}
+	return msg, err
+}
+
+func (s *streamer) Send(out internal_type.Stream) error {
+	switch out := out.(type) {
+	case *protos.ConversationInitialization:
+		if s.onConversation != nil {
+			s.onConversation(out.GetAssistantConversationId())
+		}
+	case *protos.ConversationAssistantMessage:
+		text, ok := s.reply(out)
+		if !ok {
+			return nil
+		}
+		s.mu.Lock()
+		to := s.replyTo
+		s.mu.Unlock()
+		ctx, cancel := context.WithTimeout(s.Context(), providerTimeout)
+		defer cancel()
+		if err := s.provider.Send(ctx, s.credential, to, text); err != nil {
+			s.Logger.Errorf("unable to send whatsapp reply to %s: %v", to.From, err)
+			return err
+		}
+	case *protos.ConversationDirective:
+		if out.GetType() == protos.ConversationDirective_END_CONVERSATION {
+			s.PushDisconnection(protos.ConversationDisconnection_DISCONNECTION_TYPE_TOOL)
+		}
+	}
+	return nil
+}
+
+// reply buffers the deltas of an assistant message and returns its text
+// once completed. The completed message of an LLM response holds all of its
+// text, static messages such as the greeting only complete their deltas.
+func (s *streamer) reply(msg *protos.ConversationAssistantMessage) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	buffered, ok := s.deltas[msg.GetId()]
+	if !msg.GetCompleted() {
+		if !ok {
+			buffered = &strings.Builder{}
+			s.deltas[msg.GetId()] = buffered
+		}
+		buffered.WriteString(msg.GetText())
+		return "", false
+	}
+	delete(s.deltas, msg.GetId())
+	text := msg.GetText()
+	if text == "" && ok {
+		text = buffered.String()
+	}
+	return text, strings.TrimSpace(text) != ""
+}
+
+// Close ends the conversation.
+func (s *streamer) Close() {
+	s.PushDisconnection(protos.ConversationDisconnection_DISCONNECTION_TYPE_USER)
+}
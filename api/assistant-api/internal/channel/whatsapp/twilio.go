@@ -0,0 +1,165 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package channel_whatsapp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rapidaai/protos"
+	"github.com/twilio/twilio-go"
+	openapi "github.com/twilio/twilio-go/rest/api/v2010"
+)
+
+const (
+	twilioProvider = "twilio"
+
+	twilioAddressPrefix = "whatsapp:"
+	twilioSignature     = "X-Twilio-Signature"
+)
+
+// twilioWhatsapp receives messages as form encoded webhooks signed with the
+// auth token of the account, credentials hold account_sid and account_token.
+type twilioWhatsapp struct {
+	client *http.Client
+}
+
+func (t *twilioWhatsapp) Name() string {
+	return twilioProvider
+}
+
+func (t *twilioWhatsapp) Verify(r *http.Request, publicURL string, body []byte, credential *protos.VaultCredential) error {
+	authToken, err := credentialValue(credential, "account_token")
+	if err != nil {
+		return err
+	}
+	params, err := parseForm(body)
+	if err != nil {
+		return err
+	}
+	expected := twilioSign(authToken, publicURL, params)
+	if !hmac.Equal([]byte(expected), []byte(r.Header.Get(twilioSignature))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// twilioSign computes the signature of a webhook, the base64 HMAC-SHA1 of
+// its url followed by every form parameter and value sorted by name.
+func twilioSign(authToken, publicURL string, params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(publicURL)
+	for _, k := range keys {
+		for _, v := range params[k] {
+			b.WriteString(k)
+			b.WriteString(v)
+		}
+	}
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (t *twilioWhatsapp) Challenge(query url.Values, credential *protos.VaultCredential) (string, error) {
+	return "", fmt.Errorf("%s webhooks have no subscription challenge", twilioProvider)
+}
+
+func (t *twilioWhatsapp) Parse(body []byte) ([]*Message, error) {
+	params, err := parseForm(body)
+	if err != nil {
+		return nil, err
+	}
+	from := params.Get("From")
+	if from == "" {
+		return nil, fmt.Errorf("twilio webhook has no sender")
+	}
+	msg := &Message{
+		ID:   params.Get("MessageSid"),
+		From: strings.TrimPrefix(from, twilioAddressPrefix),
+		To:   strings.TrimPrefix(params.Get("To"), twilioAddressPrefix),
+		Text: params.Get("Body"),
+	}
+	// a voice note is the only media of its message
+	if params.Get("NumMedia") != "" && params.Get("NumMedia") != "0" {
+		msg.Media = params.Get("MediaUrl0")
+		msg.MediaType = params.Get("MediaContentType0")
+	}
+	return []*Message{msg}, nil
+}
+
+// Acknowledge responds with empty TwiML, replies are sent with the REST api.
+func (t *twilioWhatsapp) Acknowledge(c *gin.Context) {
+	c.Data(http.StatusOK, "text/xml", []byte("<Response></Response>"))
+}
+
+func (t *twilioWhatsapp) Send(ctx context.Context, credential *protos.VaultCredential, to *Message, text string) error {
+	accountSid, authToken, err := t.account(credential)
+	if err != nil {
+		return err
+	}
+	client := twilio.NewRestClientWithParams(twilio.ClientParams{
+		Username: accountSid,
+		Password: authToken,
+	})
+	params := &openapi.CreateMessageParams{}
+	params.SetTo(twilioAddressPrefix + to.From)
+	params.SetFrom(twilioAddressPrefix + to.To)
+	params.SetBody(text)
+	if _, err := client.Api.CreateMessage(params); err != nil {
+		return fmt.Errorf("failed to send twilio whatsapp message: %w", err)
+	}
+	return nil
+}
+
+// Media downloads the attachment with the account credentials, Twilio
+// redirects the request to the media storage.
+func (t *twilioWhatsapp) Media(ctx context.Context, credential *protos.VaultCredential, msg *Message) ([]byte, error) {
+	accountSid, authToken, err := t.account(credential)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, msg.Media, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid twilio media url: %w", err)
+	}
+	req.SetBasicAuth(accountSid, authToken)
+	return download(t.client, req)
+}
+
+func (t *twilioWhatsapp) account(credential *protos.VaultCredential) (string, string, error) {
+	accountSid, err := credentialValue(credential, "account_sid")
+	if err != nil {
+		return "", "", err
+	}
+	authToken, err := credentialValue(credential, "account_token")
+	if err != nil {
+		return "", "", err
+	}
+	return accountSid, authToken, nil
+}
+
+func parseForm(body []byte) (url.Values, error) {
+	params, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook body: %w", err)
+	}
+	return params, nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+// Package whatsapp_voice transcribes WhatsApp voice notes, Ogg Opus audio,
+// with the speech to text provider of a deployment.
+package whatsapp_voice
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_audio_ogg "github.com/rapidaai/api/assistant-api/internal/audio/ogg"
+	internal_audio_resampler "github.com/rapidaai/api/assistant-api/internal/audio/resampler"
+	channel_whatsapp "github.com/rapidaai/api/assistant-api/internal/channel/whatsapp"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_transformer "github.com/rapidaai/api/assistant-api/internal/transformer"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"gopkg.in/hraban/opus.v2"
+)
+
+const (
+	opusSampleRate      = 48000
+	opusMaxFrameSamples = 5760 // 120ms at 48kHz
+
+	// audio is streamed in 100ms chunks, followed by silence so the provider
+	// endpoints the last utterance
+	chunkDuration   = 100 * time.Millisecond
+	trailingSilence = time.Second
+
+	// firstResultTimeout is how long to wait for the first transcript once
+	// the audio is sent, settleTimeout how long to wait for another one.
+	firstResultTimeout = 10 * time.Second
+	settleTimeout      = 1500 * time.Millisecond
+)
+
+type transcriber struct {
+	logger      commons.Logger
+	vaultClient web_client.VaultClient
+}
+
+// NewTranscriber creates a transcriber resolving provider credentials from
+// the vault.
+func NewTranscriber(logger commons.Logger, vaultClient web_client.VaultClient) channel_whatsapp.Transcriber {
+	return &transcriber{logger: logger, vaultClient: vaultClient}
+}
+
+// Transcribe decodes a voice note to linear16 16kHz mono, streams it to the
+// speech to text provider of audio and returns its final transcripts.
+func (t *transcriber) Transcribe(ctx context.Context, auth types.SimplePrinciple, audio *internal_assistant_entity.AssistantDeploymentAudio, media []byte, mimeType string) (string, error) {
+	if !strings.HasPrefix(mimeType, "audio/ogg") {
+		return "", fmt.Errorf("unsupported voice note format %s", mimeType)
+	}
+	pcm, err := decode(media)
+	if err != nil {
+		return "", err
+	}
+	resampler, err := internal_audio_resampler.GetResampler(t.logger)
+	if err != nil {
+		return "", err
+	}
+	target := internal_audio.NewLinear16khzMonoAudioConfig()
+	pcm, err = resampler.Resample(pcm, internal_audio.NewLinear48khzMonoAudioConfig(), target)
+	if err != nil {
+		return "", fmt.Errorf("failed to resample voice note: %w", err)
+	}
+
+	options := utils.Option(utils.MergeMaps(utils.Option{"microphone.eos.timeout": 500}, audio.GetOptions()))
+	credentialID, err := options.GetUint64("rapida.credential_id")
+	if err != nil {
+		return "", fmt.Errorf("input audio has no credential: %w", err)
+	}
+	credential, err := t.vaultClient.GetCredential(ctx, auth, credentialID)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve vault credential: %w", err)
+	}
+
+	var (
+		mu      sync.Mutex
+		scripts []string
+		results = make(chan struct{}, 1)
+	)
+	stt, err := internal_transformer.GetSpeechToTextTransformer(ctx, t.logger, audio.AudioProvider, credential,
+		func(pkts ...internal_type.Packet) error {
+			for _, pkt := range pkts {
+				if p, ok := pkt.(internal_type.SpeechToTextPacket); ok && !p.Interim && strings.TrimSpace(p.Script) != "" {
+					mu.Lock()
+					scripts = append(scripts, strings.TrimSpace(p.Script))
+					mu.Unlock()
+					select {
+					case results <- struct{}{}:
+					default:
+					}
+				}
+			}
+			return nil
+		}, options)
+	if err != nil {
+		return "", err
+	}
+	if err := stt.Initialize(); err != nil {
+		return "", fmt.Errorf("failed to initialize speech to text: %w", err)
+	}
+
+	chunk := internal_audio.BytesPerMs(target) * int(chunkDuration/time.Millisecond)
+	pcm = append(pcm, make([]byte, internal_audio.BytesPerMs(target)*int(trailingSilence/time.Millisecond))...)
+	for start := 0; start < len(pcm); start += chunk {
+		end := min(start+chunk, len(pcm))
+		if err := stt.Transform(ctx, internal_type.UserAudioPacket{Audio: pcm[start:end]}); err != nil {
+			stt.Close(ctx)
+			return "", fmt.Errorf("failed to stream voice note: %w", err)
+		}
+	}
+
+	wait := time.NewTimer(firstResultTimeout)
+	defer wait.Stop()
+collect:
+	for {
+		select {
+		case <-results:
+			wait.Reset(settleTimeout)
+		case <-wait.C:
+			break collect
+		case <-ctx.Done():
+			break collect
+		}
+	}
+	// closing flushes the transcripts some providers hold back
+	if err := stt.Close(ctx); err != nil {
+		t.logger.Warnf("unable to close speech to text of voice note: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return strings.Join(scripts, " "), nil
+}
+
+// decode decodes Ogg Opus audio to linear16 48kHz mono.
+func decode(media []byte) ([]byte, error) {
+	header, packets, err := internal_audio_ogg.Opus(media)
+	if err != nil {
+		return nil, err
+	}
+	channels := max(header.Channels, 1)
+	decoder, err := opus.NewDecoder(opusSampleRate, channels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create opus decoder: %w", err)
+	}
+
+	samples := make([]int16, opusMaxFrameSamples*channels)
+	var mono []int16
+	for _, packet := range packets {
+		n, err := decoder.Decode(packet, samples)
+		if err != nil {
+			return nil, fmt.Errorf("opus decode failed (payload=%d bytes): %w", len(packet), err)
+		}
+		for i := 0; i < n; i++ {
+			sum := 0
+			for c := 0; c < channels; c++ {
+				sum += int(samples[i*channels+c])
+			}
+			mono = append(mono, int16(sum/channels))
+		}
+	}
+	// the first samples of the stream are encoder warm up
+	mono = mono[min(header.PreSkip, len(mono)):]
+
+	pcm := make([]byte, len(mono)*2)
+	for i, s := range mono {
+		binary.LittleEndian.PutUint16(pcm[i*2:], uint16(s))
+	}
+	return pcm, nil
+}
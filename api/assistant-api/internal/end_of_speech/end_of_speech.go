@@ -7,8 +7,8 @@ package internal_end_of_speech
 
 import (
 	"context"
-	"fmt"
 
+	internal_livekit "github.com/rapidaai/api/assistant-api/internal/end_of_speech/internal/livekit"
 	internal_silence_based "github.com/rapidaai/api/assistant-api/internal/end_of_speech/internal/silence_based"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
@@ -29,7 +29,7 @@ func GetEndOfSpeech(ctx context.Context, logger commons.Logger, onCallback func(
 	case SilenceBasedEndOfSpeech:
 		return internal_silence_based.NewSilenceBasedEndOfSpeech(logger, onCallback, opts)
 	case LiveKitEndOfSpeech:
-		return nil, fmt.Errorf("livekit end of speech is not implemented yet")
+		return internal_livekit.NewLivekitEndOfSpeech(logger, onCallback, opts)
 	default:
 		return internal_silence_based.NewSilenceBasedEndOfSpeech(logger, onCallback, opts)
 	}
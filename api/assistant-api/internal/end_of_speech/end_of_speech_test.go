@@ -34,11 +34,14 @@ func TestGetEndOfSpeech_SilenceBasedIdentifier(t *testing.T) {
 
 func TestGetEndOfSpeech_LiveKitIdentifier(t *testing.T) {
 	logger, _ := commons.NewApplicationLogger()
+	// no turn detector model in the directory, the provider must fail instead
+	// of silently falling back to silence based detection
+	t.Setenv("LIVEKIT_EOS_MODEL_PATH", t.TempDir())
 
 	endOfSpeech, err := GetEndOfSpeech(t.Context(), logger, mockCallback, utils.Option{EndOfSpeechOptionsKeyProvider: LiveKitEndOfSpeech})
 
-	// Currently not implemented, should fail
-	assert.Error(t, err)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "turn detector tokenizer")
 	assert.Nil(t, endOfSpeech)
 }
 
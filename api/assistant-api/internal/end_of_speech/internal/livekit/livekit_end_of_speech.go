@@ -7,14 +7,370 @@ package internal_livekit
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
 
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 )
 
+const (
+	defaultTimeout    = 1000 * time.Millisecond
+	defaultMinTimeout = 250 * time.Millisecond
+	defaultMaxTimeout = 3000 * time.Millisecond
+
+	// defaultThreshold is the end-of-turn probability from which the
+	// shortest timeout applies. The model is calibrated low: a finished
+	// sentence rarely scores above 0.5.
+	defaultThreshold = 0.15
+)
+
+// SpeechSegment represents accumulated speech with metadata
+type SpeechSegment struct {
+	ContextID string
+	Text      string
+	Timestamp time.Time
+}
+
+// command defines operations for the worker goroutine
+type command struct {
+	ctx     context.Context
+	timeout time.Duration
+	segment SpeechSegment
+	fireNow bool
+	reset   bool
+
+	// predicted carries the model's timeout for segment, it replaces the
+	// pending timer without counting as new activity.
+	predicted bool
+}
+
+// LivekitEOS detects end-of-speech like the silence-based detector, but
+// scales the silence timeout with the LiveKit turn detector's prediction
+// on the rolling transcript: a finished thought ends the turn after
+// minTimeout, a trailing "and, uh" waits up to maxTimeout.
+type LivekitEOS struct {
+	logger    commons.Logger
+	callback  func(context.Context, ...internal_type.Packet) error
+	predictor turnPredictor
+
+	// timeout applies until a prediction is available
+	timeout    time.Duration
+	minTimeout time.Duration
+	maxTimeout time.Duration
+	threshold  float64
+
+	// worker orchestration
+	cmdCh  chan command
+	stopCh chan struct{}
+
+	// state
+	mu    sync.RWMutex
+	state *eosState
+}
+
+// eosState holds protected state for end-of-speech detection
+type eosState struct {
+	segment       SpeechSegment
+	timeout       time.Duration
+	callbackFired bool
+	generation    uint64
+}
+
+// NewLivekitEndOfSpeech creates a turn detector backed by the local LiveKit
+// end-of-utterance model.
 func NewLivekitEndOfSpeech(
 	logger commons.Logger, onCallback func(context.Context, ...internal_type.Packet) error, opts utils.Option,
 ) (internal_type.EndOfSpeech, error) {
-	return nil, nil
+	detector, err := loadTurnDetector()
+	if err != nil {
+		return nil, err
+	}
+	return newLivekitEndOfSpeech(logger, onCallback, opts, detector), nil
+}
+
+func newLivekitEndOfSpeech(
+	logger commons.Logger, onCallback func(context.Context, ...internal_type.Packet) error, opts utils.Option, predictor turnPredictor,
+) *LivekitEOS {
+	eos := &LivekitEOS{
+		logger:     logger,
+		callback:   onCallback,
+		predictor:  predictor,
+		timeout:    optionDuration(opts, "microphone.eos.timeout", defaultTimeout),
+		minTimeout: optionDuration(opts, "microphone.eos.min_timeout", defaultMinTimeout),
+		maxTimeout: optionDuration(opts, "microphone.eos.max_timeout", defaultMaxTimeout),
+		threshold:  defaultThreshold,
+		cmdCh:      make(chan command, 32),
+		stopCh:     make(chan struct{}),
+	}
+	if v, err := opts.GetFloat64("microphone.eos.threshold"); err == nil && v > 0 {
+		eos.threshold = v
+	}
+	// keep minTimeout <= timeout <= maxTimeout
+	eos.minTimeout = min(eos.minTimeout, eos.timeout)
+	eos.maxTimeout = max(eos.maxTimeout, eos.timeout)
+	eos.state = &eosState{timeout: eos.timeout}
+
+	go eos.worker()
+	return eos
+}
+
+func optionDuration(opts utils.Option, key string, fallback time.Duration) time.Duration {
+	if v, err := opts.GetFloat64(key); err == nil && v > 0 {
+		return time.Duration(v) * time.Millisecond
+	}
+	return fallback
+}
+
+// Name returns the component name
+func (eos *LivekitEOS) Name() string {
+	return "livekitEndOfSpeech"
+}
+
+// Analyze processes incoming speech packets
+func (eos *LivekitEOS) Analyze(ctx context.Context, pkt internal_type.Packet) error {
+	switch p := pkt.(type) {
+	case internal_type.UserTextPacket:
+		if p.Text == "" {
+			return nil
+		}
+		eos.mu.Lock()
+		seg := SpeechSegment{ContextID: p.ContextId(), Text: p.Text, Timestamp: time.Now()}
+		eos.state.segment = seg
+		eos.mu.Unlock()
+		// let the client know about interim speech
+		eos.callback(ctx, internal_type.InterimEndOfSpeechPacket{
+			Speech:    seg.Text,
+			ContextID: seg.ContextID,
+		})
+		eos.send(command{
+			ctx:     ctx,
+			segment: seg,
+			fireNow: true,
+		})
+
+	case internal_type.InterruptionPacket:
+		eos.mu.RLock()
+		seg, timeout := eos.state.segment, eos.state.timeout
+		eos.mu.RUnlock()
+
+		if seg.Text == "" {
+			return nil
+		}
+		eos.send(command{
+			ctx:     ctx,
+			segment: seg,
+			timeout: timeout,
+		})
+
+	case internal_type.SpeechToTextPacket:
+		eos.mu.Lock()
+		if p.Interim {
+			seg, timeout := eos.state.segment, eos.state.timeout
+			eos.mu.Unlock()
+			// ignore interim with no text
+			if seg.Text == "" {
+				return nil
+			}
+			eos.send(command{
+				ctx:     ctx,
+				segment: seg,
+				timeout: timeout,
+			})
+			return nil
+		}
+
+		newSeg := SpeechSegment{
+			ContextID: p.ContextId(),
+			Timestamp: time.Now(),
+			Text:      eos.state.segment.Text,
+		}
+		if newSeg.Text != "" {
+			newSeg.Text = fmt.Sprintf("%s %s", eos.state.segment.Text, p.Script)
+		} else {
+			newSeg.Text = p.Script
+		}
+		eos.state.segment = newSeg
+		eos.state.timeout = eos.timeout
+		eos.mu.Unlock()
+
+		// let the client know about interim speech
+		eos.callback(ctx, internal_type.InterimEndOfSpeechPacket{
+			Speech:    newSeg.Text,
+			ContextID: newSeg.ContextID,
+		})
+
+		// start the timer with the default timeout, the prediction adjusts it
+		eos.send(command{
+			ctx:     ctx,
+			segment: newSeg,
+			timeout: eos.timeout,
+		})
+		go eos.predict(ctx, newSeg)
+	}
+
+	return nil
+}
+
+// predict runs the turn detector on seg off the packet path and hands the
+// resulting timeout to the worker.
+func (eos *LivekitEOS) predict(ctx context.Context, seg SpeechSegment) {
+	start := time.Now()
+	probability, err := eos.predictor.Predict(seg.Text)
+	if err != nil {
+		eos.logger.Warnw("turn detector prediction failed, keeping default timeout", "error", err)
+		return
+	}
+	timeout := eos.timeoutFor(probability)
+	eos.logger.Debugw("turn detector prediction",
+		"probability", probability,
+		"timeout", timeout,
+		"latency", time.Since(start))
+
+	select {
+	case eos.cmdCh <- command{ctx: ctx, segment: seg, timeout: timeout, predicted: true}:
+	case <-eos.stopCh:
+	}
+}
+
+// timeoutFor maps an end-of-turn probability to a silence timeout, from
+// maxTimeout at 0 down to minTimeout at threshold and above.
+func (eos *LivekitEOS) timeoutFor(probability float64) time.Duration {
+	ratio := math.Max(0, math.Min(probability/eos.threshold, 1))
+	return eos.maxTimeout - time.Duration(float64(eos.maxTimeout-eos.minTimeout)*ratio)
+}
+
+// send dispatches a command to the worker
+func (eos *LivekitEOS) send(cmd command) {
+	select {
+	case eos.cmdCh <- cmd:
+	default:
+		go func() { eos.cmdCh <- cmd }()
+	}
+}
+
+// worker manages silence detection and callback invocation
+func (eos *LivekitEOS) worker() {
+	var (
+		timer        *time.Timer
+		timerC       <-chan time.Time
+		gen          uint64
+		ctx          context.Context
+		segment      SpeechSegment
+		lastActivity time.Time
+	)
+
+	cleanup := func() {
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+			timerC = nil
+		}
+	}
+
+	for {
+		select {
+		case <-eos.stopCh:
+			cleanup()
+			return
+
+		case cmd := <-eos.cmdCh:
+			eos.mu.Lock()
+
+			// handle reset
+			if cmd.reset {
+				eos.state.callbackFired = false
+				eos.state.generation++
+				eos.state.segment = SpeechSegment{}
+				eos.state.timeout = eos.timeout
+				eos.mu.Unlock()
+				continue
+			}
+
+			// drop if callback pending
+			if eos.state.callbackFired {
+				eos.mu.Unlock()
+				continue
+			}
+
+			// immediate fire
+			if cmd.fireNow {
+				eos.state.callbackFired = true
+				seg := eos.state.segment
+				cbCtx := cmd.ctx
+				cleanup()
+				eos.mu.Unlock()
+				eos.fire(cbCtx, seg)
+				continue
+			}
+
+			// a prediction only applies to the transcript it was made for,
+			// and counts silence from the last activity
+			timeout := cmd.timeout
+			if cmd.predicted {
+				if cmd.segment.Timestamp != eos.state.segment.Timestamp {
+					eos.mu.Unlock()
+					continue
+				}
+				eos.state.timeout = cmd.timeout
+				timeout = max(cmd.timeout-time.Since(lastActivity), 0)
+			} else {
+				lastActivity = time.Now()
+			}
+
+			// schedule timer
+			gen = eos.state.generation + 1
+			eos.state.generation = gen
+			ctx = cmd.ctx
+			segment = cmd.segment
+			cleanup()
+			timer = time.NewTimer(timeout)
+			timerC = timer.C
+			eos.mu.Unlock()
+
+		case <-timerC:
+			eos.mu.Lock()
+			// stale timer check
+			if eos.state.callbackFired || gen != eos.state.generation {
+				eos.mu.Unlock()
+				continue
+			}
+
+			eos.state.callbackFired = true
+			seg := segment
+			cbCtx := ctx
+			cleanup()
+			eos.mu.Unlock()
+			eos.fire(cbCtx, seg)
+		}
+	}
+}
+
+// fire triggers the callback and enqueues reset
+func (eos *LivekitEOS) fire(ctx context.Context, seg SpeechSegment) {
+	if seg.Text == "" {
+		return
+	}
+
+	// The Analyze context is typically cancelled by the time the timer
+	// fires, see the silence-based detector.
+	if ctx.Err() != nil {
+		ctx = context.Background()
+	}
+
+	_ = eos.callback(ctx, internal_type.EndOfSpeechPacket{
+		Speech:    seg.Text,
+		ContextID: seg.ContextID,
+	})
+
+	eos.send(command{reset: true})
+}
+
+// Close shuts down the detector
+func (eos *LivekitEOS) Close() error {
+	close(eos.stopCh)
+	return nil
 }
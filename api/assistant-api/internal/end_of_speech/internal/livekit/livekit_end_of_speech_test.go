@@ -0,0 +1,202 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_livekit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePredictor scores text ending with "." as a finished turn.
+type fakePredictor struct {
+	delay time.Duration
+	err   error
+}
+
+func (f fakePredictor) Predict(text string) (float64, error) {
+	time.Sleep(f.delay)
+	if f.err != nil {
+		return 0, f.err
+	}
+	if strings.HasSuffix(text, ".") {
+		return 0.9, nil
+	}
+	return 0.001, nil
+}
+
+func sttInput(msg string, complete bool) internal_type.SpeechToTextPacket {
+	return internal_type.SpeechToTextPacket{Script: msg, Interim: !complete}
+}
+
+func newTestEOS(t *testing.T, predictor turnPredictor, opts utils.Option) (*LivekitEOS, chan internal_type.EndOfSpeechPacket, chan internal_type.InterimEndOfSpeechPacket) {
+	logger, _ := commons.NewApplicationLogger()
+	final := make(chan internal_type.EndOfSpeechPacket, 4)
+	interim := make(chan internal_type.InterimEndOfSpeechPacket, 16)
+	callback := func(ctx context.Context, pkts ...internal_type.Packet) error {
+		for _, pkt := range pkts {
+			switch p := pkt.(type) {
+			case internal_type.EndOfSpeechPacket:
+				final <- p
+			case internal_type.InterimEndOfSpeechPacket:
+				interim <- p
+			}
+		}
+		return nil
+	}
+	eos := newLivekitEndOfSpeech(logger, callback, opts, predictor)
+	t.Cleanup(func() { eos.Close() })
+	return eos, final, interim
+}
+
+func waitEndOfSpeech(t *testing.T, final chan internal_type.EndOfSpeechPacket, within time.Duration) (internal_type.EndOfSpeechPacket, time.Time) {
+	t.Helper()
+	select {
+	case pkt := <-final:
+		return pkt, time.Now()
+	case <-time.After(within):
+		t.Fatal("timeout waiting for end of speech")
+	}
+	return internal_type.EndOfSpeechPacket{}, time.Time{}
+}
+
+var testOpts = utils.Option{
+	"microphone.eos.timeout":     300.0,
+	"microphone.eos.min_timeout": 100.0,
+	"microphone.eos.max_timeout": 800.0,
+}
+
+func TestLivekitEOS_LikelyEndShortensTimeout(t *testing.T) {
+	eos, final, interim := newTestEOS(t, fakePredictor{}, testOpts)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), sttInput("book a table for two.", true)))
+
+	assert.Equal(t, "book a table for two.", (<-interim).Speech)
+	pkt, at := waitEndOfSpeech(t, final, time.Second)
+	assert.Equal(t, "book a table for two.", pkt.Speech)
+	assert.Less(t, at.Sub(start), 250*time.Millisecond)
+}
+
+func TestLivekitEOS_UnlikelyEndExtendsTimeout(t *testing.T) {
+	eos, final, _ := newTestEOS(t, fakePredictor{}, testOpts)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), sttInput("book a table for", true)))
+
+	_, at := waitEndOfSpeech(t, final, 2*time.Second)
+	assert.GreaterOrEqual(t, at.Sub(start), 750*time.Millisecond)
+}
+
+func TestLivekitEOS_AccumulatesTranscript(t *testing.T) {
+	eos, final, _ := newTestEOS(t, fakePredictor{}, testOpts)
+	ctx := context.Background()
+
+	require.NoError(t, eos.Analyze(ctx, sttInput("book a table", true)))
+	require.NoError(t, eos.Analyze(ctx, sttInput("for", false)))
+	require.NoError(t, eos.Analyze(ctx, sttInput("for two.", true)))
+
+	pkt, _ := waitEndOfSpeech(t, final, 2*time.Second)
+	assert.Equal(t, "book a table for two.", pkt.Speech)
+}
+
+func TestLivekitEOS_PredictionFailureKeepsTimeout(t *testing.T) {
+	eos, final, _ := newTestEOS(t, fakePredictor{err: errors.New("boom")}, testOpts)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), sttInput("hello.", true)))
+
+	_, at := waitEndOfSpeech(t, final, time.Second)
+	assert.InDelta(t, float64(300*time.Millisecond), float64(at.Sub(start)), float64(60*time.Millisecond))
+}
+
+func TestLivekitEOS_SlowPredictionCountsElapsedSilence(t *testing.T) {
+	eos, final, _ := newTestEOS(t, fakePredictor{delay: 150 * time.Millisecond}, testOpts)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), sttInput("hello.", true)))
+
+	// the 100ms timeout has already passed when the prediction lands
+	_, at := waitEndOfSpeech(t, final, time.Second)
+	assert.Less(t, at.Sub(start), 250*time.Millisecond)
+}
+
+func TestLivekitEOS_UserTextFiresImmediately(t *testing.T) {
+	eos, final, _ := newTestEOS(t, fakePredictor{}, testOpts)
+
+	start := time.Now()
+	require.NoError(t, eos.Analyze(context.Background(), internal_type.UserTextPacket{Text: "hi"}))
+
+	pkt, at := waitEndOfSpeech(t, final, time.Second)
+	assert.Equal(t, "hi", pkt.Speech)
+	assert.Less(t, at.Sub(start), 50*time.Millisecond)
+}
+
+func TestLivekitEOS_CallbackFiresOnce(t *testing.T) {
+	eos, final, _ := newTestEOS(t, fakePredictor{}, testOpts)
+	ctx := context.Background()
+
+	require.NoError(t, eos.Analyze(ctx, sttInput("hello.", true)))
+	waitEndOfSpeech(t, final, time.Second)
+
+	// activity with no new transcript is ignored after the turn ended
+	require.NoError(t, eos.Analyze(ctx, internal_type.InterruptionPacket{Source: "vad"}))
+	select {
+	case <-final:
+		t.Fatal("end of speech fired twice")
+	case <-time.After(400 * time.Millisecond):
+	}
+}
+
+func TestLivekitEOS_TimeoutFor(t *testing.T) {
+	eos, _, _ := newTestEOS(t, fakePredictor{}, utils.Option{"microphone.eos.threshold": 0.2})
+
+	assert.Equal(t, defaultMaxTimeout, eos.timeoutFor(0))
+	assert.Equal(t, defaultMinTimeout, eos.timeoutFor(0.2))
+	assert.Equal(t, defaultMinTimeout, eos.timeoutFor(0.9))
+	assert.Equal(t, (defaultMaxTimeout+defaultMinTimeout)/2, eos.timeoutFor(0.1))
+}
+
+func TestLivekitEOS_TimeoutBounds(t *testing.T) {
+	eos, _, _ := newTestEOS(t, fakePredictor{}, utils.Option{"microphone.eos.timeout": 5000.0, "microphone.eos.min_timeout": 6000.0})
+
+	assert.Equal(t, 5000*time.Millisecond, eos.minTimeout)
+	assert.Equal(t, 5000*time.Millisecond, eos.maxTimeout)
+}
+
+func TestNormalizeTranscript(t *testing.T) {
+	assert.Equal(t, "i'm not sure -- maybe", normalizeTranscript("  I'm  not sure -- maybe?! "))
+	assert.Equal(t, "hello world", normalizeTranscript("Hello, World."))
+}
+
+func TestTurnDetector_Model(t *testing.T) {
+	modelDir := resolveModelDir()
+	if _, err := os.Stat(filepath.Join(modelDir, modelFile)); err != nil {
+		t.Skipf("turn detector model missing at %s", modelDir)
+	}
+	if _, err := os.Stat(resolveOrtLibraryPath()); err != nil {
+		t.Skipf("onnx runtime missing at %s", resolveOrtLibraryPath())
+	}
+
+	detector, err := loadTurnDetector()
+	require.NoError(t, err)
+
+	finished, err := detector.Predict("What's the weather like in Paris today?")
+	require.NoError(t, err)
+	unfinished, err := detector.Predict("What's the weather like in")
+	require.NoError(t, err)
+	assert.Greater(t, finished, unfinished)
+}
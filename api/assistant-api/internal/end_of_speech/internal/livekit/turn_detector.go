@@ -0,0 +1,179 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_livekit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/sugarme/tokenizer"
+	"github.com/sugarme/tokenizer/pretrained"
+	ort "github.com/yalue/onnxruntime_go"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// Environment variables overriding the model directory and the ONNX
+	// Runtime shared library.
+	envModelPathKey   = "LIVEKIT_EOS_MODEL_PATH"
+	envOrtLibraryPath = "ONNXRUNTIME_LIB_PATH"
+
+	// Default model directory, holding the LiveKit turn detector export.
+	defaultModelDir      = "models"
+	modelFile            = "model_q8.onnx"
+	tokenizerFile        = "tokenizer.json"
+	defaultOrtLibraryDir = "/opt/onnxruntime/lib"
+
+	modelInputName  = "input_ids"
+	modelOutputName = "prob"
+
+	// maxContextTokens is how much of the rolling transcript the model sees.
+	maxContextTokens = 128
+)
+
+// turnPredictor returns the probability that text ends the user's turn.
+type turnPredictor interface {
+	Predict(text string) (float64, error)
+}
+
+// turnDetector runs the LiveKit end-of-utterance model, a small language
+// model fine-tuned to predict <|im_end|> after the user's text. One session
+// is shared by all conversations, ONNX Runtime sessions are safe for
+// concurrent Run calls.
+type turnDetector struct {
+	tokenizer *tokenizer.Tokenizer
+	session   *ort.DynamicAdvancedSession
+}
+
+var (
+	sharedDetector     *turnDetector
+	sharedDetectorErr  error
+	sharedDetectorOnce sync.Once
+)
+
+// loadTurnDetector loads the model once per process.
+func loadTurnDetector() (*turnDetector, error) {
+	sharedDetectorOnce.Do(func() {
+		sharedDetector, sharedDetectorErr = newTurnDetector(resolveModelDir())
+	})
+	return sharedDetector, sharedDetectorErr
+}
+
+func newTurnDetector(modelDir string) (*turnDetector, error) {
+	tk, err := pretrained.FromFile(filepath.Join(modelDir, tokenizerFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load turn detector tokenizer: %w", err)
+	}
+
+	if !ort.IsInitialized() {
+		ort.SetSharedLibraryPath(resolveOrtLibraryPath())
+		if err := ort.InitializeEnvironment(); err != nil {
+			return nil, fmt.Errorf("failed to initialize onnx runtime: %w", err)
+		}
+	}
+
+	options, err := ort.NewSessionOptions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session options: %w", err)
+	}
+	defer options.Destroy()
+	// Inference is a single short sequence, more threads only add contention
+	// on busy nodes.
+	if err := options.SetIntraOpNumThreads(1); err != nil {
+		return nil, err
+	}
+	if err := options.SetInterOpNumThreads(1); err != nil {
+		return nil, err
+	}
+
+	session, err := ort.NewDynamicAdvancedSession(filepath.Join(modelDir, modelFile),
+		[]string{modelInputName}, []string{modelOutputName}, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load turn detector model: %w", err)
+	}
+	return &turnDetector{tokenizer: tk, session: session}, nil
+}
+
+// Predict formats text as an unfinished user chat turn and returns the
+// model's end-of-turn probability for its last token.
+func (d *turnDetector) Predict(text string) (float64, error) {
+	prompt := "<|im_start|>user\n" + normalizeTranscript(text)
+	encoding, err := d.tokenizer.EncodeSingle(prompt, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to tokenize transcript: %w", err)
+	}
+	ids := encoding.GetIds()
+	if len(ids) > maxContextTokens {
+		ids = ids[len(ids)-maxContextTokens:]
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	inputIDs := make([]int64, len(ids))
+	for i, id := range ids {
+		inputIDs[i] = int64(id)
+	}
+	input, err := ort.NewTensor(ort.NewShape(1, int64(len(inputIDs))), inputIDs)
+	if err != nil {
+		return 0, err
+	}
+	defer input.Destroy()
+
+	outputs := []ort.ArbitraryTensor{nil}
+	if err := d.session.Run([]ort.ArbitraryTensor{input}, outputs); err != nil {
+		return 0, fmt.Errorf("turn detector inference failed: %w", err)
+	}
+	defer outputs[0].Destroy()
+
+	probabilities, ok := outputs[0].(*ort.Tensor[float32])
+	if !ok {
+		return 0, fmt.Errorf("unexpected turn detector output type %T", outputs[0])
+	}
+	data := probabilities.GetData()
+	if len(data) == 0 {
+		return 0, fmt.Errorf("empty turn detector output")
+	}
+	return float64(data[len(data)-1]), nil
+}
+
+// normalizeTranscript matches the model's training text: NFKC, lower case,
+// punctuation removed except apostrophes and hyphens, single spaces.
+func normalizeTranscript(text string) string {
+	text = strings.ToLower(norm.NFKC.String(text))
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) && r != '\'' && r != '-' {
+			return -1
+		}
+		return r
+	}, text)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// resolveModelDir determines the model directory.
+func resolveModelDir() string {
+	if envPath := os.Getenv(envModelPathKey); envPath != "" {
+		return envPath
+	}
+	_, currentFile, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(currentFile), defaultModelDir)
+}
+
+// resolveOrtLibraryPath determines the ONNX Runtime shared library path.
+func resolveOrtLibraryPath() string {
+	if envPath := os.Getenv(envOrtLibraryPath); envPath != "" {
+		return envPath
+	}
+	if runtime.GOOS == "darwin" {
+		return filepath.Join(defaultOrtLibraryDir, "libonnxruntime.dylib")
+	}
+	return filepath.Join(defaultOrtLibraryDir, "libonnxruntime.so")
+}
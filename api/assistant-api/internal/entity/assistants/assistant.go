@@ -46,6 +46,8 @@ type Assistant struct {
 	AssistantTools               []*AssistantTool                                      `json:"assistantTools"  gorm:"foreignKey:AssistantId"`
 	AssistantAnalyses            []*AssistantAnalysis                                  `json:"assistantAnalyses"  gorm:"foreignKey:AssistantId"`
 	AssistantWebhooks            []*AssistantWebhook                                   `json:"assistantWebhooks"  gorm:"foreignKey:AssistantId"`
+	AssistantModerators          []*AssistantModerator                                 `json:"assistantModerators"  gorm:"foreignKey:AssistantId"`
+	AssistantEvaluators          []*AssistantEvaluator                                 `json:"assistantEvaluators"  gorm:"foreignKey:AssistantId"`
 }
 
 func (a *Assistant) IsPhoneDeploymentEnable() bool {
@@ -60,6 +62,10 @@ func (a *Assistant) IsWebPluginDeploymentEnable() bool {
 	return a.AssistantWhatsappDeployment != nil
 }
 
+func (a *Assistant) IsWhatsappDeploymentEnable() bool {
+	return a.AssistantWhatsappDeployment != nil
+}
+
 // AssistantTag represents a tag associated with an assistant in the database.
 // It extends the Audited model and includes fields for the assistant ID,
 // the tag itself (as a string array), and information about who created and updated the tag.
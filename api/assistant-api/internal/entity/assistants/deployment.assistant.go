@@ -39,6 +39,14 @@ type AssistantDeploymentWhatsapp struct {
 	WhatsappOptions  []*AssistantDeploymentWhatsappOption `json:"whatsappOptions"  gorm:"foreignKey:AssistantDeploymentWhatsappId"`
 }
 
+func (a *AssistantDeploymentWhatsapp) GetOptions() utils.Option {
+	opts := make(map[string]interface{})
+	for _, v := range a.WhatsappOptions {
+		opts[v.Key] = v.Value
+	}
+	return opts
+}
+
 type AssistantDeploymentWhatsappOption struct {
 	gorm_model.Audited
 	gorm_model.Mutable
@@ -118,6 +126,7 @@ type AssistantPhoneDeployment struct {
 type AssistantWhatsappDeployment struct {
 	AssistantDeploymentBehavior
 	AssistantDeploymentWhatsapp
+	InputAudio *AssistantDeploymentAudio `json:"inputAudio"  gorm:"foreignKey:AssistantDeploymentId"`
 }
 
 /**
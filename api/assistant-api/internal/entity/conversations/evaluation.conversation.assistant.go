@@ -0,0 +1,44 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_conversation_entity
+
+import (
+	"fmt"
+
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+)
+
+// AssistantConversationEvaluation is the score of one evaluator for a
+// completed conversation, kept with the assistant version it ran on.
+type AssistantConversationEvaluation struct {
+	gorm_model.Audited
+	gorm_model.Organizational
+	AssistantId              uint64  `json:"assistantId" gorm:"type:bigint;not null"`
+	AssistantProviderModelId uint64  `json:"assistantProviderModelId" gorm:"type:bigint;not null"`
+	AssistantConversationId  uint64  `json:"assistantConversationId" gorm:"type:bigint;not null"`
+	Name                     string  `json:"name" gorm:"type:string;size:50;not null"`
+	Evaluator                string  `json:"evaluator" gorm:"type:string;size:20;not null"`
+	Score                    float64 `json:"score" gorm:"type:double precision;not null"`
+	Passed                   bool    `json:"passed" gorm:"type:boolean;not null"`
+	Reason                   string  `json:"reason" gorm:"type:text;not null;default:''"`
+}
+
+// AssistantEvaluationSummary aggregates the scores of an evaluator for one
+// assistant version.
+type AssistantEvaluationSummary struct {
+	AssistantProviderModelId uint64  `json:"assistantProviderModelId"`
+	Name                     string  `json:"name"`
+	Evaluator                string  `json:"evaluator"`
+	Count                    uint64  `json:"count"`
+	AverageScore             float64 `json:"averageScore"`
+	PassRate                 float64 `json:"passRate"`
+}
+
+// Version is the assistant version label of the provider model, as used in
+// webhook payloads.
+func (s *AssistantEvaluationSummary) Version() string {
+	return fmt.Sprintf("vrsn_%d", s.AssistantProviderModelId)
+}
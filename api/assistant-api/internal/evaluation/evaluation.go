@@ -0,0 +1,134 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	internal_assertion "github.com/rapidaai/api/assistant-api/internal/evaluation/internal/assertion"
+	internal_judge "github.com/rapidaai/api/assistant-api/internal/evaluation/internal/judge"
+	internal_latency "github.com/rapidaai/api/assistant-api/internal/evaluation/internal/latency"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type EvaluatorIdentifier string
+
+const (
+	AssertionEvaluator EvaluatorIdentifier = "assertion"
+	LatencyEvaluator   EvaluatorIdentifier = "latency"
+	LLMJudgeEvaluator  EvaluatorIdentifier = "llm_judge"
+)
+
+const (
+	OptionsKeyCredentialId = "rapida.credential_id"
+
+	// metricPrefix namespaces the scores among the conversation metrics.
+	metricPrefix = "evaluation."
+)
+
+// GetEvaluator creates the evaluator of the given type, credential is only
+// required by provider backed evaluators.
+func GetEvaluator(logger commons.Logger,
+	evaluator string,
+	integration integration_client.IntegrationServiceClient,
+	auth types.SimplePrinciple,
+	credential *protos.VaultCredential,
+	opts utils.Option) (internal_type.Evaluator, error) {
+	switch EvaluatorIdentifier(evaluator) {
+	case AssertionEvaluator:
+		return internal_assertion.NewAssertionEvaluator(logger, opts)
+	case LatencyEvaluator:
+		return internal_latency.NewLatencyEvaluator(logger, opts)
+	case LLMJudgeEvaluator:
+		return internal_judge.NewJudgeEvaluator(logger, integration, auth, credential, opts)
+	default:
+		return nil, fmt.Errorf("unsupported evaluator %s", evaluator)
+	}
+}
+
+// Check is an evaluator configured on the assistant, Name identifies its
+// score on the conversation.
+type Check struct {
+	Name      string
+	Evaluator internal_type.Evaluator
+}
+
+// Score is the outcome of a check on a conversation.
+type Score struct {
+	Name      string
+	Evaluator string
+	*internal_type.EvaluationResult
+}
+
+// Metrics returns the score as conversation metrics, so it is listed with
+// the conversation and can be compared across assistant versions.
+func (s *Score) Metrics() []*protos.Metric {
+	prefix := metricPrefix + s.Name + "."
+	return []*protos.Metric{
+		{Name: prefix + "score", Value: strconv.FormatFloat(s.Score, 'f', 4, 64), Description: s.Reason},
+		{Name: prefix + "passed", Value: strconv.FormatBool(s.Passed), Description: s.Evaluator},
+	}
+}
+
+// Evaluation holds the checks of an assistant. A nil Evaluation has no
+// checks.
+type Evaluation struct {
+	logger commons.Logger
+	checks []*Check
+}
+
+func NewEvaluation(logger commons.Logger, checks ...*Check) *Evaluation {
+	return &Evaluation{logger: logger, checks: checks}
+}
+
+// Enabled reports whether the assistant has any check.
+func (e *Evaluation) Enabled() bool {
+	return e != nil && len(e.checks) > 0
+}
+
+// Evaluate runs the checks concurrently and returns the scores in
+// configuration order. A failing check is logged and left out.
+func (e *Evaluation) Evaluate(ctx context.Context, in *internal_type.EvaluationInput) []*Score {
+	if !e.Enabled() {
+		return nil
+	}
+	results := make([]*internal_type.EvaluationResult, len(e.checks))
+	var wg sync.WaitGroup
+	for i, check := range e.checks {
+		wg.Add(1)
+		go func(i int, check *Check) {
+			defer wg.Done()
+			res, err := check.Evaluator.Evaluate(ctx, in)
+			if err != nil {
+				e.logger.Warnf("evaluator %s failed, conversation is not scored: %v", check.Name, err)
+				return
+			}
+			results[i] = res
+		}(i, check)
+	}
+	wg.Wait()
+
+	scores := make([]*Score, 0, len(results))
+	for i, res := range results {
+		if res == nil {
+			continue
+		}
+		scores = append(scores, &Score{
+			Name:             e.checks[i].Name,
+			Evaluator:        e.checks[i].Evaluator.Name(),
+			EvaluationResult: res,
+		})
+	}
+	return scores
+}
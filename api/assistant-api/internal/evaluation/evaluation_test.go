@@ -0,0 +1,192 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_evaluation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeIntegrationClient struct {
+	integration_client.IntegrationServiceClient
+	provider string
+	request  *protos.ChatRequest
+	reply    string
+}
+
+func (f *fakeIntegrationClient) Chat(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.ChatRequest) (*protos.ChatResponse, error) {
+	f.provider = providerName
+	f.request = in
+	return &protos.ChatResponse{
+		Success: true,
+		Data: &protos.Message{Role: "assistant", Message: &protos.Message_Assistant{
+			Assistant: &protos.AssistantMessage{Contents: []string{f.reply}},
+		}},
+	}, nil
+}
+
+type failingEvaluator struct{}
+
+func (failingEvaluator) Name() string { return "failing" }
+
+func (failingEvaluator) Evaluate(ctx context.Context, in *internal_type.EvaluationInput) (*internal_type.EvaluationResult, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func newTestLogger() commons.Logger {
+	logger, _ := commons.NewApplicationLogger()
+	return logger
+}
+
+func transcript() *internal_type.EvaluationInput {
+	return &internal_type.EvaluationInput{
+		Messages: []internal_type.MessagePacket{
+			internal_type.UserTextPacket{ContextID: "1", Text: "I want to cancel my order"},
+			internal_type.LLMResponseDonePacket{ContextID: "1", Text: "Sure, your order 42 is cancelled."},
+		},
+		Metrics: map[string]string{"TIME_TO_FIRST_AUDIO": "850", "INTERRUPTION_COUNT": "2"},
+	}
+}
+
+func evaluate(t *testing.T, evaluator string, opts utils.Option) *internal_type.EvaluationResult {
+	e, err := GetEvaluator(newTestLogger(), evaluator, nil, nil, nil, opts)
+	require.NoError(t, err)
+	res, err := e.Evaluate(context.Background(), transcript())
+	require.NoError(t, err)
+	return res
+}
+
+func TestGetEvaluator_Unsupported(t *testing.T) {
+	_, err := GetEvaluator(newTestLogger(), "unknown", nil, nil, nil, utils.Option{})
+	assert.Error(t, err)
+}
+
+func TestAssertionEvaluator(t *testing.T) {
+	res := evaluate(t, "assertion", utils.Option{"evaluator.keywords": "cancelled, refunded"})
+	assert.True(t, res.Passed)
+	assert.Equal(t, 1.0, res.Score)
+
+	// keywords are matched on the assistant messages by default
+	res = evaluate(t, "assertion", utils.Option{"evaluator.keywords": "cancel"})
+	assert.False(t, res.Passed)
+	assert.Equal(t, 0.0, res.Score)
+
+	res = evaluate(t, "assertion", utils.Option{"evaluator.keywords": "cancel", "evaluator.role": "user"})
+	assert.True(t, res.Passed)
+
+	res = evaluate(t, "assertion", utils.Option{"evaluator.pattern": `order \d+`, "evaluator.expect": "absent"})
+	assert.False(t, res.Passed)
+}
+
+func TestAssertionEvaluator_InvalidOptions(t *testing.T) {
+	for _, opts := range []utils.Option{
+		{},
+		{"evaluator.pattern": "("},
+		{"evaluator.keywords": "refund", "evaluator.role": "system"},
+		{"evaluator.keywords": "refund", "evaluator.expect": "maybe"},
+	} {
+		_, err := GetEvaluator(newTestLogger(), "assertion", nil, nil, nil, opts)
+		assert.Error(t, err, opts)
+	}
+}
+
+func TestLatencyEvaluator(t *testing.T) {
+	res := evaluate(t, "latency", utils.Option{"evaluator.max": "1000"})
+	assert.True(t, res.Passed)
+	assert.Contains(t, res.Reason, "TIME_TO_FIRST_AUDIO is 850")
+
+	res = evaluate(t, "latency", utils.Option{"evaluator.metric": "INTERRUPTION_COUNT", "evaluator.max": 1})
+	assert.False(t, res.Passed)
+
+	_, err := GetEvaluator(newTestLogger(), "latency", nil, nil, nil, utils.Option{})
+	assert.Error(t, err)
+
+	e, err := GetEvaluator(newTestLogger(), "latency", nil, nil, nil, utils.Option{"evaluator.metric": "UNKNOWN", "evaluator.max": 1})
+	require.NoError(t, err)
+	_, err = e.Evaluate(context.Background(), transcript())
+	assert.Error(t, err)
+}
+
+func TestJudgeEvaluator(t *testing.T) {
+	client := &fakeIntegrationClient{reply: "```json\n{\"score\": 0.9, \"reason\": \"resolved the request\"}\n```"}
+	credential := &protos.VaultCredential{Id: 7, Value: &structpb.Struct{}}
+	e, err := GetEvaluator(newTestLogger(), "llm_judge", client, nil, credential, utils.Option{
+		"evaluator.provider": "openai",
+		"evaluator.rubric":   "The assistant resolves the request.",
+		"model.name":         "gpt-4o-mini",
+	})
+	require.NoError(t, err)
+
+	res, err := e.Evaluate(context.Background(), transcript())
+	require.NoError(t, err)
+	assert.True(t, res.Passed)
+	assert.Equal(t, 0.9, res.Score)
+	assert.Equal(t, "resolved the request", res.Reason)
+
+	assert.Equal(t, "openai", client.provider)
+	assert.Equal(t, uint64(7), client.request.GetCredential().GetId())
+	assert.Contains(t, client.request.GetModelParameters(), "model.name")
+	require.Len(t, client.request.GetConversations(), 2)
+	assert.Contains(t, client.request.GetConversations()[0].GetSystem().GetContent(), "The assistant resolves the request.")
+	assert.Contains(t, client.request.GetConversations()[1].GetUser().GetContent(), "user: I want to cancel my order")
+
+	client.reply = `{"score": 1.5}`
+	res, err = e.Evaluate(context.Background(), transcript())
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, res.Score)
+
+	client.reply = "looks good"
+	_, err = e.Evaluate(context.Background(), transcript())
+	assert.Error(t, err)
+}
+
+func TestJudgeEvaluator_InvalidOptions(t *testing.T) {
+	credential := &protos.VaultCredential{Id: 7}
+	_, err := GetEvaluator(newTestLogger(), "llm_judge", nil, nil, nil, utils.Option{"evaluator.provider": "openai", "evaluator.rubric": "x"})
+	assert.Error(t, err)
+	_, err = GetEvaluator(newTestLogger(), "llm_judge", nil, nil, credential, utils.Option{"evaluator.rubric": "x"})
+	assert.Error(t, err)
+	_, err = GetEvaluator(newTestLogger(), "llm_judge", nil, nil, credential, utils.Option{"evaluator.provider": "openai"})
+	assert.Error(t, err)
+}
+
+func TestEvaluation_NilIsDisabled(t *testing.T) {
+	var e *Evaluation
+	assert.False(t, e.Enabled())
+	assert.Nil(t, e.Evaluate(context.Background(), transcript()))
+}
+
+func TestEvaluation_SkipsFailingCheck(t *testing.T) {
+	assertion, err := GetEvaluator(newTestLogger(), "assertion", nil, nil, nil, utils.Option{"evaluator.keywords": "cancelled"})
+	require.NoError(t, err)
+	e := NewEvaluation(newTestLogger(),
+		&Check{Name: "failing", Evaluator: failingEvaluator{}},
+		&Check{Name: "confirms_cancellation", Evaluator: assertion},
+	)
+
+	scores := e.Evaluate(context.Background(), transcript())
+	require.Len(t, scores, 1)
+	assert.Equal(t, "confirms_cancellation", scores[0].Name)
+
+	metrics := scores[0].Metrics()
+	require.Len(t, metrics, 2)
+	assert.Equal(t, "evaluation.confirms_cancellation.score", metrics[0].GetName())
+	assert.Equal(t, "1.0000", metrics[0].GetValue())
+	assert.Equal(t, "evaluation.confirms_cancellation.passed", metrics[1].GetName())
+	assert.Equal(t, "true", metrics[1].GetValue())
+	assert.Equal(t, "assertion", metrics[1].GetDescription())
+}
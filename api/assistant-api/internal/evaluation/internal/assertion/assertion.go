@@ -0,0 +1,108 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assertion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	OptionsKeyPattern  = "evaluator.pattern"
+	OptionsKeyKeywords = "evaluator.keywords"
+	OptionsKeyRole     = "evaluator.role"
+	OptionsKeyExpect   = "evaluator.expect"
+
+	roleAny       = "any"
+	expectPresent = "present"
+	expectAbsent  = "absent"
+)
+
+// assertionEvaluator checks that the messages of a role match, or never
+// match, a regular expression or a list of keywords.
+type assertionEvaluator struct {
+	logger  commons.Logger
+	pattern *regexp.Regexp
+	role    string
+	expect  string
+}
+
+func NewAssertionEvaluator(logger commons.Logger, opts utils.Option) (internal_type.Evaluator, error) {
+	var pattern *regexp.Regexp
+	if raw, err := opts.GetString(OptionsKeyPattern); err == nil && strings.TrimSpace(raw) != "" {
+		pattern, err = regexp.Compile(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", OptionsKeyPattern, err)
+		}
+	} else if raw, err := opts.GetString(OptionsKeyKeywords); err == nil {
+		keywords := make([]string, 0)
+		for _, k := range strings.Split(raw, ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				keywords = append(keywords, regexp.QuoteMeta(k))
+			}
+		}
+		if len(keywords) > 0 {
+			pattern = regexp.MustCompile(`(?i)\b(?:` + strings.Join(keywords, "|") + `)\b`)
+		}
+	}
+	if pattern == nil {
+		return nil, fmt.Errorf("assertion evaluator requires %s or %s", OptionsKeyPattern, OptionsKeyKeywords)
+	}
+
+	e := &assertionEvaluator{logger: logger, pattern: pattern, role: "assistant", expect: expectPresent}
+	if role, err := opts.GetString(OptionsKeyRole); err == nil && role != "" {
+		switch role {
+		case "user", "assistant", roleAny:
+			e.role = role
+		default:
+			return nil, fmt.Errorf("unsupported assertion role %s", role)
+		}
+	}
+	if expect, err := opts.GetString(OptionsKeyExpect); err == nil && expect != "" {
+		switch expect {
+		case expectPresent, expectAbsent:
+			e.expect = expect
+		default:
+			return nil, fmt.Errorf("unsupported assertion expectation %s", expect)
+		}
+	}
+	return e, nil
+}
+
+func (e *assertionEvaluator) Name() string {
+	return "assertion"
+}
+
+func (e *assertionEvaluator) Evaluate(ctx context.Context, in *internal_type.EvaluationInput) (*internal_type.EvaluationResult, error) {
+	var match string
+	for _, msg := range in.Messages {
+		if e.role != roleAny && msg.Role() != e.role {
+			continue
+		}
+		if match = e.pattern.FindString(msg.Content()); match != "" {
+			break
+		}
+	}
+
+	passed := (match != "") == (e.expect == expectPresent)
+	result := &internal_type.EvaluationResult{Passed: passed}
+	if passed {
+		result.Score = 1
+	}
+	switch {
+	case match != "":
+		result.Reason = fmt.Sprintf("%s message matched %q", e.role, match)
+	default:
+		result.Reason = fmt.Sprintf("no %s message matched %s", e.role, e.pattern.String())
+	}
+	return result, nil
+}
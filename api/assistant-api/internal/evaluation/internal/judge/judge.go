@@ -0,0 +1,145 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_judge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	OptionsKeyProvider  = "evaluator.provider"
+	OptionsKeyRubric    = "evaluator.rubric"
+	OptionsKeyThreshold = "evaluator.threshold"
+
+	defaultThreshold = 0.7
+
+	instruction = `You evaluate a conversation between a user and a voice assistant against a rubric.
+
+Rubric:
+%s
+
+Reply only with a JSON object of the form {"score": <number between 0 and 1>, "reason": "<one sentence>"}.`
+)
+
+// judgeEvaluator asks a language model to score the transcript against a
+// rubric, the conversation passes when the score reaches the threshold.
+type judgeEvaluator struct {
+	logger       commons.Logger
+	integration  integration_client.IntegrationServiceClient
+	inputBuilder integration_client_builders.InputChatBuilder
+	auth         types.SimplePrinciple
+	credential   *protos.Credential
+	provider     string
+	rubric       string
+	threshold    float64
+	parameters   map[string]interface{}
+}
+
+func NewJudgeEvaluator(logger commons.Logger,
+	integration integration_client.IntegrationServiceClient,
+	auth types.SimplePrinciple,
+	credential *protos.VaultCredential,
+	opts utils.Option) (internal_type.Evaluator, error) {
+	if credential == nil {
+		return nil, fmt.Errorf("llm judge evaluator requires a credential")
+	}
+	provider, err := opts.GetString(OptionsKeyProvider)
+	if err != nil || provider == "" {
+		return nil, fmt.Errorf("llm judge evaluator requires %s", OptionsKeyProvider)
+	}
+	rubric, err := opts.GetString(OptionsKeyRubric)
+	if err != nil || strings.TrimSpace(rubric) == "" {
+		return nil, fmt.Errorf("llm judge evaluator requires %s", OptionsKeyRubric)
+	}
+	threshold := defaultThreshold
+	if t, err := opts.GetFloat64(OptionsKeyThreshold); err == nil {
+		threshold = t
+	}
+	parameters := make(map[string]interface{})
+	for k, v := range opts {
+		if strings.HasPrefix(k, "model.") {
+			parameters[k] = v
+		}
+	}
+	return &judgeEvaluator{
+		logger:       logger,
+		integration:  integration,
+		inputBuilder: integration_client_builders.NewChatInputBuilder(logger),
+		auth:         auth,
+		credential:   &protos.Credential{Id: credential.GetId(), Value: credential.GetValue()},
+		provider:     provider,
+		rubric:       rubric,
+		threshold:    threshold,
+		parameters:   parameters,
+	}, nil
+}
+
+func (e *judgeEvaluator) Name() string {
+	return "llm_judge"
+}
+
+func (e *judgeEvaluator) Evaluate(ctx context.Context, in *internal_type.EvaluationInput) (*internal_type.EvaluationResult, error) {
+	var transcript strings.Builder
+	for _, msg := range in.Messages {
+		fmt.Fprintf(&transcript, "%s: %s\n", msg.Role(), msg.Content())
+	}
+	res, err := e.integration.Chat(ctx, e.auth, e.provider, e.inputBuilder.Chat(
+		"",
+		e.credential,
+		e.inputBuilder.Options(e.parameters, nil),
+		nil,
+		map[string]string{},
+		&protos.Message{Role: "system", Message: &protos.Message_System{System: &protos.SystemMessage{Content: fmt.Sprintf(instruction, e.rubric)}}},
+		&protos.Message{Role: "user", Message: &protos.Message_User{User: &protos.UserMessage{Content: transcript.String()}}},
+	))
+	if err != nil {
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		return nil, fmt.Errorf("llm judge failed: %s", res.GetError().GetHumanMessage())
+	}
+	verdict, err := parseVerdict(strings.Join(res.GetData().GetAssistant().GetContents(), ""))
+	if err != nil {
+		return nil, err
+	}
+	return &internal_type.EvaluationResult{
+		Score:  verdict.Score,
+		Passed: verdict.Score >= e.threshold,
+		Reason: verdict.Reason,
+	}, nil
+}
+
+type verdict struct {
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+}
+
+// parseVerdict reads the JSON object of the reply, models tend to wrap it in
+// prose or code fences.
+func parseVerdict(reply string) (*verdict, error) {
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("llm judge replied without a verdict: %q", reply)
+	}
+	var v verdict
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &v); err != nil {
+		return nil, fmt.Errorf("unable to parse llm judge verdict: %w", err)
+	}
+	v.Score = math.Max(0, math.Min(1, v.Score))
+	return &v, nil
+}
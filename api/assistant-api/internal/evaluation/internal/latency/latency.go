@@ -0,0 +1,67 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_latency
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const (
+	OptionsKeyMetric = "evaluator.metric"
+	OptionsKeyMax    = "evaluator.max"
+)
+
+// latencyEvaluator checks a conversation metric against a service level
+// objective, such as the time to first audio in milliseconds or the number
+// of interruptions. The conversation passes when the metric is at most the
+// configured maximum.
+type latencyEvaluator struct {
+	logger commons.Logger
+	metric string
+	max    float64
+}
+
+func NewLatencyEvaluator(logger commons.Logger, opts utils.Option) (internal_type.Evaluator, error) {
+	metric := type_enums.TIME_TO_FIRST_AUDIO.String()
+	if m, err := opts.GetString(OptionsKeyMetric); err == nil && m != "" {
+		metric = m
+	}
+	max, err := opts.GetFloat64(OptionsKeyMax)
+	if err != nil {
+		return nil, fmt.Errorf("latency evaluator requires %s: %w", OptionsKeyMax, err)
+	}
+	return &latencyEvaluator{logger: logger, metric: metric, max: max}, nil
+}
+
+func (e *latencyEvaluator) Name() string {
+	return "latency"
+}
+
+func (e *latencyEvaluator) Evaluate(ctx context.Context, in *internal_type.EvaluationInput) (*internal_type.EvaluationResult, error) {
+	raw, ok := in.Metrics[e.metric]
+	if !ok {
+		return nil, fmt.Errorf("metric %s is not recorded for the conversation", e.metric)
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("metric %s is not numeric: %w", e.metric, err)
+	}
+	result := &internal_type.EvaluationResult{
+		Passed: value <= e.max,
+		Reason: fmt.Sprintf("%s is %s, objective is at most %s", e.metric, raw, strconv.FormatFloat(e.max, 'f', -1, 64)),
+	}
+	if result.Passed {
+		result.Score = 1
+	}
+	return result, nil
+}
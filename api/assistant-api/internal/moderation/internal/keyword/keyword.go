@@ -0,0 +1,63 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_keyword
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/utils"
+)
+
+const OptionsKeyKeywords = "moderation.keywords"
+
+// keywordModerator flags text containing any of the configured words or
+// phrases, matched case-insensitively on word boundaries. It runs in
+// process so it adds no network round trip to the turn.
+type keywordModerator struct {
+	logger  commons.Logger
+	pattern *regexp.Regexp
+}
+
+func NewKeywordModerator(logger commons.Logger, opts utils.Option) (internal_type.Moderator, error) {
+	raw, err := opts.GetString(OptionsKeyKeywords)
+	if err != nil {
+		return nil, fmt.Errorf("keyword moderator requires %s: %w", OptionsKeyKeywords, err)
+	}
+	keywords := make([]string, 0)
+	for _, k := range strings.Split(raw, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keywords = append(keywords, regexp.QuoteMeta(k))
+		}
+	}
+	if len(keywords) == 0 {
+		return nil, fmt.Errorf("keyword moderator requires at least one keyword in %s", OptionsKeyKeywords)
+	}
+	return &keywordModerator{
+		logger:  logger,
+		pattern: regexp.MustCompile(`(?i)\b(?:` + strings.Join(keywords, "|") + `)\b`),
+	}, nil
+}
+
+func (m *keywordModerator) Name() string {
+	return "keyword"
+}
+
+func (m *keywordModerator) Moderate(ctx context.Context, text string) (*internal_type.ModerationResult, error) {
+	matches := m.pattern.FindAllString(text, -1)
+	if len(matches) == 0 {
+		return &internal_type.ModerationResult{}, nil
+	}
+	return &internal_type.ModerationResult{
+		Flagged:    true,
+		Categories: map[string]float64{"keyword": 1},
+		Matches:    matches,
+	}, nil
+}
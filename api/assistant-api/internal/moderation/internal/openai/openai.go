@@ -0,0 +1,87 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_openai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// openaiModerator classifies text with the OpenAI moderation model through
+// integration-api.
+type openaiModerator struct {
+	logger      commons.Logger
+	integration integration_client.IntegrationServiceClient
+	auth        types.SimplePrinciple
+	credential  *protos.Credential
+	parameters  map[string]interface{}
+}
+
+func NewOpenAIModerator(logger commons.Logger,
+	integration integration_client.IntegrationServiceClient,
+	auth types.SimplePrinciple,
+	credential *protos.VaultCredential,
+	opts utils.Option) (internal_type.Moderator, error) {
+	if credential == nil {
+		return nil, fmt.Errorf("openai moderator requires a credential")
+	}
+	parameters := make(map[string]interface{})
+	for k, v := range opts {
+		if strings.HasPrefix(k, "model.") {
+			parameters[k] = v
+		}
+	}
+	return &openaiModerator{
+		logger:      logger,
+		integration: integration,
+		auth:        auth,
+		credential:  &protos.Credential{Id: credential.GetId(), Value: credential.GetValue()},
+		parameters:  parameters,
+	}, nil
+}
+
+func (m *openaiModerator) Name() string {
+	return "openai"
+}
+
+func (m *openaiModerator) Moderate(ctx context.Context, text string) (*internal_type.ModerationResult, error) {
+	parameters, err := utils.InterfaceMapToAnyMap(m.parameters)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.integration.Moderation(ctx, m.auth, "openai", &protos.GetModerationRequest{
+		Credential:      m.credential,
+		Content:         text,
+		ModelParameters: parameters,
+		AdditionalData:  map[string]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		return nil, fmt.Errorf("moderation failed: %s", res.GetError().GetHumanMessage())
+	}
+
+	result := &internal_type.ModerationResult{Categories: map[string]float64{}}
+	for _, md := range res.GetData() {
+		if md.GetName() == "flagged" {
+			result.Flagged = md.GetValue() == "true"
+			continue
+		}
+		score, _ := strconv.ParseFloat(md.GetValue(), 64)
+		result.Categories[md.GetName()] = score
+	}
+	return result, nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	internal_keyword "github.com/rapidaai/api/assistant-api/internal/moderation/internal/keyword"
+	internal_openai "github.com/rapidaai/api/assistant-api/internal/moderation/internal/openai"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+type ModeratorIdentifier string
+
+const (
+	KeywordModerator ModeratorIdentifier = "keyword"
+	OpenAIModerator  ModeratorIdentifier = "openai"
+)
+
+// Stages a moderator applies to, the user transcript before the LLM and the
+// LLM output before text to speech.
+const (
+	StageInput  = "input"
+	StageOutput = "output"
+)
+
+// Action is what happens to flagged text.
+type Action string
+
+const (
+	// ActionBlock replaces the text with the canned response.
+	ActionBlock Action = "block"
+	// ActionRedact masks the flagged words, or the whole text when the
+	// moderator cannot locate them, and lets the turn continue.
+	ActionRedact Action = "redact"
+	// ActionEndConversation speaks the canned response and ends the conversation.
+	ActionEndConversation Action = "end_conversation"
+)
+
+const (
+	OptionsKeyAction       = "moderation.action"
+	OptionsKeyResponse     = "moderation.response"
+	OptionsKeyCredentialId = "rapida.credential_id"
+
+	defaultResponse = "I'm sorry, I can't help with that."
+	redactionMask   = "[redacted]"
+)
+
+// GetModerator creates the moderator of the given type, credential is only
+// required by provider backed moderators.
+func GetModerator(logger commons.Logger,
+	moderator string,
+	integration integration_client.IntegrationServiceClient,
+	auth types.SimplePrinciple,
+	credential *protos.VaultCredential,
+	opts utils.Option) (internal_type.Moderator, error) {
+	switch ModeratorIdentifier(moderator) {
+	case KeywordModerator:
+		return internal_keyword.NewKeywordModerator(logger, opts)
+	case OpenAIModerator:
+		return internal_openai.NewOpenAIModerator(logger, integration, auth, credential, opts)
+	default:
+		return nil, fmt.Errorf("unsupported moderator %s", moderator)
+	}
+}
+
+// Rule applies a moderator to a stage.
+type Rule struct {
+	Stage     string
+	Moderator internal_type.Moderator
+	Action    Action
+	Response  string
+}
+
+// NewRule reads the action and canned response from the moderator options.
+func NewRule(stage string, moderator internal_type.Moderator, opts utils.Option) (*Rule, error) {
+	switch stage {
+	case StageInput, StageOutput:
+	default:
+		return nil, fmt.Errorf("unsupported moderation stage %s", stage)
+	}
+	rule := &Rule{Stage: stage, Moderator: moderator, Action: ActionBlock, Response: defaultResponse}
+	if action, err := opts.GetString(OptionsKeyAction); err == nil && action != "" {
+		switch Action(action) {
+		case ActionBlock, ActionRedact, ActionEndConversation:
+			rule.Action = Action(action)
+		default:
+			return nil, fmt.Errorf("unsupported moderation action %s", action)
+		}
+	}
+	if response, err := opts.GetString(OptionsKeyResponse); err == nil && strings.TrimSpace(response) != "" {
+		rule.Response = response
+	}
+	return rule, nil
+}
+
+// Decision is the outcome of moderating text at a stage.
+type Decision struct {
+	Stage      string
+	Flagged    bool
+	Action     Action
+	Moderator  string
+	Categories map[string]float64
+
+	// Text is what the turn continues with: the canned response for block
+	// and end_conversation, the masked text for redact.
+	Text string
+}
+
+// Metadata returns the decision as message metadata, keyed by stage.
+func (d *Decision) Metadata() []*protos.Metadata {
+	prefix := "moderation." + d.Stage + "."
+	categories := make([]string, 0, len(d.Categories))
+	for c := range d.Categories {
+		categories = append(categories, c)
+	}
+	sort.Strings(categories)
+	return []*protos.Metadata{
+		{Key: prefix + "flagged", Value: strconv.FormatBool(d.Flagged)},
+		{Key: prefix + "moderator", Value: d.Moderator},
+		{Key: prefix + "action", Value: string(d.Action)},
+		{Key: prefix + "categories", Value: strings.Join(categories, ",")},
+	}
+}
+
+// Moderation holds the rules of an assistant by stage. A nil Moderation has
+// no rules, so callers can skip moderation without a lookup.
+type Moderation struct {
+	logger commons.Logger
+	rules  map[string][]*Rule
+}
+
+func NewModeration(logger commons.Logger, rules ...*Rule) *Moderation {
+	m := &Moderation{logger: logger, rules: make(map[string][]*Rule)}
+	for _, r := range rules {
+		m.rules[r.Stage] = append(m.rules[r.Stage], r)
+	}
+	return m
+}
+
+// Enabled reports whether any moderator applies to the stage.
+func (m *Moderation) Enabled(stage string) bool {
+	return m != nil && len(m.rules[stage]) > 0
+}
+
+// Moderate runs the moderators of the stage concurrently and returns the
+// decision of the first flagging rule in configuration order, nil when the
+// text is clean. A failing moderator is logged and does not flag the text.
+func (m *Moderation) Moderate(ctx context.Context, stage, text string) *Decision {
+	if !m.Enabled(stage) || strings.TrimSpace(text) == "" {
+		return nil
+	}
+	rules := m.rules[stage]
+	results := make([]*internal_type.ModerationResult, len(rules))
+	var wg sync.WaitGroup
+	for i, rule := range rules {
+		wg.Add(1)
+		go func(i int, rule *Rule) {
+			defer wg.Done()
+			res, err := rule.Moderator.Moderate(ctx, text)
+			if err != nil {
+				m.logger.Warnf("moderator %s failed on %s stage, letting the text through: %v", rule.Moderator.Name(), stage, err)
+				return
+			}
+			results[i] = res
+		}(i, rule)
+	}
+	wg.Wait()
+
+	for i, res := range results {
+		if res == nil || !res.Flagged {
+			continue
+		}
+		rule := rules[i]
+		decision := &Decision{
+			Stage:      stage,
+			Flagged:    true,
+			Action:     rule.Action,
+			Moderator:  rule.Moderator.Name(),
+			Categories: res.Categories,
+			Text:       rule.Response,
+		}
+		if rule.Action == ActionRedact {
+			decision.Text = redact(text, res.Matches)
+		}
+		return decision
+	}
+	return nil
+}
+
+func redact(text string, matches []string) string {
+	if len(matches) == 0 {
+		return redactionMask
+	}
+	quoted := make([]string, 0, len(matches))
+	for _, m := range matches {
+		quoted = append(quoted, regexp.QuoteMeta(m))
+	}
+	return regexp.MustCompile(`(?i)`+strings.Join(quoted, "|")).ReplaceAllString(text, redactionMask)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_moderation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeIntegrationClient struct {
+	integration_client.IntegrationServiceClient
+	request  *protos.GetModerationRequest
+	response *protos.GetModerationResponse
+}
+
+func (f *fakeIntegrationClient) Moderation(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.GetModerationRequest) (*protos.GetModerationResponse, error) {
+	f.request = in
+	return f.response, nil
+}
+
+type failingModerator struct{}
+
+func (failingModerator) Name() string { return "failing" }
+
+func (failingModerator) Moderate(ctx context.Context, text string) (*internal_type.ModerationResult, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func newTestLogger() commons.Logger {
+	logger, _ := commons.NewApplicationLogger()
+	return logger
+}
+
+func newKeywordRule(t *testing.T, stage string, opts utils.Option) *Rule {
+	moderator, err := GetModerator(newTestLogger(), "keyword", nil, nil, nil, opts)
+	require.NoError(t, err)
+	rule, err := NewRule(stage, moderator, opts)
+	require.NoError(t, err)
+	return rule
+}
+
+func TestModeration_NilIsDisabled(t *testing.T) {
+	var m *Moderation
+	assert.False(t, m.Enabled(StageInput))
+	assert.Nil(t, m.Moderate(context.Background(), StageInput, "anything"))
+}
+
+func TestModeration_Block(t *testing.T) {
+	m := NewModeration(newTestLogger(), newKeywordRule(t, StageInput, utils.Option{
+		"moderation.keywords": "credit card, password",
+		"moderation.response": "I can't take that over the phone.",
+	}))
+	assert.True(t, m.Enabled(StageInput))
+	assert.False(t, m.Enabled(StageOutput))
+
+	assert.Nil(t, m.Moderate(context.Background(), StageInput, "what is my passwordless login"))
+
+	decision := m.Moderate(context.Background(), StageInput, "my Credit Card number is 4111")
+	require.NotNil(t, decision)
+	assert.Equal(t, ActionBlock, decision.Action)
+	assert.Equal(t, "I can't take that over the phone.", decision.Text)
+	assert.Equal(t, "keyword", decision.Moderator)
+}
+
+func TestModeration_Redact(t *testing.T) {
+	m := NewModeration(newTestLogger(), newKeywordRule(t, StageOutput, utils.Option{
+		"moderation.keywords": "secret",
+		"moderation.action":   "redact",
+	}))
+	decision := m.Moderate(context.Background(), StageOutput, "The Secret code is the secret.")
+	require.NotNil(t, decision)
+	assert.Equal(t, ActionRedact, decision.Action)
+	assert.Equal(t, "The [redacted] code is the [redacted].", decision.Text)
+	assert.Equal(t, []*protos.Metadata{
+		{Key: "moderation.output.flagged", Value: "true"},
+		{Key: "moderation.output.moderator", Value: "keyword"},
+		{Key: "moderation.output.action", Value: "redact"},
+		{Key: "moderation.output.categories", Value: "keyword"},
+	}, decision.Metadata())
+}
+
+func TestModeration_FailingModeratorLetsTextThrough(t *testing.T) {
+	failing, err := NewRule(StageInput, failingModerator{}, utils.Option{})
+	require.NoError(t, err)
+	m := NewModeration(newTestLogger(), failing, newKeywordRule(t, StageInput, utils.Option{
+		"moderation.keywords": "bomb",
+		"moderation.action":   "end_conversation",
+	}))
+
+	assert.Nil(t, m.Moderate(context.Background(), StageInput, "hello there"))
+	decision := m.Moderate(context.Background(), StageInput, "there is a bomb")
+	require.NotNil(t, decision)
+	assert.Equal(t, ActionEndConversation, decision.Action)
+	assert.Equal(t, defaultResponse, decision.Text)
+}
+
+func TestNewRule_Invalid(t *testing.T) {
+	_, err := NewRule("before_tts", failingModerator{}, utils.Option{})
+	assert.Error(t, err)
+	_, err = NewRule(StageInput, failingModerator{}, utils.Option{"moderation.action": "shout"})
+	assert.Error(t, err)
+	_, err = GetModerator(newTestLogger(), "keyword", nil, nil, nil, utils.Option{"moderation.keywords": " , "})
+	assert.Error(t, err)
+}
+
+func TestOpenAIModerator(t *testing.T) {
+	value, err := structpb.NewStruct(map[string]interface{}{"key": "sk"})
+	require.NoError(t, err)
+	client := &fakeIntegrationClient{response: &protos.GetModerationResponse{
+		Success: true,
+		Data: []*protos.Moderation{
+			{Name: "flagged", Value: "true"},
+			{Name: "harassment", Value: "0.9132"},
+		},
+	}}
+	moderator, err := GetModerator(newTestLogger(), "openai", client, nil,
+		&protos.VaultCredential{Id: 7, Value: value},
+		utils.Option{"model.name": "omni-moderation-latest", "moderation.action": "block"})
+	require.NoError(t, err)
+
+	res, err := moderator.Moderate(context.Background(), "you are useless")
+	require.NoError(t, err)
+	assert.True(t, res.Flagged)
+	assert.Equal(t, map[string]float64{"harassment": 0.9132}, res.Categories)
+	assert.Equal(t, "you are useless", client.request.GetContent())
+	assert.Equal(t, uint64(7), client.request.GetCredential().GetId())
+	assert.Contains(t, client.request.GetModelParameters(), "model.name")
+	assert.NotContains(t, client.request.GetModelParameters(), "moderation.action")
+
+	_, err = GetModerator(newTestLogger(), "openai", client, nil, nil, utils.Option{})
+	assert.Error(t, err)
+}
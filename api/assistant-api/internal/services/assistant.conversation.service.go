@@ -293,6 +293,22 @@ type AssistantConversationService interface {
 		metrics []*types.Metric,
 	) ([]*internal_conversation_entity.AssistantConversationMetric, error)
 
+	// ApplyConversationEvaluations stores the evaluator scores of a
+	// conversation, a score of the same evaluator replaces the earlier one.
+	ApplyConversationEvaluations(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		evaluations []*internal_conversation_entity.AssistantConversationEvaluation,
+	) error
+
+	// GetAllEvaluationSummary aggregates the evaluator scores of an assistant
+	// per assistant provider model, i.e. per assistant version.
+	GetAllEvaluationSummary(
+		ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+	) ([]*internal_conversation_entity.AssistantEvaluationSummary, error)
+
 	CreateConversationRecording(
 		ctx context.Context,
 		auth types.SimplePrinciple,
@@ -27,8 +27,10 @@ type GetAssistantOption struct {
 	//
 	InjectConversations bool
 
-	InjectAnalysis bool
-	InjectWebhook  bool
+	InjectAnalysis  bool
+	InjectWebhook   bool
+	InjectModerator bool
+	InjectEvaluator bool
 }
 
 func NewDefaultGetAssistantOption() *GetAssistantOption {
@@ -148,6 +150,7 @@ type AssistantDeploymentService interface {
 		greeting, mistake *string,
 		idealTimeout *uint64, idealTimeoutBackoff *uint64, idealTimeoutMessage *string, maxSessionDuration *uint64,
 		whatsappProvider string,
+		inputAudio *workflow_api.DeploymentAudioProvider,
 		opts []*workflow_api.Metadata,
 	) (*internal_assistant_entity.AssistantWhatsappDeployment, error)
 
@@ -197,4 +200,14 @@ type AssistantDeploymentService interface {
 	GetAssistantPhoneDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantPhoneDeployment, error)
 	GetAssistantWebpluginDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantWebPluginDeployment, error)
 	GetAssistantWhatsappDeployment(ctx context.Context, auth types.SimplePrinciple, assistantId uint64) (*internal_assistant_entity.AssistantWhatsappDeployment, error)
+
+	// GetAllAssistantWithPhoneDeployment returns the active assistants of all
+	// projects whose latest phone deployment uses telephonyProvider, with the
+	// deployment and its options attached.
+	GetAllAssistantWithPhoneDeployment(ctx context.Context, telephonyProvider string) ([]*internal_assistant_entity.Assistant, error)
+
+	// GetAssistantWithWhatsappDeployment returns an active assistant of any
+	// project with its latest whatsapp deployment attached, for webhooks that
+	// are authenticated by the provider rather than a principal.
+	GetAssistantWithWhatsappDeployment(ctx context.Context, assistantId uint64) (*internal_assistant_entity.Assistant, error)
 }
@@ -241,6 +241,7 @@ func (eService assistantDeploymentService) CreateWhatsappDeployment(
 	idealTimeoutBackoff *uint64,
 	idealTimeoutMessage *string, maxSessionDuration *uint64,
 	whatsappProvider string,
+	inputAudio *protos.DeploymentAudioProvider,
 	whatsappOptions []*protos.Metadata,
 ) (*internal_assistant_entity.AssistantWhatsappDeployment, error) {
 	db := eService.postgres.DB(ctx)
@@ -272,6 +273,11 @@ func (eService assistantDeploymentService) CreateWhatsappDeployment(
 		return nil, tx.Error
 	}
 
+	// voice notes are transcribed with the input audio provider
+	if inputAudio != nil {
+		eService.createAssistantDeploymentAudio(ctx, auth, deployment.Id, "input", inputAudio)
+	}
+
 	if len(whatsappOptions) == 0 {
 		return deployment, nil
 	}
@@ -481,6 +487,8 @@ func (eService assistantDeploymentService) GetAssistantWhatsappDeployment(ctx co
 	db := eService.postgres.DB(ctx)
 	var whatsappDeployment *internal_assistant_entity.AssistantWhatsappDeployment
 	qry := db.
+		Preload("InputAudio", "audio_type = ?", "input").
+		Preload("InputAudio.AudioOptions").
 		Preload("WhatsappOptions").
 		Where("assistant_id = ?", assistantId)
 	tx := qry.Order(clause.OrderByColumn{
@@ -497,3 +505,55 @@ func (eService assistantDeploymentService) GetAssistantWhatsappDeployment(ctx co
 	}
 	return whatsappDeployment, nil
 }
+
+func (eService assistantDeploymentService) GetAllAssistantWithPhoneDeployment(ctx context.Context, telephonyProvider string) ([]*internal_assistant_entity.Assistant, error) {
+	db := eService.postgres.DB(ctx)
+	var phoneDeployments []*internal_assistant_entity.AssistantPhoneDeployment
+	latest := db.Table("assistant_phone_deployments AS latest").
+		Select("MAX(latest.created_date)").
+		Where("latest.assistant_id = assistant_phone_deployments.assistant_id")
+	tx := db.
+		Preload("TelephonyOption").
+		Where("assistant_phone_deployments.telephony_provider = ? AND assistant_phone_deployments.created_date = (?)", telephonyProvider, latest).
+		Find(&phoneDeployments)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find phone deployments for provider %s with error %v", telephonyProvider, tx.Error)
+		return nil, tx.Error
+	}
+	if len(phoneDeployments) == 0 {
+		return nil, nil
+	}
+
+	byAssistant := make(map[uint64]*internal_assistant_entity.AssistantPhoneDeployment, len(phoneDeployments))
+	assistantIds := make([]uint64, 0, len(phoneDeployments))
+	for _, deployment := range phoneDeployments {
+		byAssistant[deployment.AssistantId] = deployment
+		assistantIds = append(assistantIds, deployment.AssistantId)
+	}
+	var assistants []*internal_assistant_entity.Assistant
+	tx = db.Where("id IN ? AND status = ?", assistantIds, type_enums.RECORD_ACTIVE).Find(&assistants)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find assistants of phone deployments with error %v", tx.Error)
+		return nil, tx.Error
+	}
+	for _, assistant := range assistants {
+		assistant.AssistantPhoneDeployment = byAssistant[assistant.Id]
+	}
+	return assistants, nil
+}
+
+func (eService assistantDeploymentService) GetAssistantWithWhatsappDeployment(ctx context.Context, assistantId uint64) (*internal_assistant_entity.Assistant, error) {
+	db := eService.postgres.DB(ctx)
+	var assistant *internal_assistant_entity.Assistant
+	tx := db.Where("id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE).First(&assistant)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find assistant %d with error %v", assistantId, tx.Error)
+		return nil, tx.Error
+	}
+	deployment, err := eService.GetAssistantWhatsappDeployment(ctx, nil, assistantId)
+	if err != nil {
+		return nil, err
+	}
+	assistant.AssistantWhatsappDeployment = deployment
+	return assistant, nil
+}
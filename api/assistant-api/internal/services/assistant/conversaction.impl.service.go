@@ -448,6 +448,56 @@ func (conversationService *assistantConversationService) ApplyConversationMetric
 	return mtrs, nil
 }
 
+func (conversationService *assistantConversationService) ApplyConversationEvaluations(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	evaluations []*internal_conversation_entity.AssistantConversationEvaluation,
+) error {
+	start := time.Now()
+	if len(evaluations) == 0 {
+		return nil
+	}
+	for _, e := range evaluations {
+		e.OrganizationId = *auth.GetCurrentOrganizationId()
+		e.ProjectId = *auth.GetCurrentProjectId()
+	}
+	tx := conversationService.postgres.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "assistant_conversation_id"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"evaluator", "score", "passed", "reason", "updated_date"}),
+	}).Create(&evaluations)
+	conversationService.logger.Benchmark("conversationService.ApplyConversationEvaluations", time.Since(start))
+	if tx.Error != nil {
+		conversationService.logger.Errorf("error while storing conversation evaluations %v", tx.Error)
+		return tx.Error
+	}
+	return nil
+}
+
+func (conversationService *assistantConversationService) GetAllEvaluationSummary(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+) ([]*internal_conversation_entity.AssistantEvaluationSummary, error) {
+	start := time.Now()
+	var summaries []*internal_conversation_entity.AssistantEvaluationSummary
+	tx := conversationService.postgres.DB(ctx).
+		Model(&internal_conversation_entity.AssistantConversationEvaluation{}).
+		Select(`assistant_provider_model_id, name, MAX(evaluator) AS evaluator, COUNT(*) AS count,
+			AVG(score) AS average_score, AVG(CASE WHEN passed THEN 1.0 ELSE 0.0 END) AS pass_rate`).
+		Where("assistant_id = ? AND organization_id = ? AND project_id = ?",
+			assistantId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId()).
+		Group("assistant_provider_model_id, name").
+		Order("assistant_provider_model_id DESC, name").
+		Scan(&summaries)
+	conversationService.logger.Benchmark("conversationService.GetAllEvaluationSummary", time.Since(start))
+	if tx.Error != nil {
+		conversationService.logger.Errorf("error while aggregating evaluations of assistant %d %v", assistantId, tx.Error)
+		return nil, tx.Error
+	}
+	return summaries, nil
+}
+
 /* */
 func (conversationService *assistantConversationService) CreateConversationMetric(
 	ctx context.Context,
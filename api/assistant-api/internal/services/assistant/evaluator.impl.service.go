@@ -0,0 +1,247 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_evaluation "github.com/rapidaai/api/assistant-api/internal/evaluation"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm/clause"
+)
+
+type assistantEvaluatorService struct {
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+}
+
+func NewAssistantEvaluatorService(logger commons.Logger, postgres connectors.PostgresConnector) internal_services.AssistantEvaluatorService {
+	return &assistantEvaluatorService{
+		logger:   logger,
+		postgres: postgres,
+	}
+}
+
+// validateEvaluator rejects evaluator types the post call processing would
+// silently skip.
+func validateEvaluator(evaluatorType string) error {
+	switch internal_evaluation.EvaluatorIdentifier(evaluatorType) {
+	case internal_evaluation.AssertionEvaluator, internal_evaluation.LatencyEvaluator, internal_evaluation.LLMJudgeEvaluator:
+		return nil
+	default:
+		return fmt.Errorf("unsupported evaluator %s", evaluatorType)
+	}
+}
+
+// Create implements internal_services.AssistantEvaluatorService.
+func (eService *assistantEvaluatorService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	evaluatorType string,
+	name string,
+	options []*protos.Metadata,
+) (*internal_assistant_entity.AssistantEvaluator, error) {
+	start := time.Now()
+	if err := validateEvaluator(evaluatorType); err != nil {
+		return nil, err
+	}
+	db := eService.postgres.DB(ctx)
+	evaluator := &internal_assistant_entity.AssistantEvaluator{
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_ACTIVE,
+		},
+		AssistantId: assistantId,
+		Type:        evaluatorType,
+		Name:        name,
+	}
+	tx := db.Create(&evaluator)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantEvaluatorService.Create", time.Since(start))
+		eService.logger.Errorf("error while creating evaluator %v", tx.Error)
+		return nil, tx.Error
+	}
+
+	opts, err := eService.createOrUpdateOptions(ctx, auth, evaluator.Id, options)
+	if err != nil {
+		eService.logger.Benchmark("assistantEvaluatorService.Create", time.Since(start))
+		return nil, err
+	}
+	evaluator.Options = opts
+	eService.logger.Benchmark("assistantEvaluatorService.Create", time.Since(start))
+	return evaluator, nil
+}
+
+// Update implements internal_services.AssistantEvaluatorService.
+func (eService *assistantEvaluatorService) Update(ctx context.Context,
+	auth types.SimplePrinciple,
+	evaluatorId uint64,
+	assistantId uint64,
+	evaluatorType string,
+	name string,
+	options []*protos.Metadata,
+) (*internal_assistant_entity.AssistantEvaluator, error) {
+	start := time.Now()
+	if err := validateEvaluator(evaluatorType); err != nil {
+		return nil, err
+	}
+	db := eService.postgres.DB(ctx)
+	evaluator := &internal_assistant_entity.AssistantEvaluator{
+		Mutable: gorm_models.Mutable{
+			UpdatedBy: *auth.GetUserId(),
+		},
+		Type: evaluatorType,
+		Name: name,
+	}
+	tx := db.Where("id = ? AND assistant_id = ? AND status = ?",
+		evaluatorId,
+		assistantId, type_enums.RECORD_ACTIVE).Updates(&evaluator)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantEvaluatorService.Update", time.Since(start))
+		eService.logger.Errorf("error while updating evaluator %v", tx.Error)
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		eService.logger.Benchmark("assistantEvaluatorService.Update", time.Since(start))
+		return nil, fmt.Errorf("evaluator %d not found for assistant %d", evaluatorId, assistantId)
+	}
+
+	// options are replaced as a whole, the ones left out are archived
+	tx = db.Where("assistant_evaluator_id = ?", evaluatorId).
+		Updates(&internal_assistant_entity.AssistantEvaluatorOption{
+			Mutable: gorm_models.Mutable{
+				Status:    type_enums.RECORD_ARCHIEVE,
+				UpdatedBy: *auth.GetUserId(),
+			},
+		})
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantEvaluatorService.Update", time.Since(start))
+		eService.logger.Errorf("error while archiving evaluator options %v", tx.Error)
+		return nil, tx.Error
+	}
+	opts, err := eService.createOrUpdateOptions(ctx, auth, evaluatorId, options)
+	if err != nil {
+		eService.logger.Benchmark("assistantEvaluatorService.Update", time.Since(start))
+		return nil, err
+	}
+	evaluator.Id = evaluatorId
+	evaluator.AssistantId = assistantId
+	evaluator.Options = opts
+	eService.logger.Benchmark("assistantEvaluatorService.Update", time.Since(start))
+	return evaluator, nil
+}
+
+// Delete implements internal_services.AssistantEvaluatorService.
+func (eService *assistantEvaluatorService) Delete(ctx context.Context,
+	auth types.SimplePrinciple,
+	evaluatorId uint64,
+	assistantId uint64,
+) (*internal_assistant_entity.AssistantEvaluator, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	evaluator := &internal_assistant_entity.AssistantEvaluator{
+		Mutable: gorm_models.Mutable{
+			Status:    type_enums.RECORD_ARCHIEVE,
+			UpdatedBy: *auth.GetUserId(),
+		},
+	}
+	tx := db.Where("id = ? AND assistant_id = ? ",
+		evaluatorId,
+		assistantId).Updates(&evaluator)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantEvaluatorService.Delete", time.Since(start))
+		eService.logger.Errorf("error while deleting evaluator %v", tx.Error)
+		return nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantEvaluatorService.Delete", time.Since(start))
+	return evaluator, nil
+}
+
+// GetAll implements internal_services.AssistantEvaluatorService.
+func (eService *assistantEvaluatorService) GetAll(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantEvaluator, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var (
+		evaluators []*internal_assistant_entity.AssistantEvaluator
+		cnt        int64
+	)
+	qry := db.Model(internal_assistant_entity.AssistantEvaluator{})
+	qry = qry.
+		Preload("Options", "status = ?", type_enums.RECORD_ACTIVE).
+		Where("assistant_id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE)
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&evaluators)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any evaluators %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantEvaluatorService.GetAll", time.Since(start))
+	return cnt, evaluators, nil
+}
+
+func (eService *assistantEvaluatorService) createOrUpdateOptions(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	evaluatorId uint64,
+	metadata []*protos.Metadata,
+) ([]*internal_assistant_entity.AssistantEvaluatorOption, error) {
+	opts := make([]*internal_assistant_entity.AssistantEvaluatorOption, 0, len(metadata))
+	if len(metadata) == 0 {
+		return opts, nil
+	}
+	for _, mtr := range metadata {
+		opts = append(opts, &internal_assistant_entity.AssistantEvaluatorOption{
+			Metadata: gorm_models.Metadata{
+				Key:   mtr.GetKey(),
+				Value: mtr.GetValue(),
+			},
+			Mutable: gorm_models.Mutable{
+				Status:    type_enums.RECORD_ACTIVE,
+				CreatedBy: *auth.GetUserId(),
+				UpdatedBy: *auth.GetUserId(),
+			},
+			AssistantEvaluatorId: evaluatorId,
+		})
+	}
+	tx := eService.postgres.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}, {Name: "assistant_evaluator_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"status",
+			"value",
+			"updated_by", "updated_date"}),
+	}).Create(&opts)
+	if tx.Error != nil {
+		eService.logger.Errorf("error while updating evaluator options %v", tx.Error)
+		return nil, tx.Error
+	}
+	return opts, nil
+}
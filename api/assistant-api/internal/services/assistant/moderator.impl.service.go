@@ -0,0 +1,254 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_assistant_service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_moderation "github.com/rapidaai/api/assistant-api/internal/moderation"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/protos"
+	"gorm.io/gorm/clause"
+)
+
+type assistantModeratorService struct {
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+}
+
+func NewAssistantModeratorService(logger commons.Logger, postgres connectors.PostgresConnector) internal_services.AssistantModeratorService {
+	return &assistantModeratorService{
+		logger:   logger,
+		postgres: postgres,
+	}
+}
+
+// validateModerator rejects stages and moderator types the pipeline would
+// silently skip.
+func validateModerator(stage, moderatorType string) error {
+	if stage != internal_moderation.StageInput && stage != internal_moderation.StageOutput {
+		return fmt.Errorf("unsupported moderation stage %s", stage)
+	}
+	switch internal_moderation.ModeratorIdentifier(moderatorType) {
+	case internal_moderation.KeywordModerator, internal_moderation.OpenAIModerator:
+		return nil
+	default:
+		return fmt.Errorf("unsupported moderator %s", moderatorType)
+	}
+}
+
+// Create implements internal_services.AssistantModeratorService.
+func (eService *assistantModeratorService) Create(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	stage string,
+	moderatorType string,
+	name string,
+	options []*protos.Metadata,
+) (*internal_assistant_entity.AssistantModerator, error) {
+	start := time.Now()
+	if err := validateModerator(stage, moderatorType); err != nil {
+		return nil, err
+	}
+	db := eService.postgres.DB(ctx)
+	moderator := &internal_assistant_entity.AssistantModerator{
+		Mutable: gorm_models.Mutable{
+			CreatedBy: *auth.GetUserId(),
+			Status:    type_enums.RECORD_ACTIVE,
+		},
+		AssistantId: assistantId,
+		Stage:       stage,
+		Type:        moderatorType,
+		Name:        name,
+	}
+	tx := db.Create(&moderator)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantModeratorService.Create", time.Since(start))
+		eService.logger.Errorf("error while creating moderator %v", tx.Error)
+		return nil, tx.Error
+	}
+
+	opts, err := eService.createOrUpdateOptions(ctx, auth, moderator.Id, options)
+	if err != nil {
+		eService.logger.Benchmark("assistantModeratorService.Create", time.Since(start))
+		return nil, err
+	}
+	moderator.Options = opts
+	eService.logger.Benchmark("assistantModeratorService.Create", time.Since(start))
+	return moderator, nil
+}
+
+// Update implements internal_services.AssistantModeratorService.
+func (eService *assistantModeratorService) Update(ctx context.Context,
+	auth types.SimplePrinciple,
+	moderatorId uint64,
+	assistantId uint64,
+	stage string,
+	moderatorType string,
+	name string,
+	options []*protos.Metadata,
+) (*internal_assistant_entity.AssistantModerator, error) {
+	start := time.Now()
+	if err := validateModerator(stage, moderatorType); err != nil {
+		return nil, err
+	}
+	db := eService.postgres.DB(ctx)
+	moderator := &internal_assistant_entity.AssistantModerator{
+		Mutable: gorm_models.Mutable{
+			UpdatedBy: *auth.GetUserId(),
+		},
+		Stage: stage,
+		Type:  moderatorType,
+		Name:  name,
+	}
+	tx := db.Where("id = ? AND assistant_id = ? AND status = ?",
+		moderatorId,
+		assistantId, type_enums.RECORD_ACTIVE).Updates(&moderator)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantModeratorService.Update", time.Since(start))
+		eService.logger.Errorf("error while updating moderator %v", tx.Error)
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		eService.logger.Benchmark("assistantModeratorService.Update", time.Since(start))
+		return nil, fmt.Errorf("moderator %d not found for assistant %d", moderatorId, assistantId)
+	}
+
+	// options are replaced as a whole, the ones left out are archived
+	tx = db.Where("assistant_moderator_id = ?", moderatorId).
+		Updates(&internal_assistant_entity.AssistantModeratorOption{
+			Mutable: gorm_models.Mutable{
+				Status:    type_enums.RECORD_ARCHIEVE,
+				UpdatedBy: *auth.GetUserId(),
+			},
+		})
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantModeratorService.Update", time.Since(start))
+		eService.logger.Errorf("error while archiving moderator options %v", tx.Error)
+		return nil, tx.Error
+	}
+	opts, err := eService.createOrUpdateOptions(ctx, auth, moderatorId, options)
+	if err != nil {
+		eService.logger.Benchmark("assistantModeratorService.Update", time.Since(start))
+		return nil, err
+	}
+	moderator.Id = moderatorId
+	moderator.AssistantId = assistantId
+	moderator.Options = opts
+	eService.logger.Benchmark("assistantModeratorService.Update", time.Since(start))
+	return moderator, nil
+}
+
+// Delete implements internal_services.AssistantModeratorService.
+func (eService *assistantModeratorService) Delete(ctx context.Context,
+	auth types.SimplePrinciple,
+	moderatorId uint64,
+	assistantId uint64,
+) (*internal_assistant_entity.AssistantModerator, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	moderator := &internal_assistant_entity.AssistantModerator{
+		Mutable: gorm_models.Mutable{
+			Status:    type_enums.RECORD_ARCHIEVE,
+			UpdatedBy: *auth.GetUserId(),
+		},
+	}
+	tx := db.Where("id = ? AND assistant_id = ? ",
+		moderatorId,
+		assistantId).Updates(&moderator)
+	if tx.Error != nil {
+		eService.logger.Benchmark("assistantModeratorService.Delete", time.Since(start))
+		eService.logger.Errorf("error while deleting moderator %v", tx.Error)
+		return nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantModeratorService.Delete", time.Since(start))
+	return moderator, nil
+}
+
+// GetAll implements internal_services.AssistantModeratorService.
+func (eService *assistantModeratorService) GetAll(ctx context.Context,
+	auth types.SimplePrinciple,
+	assistantId uint64,
+	criterias []*protos.Criteria,
+	paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantModerator, error) {
+	start := time.Now()
+	db := eService.postgres.DB(ctx)
+	var (
+		moderators []*internal_assistant_entity.AssistantModerator
+		cnt        int64
+	)
+	qry := db.Model(internal_assistant_entity.AssistantModerator{})
+	qry = qry.
+		Preload("Options", "status = ?", type_enums.RECORD_ACTIVE).
+		Where("assistant_id = ? AND status = ?", assistantId, type_enums.RECORD_ACTIVE)
+	for _, ct := range criterias {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).Find(&moderators)
+	if tx.Error != nil {
+		eService.logger.Errorf("not able to find any moderators %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+	eService.logger.Benchmark("assistantModeratorService.GetAll", time.Since(start))
+	return cnt, moderators, nil
+}
+
+func (eService *assistantModeratorService) createOrUpdateOptions(
+	ctx context.Context,
+	auth types.SimplePrinciple,
+	moderatorId uint64,
+	metadata []*protos.Metadata,
+) ([]*internal_assistant_entity.AssistantModeratorOption, error) {
+	opts := make([]*internal_assistant_entity.AssistantModeratorOption, 0, len(metadata))
+	if len(metadata) == 0 {
+		return opts, nil
+	}
+	for _, mtr := range metadata {
+		opts = append(opts, &internal_assistant_entity.AssistantModeratorOption{
+			Metadata: gorm_models.Metadata{
+				Key:   mtr.GetKey(),
+				Value: mtr.GetValue(),
+			},
+			Mutable: gorm_models.Mutable{
+				Status:    type_enums.RECORD_ACTIVE,
+				CreatedBy: *auth.GetUserId(),
+				UpdatedBy: *auth.GetUserId(),
+			},
+			AssistantModeratorId: moderatorId,
+		})
+	}
+	tx := eService.postgres.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}, {Name: "assistant_moderator_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"status",
+			"value",
+			"updated_by", "updated_date"}),
+	}).Create(&opts)
+	if tx.Error != nil {
+		eService.logger.Errorf("error while updating moderator options %v", tx.Error)
+		return nil, tx.Error
+	}
+	return opts, nil
+}
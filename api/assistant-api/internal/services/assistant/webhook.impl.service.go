@@ -247,6 +247,7 @@ func (eService *assistantWebhookService) CreateLog(
 		ResponseStatus:          responseStatus,
 		Event:                   event,
 		TimeTaken:               timeTaken,
+		RetryCount:              retryCount,
 		Organizational: gorm_models.Organizational{
 			ProjectId:      *auth.GetCurrentProjectId(),
 			OrganizationId: *auth.GetCurrentOrganizationId(),
@@ -7,7 +7,6 @@ package internal_knowledge_service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -32,24 +31,24 @@ import (
 )
 
 type knowledgeDocumentService struct {
-	config     *config.AssistantConfig
-	logger     commons.Logger
-	postgres   connectors.PostgresConnector
-	opensearch connectors.OpenSearchConnector
-	storage    storages.Storage
+	config   *config.AssistantConfig
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+	vectordb connectors.VectorConnector
+	storage  storages.Storage
 }
 
 var (
 	KNOWLEDGE_DOCUMENT_PREFIX = "knowledge-document__"
 )
 
-func NewKnowledgeDocumentService(config *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, opensearch connectors.OpenSearchConnector) internal_services.KnowledgeDocumentService {
+func NewKnowledgeDocumentService(config *config.AssistantConfig, logger commons.Logger, postgres connectors.PostgresConnector, vectordb connectors.VectorConnector) internal_services.KnowledgeDocumentService {
 	return &knowledgeDocumentService{
-		config:     config,
-		logger:     logger,
-		postgres:   postgres,
-		opensearch: opensearch,
-		storage:    storage_files.NewStorage(config.AssetStoreConfig, logger),
+		config:   config,
+		logger:   logger,
+		postgres: postgres,
+		vectordb: vectordb,
+		storage:  storage_files.NewStorage(config.AssetStoreConfig, logger),
 	}
 }
 
@@ -288,76 +287,25 @@ func (knowledge *knowledgeDocumentService) GetAllDocumentSegment(
 	storageNamespace string,
 	criterias []*protos.Criteria,
 	paginate *protos.Paginate) (int64, []*protos.KnowledgeDocumentSegment, error) {
-	indexs := make([]string, 0)
-	indexs = append(indexs, storageNamespace)
-	// Construct the OpenSearch query
-	query := map[string]interface{}{
-		"query": map[string]interface{}{
-			"bool": map[string]interface{}{
-				"must": []map[string]interface{}{
-					{
-						"term": map[string]uint64{
-							"metadata.knowledge_id": knowledgeId,
-						},
-					},
-					{
-						"term": map[string]uint64{
-							"metadata.project_id": *auth.GetCurrentProjectId(),
-						},
-					},
-					{
-						"term": map[string]uint64{
-							"metadata.organization_id": *auth.GetCurrentOrganizationId(),
-						},
-					},
-				},
-			},
-		},
-		"_source": map[string]interface{}{
-			"includes": []string{
-				"document_hash",
-				"document_id",
-				"text",
-				"metadata",
-				"entities",
-			},
-		},
+	filter := map[string]interface{}{
+		"knowledge_id":    knowledgeId,
+		"project_id":      *auth.GetCurrentProjectId(),
+		"organization_id": *auth.GetCurrentOrganizationId(),
 	}
-
-	// Add additional criteria to the query
 	for _, ct := range criterias {
-		query["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"] = append(
-			query["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"term": map[string]interface{}{
-					fmt.Sprintf("metadata.%s", ct.GetKey()): ct.GetValue(),
-				},
-			},
-		)
+		filter[ct.GetKey()] = ct.GetValue()
 	}
 
-	// Set up pagination
 	from := (paginate.GetPage() - 1) * paginate.GetPageSize()
 	size := paginate.GetPageSize()
-
-	// Add pagination to the query
-	query["from"] = from
-	query["size"] = size
-
-	searchBodyJSON, err := json.Marshal(query)
+	total, hits, err := knowledge.vectordb.ListDocuments(ctx, storageNamespace, filter, int(from), int(size))
 	if err != nil {
-		knowledge.logger.Errorf("Error marshaling search body: %v", err)
-		return 0, nil, err
-	}
-
-	result := knowledge.opensearch.SearchWithCount(ctx, indexs, string(searchBodyJSON))
-	if result.Err != nil {
-		knowledge.logger.Errorf("Error while searching opensearch: %v", err)
+		knowledge.logger.Errorf("Error while listing document segments: %v", err)
 		return 0, nil, err
 	}
 
 	segments := make([]*protos.KnowledgeDocumentSegment, 0)
-	for _, hit := range result.Hits.Hits {
+	for _, hit := range hits {
 		index, _ := hit["_index"].(string)
 		source, ok := hit["_source"].(map[string]interface{})
 		if !ok {
@@ -378,7 +326,7 @@ func (knowledge *knowledgeDocumentService) GetAllDocumentSegment(
 		segment.Index = index
 		segments = append(segments, segment)
 	}
-	return int64(result.Hits.Total), segments, nil
+	return total, segments, nil
 }
 
 func (knowledge *knowledgeDocumentService) UpdateDocumentSegment(
@@ -397,14 +345,12 @@ func (knowledge *knowledgeDocumentService) UpdateDocumentSegment(
 	locations []string,
 	industries []string,
 ) (*protos.KnowledgeDocumentSegment, error) {
-	// Construct the update query
-	updateQuery := map[string]interface{}{
-		"doc": map[string]interface{}{},
-	}
+	// Construct the partial document
+	doc := map[string]interface{}{}
 
 	// Add metadata if documentName is not empty
 	if documentName != "" {
-		updateQuery["doc"].(map[string]interface{})["metadata"] = map[string]interface{}{
+		doc["metadata"] = map[string]interface{}{
 			"document_name": documentName,
 		}
 	}
@@ -441,18 +387,12 @@ func (knowledge *knowledgeDocumentService) UpdateDocumentSegment(
 		entities["industries"] = industries
 	}
 
-	// Add entities to updateQuery if not empty
+	// Add entities to the document if not empty
 	if len(entities) > 0 {
-		updateQuery["doc"].(map[string]interface{})["entities"] = entities
-	}
-
-	updateBodyJSON, err := json.Marshal(updateQuery)
-	if err != nil {
-		knowledge.logger.Errorf("Error marshaling update body: %s", err)
-		return nil, err
+		doc["entities"] = entities
 	}
 
-	err = knowledge.opensearch.Update(ctx, index, documentId, string(updateBodyJSON))
+	err := knowledge.vectordb.UpdateDocument(ctx, index, documentId, doc)
 	if err != nil {
 		knowledge.logger.Errorf("Error updating document segment: %s", err)
 		return nil, err
@@ -468,19 +408,10 @@ func (knowledge *knowledgeDocumentService) DeleteDocumentSegment(
 	reason string,
 ) (*protos.KnowledgeDocumentSegment, error) {
 	// Update the document status directly
-	updateBody := map[string]interface{}{
-		"doc": map[string]interface{}{
-			"status":          type_enums.RECORD_ARCHIEVE.String(), // Assuming there's a status field, adjust as needed
-			"archieve_reason": reason,
-		},
-	}
-	updateBodyJSON, err := json.Marshal(updateBody)
-	if err != nil {
-		knowledge.logger.Errorf("Error marshaling update body: %s", err)
-		return nil, err
-	}
-
-	err = knowledge.opensearch.Update(ctx, index, documentId, string(updateBodyJSON))
+	err := knowledge.vectordb.UpdateDocument(ctx, index, documentId, map[string]interface{}{
+		"status":          type_enums.RECORD_ARCHIEVE.String(), // Assuming there's a status field, adjust as needed
+		"archieve_reason": reason,
+	})
 	if err != nil {
 		knowledge.logger.Errorf("Error updating document segment: %s", err)
 		return nil, err
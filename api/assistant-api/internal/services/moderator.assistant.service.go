@@ -0,0 +1,46 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_services
+
+import (
+	"context"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	"github.com/rapidaai/pkg/types"
+	protos "github.com/rapidaai/protos"
+)
+
+type AssistantModeratorService interface {
+	GetAll(ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		criterias []*protos.Criteria,
+		paginate *protos.Paginate) (int64, []*internal_assistant_entity.AssistantModerator, error)
+
+	Create(ctx context.Context,
+		auth types.SimplePrinciple,
+		assistantId uint64,
+		stage string,
+		moderatorType string,
+		name string,
+		options []*protos.Metadata,
+	) (*internal_assistant_entity.AssistantModerator, error)
+
+	Update(ctx context.Context,
+		auth types.SimplePrinciple,
+		moderatorId uint64,
+		assistantId uint64,
+		stage string,
+		moderatorType string,
+		name string,
+		options []*protos.Metadata,
+	) (*internal_assistant_entity.AssistantModerator, error)
+
+	Delete(ctx context.Context,
+		auth types.SimplePrinciple,
+		moderatorId uint64,
+		assistantId uint64) (*internal_assistant_entity.AssistantModerator, error)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_type
+
+import (
+	"context"
+)
+
+// EvaluationInput is a completed conversation handed to the evaluators.
+type EvaluationInput struct {
+	// Messages holds the transcript in the order it was spoken.
+	Messages []MessagePacket
+
+	// Metrics holds the conversation metrics by name.
+	Metrics map[string]string
+}
+
+// EvaluationResult is the score an evaluator gives to a conversation.
+type EvaluationResult struct {
+	// Score is normalized between 0 and 1.
+	Score  float64
+	Passed bool
+	Reason string
+}
+
+type Evaluator interface {
+	Name() string
+	Evaluate(ctx context.Context, in *EvaluationInput) (*EvaluationResult, error)
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_type
+
+import (
+	"context"
+)
+
+// ModerationResult is the verdict of a moderator on a piece of text.
+type ModerationResult struct {
+	Flagged bool
+
+	// Categories holds the flagged categories with their scores.
+	Categories map[string]float64
+
+	// Matches holds the flagged substrings when the moderator can locate
+	// them, redaction masks the whole text otherwise.
+	Matches []string
+}
+
+type Moderator interface {
+	Name() string
+	Moderate(ctx context.Context, text string) (*ModerationResult, error)
+}
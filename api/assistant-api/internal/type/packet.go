@@ -287,6 +287,21 @@ func (f StaticPacket) Role() string {
 	return "rapida"
 }
 
+// FillerPacket is spoken while the assistant is busy, e.g. on a slow tool
+// call. Unlike StaticPacket it is not part of the conversation, it never
+// reaches the LLM history and leaves the turn state as is.
+type FillerPacket struct {
+	// contextID of the turn the filler is spoken in.
+	ContextID string
+
+	// message
+	Text string
+}
+
+func (f FillerPacket) ContextId() string {
+	return f.ContextID
+}
+
 // =============================================================================
 // LLM Packets end
 // =============================================================================
@@ -358,6 +373,51 @@ func (f UserAudioPacket) Role() string {
 	return "user"
 }
 
+// DTMFPacket carries a single key press from a telephony channel. Key presses
+// are collected into user input before they reach the executor.
+type DTMFPacket struct {
+	// contextID identifies the context the key press belongs to.
+	ContextID string
+
+	// Digit is the pressed key: 0-9, *, #, A-D
+	Digit string
+
+	// Duration of the tone in milliseconds, 0 when unknown.
+	Duration uint32
+}
+
+func (f DTMFPacket) ContextId() string {
+	return f.ContextID
+}
+
+// DTMFInputPacket carries the digits of a completed key press collection,
+// they are handed to the executor as the user's input.
+type DTMFInputPacket struct {
+	// contextID identifies the context the input belongs to.
+	ContextID string
+
+	// Digits collected in order, without the terminator.
+	Digits string
+}
+
+func (f DTMFInputPacket) ContextId() string {
+	return f.ContextID
+}
+
+// SendDTMFPacket asks the channel to send key presses to the remote party,
+// e.g. to navigate a downstream IVR.
+type SendDTMFPacket struct {
+	// contextID identifies the context the request belongs to.
+	ContextID string
+
+	// Digits to send in order: 0-9, *, #, A-D
+	Digits string
+}
+
+func (f SendDTMFPacket) ContextId() string {
+	return f.ContextID
+}
+
 // =============================================================================
 // End of speech Packet
 // =============================================================================
@@ -412,6 +472,19 @@ type KnowledgeRetrieveOption struct {
 	RetrievalMethod             string
 	TopK                        uint32
 	ScoreThreshold              float32
+
+	// Rerank, when set, over-fetches candidates and reorders them with a
+	// reranking model before trimming to TopK.
+	Rerank *KnowledgeRerankOption
+}
+
+// KnowledgeRerankOption configures the rerank stage of knowledge retrieval
+type KnowledgeRerankOption struct {
+	ProviderCredential *protos.VaultCredential
+	ModelProviderName  string
+	Options            map[string]interface{}
+	// Candidates is the number of results fetched for reranking
+	Candidates uint32
 }
 
 type KnowledgeContextResult struct {
@@ -420,4 +493,6 @@ type KnowledgeContextResult struct {
 	Metadata   map[string]interface{} `json:"metadata"`
 	Content    string                 `json:"content"`
 	Score      float64                `json:"score"`
+	// RerankScore is the reranking model's relevance, set when reranked
+	RerankScore float64 `json:"rerank_score,omitempty"`
 }
@@ -7,6 +7,7 @@
 package internal_type
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -74,6 +75,34 @@ type Telephony interface {
 	OutboundCall(auth types.SimplePrinciple, toPhone string, fromPhone string, assistantId, assistantConversationId uint64, vaultCredential *protos.VaultCredential, opts utils.Option) (*CallInfo, error)
 	// InboundCall instructs the provider to answer/connect the inbound call.
 	InboundCall(c *gin.Context, auth types.SimplePrinciple, assistantId uint64, clientNumber string, assistantConversationId uint64) error
+	// TransferCall hands the live call identified by channelUUID over to the given
+	// destination (phone number or SIP URI) and returns structured call info.
+	TransferCall(auth types.SimplePrinciple, channelUUID string, toPhone string, vaultCredential *protos.VaultCredential, opts utils.Option) (*CallInfo, error)
+}
+
+// CallTransferer is implemented by telephony streamers that can transfer the live
+// call they are carrying. On success the streamer releases the media connection
+// without hanging up, the call continues with the transfer destination.
+type CallTransferer interface {
+	TransferCall(ctx context.Context, toPhone string, opts utils.Option) (*CallInfo, error)
+}
+
+// TransferDestinationResolver is implemented by telephony providers whose flow
+// fetches the destination of a transferred call once the media stream ended.
+type TransferDestinationResolver interface {
+	TransferDestination(c *gin.Context, transferTo string) error
+}
+
+// DTMFSender is implemented by telephony streamers that can send key presses
+// to the remote party of the live call they are carrying.
+type DTMFSender interface {
+	SendDTMF(ctx context.Context, digits string) error
+}
+
+// RegistrationSyncer reconciles the SIP registrations with the phone
+// deployments, it is called once a phone deployment or an assistant changed.
+type RegistrationSyncer interface {
+	SyncRegistrations(ctx context.Context) error
 }
 
 // GetContextAnswerPath returns the contextId-based WebSocket path for media streaming.
@@ -0,0 +1,74 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_ten_vad
+
+// segment is a detected stretch of speech, in seconds since the stream
+// started. EndAt stays 0 while the speech continues past the current chunk.
+type segment struct {
+	StartAt float64
+	EndAt   float64
+}
+
+// segmenter turns per-hop speech probabilities into speech segments with the
+// same hysteresis as the Silero detector: speech starts at threshold and
+// ends once the probability stays below threshold-0.15 for minSilence, with
+// both edges padded by speechPad. This keeps the interruption timing the
+// same whichever provider is selected.
+type segmenter struct {
+	sampleRate        int
+	threshold         float32
+	minSilenceSamples int
+	speechPadSamples  int
+
+	currSample int
+	triggered  bool
+	tempEnd    int
+}
+
+func newSegmenter(sampleRate int, threshold float32, minSilenceMs, speechPadMs int) *segmenter {
+	return &segmenter{
+		sampleRate:        sampleRate,
+		threshold:         threshold,
+		minSilenceSamples: minSilenceMs * sampleRate / 1000,
+		speechPadSamples:  speechPadMs * sampleRate / 1000,
+	}
+}
+
+// push accounts one hop of hopSize samples with the given probability and
+// appends to or closes the last of segments. A speech end whose start was
+// reported in an earlier chunk has no segment to close and is only tracked.
+func (s *segmenter) push(segments []segment, probability float32, hopSize int) []segment {
+	s.currSample += hopSize
+
+	if probability >= s.threshold && s.tempEnd != 0 {
+		s.tempEnd = 0
+	}
+
+	if probability >= s.threshold && !s.triggered {
+		s.triggered = true
+		startAt := float64(s.currSample-hopSize-s.speechPadSamples) / float64(s.sampleRate)
+		if startAt < 0 {
+			startAt = 0
+		}
+		return append(segments, segment{StartAt: startAt})
+	}
+
+	if probability < s.threshold-0.15 && s.triggered {
+		if s.tempEnd == 0 {
+			s.tempEnd = s.currSample
+		}
+		if s.currSample-s.tempEnd < s.minSilenceSamples {
+			return segments
+		}
+		endAt := float64(s.tempEnd+s.speechPadSamples) / float64(s.sampleRate)
+		s.tempEnd = 0
+		s.triggered = false
+		if len(segments) > 0 {
+			segments[len(segments)-1].EndAt = endAt
+		}
+	}
+	return segments
+}
@@ -1,12 +1,232 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
 package internal_ten_vad
 
 import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_audio_resampler "github.com/rapidaai/api/assistant-api/internal/audio/resampler"
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 )
 
-func NewTenVad(logger commons.Logger, inputAudio *protos.AudioConfig, options utils.Option) (internal_type.Vad, error) {
-	return nil, nil
+// -----------------------------------------------------------------------------
+// Constants
+// -----------------------------------------------------------------------------
+
+const (
+	// vadName is the identifier for this VAD implementation
+	vadName = "ten_vad"
+
+	// Default configuration values, shared with Silero so both providers
+	// report the same speech boundaries.
+	defaultThreshold            = 0.5
+	defaultMinSilenceDurationMs = 100
+	defaultSpeechPadMs          = 30
+
+	// TEN VAD runs on 16kHz audio in hops of 256 samples (16ms), the size
+	// it was trained with.
+	vadSampleRate = 16000
+	hopSize       = 256
+)
+
+// -----------------------------------------------------------------------------
+// TenVAD - Voice Activity Detection using TEN VAD
+// -----------------------------------------------------------------------------
+
+// TenVAD implements the Vad interface using the TEN VAD native library. It
+// is lighter than Silero (no ONNX runtime, 16ms hops) and emits the same
+// interruption packets.
+type TenVAD struct {
+	// Core dependencies
+	logger   commons.Logger
+	onPacket func(ctx context.Context, pkt ...internal_type.Packet) error
+
+	// Audio processing pipeline
+	audioSampler internal_type.AudioResampler
+
+	// Audio configuration
+	inputConfig *protos.AudioConfig // Input audio format from caller
+	vadConfig   *protos.AudioConfig // Required format for VAD (16kHz mono)
+
+	// TEN detector (CGO-backed) and the speech state fed by it. pending
+	// carries samples short of a full hop over to the next packet.
+	detector  *tenDetector
+	segmenter *segmenter
+	pending   []int16
+
+	// mu serializes Process against Close, the detector is stateful and
+	// must not be destroyed mid-hop.
+	mu           sync.Mutex
+	isTerminated bool
+}
+
+// -----------------------------------------------------------------------------
+// Constructor
+// -----------------------------------------------------------------------------
+
+// NewTenVad creates a new TenVAD instance.
+// The VAD will automatically close when the provided context is cancelled,
+// ensuring safe cleanup of CGO resources.
+func NewTenVad(
+	ctx context.Context,
+	logger commons.Logger,
+	inputAudio *protos.AudioConfig,
+	onPacket func(ctx context.Context, pkt ...internal_type.Packet) error,
+	options utils.Option,
+) (internal_type.Vad, error) {
+	threshold := float32(resolveThreshold(options))
+	detector, err := newTenDetector(hopSize, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ten vad detector: %w", err)
+	}
+
+	resampler, err := internal_audio_resampler.GetResampler(logger)
+	if err != nil {
+		detector.destroy()
+		return nil, fmt.Errorf("failed to get resampler: %w", err)
+	}
+
+	tvad := &TenVAD{
+		logger:       logger,
+		onPacket:     onPacket,
+		audioSampler: resampler,
+		inputConfig:  inputAudio,
+		vadConfig:    internal_audio.NewLinear16khzMonoAudioConfig(),
+		detector:     detector,
+		segmenter:    newSegmenter(vadSampleRate, threshold, defaultMinSilenceDurationMs, defaultSpeechPadMs),
+	}
+
+	logger.Debugw("ten vad initialized", "version", tenVadVersion(), "threshold", threshold)
+
+	// Start lifecycle manager for automatic cleanup
+	go func() {
+		<-ctx.Done()
+		tvad.Close()
+	}()
+	return tvad, nil
+}
+
+// -----------------------------------------------------------------------------
+// Public Interface Methods
+// -----------------------------------------------------------------------------
+
+// Name returns the identifier for this VAD implementation.
+func (t *TenVAD) Name() string {
+	return vadName
+}
+
+// Process analyzes an audio packet for voice activity.
+// Returns immediately if the VAD has been terminated.
+// Thread-safe for concurrent calls.
+func (t *TenVAD) Process(ctx context.Context, pkt internal_type.UserAudioPacket) error {
+	resampled, err := t.audioSampler.Resample(pkt.Audio, t.inputConfig, t.vadConfig)
+	if err != nil {
+		t.logger.Debugf("Resampling failed: %+v", err)
+		return fmt.Errorf("resampling failed: %w", err)
+	}
+
+	segments, err := t.detect(resampled)
+	if err != nil {
+		return err
+	}
+
+	// Notify callback if speech detected
+	if len(segments) > 0 {
+		t.notifyActivity(ctx, segments)
+	}
+	return nil
+}
+
+// Close terminates the VAD and releases all CGO resources.
+// Safe to call multiple times; subsequent calls are no-ops.
+// Thread-safe.
+func (t *TenVAD) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.isTerminated {
+		return nil
+	}
+	t.isTerminated = true
+
+	if t.detector != nil {
+		t.detector.destroy()
+		t.detector = nil
+	}
+	t.pending = nil
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Private Helper Methods
+// -----------------------------------------------------------------------------
+
+// resolveThreshold extracts threshold from options or returns default.
+func resolveThreshold(options utils.Option) float64 {
+	if options == nil {
+		return defaultThreshold
+	}
+	if threshold, err := options.GetFloat64("microphone.vad.threshold"); err == nil {
+		return threshold
+	}
+	return defaultThreshold
+}
+
+// detect runs every complete hop of pcm (16kHz linear16) through the
+// detector and returns the speech segments started in it.
+func (t *TenVAD) detect(pcm []byte) ([]segment, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isTerminated || t.detector == nil {
+		return nil, nil
+	}
+
+	samples := t.pending
+	for i := 0; i+1 < len(pcm); i += 2 {
+		samples = append(samples, int16(binary.LittleEndian.Uint16(pcm[i:])))
+	}
+
+	var segments []segment
+	offset := 0
+	for ; offset+hopSize <= len(samples); offset += hopSize {
+		probability, err := t.detector.process(samples[offset : offset+hopSize])
+		if err != nil {
+			t.pending = nil
+			return nil, fmt.Errorf("detection failed: %w", err)
+		}
+		segments = t.segmenter.push(segments, probability, hopSize)
+	}
+	t.pending = append(t.pending[:0:0], samples[offset:]...)
+	return segments, nil
+}
+
+// notifyActivity calculates speech boundaries and invokes the callback.
+func (t *TenVAD) notifyActivity(ctx context.Context, segments []segment) {
+	minStart := math.MaxFloat64
+	maxEnd := -math.MaxFloat64
+	for _, seg := range segments {
+		if seg.StartAt < minStart {
+			minStart = seg.StartAt
+		}
+		if seg.EndAt > maxEnd {
+			maxEnd = seg.EndAt
+		}
+	}
+
+	t.onPacket(ctx, internal_type.InterruptionPacket{
+		Source:  internal_type.InterruptionSourceVad,
+		StartAt: minStart,
+		EndAt:   maxEnd,
+	})
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_ten_vad
+
+/*
+#cgo CFLAGS: -I.
+#cgo LDFLAGS: -L./models -lten_vad
+#include <ten_vad.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// tenDetector wraps one ten_vad C instance. The library embeds its model,
+// so there is no model file to resolve. Not safe for concurrent use.
+type tenDetector struct {
+	handle  C.ten_vad_handle_t
+	hopSize int
+}
+
+// newTenDetector creates a detector consuming hopSize samples of 16kHz
+// linear16 per call.
+func newTenDetector(hopSize int, threshold float32) (*tenDetector, error) {
+	var handle C.ten_vad_handle_t
+	if rc := C.ten_vad_create(&handle, C.size_t(hopSize), C.float(threshold)); rc != 0 || handle == nil {
+		return nil, fmt.Errorf("ten_vad_create failed with code %d", int(rc))
+	}
+	return &tenDetector{handle: handle, hopSize: hopSize}, nil
+}
+
+// process returns the speech probability of exactly one hop of samples.
+func (d *tenDetector) process(hop []int16) (float32, error) {
+	if d.handle == nil {
+		return 0, fmt.Errorf("ten_vad instance is destroyed")
+	}
+	if len(hop) != d.hopSize {
+		return 0, fmt.Errorf("input must be exactly %d samples, got %d", d.hopSize, len(hop))
+	}
+
+	var probability C.float
+	var flag C.int
+	rc := C.ten_vad_process(d.handle, (*C.int16_t)(unsafe.Pointer(&hop[0])), C.size_t(len(hop)), &probability, &flag)
+	if rc != 0 {
+		return 0, fmt.Errorf("ten_vad_process failed with code %d", int(rc))
+	}
+	return float32(probability), nil
+}
+
+// destroy releases the C instance, it is a no-op once destroyed.
+func (d *tenDetector) destroy() {
+	if d.handle != nil {
+		C.ten_vad_destroy(&d.handle)
+		d.handle = nil
+	}
+}
+
+// tenVadVersion returns the version of the linked library.
+func tenVadVersion() string {
+	return C.GoString(C.ten_vad_get_version())
+}
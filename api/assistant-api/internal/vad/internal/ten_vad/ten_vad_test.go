@@ -0,0 +1,190 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package internal_ten_vad
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"sync"
+	"testing"
+
+	internal_audio "github.com/rapidaai/api/assistant-api/internal/audio"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/protos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTenVadForTest(t *testing.T, inputCfg *protos.AudioConfig, threshold float64, cb func(ctx context.Context, pkt ...internal_type.Packet) error) *TenVAD {
+	logger, _ := commons.NewApplicationLogger()
+	opts := map[string]interface{}{}
+	if threshold >= 0 {
+		opts["microphone.vad.threshold"] = threshold
+	}
+	vad, err := NewTenVad(t.Context(), logger, inputCfg, cb, opts)
+	require.NoError(t, err)
+	ten := vad.(*TenVAD)
+	t.Cleanup(func() { _ = ten.Close() })
+	return ten
+}
+
+// Fixtures match the Silero tests so both providers see the same audio.
+
+// loadSpeech returns the samples of the shared speech fixture, "what's the
+// weather like" as 16kHz mono linear16 after a canonical 44 byte header.
+func loadSpeech(t *testing.T) []byte {
+	data, err := os.ReadFile("../../testdata/whats_the_weather_like.wav")
+	require.NoError(t, err)
+	return data[44:]
+}
+
+func generateSilence(samples int) internal_type.UserAudioPacket {
+	return internal_type.UserAudioPacket{Audio: make([]byte, samples*2)}
+}
+
+func generateSineWave(samples int, frequency, amplitude float64) internal_type.UserAudioPacket {
+	data := make([]byte, samples*2)
+	for i := 0; i < samples; i++ {
+		sample := int16(amplitude * 32767 * math.Sin(2*math.Pi*float64(i)*frequency/16000))
+		binary.LittleEndian.PutUint16(data[i*2:i*2+2], uint16(sample))
+	}
+	return internal_type.UserAudioPacket{Audio: data}
+}
+
+func generateNoise(samples int) internal_type.UserAudioPacket {
+	data := make([]byte, samples*2)
+	for i := 0; i < samples; i++ {
+		sample := int16((i*7919)%65536 - 32768)
+		binary.LittleEndian.PutUint16(data[i*2:i*2+2], uint16(sample))
+	}
+	return internal_type.UserAudioPacket{Audio: data}
+}
+
+func TestSegmenter_StartAndEnd(t *testing.T) {
+	s := newSegmenter(16000, 0.5, 100, 30)
+	var segments []segment
+
+	for i := 0; i < 10; i++ {
+		segments = s.push(segments, 0.1, hopSize)
+	}
+	assert.Empty(t, segments)
+
+	segments = s.push(segments, 0.9, hopSize)
+	require.Len(t, segments, 1)
+	// 10 silent hops, minus 30ms of padding
+	assert.InDelta(t, float64(10*hopSize-480)/16000, segments[0].StartAt, 1e-9)
+	assert.Zero(t, segments[0].EndAt)
+
+	// between threshold-0.15 and threshold keeps speech going
+	segments = s.push(segments, 0.4, hopSize)
+	assert.Zero(t, segments[0].EndAt)
+
+	// 100ms of silence at 16ms hops ends speech on the 8th hop
+	for i := 0; i < 7; i++ {
+		segments = s.push(segments, 0.1, hopSize)
+	}
+	assert.Zero(t, segments[0].EndAt)
+	segments = s.push(segments, 0.1, hopSize)
+	assert.InDelta(t, float64(13*hopSize+480)/16000, segments[0].EndAt, 1e-9)
+	assert.False(t, s.triggered)
+}
+
+func TestSegmenter_EndFromEarlierChunk(t *testing.T) {
+	s := newSegmenter(16000, 0.5, 0, 0)
+	started := s.push(nil, 0.9, hopSize)
+	require.Len(t, started, 1)
+
+	// the next chunk only sees the end, there is nothing to report
+	assert.Empty(t, s.push(nil, 0.1, hopSize))
+	assert.False(t, s.triggered)
+}
+
+func TestTenVAD_Name(t *testing.T) {
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(context.Context, ...internal_type.Packet) error { return nil })
+	assert.Equal(t, "ten_vad", vad.Name())
+}
+
+func TestTenVAD_Process_Silence_NoCallback(t *testing.T) {
+	callbackCalled := false
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(context.Context, ...internal_type.Packet) error {
+		callbackCalled = true
+		return nil
+	})
+
+	require.NoError(t, vad.Process(context.Background(), generateSilence(16000)))
+	assert.False(t, callbackCalled)
+}
+
+func TestTenVAD_Process_Speech_AllowsCallback(t *testing.T) {
+	var interruptions []internal_type.InterruptionPacket
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(ctx context.Context, pkt ...internal_type.Packet) error {
+		for _, p := range pkt {
+			if interruption, ok := p.(internal_type.InterruptionPacket); ok {
+				interruptions = append(interruptions, interruption)
+			}
+		}
+		return nil
+	})
+
+	// 20ms packets as sent by telephony, speech starts at about 0.65s
+	speech := loadSpeech(t)
+	for offset := 0; offset < len(speech); offset += 640 {
+		end := min(offset+640, len(speech))
+		require.NoError(t, vad.Process(context.Background(), internal_type.UserAudioPacket{Audio: speech[offset:end]}))
+	}
+	require.NotEmpty(t, interruptions, "speech must be detected")
+	assert.Equal(t, internal_type.InterruptionSourceVad, interruptions[0].Source)
+	assert.InDelta(t, 0.65, interruptions[0].StartAt, 0.15)
+}
+
+func TestTenVAD_Process_DifferentSampleRates(t *testing.T) {
+	for _, rate := range []uint32{8000, 16000, 24000, 48000} {
+		cfg := &protos.AudioConfig{AudioFormat: protos.AudioConfig_LINEAR16, SampleRate: rate, Channels: 1}
+		vad := newTenVadForTest(t, cfg, 0.5, func(context.Context, ...internal_type.Packet) error { return nil })
+		assert.NoError(t, vad.Process(context.Background(), generateSilence(int(rate)/10)), "rate %d", rate)
+	}
+}
+
+func TestTenVAD_Process_CarriesPartialHop(t *testing.T) {
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(context.Context, ...internal_type.Packet) error { return nil })
+
+	// 20ms packets are not a multiple of the 16ms hop
+	require.NoError(t, vad.Process(context.Background(), generateSilence(320)))
+	assert.Len(t, vad.pending, 320-hopSize)
+	require.NoError(t, vad.Process(context.Background(), generateSilence(320)))
+	assert.Len(t, vad.pending, 640-2*hopSize)
+	assert.Equal(t, 2*hopSize, vad.segmenter.currSample)
+}
+
+func TestTenVAD_Process_NoisePatterns(t *testing.T) {
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(context.Context, ...internal_type.Packet) error { return nil })
+	assert.NoError(t, vad.Process(context.Background(), generateNoise(16000)))
+}
+
+func TestTenVAD_Process_Concurrent(t *testing.T) {
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(context.Context, ...internal_type.Packet) error { return nil })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, vad.Process(context.Background(), generateSineWave(1600, 440, 0.5)))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTenVAD_Close_Idempotent(t *testing.T) {
+	vad := newTenVadForTest(t, internal_audio.NewLinear16khzMonoAudioConfig(), 0.5, func(context.Context, ...internal_type.Packet) error { return nil })
+
+	require.NoError(t, vad.Close())
+	require.NoError(t, vad.Close())
+	assert.NoError(t, vad.Process(context.Background(), generateSilence(1600)), "process after close is a no-op")
+}
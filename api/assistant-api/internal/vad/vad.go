@@ -10,6 +10,7 @@ import (
 
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	internal_vad_silero "github.com/rapidaai/api/assistant-api/internal/vad/internal/silero_vad"
+	internal_vad_ten "github.com/rapidaai/api/assistant-api/internal/vad/internal/ten_vad"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -29,6 +30,8 @@ func GetVAD(ctx context.Context, logger commons.Logger, intputAudio *protos.Audi
 	switch VADIdentifier(typ) {
 	case SILERO_VAD:
 		return internal_vad_silero.NewSileroVAD(ctx, logger, intputAudio, callback, options)
+	case TEN_VAD:
+		return internal_vad_ten.NewTenVad(ctx, logger, intputAudio, callback, options)
 	default:
 		return internal_vad_silero.NewSileroVAD(ctx, logger, intputAudio, callback, options)
 	}
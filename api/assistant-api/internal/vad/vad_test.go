@@ -8,6 +8,9 @@ package internal_vad
 
 import (
 	"context"
+	"encoding/binary"
+	"math"
+	"os"
 	"testing"
 
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
@@ -231,6 +234,52 @@ func TestGetVAD_AllIdentifiers(t *testing.T) {
 	}
 }
 
+// speechBoundaries runs the speech fixture through a VAD in one packet and
+// returns the boundaries it reported.
+func speechBoundaries(t *testing.T, identifier VADIdentifier) internal_type.InterruptionPacket {
+	data, err := os.ReadFile("testdata/whats_the_weather_like.wav")
+	require.NoError(t, err)
+	// the fixture is 16kHz mono linear16 with a canonical 44 byte header
+	speech := data[44:]
+
+	logger, _ := commons.NewApplicationLogger()
+	audioConfig := &protos.AudioConfig{
+		AudioFormat: protos.AudioConfig_LINEAR16,
+		SampleRate:  16000,
+		Channels:    1,
+	}
+	var interruptions []internal_type.InterruptionPacket
+	vad, err := GetVAD(t.Context(), logger, audioConfig, func(ctx context.Context, pkt ...internal_type.Packet) error {
+		for _, p := range pkt {
+			if interruption, ok := p.(internal_type.InterruptionPacket); ok {
+				interruptions = append(interruptions, interruption)
+			}
+		}
+		return nil
+	}, map[string]interface{}{
+		OptionsKeyVadProvider: identifier,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = vad.Close() })
+
+	require.NoError(t, vad.Process(t.Context(), internal_type.UserAudioPacket{Audio: speech}))
+	require.Len(t, interruptions, 1, "%s must detect the speech", identifier)
+	return interruptions[0]
+}
+
+// TestGetVAD_TenMatchesSilero checks that TEN VAD finds the speech of the
+// fixture where Silero does, so switching providers keeps turn timing.
+func TestGetVAD_TenMatchesSilero(t *testing.T) {
+	silero := speechBoundaries(t, SILERO_VAD)
+	ten := speechBoundaries(t, TEN_VAD)
+
+	// speech runs from about 0.65s to 2.7s
+	assert.InDelta(t, 0.65, silero.StartAt, 0.15)
+	assert.InDelta(t, 2.7, silero.EndAt, 0.2)
+	assert.InDelta(t, silero.StartAt, ten.StartAt, 0.1, "start of speech")
+	assert.InDelta(t, silero.EndAt, ten.EndAt, 0.15, "end of speech")
+}
+
 // TestVADIdentifier_String tests VADIdentifier string representation
 func TestVADIdentifier_String(t *testing.T) {
 	testCases := []struct {
@@ -257,6 +306,62 @@ func TestVADIdentifier_String(t *testing.T) {
 	}
 }
 
+// TestVAD_ProviderParity runs Silero and TEN VAD on the same audio and
+// checks both report it the same way
+func TestVAD_ProviderParity(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	audioConfig := &protos.AudioConfig{
+		AudioFormat: protos.AudioConfig_LINEAR16,
+		SampleRate:  16000,
+		Channels:    1,
+	}
+
+	sineWave := make([]byte, 32000)
+	for i := 0; i < 16000; i++ {
+		sample := int16(0.9 * 32767 * math.Sin(2*math.Pi*float64(i)*440/16000))
+		binary.LittleEndian.PutUint16(sineWave[i*2:], uint16(sample))
+	}
+	fixtures := map[string][]byte{
+		"silence":   make([]byte, 32000),
+		"sine_wave": sineWave,
+	}
+
+	for name, audio := range fixtures {
+		t.Run(name, func(t *testing.T) {
+			packets := map[VADIdentifier][]internal_type.InterruptionPacket{}
+			for _, identifier := range []VADIdentifier{SILERO_VAD, TEN_VAD} {
+				vad, err := GetVAD(t.Context(), logger, audioConfig, func(ctx context.Context, p ...internal_type.Packet) error {
+					for _, pkt := range p {
+						if interruption, ok := pkt.(internal_type.InterruptionPacket); ok {
+							packets[identifier] = append(packets[identifier], interruption)
+						}
+					}
+					return nil
+				}, map[string]interface{}{
+					OptionsKeyVadProvider:      identifier,
+					"microphone.vad.threshold": 0.5,
+				})
+				require.NoError(t, err)
+				require.Equal(t, string(identifier), vad.Name())
+				require.NoError(t, vad.Process(t.Context(), internal_type.UserAudioPacket{Audio: audio}))
+				require.NoError(t, vad.Close())
+			}
+
+			if name == "silence" {
+				assert.Empty(t, packets[SILERO_VAD])
+				assert.Empty(t, packets[TEN_VAD])
+			}
+			for identifier, interruptions := range packets {
+				for _, interruption := range interruptions {
+					assert.Equal(t, internal_type.InterruptionSourceVad, interruption.Source, identifier)
+					assert.GreaterOrEqual(t, interruption.StartAt, 0.0, identifier)
+					assert.LessOrEqual(t, interruption.StartAt, 1.0, identifier)
+				}
+			}
+		})
+	}
+}
+
 // BenchmarkGetVAD_SILERO_VAD benchmarks VAD factory with SILERO_VAD
 func BenchmarkGetVAD_SILERO_VAD(b *testing.B) {
 	logger, _ := commons.NewApplicationLogger()
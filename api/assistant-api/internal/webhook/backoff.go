@@ -0,0 +1,54 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultBackoffBase = 5 * time.Second
+	defaultBackoffMax  = 30 * time.Minute
+)
+
+// Backoff returns the wait before retry number attempt (1 for the first
+// retry). The delay doubles per attempt up to max and half of it is jitter,
+// so receivers recovering from an outage are not hit by every retry at once.
+func Backoff(attempt uint32, base, max time.Duration) time.Duration {
+	delay := max
+	if attempt < 32 {
+		if d := base << (attempt - 1); d > 0 && d < max {
+			delay = d
+		}
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Retryable reports whether a response status is listed in the webhook's
+// retry status codes, codes may use X as a digit wildcard such as 50X.
+func Retryable(retryStatusCodes []string, status int) bool {
+	code := strconv.Itoa(status)
+	for _, candidate := range retryStatusCodes {
+		if len(candidate) != len(code) {
+			continue
+		}
+		matched := true
+		for i := range candidate {
+			if candidate[i] != code[i] && candidate[i] != 'X' && candidate[i] != 'x' {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Bounds(t *testing.T) {
+	base, max := time.Second, time.Minute
+	for attempt, want := range map[uint32]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		4:  8 * time.Second,
+		10: time.Minute,
+		40: time.Minute,
+	} {
+		for i := 0; i < 50; i++ {
+			got := Backoff(attempt, base, max)
+			assert.GreaterOrEqual(t, got, want/2, "attempt %d", attempt)
+			assert.LessOrEqual(t, got, want, "attempt %d", attempt)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	codes := []string{"429", "50X"}
+	assert.True(t, Retryable(codes, 429))
+	assert.True(t, Retryable(codes, 500))
+	assert.True(t, Retryable(codes, 503))
+	assert.False(t, Retryable(codes, 404))
+	assert.False(t, Retryable(codes, 200))
+	assert.True(t, Retryable([]string{"40x"}, 401))
+	assert.False(t, Retryable(nil, 500))
+}
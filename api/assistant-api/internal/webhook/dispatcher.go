@@ -0,0 +1,257 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	internal_assistant_service "github.com/rapidaai/api/assistant-api/internal/services/assistant"
+	"github.com/rapidaai/pkg/clients/rest"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	storage_files "github.com/rapidaai/pkg/storages/file-storage"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+const (
+	defaultPollInterval   = 2 * time.Second
+	defaultBatchSize      = 20
+	defaultWebhookTimeout = 30 * time.Second
+	// leaseGrace is the lease of a claimed delivery until its webhook is
+	// loaded, the lease is then extended by the webhook timeout. A claimed
+	// delivery is picked up again once its lease is over.
+	leaseGrace = 30 * time.Second
+)
+
+// Dispatcher delivers the webhook deliveries stored by conversations. It
+// polls the store for due deliveries, sends them, and either marks them
+// delivered, schedules a retry with exponential backoff, or dead-letters them
+// once the webhook's MaxRetryCount is exhausted. Every delivered or dead
+// delivery is recorded as an AssistantWebhookLog.
+type Dispatcher struct {
+	logger         commons.Logger
+	store          Store
+	webhookService internal_services.AssistantWebhookService
+	vault          web_client.VaultClient
+
+	pollInterval time.Duration
+	batchSize    int
+	backoffBase  time.Duration
+	backoffMax   time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher creates a webhook dispatcher backed by Postgres.
+func NewDispatcher(cfg *config.AssistantConfig, logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+) *Dispatcher {
+	return &Dispatcher{
+		logger:         logger,
+		store:          NewStore(postgres, logger),
+		webhookService: internal_assistant_service.NewAssistantWebhookService(logger, postgres, storage_files.NewStorage(cfg.AssetStoreConfig, logger)),
+		vault:          web_client.NewVaultClientGRPC(&cfg.AppConfig, logger, redis),
+		pollInterval:   defaultPollInterval,
+		batchSize:      defaultBatchSize,
+		backoffBase:    defaultBackoffBase,
+		backoffMax:     defaultBackoffMax,
+	}
+}
+
+// Connect starts polling for due deliveries.
+func (d *Dispatcher) Connect(ctx context.Context) error {
+	ctx, d.cancel = context.WithCancel(context.Background())
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(d.pollInterval)
+		defer ticker.Stop()
+		for {
+			d.poll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	d.logger.Infof("webhook dispatcher started, polling every %s", d.pollInterval)
+	return nil
+}
+
+// Disconnect stops polling and waits for in flight deliveries, deliveries
+// interrupted by a shutdown are retried once their lease expires.
+func (d *Dispatcher) Disconnect(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+	d.wg.Wait()
+	return nil
+}
+
+func (d *Dispatcher) poll(ctx context.Context) {
+	deliveries, err := d.store.Claim(ctx, d.batchSize, leaseGrace)
+	if err != nil {
+		d.logger.Errorf("unable to claim webhook deliveries: %v", err)
+		return
+	}
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *Delivery) {
+			defer wg.Done()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) {
+	auth := delivery.ToAuth()
+	webhook, err := d.webhookService.Get(ctx, auth, delivery.WebhookID, delivery.AssistantID)
+	if err != nil || webhook.Status != type_enums.RECORD_ACTIVE {
+		d.logger.Warnf("webhook %d of delivery %d is no longer active, dead-lettering", delivery.WebhookID, delivery.Id)
+		delivery.LastError = "webhook is no longer active"
+		if err != nil {
+			webhook = nil
+		}
+		// logged so the delivery can be redelivered once the webhook is back
+		delivery.WebhookLogID = d.log(ctx, delivery, webhook, nil, 0, type_enums.RECORD_FAILED)
+		if err := d.store.DeadLetter(ctx, delivery); err != nil {
+			d.logger.Errorf("unable to dead-letter webhook delivery %d: %v", delivery.Id, err)
+		}
+		return
+	}
+
+	// the claim lease only covers loading the webhook, hold the delivery
+	// for as long as the webhook may take to answer
+	if err := d.store.Extend(ctx, delivery, webhookTimeout(webhook)+leaseGrace); err != nil {
+		d.logger.Errorf("unable to extend lease of webhook delivery %d, leaving it to the next claim: %v", delivery.Id, err)
+		return
+	}
+
+	delivery.Attempt++
+	start := time.Now()
+	res, err := d.send(ctx, delivery, webhook)
+	timeTaken := time.Since(start)
+
+	delivery.LastError = ""
+	delivery.LastResponseStatus = 0
+	if err != nil {
+		delivery.LastError = err.Error()
+	} else {
+		delivery.LastResponseStatus = int64(res.StatusCode)
+	}
+
+	switch {
+	case err == nil && res.StatusCode >= 200 && res.StatusCode < 300:
+		delivery.WebhookLogID = d.log(ctx, delivery, webhook, res, timeTaken, type_enums.RECORD_COMPLETE)
+		if err := d.store.Delivered(ctx, delivery); err != nil {
+			d.logger.Errorf("unable to mark webhook delivery %d delivered: %v", delivery.Id, err)
+		}
+	case (err != nil || Retryable(webhook.GetRetryStatusCode(), res.StatusCode)) && delivery.Attempt <= webhook.GetMaxRetryCount():
+		delivery.NextAttemptAt = time.Now().Add(Backoff(delivery.Attempt, d.backoffBase, d.backoffMax))
+		d.logger.Debugf("webhook delivery %d attempt %d failed, retrying at %s", delivery.Id, delivery.Attempt, delivery.NextAttemptAt)
+		if err := d.store.Retry(ctx, delivery); err != nil {
+			d.logger.Errorf("unable to schedule retry of webhook delivery %d: %v", delivery.Id, err)
+		}
+	default:
+		d.logger.Warnf("webhook delivery %d failed after %d attempts, dead-lettering: status=%d error=%s",
+			delivery.Id, delivery.Attempt, delivery.LastResponseStatus, delivery.LastError)
+		delivery.WebhookLogID = d.log(ctx, delivery, webhook, res, timeTaken, type_enums.RECORD_FAILED)
+		if err := d.store.DeadLetter(ctx, delivery); err != nil {
+			d.logger.Errorf("unable to dead-letter webhook delivery %d: %v", delivery.Id, err)
+		}
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery *Delivery, webhook *internal_assistant_entity.AssistantWebhook) (*rest.APIResponse, error) {
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(delivery.Payload), &params); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	headers, secret, err := webhookAuth(ctx, d.vault, delivery.ToAuth(), webhook.GetHeaders())
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign webhook: %w", err)
+	}
+	if secret != nil {
+		headers = signWebhook(secret, time.Now(), webhook.GetMethod(), headers, []byte(delivery.Payload), params)
+	}
+
+	client := rest.NewRestClientWithConfig(webhook.GetUrl(), headers, uint32(webhookTimeout(webhook)/time.Second))
+	// the stored payload is sent as is so the signature covers the exact body
+	body := json.RawMessage(delivery.Payload)
+	switch webhook.GetMethod() {
+	case "POST":
+		return client.Post(ctx, "", body, headers)
+	case "PUT":
+		return client.Put(ctx, "", body, headers)
+	case "PATCH":
+		return client.Patch(ctx, "", body, headers)
+	default:
+		return client.Get(ctx, "", params, headers)
+	}
+}
+
+// webhookTimeout is the configured timeout of a webhook, defaultWebhookTimeout
+// when unset.
+func webhookTimeout(webhook *internal_assistant_entity.AssistantWebhook) time.Duration {
+	if webhook.GetTimeoutSecond() > 0 {
+		return time.Duration(webhook.GetTimeoutSecond()) * time.Second
+	}
+	return defaultWebhookTimeout
+}
+
+// log records the outcome of a delivery and returns the log id. webhook is
+// nil when it was deleted, the log then carries the delivery's webhook id.
+func (d *Dispatcher) log(ctx context.Context, delivery *Delivery, webhook *internal_assistant_entity.AssistantWebhook, res *rest.APIResponse,
+	timeTaken time.Duration, status type_enums.RecordState) uint64 {
+	response := []byte(fmt.Sprintf(`{"error":%q}`, delivery.LastError))
+	if res != nil {
+		if v, err := res.ToJSON(); err == nil {
+			response = v
+		}
+	}
+	var url, method string
+	if webhook != nil {
+		url, method = webhook.GetUrl(), webhook.GetMethod()
+	}
+	var retries uint32
+	if delivery.Attempt > 0 {
+		retries = delivery.Attempt - 1
+	}
+	wl, err := d.webhookService.CreateLog(ctx, delivery.ToAuth(),
+		delivery.WebhookID,
+		delivery.AssistantID,
+		delivery.ConversationID,
+		url,
+		method,
+		delivery.Event,
+		delivery.LastResponseStatus,
+		int64(timeTaken),
+		retries,
+		status,
+		[]byte(delivery.Payload),
+		response,
+	)
+	if err != nil {
+		d.logger.Errorf("unable to create webhook log for delivery %d: %v", delivery.Id, err)
+		return 0
+	}
+	return wl.Id
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	internal_assistant_entity "github.com/rapidaai/api/assistant-api/internal/entity/assistants"
+	internal_services "github.com/rapidaai/api/assistant-api/internal/services"
+	"github.com/rapidaai/pkg/commons"
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	Store
+	retried, delivered, dead []*Delivery
+	leases                   []time.Duration
+}
+
+func (s *memoryStore) Extend(ctx context.Context, d *Delivery, lease time.Duration) error {
+	s.leases = append(s.leases, lease)
+	return nil
+}
+
+func (s *memoryStore) Delivered(ctx context.Context, d *Delivery) error {
+	s.delivered = append(s.delivered, d)
+	return nil
+}
+
+func (s *memoryStore) Retry(ctx context.Context, d *Delivery) error {
+	s.retried = append(s.retried, d)
+	return nil
+}
+
+func (s *memoryStore) DeadLetter(ctx context.Context, d *Delivery) error {
+	s.dead = append(s.dead, d)
+	return nil
+}
+
+type fakeWebhookService struct {
+	internal_services.AssistantWebhookService
+	webhook *internal_assistant_entity.AssistantWebhook
+	logs    []type_enums.RecordState
+	retries []uint32
+}
+
+func (f *fakeWebhookService) Get(ctx context.Context, auth types.SimplePrinciple, webhookId, assistantId uint64) (*internal_assistant_entity.AssistantWebhook, error) {
+	if f.webhook == nil {
+		return nil, errors.New("not found")
+	}
+	return f.webhook, nil
+}
+
+func (f *fakeWebhookService) CreateLog(ctx context.Context, auth types.SimplePrinciple, webhookId, assistantId, conversationId uint64,
+	httpUrl, httpMethod, event string, responseStatus, timeTaken int64, retryCount uint32, status type_enums.RecordState,
+	request, response []byte) (*internal_assistant_entity.AssistantWebhookLog, error) {
+	f.logs = append(f.logs, status)
+	f.retries = append(f.retries, retryCount)
+	return &internal_assistant_entity.AssistantWebhookLog{Audited: gorm_model.Audited{Id: 77}}, nil
+}
+
+func newTestDispatcher(t *testing.T, status int, maxRetry uint32) (*Dispatcher, *memoryStore, *fakeWebhookService, *[]string) {
+	bodies := &[]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		*bodies = append(*bodies, string(b))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	logger, _ := commons.NewApplicationLogger()
+	store := &memoryStore{}
+	service := &fakeWebhookService{webhook: &internal_assistant_entity.AssistantWebhook{
+		Mutable:          gorm_model.Mutable{Status: type_enums.RECORD_ACTIVE},
+		HttpMethod:       "POST",
+		HttpUrl:          server.URL,
+		RetryStatusCodes: []string{"50X"},
+		MaxRetryCount:    maxRetry,
+	}}
+	return &Dispatcher{
+		logger:         logger,
+		store:          store,
+		webhookService: service,
+		backoffBase:    time.Second,
+		backoffMax:     time.Minute,
+	}, store, service, bodies
+}
+
+func TestDispatcher_Delivered(t *testing.T) {
+	d, store, service, bodies := newTestDispatcher(t, http.StatusOK, 2)
+	delivery := &Delivery{Id: 1, Event: "conversation.completed", Payload: `{"b":1,"a":2}`}
+
+	d.deliver(context.Background(), delivery)
+
+	require.Len(t, store.delivered, 1)
+	assert.Equal(t, []string{`{"b":1,"a":2}`}, *bodies, "payload is sent exactly as stored")
+	assert.Equal(t, []type_enums.RecordState{type_enums.RECORD_COMPLETE}, service.logs)
+	assert.Equal(t, uint64(77), delivery.WebhookLogID)
+	assert.Equal(t, int64(http.StatusOK), delivery.LastResponseStatus)
+}
+
+func TestDispatcher_RetriesThenDeadLetters(t *testing.T) {
+	d, store, service, _ := newTestDispatcher(t, http.StatusServiceUnavailable, 1)
+	delivery := &Delivery{Id: 1, Payload: `{}`}
+
+	before := time.Now()
+	d.deliver(context.Background(), delivery)
+	require.Len(t, store.retried, 1)
+	assert.Equal(t, uint32(1), delivery.Attempt)
+	assert.True(t, delivery.NextAttemptAt.After(before))
+	assert.Empty(t, service.logs, "retries are not logged")
+
+	d.deliver(context.Background(), delivery)
+	require.Len(t, store.dead, 1)
+	assert.Equal(t, []type_enums.RecordState{type_enums.RECORD_FAILED}, service.logs)
+	assert.Equal(t, []uint32{1}, service.retries)
+	assert.Equal(t, uint64(77), delivery.WebhookLogID)
+}
+
+func TestDispatcher_NotRetryableStatus(t *testing.T) {
+	d, store, service, _ := newTestDispatcher(t, http.StatusBadRequest, 3)
+
+	d.deliver(context.Background(), &Delivery{Id: 1, Payload: `{}`})
+
+	assert.Empty(t, store.retried)
+	require.Len(t, store.dead, 1)
+	assert.Equal(t, []type_enums.RecordState{type_enums.RECORD_FAILED}, service.logs)
+}
+
+func TestDispatcher_MissingWebhook(t *testing.T) {
+	d, store, service, bodies := newTestDispatcher(t, http.StatusOK, 3)
+	service.webhook = nil
+
+	d.deliver(context.Background(), &Delivery{Id: 1, Payload: `{}`})
+
+	require.Len(t, store.dead, 1)
+	assert.Empty(t, *bodies)
+	assert.Empty(t, store.leases)
+	// logged so redeliver can find it
+	assert.Equal(t, []type_enums.RecordState{type_enums.RECORD_FAILED}, service.logs)
+	assert.Equal(t, uint64(77), store.dead[0].WebhookLogID)
+}
+
+func TestDispatcher_LeaseFollowsWebhookTimeout(t *testing.T) {
+	d, store, service, _ := newTestDispatcher(t, http.StatusOK, 3)
+	d.deliver(context.Background(), &Delivery{Id: 1, Payload: `{}`})
+	service.webhook.TimeoutSeconds = 120
+	d.deliver(context.Background(), &Delivery{Id: 2, Payload: `{}`})
+
+	assert.Equal(t, []time.Duration{defaultWebhookTimeout + leaseGrace, 120*time.Second + leaseGrace}, store.leases)
+}
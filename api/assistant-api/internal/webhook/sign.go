@@ -0,0 +1,89 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+)
+
+// Webhooks opt in to signing through reserved header keys, they are resolved
+// here and never sent to the receiver:
+//
+//	rapida.auth.type           hmac_sha256
+//	rapida.auth.credential_id  vault credential holding the signing secret as `key`
+const (
+	webhookAuthPrefix       = "rapida.auth."
+	webhookAuthType         = "rapida.auth.type"
+	webhookAuthCredentialId = "rapida.auth.credential_id"
+
+	webhookAuthHmacSha256 = "hmac_sha256"
+)
+
+// webhookAuth strips the reserved auth keys from the webhook headers and
+// returns the signing secret, nil when the webhook is not signed.
+func webhookAuth(ctx context.Context, vault web_client.VaultClient, auth types.SimplePrinciple, headers map[string]string) (map[string]string, []byte, error) {
+	sent := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if !strings.HasPrefix(k, webhookAuthPrefix) {
+			sent[k] = v
+		}
+	}
+
+	switch headers[webhookAuthType] {
+	case "":
+		return sent, nil, nil
+	case webhookAuthHmacSha256:
+	default:
+		return nil, nil, fmt.Errorf("unsupported webhook auth type %s", headers[webhookAuthType])
+	}
+
+	credentialId, err := strconv.ParseUint(headers[webhookAuthCredentialId], 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s is not a valid credential id: %v", webhookAuthCredentialId, err)
+	}
+	credential, err := vault.GetCredential(ctx, auth, credentialId)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret, ok := credential.GetValue().AsMap()["key"].(string)
+	if !ok || secret == "" {
+		return nil, nil, fmt.Errorf("webhook signing credential %d has no key", credentialId)
+	}
+	return sent, []byte(secret), nil
+}
+
+// signWebhook adds the timestamp and signature headers. The signed payload
+// is the JSON body, or the url encoded query for GET webhooks as the body is
+// sent as query parameters.
+func signWebhook(secret []byte, now time.Time, method string, headers map[string]string, payload []byte, params map[string]interface{}) map[string]string {
+	switch method {
+	case "POST", "PUT", "PATCH":
+	default:
+		query := url.Values{}
+		for k, v := range params {
+			query.Set(k, fmt.Sprintf("%v", v))
+		}
+		payload = []byte(query.Encode())
+	}
+
+	signed := make(map[string]string, len(headers)+2)
+	for k, v := range headers {
+		signed[k] = v
+	}
+	signed[utils.HEADER_TIMESTAMP_KEY] = strconv.FormatInt(now.Unix(), 10)
+	signed[utils.HEADER_SIGNATURE_KEY] = utils.SignPayload(secret, now, payload)
+	return signed
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// Store persists webhook deliveries in Postgres.
+//
+// A dispatcher claims due deliveries with a lease: claiming moves them to
+// "processing" and pushes next_attempt_at to the end of the lease. A
+// dispatcher that dies mid delivery leaves the row processing with an
+// expired lease, the next Claim picks it up again, so a delivery is sent at
+// least once.
+type Store interface {
+	// Enqueue stores a new pending delivery, due immediately.
+	Enqueue(ctx context.Context, d *Delivery) error
+
+	// Claim returns up to limit due deliveries, pending ones whose
+	// next_attempt_at has passed and processing ones whose lease expired.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+
+	// Extend moves the lease of a claimed delivery to lease from now.
+	Extend(ctx context.Context, d *Delivery, lease time.Duration) error
+
+	// Delivered marks a delivery as delivered.
+	Delivered(ctx context.Context, d *Delivery) error
+
+	// Retry schedules the next attempt of a delivery at d.NextAttemptAt.
+	Retry(ctx context.Context, d *Delivery) error
+
+	// DeadLetter marks a delivery as dead and links the log of its last attempt.
+	DeadLetter(ctx context.Context, d *Delivery) error
+
+	// Redeliver moves the dead delivery logged as webhookLogId back to pending
+	// with a fresh retry budget.
+	Redeliver(ctx context.Context, organizationId, projectId, webhookLogId uint64) (*Delivery, error)
+}
+
+type postgresStore struct {
+	postgres connectors.PostgresConnector
+	logger   commons.Logger
+}
+
+// NewStore creates a new webhook delivery store backed by Postgres.
+func NewStore(postgres connectors.PostgresConnector, logger commons.Logger) Store {
+	return &postgresStore{
+		postgres: postgres,
+		logger:   logger,
+	}
+}
+
+func (s *postgresStore) Enqueue(ctx context.Context, d *Delivery) error {
+	d.Status = StatusPending
+	if err := s.postgres.DB(ctx).Create(d).Error; err != nil {
+		return fmt.Errorf("failed to enqueue webhook delivery for webhook %d: %w", d.WebhookID, err)
+	}
+	s.logger.Debugf("enqueued webhook delivery: id=%d, webhook=%d, event=%s", d.Id, d.WebhookID, d.Event)
+	return nil
+}
+
+// Claim locks the due rows with FOR UPDATE SKIP LOCKED so concurrent
+// dispatchers, in this process or another replica, never claim the same row.
+func (s *postgresStore) Claim(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error) {
+	var claimed []*Delivery
+	now := time.Now()
+	err := s.postgres.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status IN ? AND next_attempt_at <= ?", []string{StatusPending, StatusProcessing}, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&claimed).Error; err != nil {
+			return err
+		}
+		if len(claimed) == 0 {
+			return nil
+		}
+		ids := make([]uint64, 0, len(claimed))
+		for _, d := range claimed {
+			d.Status = StatusProcessing
+			d.NextAttemptAt = now.Add(lease)
+			ids = append(ids, d.Id)
+		}
+		return tx.Model(&Delivery{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":          StatusProcessing,
+				"next_attempt_at": now.Add(lease),
+				"updated_date":    now,
+			}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	return claimed, nil
+}
+
+func (s *postgresStore) Extend(ctx context.Context, d *Delivery, lease time.Duration) error {
+	d.NextAttemptAt = time.Now().Add(lease)
+	return s.update(ctx, d.Id, map[string]interface{}{
+		"next_attempt_at": d.NextAttemptAt,
+	})
+}
+
+func (s *postgresStore) Delivered(ctx context.Context, d *Delivery) error {
+	return s.update(ctx, d.Id, map[string]interface{}{
+		"status":               StatusDelivered,
+		"attempt":              d.Attempt,
+		"last_error":           "",
+		"last_response_status": d.LastResponseStatus,
+		"webhook_log_id":       d.WebhookLogID,
+	})
+}
+
+func (s *postgresStore) Retry(ctx context.Context, d *Delivery) error {
+	return s.update(ctx, d.Id, map[string]interface{}{
+		"status":               StatusPending,
+		"attempt":              d.Attempt,
+		"next_attempt_at":      d.NextAttemptAt,
+		"last_error":           d.LastError,
+		"last_response_status": d.LastResponseStatus,
+	})
+}
+
+func (s *postgresStore) DeadLetter(ctx context.Context, d *Delivery) error {
+	return s.update(ctx, d.Id, map[string]interface{}{
+		"status":               StatusDead,
+		"attempt":              d.Attempt,
+		"last_error":           d.LastError,
+		"last_response_status": d.LastResponseStatus,
+		"webhook_log_id":       d.WebhookLogID,
+	})
+}
+
+func (s *postgresStore) Redeliver(ctx context.Context, organizationId, projectId, webhookLogId uint64) (*Delivery, error) {
+	db := s.postgres.DB(ctx)
+	now := time.Now()
+	result := db.Model(&Delivery{}).
+		Where("webhook_log_id = ? AND organization_id = ? AND project_id = ? AND status = ?",
+			webhookLogId, organizationId, projectId, StatusDead).
+		Updates(map[string]interface{}{
+			"status":          StatusPending,
+			"attempt":         0,
+			"next_attempt_at": now,
+			"updated_date":    now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to redeliver webhook log %d: %w", webhookLogId, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("%w: no dead delivery for webhook log %d", ErrDeliveryNotFound, webhookLogId)
+	}
+
+	var d Delivery
+	if err := db.Where("webhook_log_id = ? AND organization_id = ?", webhookLogId, organizationId).First(&d).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch redelivered webhook log %d: %w", webhookLogId, err)
+	}
+	s.logger.Infof("redelivering webhook delivery: id=%d, webhook=%d, log=%d", d.Id, d.WebhookID, webhookLogId)
+	return &d, nil
+}
+
+func (s *postgresStore) update(ctx context.Context, id uint64, fields map[string]interface{}) error {
+	fields["updated_date"] = time.Now()
+	if err := s.postgres.DB(ctx).Model(&Delivery{}).Where("id = ?", id).Updates(fields).Error; err != nil {
+		return fmt.Errorf("failed to update webhook delivery %d: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package internal_webhook
+
+import (
+	"time"
+
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Delivery status constants.
+const (
+	StatusPending    = "pending"    // Waiting for its next attempt
+	StatusProcessing = "processing" // Claimed by a dispatcher until its lease expires
+	StatusDelivered  = "delivered"  // Receiver answered with a 2xx
+	StatusDead       = "dead"       // Retries exhausted or not retryable, kept for redelivery
+)
+
+// Delivery is one webhook event waiting to be sent to its receiver.
+//
+// Stored in Postgres (assistant_webhook_deliveries table). The payload is the
+// resolved webhook body, the webhook itself is read again on every attempt so
+// edits to url, headers or retry settings apply to pending deliveries.
+type Delivery struct {
+	Id                 uint64    `json:"id" gorm:"type:bigint;primaryKey;<-:create"`
+	WebhookID          uint64    `json:"webhookId" gorm:"column:webhook_id;type:bigint;not null"`
+	WebhookLogID       uint64    `json:"webhookLogId" gorm:"column:webhook_log_id;type:bigint;not null;default:0"`
+	AssistantID        uint64    `json:"assistantId" gorm:"column:assistant_id;type:bigint;not null"`
+	ConversationID     uint64    `json:"conversationId" gorm:"column:assistant_conversation_id;type:bigint;not null;default:0"`
+	ProjectID          uint64    `json:"projectId" gorm:"column:project_id;type:bigint;not null"`
+	OrganizationID     uint64    `json:"organizationId" gorm:"column:organization_id;type:bigint;not null"`
+	Event              string    `json:"event" gorm:"column:event;type:varchar(200);not null"`
+	Payload            string    `json:"payload" gorm:"column:payload;type:text;not null"`
+	Status             string    `json:"status" gorm:"column:status;type:varchar(20);not null;default:pending"`
+	Attempt            uint32    `json:"attempt" gorm:"column:attempt;type:int;not null;default:0"`
+	NextAttemptAt      time.Time `json:"nextAttemptAt" gorm:"column:next_attempt_at;type:timestamp;not null"`
+	LastError          string    `json:"lastError" gorm:"column:last_error;type:text;not null;default:''"`
+	LastResponseStatus int64     `json:"lastResponseStatus" gorm:"column:last_response_status;type:bigint;not null;default:0"`
+	CreatedDate        time.Time `json:"createdDate" gorm:"type:timestamp;not null;default:NOW();<-:create"`
+	UpdatedDate        time.Time `json:"updatedDate" gorm:"type:timestamp;default:null"`
+}
+
+func (Delivery) TableName() string {
+	return "assistant_webhook_deliveries"
+}
+
+func (d *Delivery) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.Id <= 0 {
+		d.Id = gorm_generator.ID()
+	}
+	if d.CreatedDate.IsZero() {
+		d.CreatedDate = time.Now()
+	}
+	if d.NextAttemptAt.IsZero() {
+		d.NextAttemptAt = d.CreatedDate
+	}
+	return nil
+}
+
+// ToAuth converts the Delivery into a SimplePrinciple for use in service calls.
+func (d *Delivery) ToAuth() types.SimplePrinciple {
+	return &types.ServiceScope{
+		ProjectId:      utils.Ptr(d.ProjectID),
+		OrganizationId: utils.Ptr(d.OrganizationID),
+	}
+}
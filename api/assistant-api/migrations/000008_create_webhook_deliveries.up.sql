@@ -0,0 +1,26 @@
+-- Durable webhook deliveries. Conversation events enqueue a row here and the
+-- webhook dispatcher delivers it, so pending deliveries survive a restart.
+-- A delivery moves pending -> processing -> delivered, or back to pending with
+-- a later next_attempt_at on a retryable failure, and to dead once the
+-- webhook's max_retry_count is exhausted.
+CREATE TABLE public.assistant_webhook_deliveries (
+    id bigint PRIMARY KEY,
+    webhook_id bigint NOT NULL,
+    webhook_log_id bigint NOT NULL DEFAULT 0,
+    assistant_id bigint NOT NULL,
+    assistant_conversation_id bigint NOT NULL DEFAULT 0,
+    project_id bigint NOT NULL,
+    organization_id bigint NOT NULL,
+    event character varying(200) NOT NULL,
+    payload text NOT NULL DEFAULT '{}',
+    status character varying(20) DEFAULT 'pending' NOT NULL,
+    attempt integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_error text NOT NULL DEFAULT '',
+    last_response_status bigint NOT NULL DEFAULT 0,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone
+);
+
+CREATE INDEX assistant_webhook_deliveries_due_idx ON public.assistant_webhook_deliveries (status, next_attempt_at);
+CREATE INDEX assistant_webhook_deliveries_webhook_log_id_idx ON public.assistant_webhook_deliveries (webhook_log_id);
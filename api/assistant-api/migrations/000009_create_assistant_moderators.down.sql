@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.assistant_moderator_options;
+DROP TABLE IF EXISTS public.assistant_moderators;
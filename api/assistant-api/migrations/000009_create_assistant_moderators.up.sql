@@ -0,0 +1,29 @@
+CREATE TABLE public.assistant_moderators (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    assistant_id bigint NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    stage character varying(20) NOT NULL,
+    type character varying(20) NOT NULL,
+    name character varying(20) NOT NULL
+);
+CREATE INDEX assistant_moderators_assistant_id_idx ON public.assistant_moderators (assistant_id);
+
+CREATE TABLE public.assistant_moderator_options (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    key character varying(200) NOT NULL,
+    value text NOT NULL,
+    assistant_moderator_id bigint NOT NULL
+);
+ALTER TABLE ONLY public.assistant_moderator_options
+    ADD CONSTRAINT uk_assistant_moderator_option_key UNIQUE (key, assistant_moderator_id);
+CREATE INDEX idx_assistant_moderator_options_assistant_moderator_id
+    ON public.assistant_moderator_options (assistant_moderator_id);
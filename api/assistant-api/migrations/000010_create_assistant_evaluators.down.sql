@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.assistant_evaluator_options;
+DROP TABLE IF EXISTS public.assistant_evaluators;
@@ -0,0 +1,28 @@
+CREATE TABLE public.assistant_evaluators (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    assistant_id bigint NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    type character varying(20) NOT NULL,
+    name character varying(50) NOT NULL
+);
+CREATE INDEX assistant_evaluators_assistant_id_idx ON public.assistant_evaluators (assistant_id);
+
+CREATE TABLE public.assistant_evaluator_options (
+    id bigint PRIMARY KEY,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL,
+    created_by bigint NOT NULL,
+    updated_by bigint,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone,
+    key character varying(200) NOT NULL,
+    value text NOT NULL,
+    assistant_evaluator_id bigint NOT NULL
+);
+ALTER TABLE ONLY public.assistant_evaluator_options
+    ADD CONSTRAINT uk_assistant_evaluator_option_key UNIQUE (key, assistant_evaluator_id);
+CREATE INDEX idx_assistant_evaluator_options_assistant_evaluator_id
+    ON public.assistant_evaluator_options (assistant_evaluator_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS public.assistant_campaign_credential_limits;
+DROP TABLE IF EXISTS public.assistant_do_not_call_numbers;
+DROP TABLE IF EXISTS public.assistant_campaign_contacts;
+DROP TABLE IF EXISTS public.assistant_campaigns;
@@ -0,0 +1,80 @@
+-- Outbound call campaigns. The campaign engine dials the due contacts of
+-- running campaigns within their calling window, paced by the limits of the
+-- provider credential, and retries them by the final status of their call
+-- context.
+CREATE TABLE public.assistant_campaigns (
+    id bigint PRIMARY KEY,
+    status character varying(20) DEFAULT 'running' NOT NULL,
+    organization_id bigint NOT NULL,
+    project_id bigint NOT NULL,
+    assistant_id bigint NOT NULL,
+    version character varying(50) NOT NULL DEFAULT '',
+    credential_id bigint NOT NULL,
+    name character varying(200) NOT NULL,
+    from_number character varying(50) NOT NULL DEFAULT '',
+    timezone character varying(64) NOT NULL DEFAULT 'UTC',
+    window_start character varying(5) NOT NULL DEFAULT '',
+    window_end character varying(5) NOT NULL DEFAULT '',
+    max_attempts integer NOT NULL DEFAULT 3,
+    retry_rules text NOT NULL DEFAULT '{}',
+    start_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone
+);
+
+CREATE INDEX assistant_campaigns_status_idx ON public.assistant_campaigns (status);
+CREATE INDEX assistant_campaigns_project_idx ON public.assistant_campaigns (organization_id, project_id);
+
+-- A contact moves pending -> dialing -> completed or failed, or back to
+-- pending with a later next_attempt_at when its call status is retried.
+CREATE TABLE public.assistant_campaign_contacts (
+    id bigint PRIMARY KEY,
+    campaign_id bigint NOT NULL,
+    status character varying(20) DEFAULT 'pending' NOT NULL,
+    phone_number character varying(50) NOT NULL,
+    timezone character varying(64) NOT NULL DEFAULT '',
+    arguments text NOT NULL DEFAULT '{}',
+    options text NOT NULL DEFAULT '{}',
+    metadata text NOT NULL DEFAULT '{}',
+    attempt integer NOT NULL DEFAULT 0,
+    credential_id bigint NOT NULL DEFAULT 0,
+    assistant_conversation_id bigint NOT NULL DEFAULT 0,
+    call_context_id character varying(36) NOT NULL DEFAULT '',
+    last_status character varying(20) NOT NULL DEFAULT '',
+    next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_dialed_at timestamp without time zone,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone
+);
+
+CREATE INDEX assistant_campaign_contacts_due_idx ON public.assistant_campaign_contacts (campaign_id, status, next_attempt_at);
+CREATE INDEX assistant_campaign_contacts_credential_idx ON public.assistant_campaign_contacts (credential_id, status);
+CREATE INDEX assistant_campaign_contacts_dialed_idx ON public.assistant_campaign_contacts (credential_id, last_dialed_at);
+CREATE INDEX assistant_campaign_contacts_phone_number_idx ON public.assistant_campaign_contacts (phone_number);
+
+-- Numbers never called by the campaigns of a project.
+CREATE TABLE public.assistant_do_not_call_numbers (
+    id bigint PRIMARY KEY,
+    organization_id bigint NOT NULL,
+    project_id bigint NOT NULL,
+    phone_number character varying(50) NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX assistant_do_not_call_numbers_project_phone_idx ON public.assistant_do_not_call_numbers (project_id, phone_number);
+
+-- Pacing of the campaign calls placed with a provider credential, credentials
+-- without a row use the engine defaults.
+CREATE TABLE public.assistant_campaign_credential_limits (
+    id bigint PRIMARY KEY,
+    organization_id bigint NOT NULL,
+    project_id bigint NOT NULL,
+    credential_id bigint NOT NULL,
+    max_concurrent_calls integer NOT NULL,
+    calls_per_second double precision NOT NULL,
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone
+);
+
+CREATE UNIQUE INDEX assistant_campaign_credential_limits_credential_idx ON public.assistant_campaign_credential_limits (project_id, credential_id);
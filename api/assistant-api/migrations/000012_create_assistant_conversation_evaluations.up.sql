@@ -0,0 +1,22 @@
+-- One row per evaluator score of a completed conversation. The assistant
+-- provider model is the assistant version the conversation ran on, scores
+-- are compared across versions by it.
+CREATE TABLE public.assistant_conversation_evaluations (
+    id bigint PRIMARY KEY,
+    organization_id bigint NOT NULL,
+    project_id bigint NOT NULL,
+    assistant_id bigint NOT NULL,
+    assistant_provider_model_id bigint NOT NULL,
+    assistant_conversation_id bigint NOT NULL,
+    name character varying(50) NOT NULL,
+    evaluator character varying(20) NOT NULL,
+    score double precision NOT NULL,
+    passed boolean NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_date timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_date timestamp without time zone
+);
+ALTER TABLE ONLY public.assistant_conversation_evaluations
+    ADD CONSTRAINT uk_assistant_conversation_evaluation_name UNIQUE (assistant_conversation_id, name);
+CREATE INDEX assistant_conversation_evaluations_version_idx
+    ON public.assistant_conversation_evaluations (assistant_id, assistant_provider_model_id);
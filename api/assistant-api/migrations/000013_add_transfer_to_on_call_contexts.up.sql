@@ -0,0 +1,2 @@
+ALTER TABLE public.call_contexts
+    ADD COLUMN transfer_to character varying(200) NOT NULL DEFAULT '';
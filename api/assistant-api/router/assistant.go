@@ -12,6 +12,8 @@ import (
 	assistantDeploymentApi "github.com/rapidaai/api/assistant-api/api/assistant-deployment"
 	assistantTalkApi "github.com/rapidaai/api/assistant-api/api/talk"
 	"github.com/rapidaai/api/assistant-api/config"
+	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
+	assistant_sip "github.com/rapidaai/api/assistant-api/sip"
 	sip_infra "github.com/rapidaai/api/assistant-api/sip/infra"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
@@ -26,6 +28,8 @@ func AssistantApiRoute(
 	Postgres connectors.PostgresConnector,
 	Redis connectors.RedisConnector,
 	Opensearch connectors.OpenSearchConnector,
+	VectorDB connectors.VectorConnector,
+	SIPEngine *assistant_sip.SIPEngine,
 ) {
 	workflow_api.RegisterAssistantServiceServer(S,
 		assistantApi.NewAssistantGRPCApi(Cfg,
@@ -33,21 +37,32 @@ func AssistantApiRoute(
 			Postgres,
 			Redis,
 			Opensearch,
-			Opensearch,
+			VectorDB,
+			registrationSyncer(SIPEngine),
 		))
 }
 
 func AssistantDeploymentApiRoute(Cfg *config.AssistantConfig,
 	S *grpc.Server,
 	Logger commons.Logger,
-	Postgres connectors.PostgresConnector) {
+	Postgres connectors.PostgresConnector,
+	SIPEngine *assistant_sip.SIPEngine) {
 	workflow_api.RegisterAssistantDeploymentServiceServer(S,
 		assistantDeploymentApi.NewAssistantDeploymentGRPCApi(Cfg,
 			Logger,
 			Postgres,
+			registrationSyncer(SIPEngine),
 		))
 }
 
+// registrationSyncer keeps a SIP engine that is not running out of the apis.
+func registrationSyncer(SIPEngine *assistant_sip.SIPEngine) internal_type.RegistrationSyncer {
+	if SIPEngine == nil {
+		return nil
+	}
+	return SIPEngine
+}
+
 func AssistantConversationApiRoute(
 	Cfg *config.AssistantConfig,
 	S *grpc.Server,
@@ -55,6 +70,7 @@ func AssistantConversationApiRoute(
 	Postgres connectors.PostgresConnector,
 	Redis connectors.RedisConnector,
 	Opensearch connectors.OpenSearchConnector,
+	VectorDB connectors.VectorConnector,
 	sipServer *sip_infra.Server,
 ) {
 	workflow_api.RegisterTalkServiceServer(S,
@@ -63,7 +79,7 @@ func AssistantConversationApiRoute(
 			Postgres,
 			Redis,
 			Opensearch,
-			Opensearch,
+			VectorDB,
 			sipServer,
 		))
 	workflow_api.RegisterWebRTCServer(S,
@@ -72,7 +88,7 @@ func AssistantConversationApiRoute(
 			Postgres,
 			Redis,
 			Opensearch,
-			Opensearch,
+			VectorDB,
 			sipServer,
 		))
 }
@@ -82,10 +98,11 @@ func TalkCallbackApiRoute(
 	postgres connectors.PostgresConnector,
 	redis connectors.RedisConnector,
 	opensearch connectors.OpenSearchConnector,
+	vectordb connectors.VectorConnector,
 	sipServer *sip_infra.Server,
 ) {
 	apiv1 := engine.Group("v1/talk")
-	talkRpcApi := assistantTalkApi.NewConversationApi(cfg, logger, postgres, redis, opensearch, opensearch, sipServer)
+	talkRpcApi := assistantTalkApi.NewConversationApi(cfg, logger, postgres, redis, opensearch, vectordb, sipServer)
 	{
 		// global catch-all event logging
 		apiv1.GET("/:telephony/event/:assistantId", talkRpcApi.UnviersalCallback)
@@ -99,5 +116,26 @@ func TalkCallbackApiRoute(
 		apiv1.GET("/:telephony/ctx/:contextId", talkRpcApi.CallTalkerByContext)
 		apiv1.GET("/:telephony/ctx/:contextId/event", talkRpcApi.CallbackByContext)
 		apiv1.POST("/:telephony/ctx/:contextId/event", talkRpcApi.CallbackByContext)
+
+		// transfer destination fetched by the provider flow after a transferred call left the stream (Exotel connect applet)
+		apiv1.GET("/:telephony/transfer", talkRpcApi.TransferDestination)
+	}
+}
+
+func WhatsappApiRoute(
+	cfg *config.AssistantConfig, engine *gin.Engine, logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+	opensearch connectors.OpenSearchConnector,
+	vectordb connectors.VectorConnector,
+	sipServer *sip_infra.Server,
+) {
+	apiv1 := engine.Group("v1/whatsapp")
+	talkRpcApi := assistantTalkApi.NewConversationApi(cfg, logger, postgres, redis, opensearch, vectordb, sipServer)
+	{
+		// inbound messages of the whatsapp deployment, signed by the provider
+		apiv1.POST("/:assistantId", talkRpcApi.WhatsappReciever)
+		// webhook subscription challenge of providers that verify the endpoint
+		apiv1.GET("/:assistantId", talkRpcApi.WhatsappVerification)
 	}
 }
@@ -20,14 +20,14 @@ func KnowledgeApiRoute(
 	Logger commons.Logger,
 	Postgres connectors.PostgresConnector,
 	Redis connectors.RedisConnector,
-	Opensearch connectors.OpenSearchConnector,
+	VectorDB connectors.VectorConnector,
 ) {
 	workflow_api.RegisterKnowledgeServiceServer(S,
 		knowledgeApi.NewKnowledgeGRPCApi(Cfg,
 			Logger,
 			Postgres,
 			Redis,
-			Opensearch,
+			VectorDB,
 		))
 }
 
@@ -37,7 +37,7 @@ func DocumentApiRoute(
 	Logger commons.Logger,
 	Postgres connectors.PostgresConnector,
 	Redis connectors.RedisConnector,
-	Opensearch connectors.OpenSearchConnector,
+	VectorDB connectors.VectorConnector,
 
 ) {
 	workflow_api.RegisterDocumentServiceServer(S,
@@ -45,6 +45,6 @@ func DocumentApiRoute(
 			Logger,
 			Postgres,
 			Redis,
-			Opensearch,
+			VectorDB,
 		))
 }
@@ -9,6 +9,8 @@ package sip_infra
 import (
 	"fmt"
 	"strings"
+
+	"github.com/emiago/sipgo"
 )
 
 // SipURICredentials holds parsed credentials from a SIP URI.
@@ -66,6 +68,15 @@ func ParseCredentialsFromURI(uri string) (*SipURICredentials, error) {
 	}, nil
 }
 
+// DigestCredentials returns the credentials used to answer 401/407 digest
+// challenges from a registrar or proxy (RFC 2617 / RFC 8760).
+func DigestCredentials(cfg *Config) sipgo.DigestAuth {
+	return sipgo.DigestAuth{
+		Username: cfg.Username,
+		Password: cfg.Password,
+	}
+}
+
 // CredentialMiddleware is the first middleware in the SIP authentication chain.
 // It parses the SIP URI from the To header to extract the assistantID and apiKey,
 // then sets them on the SIPRequestContext for downstream middlewares.
@@ -74,7 +85,15 @@ func ParseCredentialsFromURI(uri string) (*SipURICredentials, error) {
 //
 // This middleware runs for ALL SIP requests (INVITE, BYE, REGISTER, OPTIONS, etc.)
 // to ensure every request carries valid authentication credentials.
+//
+// Calls delivered through an outbound registration carry the PBX extension in
+// the To header; the server has already set the assistant and principal of the
+// registration on the context, so the URI is not parsed.
 func CredentialMiddleware(ctx *SIPRequestContext, next func() (*InviteResult, error)) (*InviteResult, error) {
+	if _, ok := ctx.Get("auth"); ok && ctx.AssistantID != "" {
+		return next()
+	}
+
 	// Parse credentials from To URI — the To header contains the target identity
 	// which embeds the authentication: sip:{assistantID}:{apiKey}@host
 	creds, err := ParseCredentialsFromURI(ctx.ToURI)
@@ -0,0 +1,124 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// telephoneEventDigits maps RFC 4733 event codes 0-15 to their keys.
+const telephoneEventDigits = "0123456789*#ABCD"
+
+// telephoneEvent is the decoded payload of an RFC 4733 telephone-event packet.
+type telephoneEvent struct {
+	Digit    string
+	End      bool
+	Duration uint16 // in RTP timestamp units
+}
+
+// parseTelephoneEvent decodes an RFC 4733 payload:
+//
+//	event (8) | E (1) | R (1) | volume (6) | duration (16)
+func parseTelephoneEvent(payload []byte) (*telephoneEvent, error) {
+	if len(payload) < 4 {
+		return nil, fmt.Errorf("telephone-event payload too short: %d bytes", len(payload))
+	}
+	code := int(payload[0])
+	if code >= len(telephoneEventDigits) {
+		return nil, fmt.Errorf("unsupported telephone-event code %d", code)
+	}
+	return &telephoneEvent{
+		Digit:    string(telephoneEventDigits[code]),
+		End:      payload[1]&0x80 != 0,
+		Duration: uint16(payload[2])<<8 | uint16(payload[3]),
+	}, nil
+}
+
+// encodeTelephoneEvent builds an RFC 4733 payload for the key at code.
+func encodeTelephoneEvent(code byte, end bool, volume uint8, duration uint16) []byte {
+	payload := make([]byte, 4)
+	payload[0] = code
+	payload[1] = volume & 0x3F
+	if end {
+		payload[1] |= 0x80
+	}
+	payload[2] = byte(duration >> 8)
+	payload[3] = byte(duration)
+	return payload
+}
+
+// telephoneEventCodes maps each key of digits to its RFC 4733 event code.
+func telephoneEventCodes(digits string) ([]byte, error) {
+	codes := make([]byte, 0, len(digits))
+	for _, d := range strings.ToUpper(digits) {
+		code := strings.IndexRune(telephoneEventDigits, d)
+		if code < 0 {
+			return nil, fmt.Errorf("invalid DTMF digit %q", d)
+		}
+		codes = append(codes, byte(code))
+	}
+	return codes, nil
+}
+
+// ParseDTMFInfo extracts a key press from a SIP INFO body. Supports
+// application/dtmf-relay ("Signal=5\r\nDuration=160") and application/dtmf
+// (body is the key itself).
+func ParseDTMFInfo(contentType string, body []byte) (*DTMFEvent, bool) {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch mediaType {
+	case "application/dtmf-relay":
+		event := &DTMFEvent{}
+		for _, line := range strings.Split(string(body), "\n") {
+			key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+			if !ok {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "signal":
+				event.Digit = normalizeDTMFDigit(value)
+			case "duration":
+				if d, err := strconv.Atoi(value); err == nil {
+					event.Duration = d
+				}
+			}
+		}
+		return event, event.Digit != ""
+	case "application/dtmf":
+		digit := normalizeDTMFDigit(strings.TrimSpace(string(body)))
+		return &DTMFEvent{Digit: digit}, digit != ""
+	}
+	return nil, false
+}
+
+// normalizeDTMFDigit accepts a key or its numeric RFC 4733 event code
+// (some PBXes send Signal=10 for '*' and Signal=11 for '#').
+func normalizeDTMFDigit(value string) string {
+	value = strings.ToUpper(value)
+	if len(value) == 1 && strings.Contains(telephoneEventDigits, value) {
+		return value
+	}
+	if code, err := strconv.Atoi(value); err == nil && code >= 0 && code < len(telephoneEventDigits) {
+		return string(telephoneEventDigits[code])
+	}
+	return ""
+}
+
+// DTMFFromEvent returns the key press carried by an EventTypeDTMF session event.
+func DTMFFromEvent(event Event) (*DTMFEvent, bool) {
+	if event.Type != EventTypeDTMF {
+		return nil, false
+	}
+	digit, _ := event.Data["digit"].(string)
+	if digit == "" {
+		return nil, false
+	}
+	duration, _ := event.Data["duration_ms"].(int)
+	return &DTMFEvent{Digit: digit, Duration: duration}, true
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTelephoneEvent(t *testing.T) {
+	// '#' (11), end bit set, volume 10, duration 800 timestamp units
+	event, err := parseTelephoneEvent([]byte{11, 0x8A, 0x03, 0x20})
+	require.NoError(t, err)
+	assert.Equal(t, "#", event.Digit)
+	assert.True(t, event.End)
+	assert.Equal(t, uint16(800), event.Duration)
+
+	event, err = parseTelephoneEvent([]byte{5, 0x0A, 0x00, 0xA0})
+	require.NoError(t, err)
+	assert.Equal(t, "5", event.Digit)
+	assert.False(t, event.End)
+
+	_, err = parseTelephoneEvent([]byte{16, 0x80, 0x00, 0x00})
+	assert.Error(t, err)
+	_, err = parseTelephoneEvent([]byte{1, 0x80})
+	assert.Error(t, err)
+}
+
+func TestEncodeTelephoneEvent(t *testing.T) {
+	assert.Equal(t, []byte{11, 0x8A, 0x03, 0x20}, encodeTelephoneEvent(11, true, 10, 800))
+
+	event, err := parseTelephoneEvent(encodeTelephoneEvent(4, false, 10, 160))
+	require.NoError(t, err)
+	assert.Equal(t, &telephoneEvent{Digit: "4", Duration: 160}, event)
+
+	codes, err := telephoneEventCodes("12*#a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 10, 11, 12}, codes)
+	_, err = telephoneEventCodes("1x")
+	assert.Error(t, err)
+}
+
+func TestRTPHandler_SendTelephoneEvent(t *testing.T) {
+	remote, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer remote.Close()
+	sendConn, err := net.DialUDP("udp", nil, remote.LocalAddr().(*net.UDPAddr))
+	require.NoError(t, err)
+	defer sendConn.Close()
+
+	h := &RTPHandler{codec: &CodecPCMU, sendConn: sendConn, timestamp: 4000, sequenceNumber: 10}
+	ev := &outboundDTMF{code: 5}
+	ended := 0
+	for i := 0; i < 5; i++ {
+		if h.sendTelephoneEvent(ev, 160, 800, nil) {
+			ended++
+		}
+	}
+	assert.Equal(t, 1, ended)
+
+	var packets []*RTPPacket
+	buf := make([]byte, rtpPacketMaxSize)
+	remote.SetReadDeadline(time.Now().Add(time.Second))
+	for len(packets) < 4+dtmfEndRetransmits {
+		n, _, err := remote.ReadFromUDP(buf)
+		require.NoError(t, err)
+		packet, err := h.parseRTPPacket(buf[:n])
+		require.NoError(t, err)
+		packets = append(packets, packet)
+	}
+	for i, packet := range packets {
+		assert.Equal(t, CodecTelephoneEvent.PayloadType, packet.PayloadType)
+		assert.Equal(t, uint32(4000), packet.Timestamp)
+		assert.Equal(t, uint16(10+i), packet.SequenceNumber)
+		assert.Equal(t, i == 0, packet.Marker)
+		event, err := parseTelephoneEvent(packet.Payload)
+		require.NoError(t, err)
+		assert.Equal(t, "5", event.Digit)
+		assert.Equal(t, i >= 4, event.End)
+	}
+	// media resumes after the event
+	assert.Equal(t, uint32(4800), h.timestamp)
+}
+
+func TestRTPHandler_TelephoneEventReportedOnce(t *testing.T) {
+	h := &RTPHandler{codec: &CodecPCMU}
+	var got []DTMFEvent
+	h.SetOnDTMF(func(e DTMFEvent) { got = append(got, e) })
+
+	start := &RTPPacket{Timestamp: 1000, Payload: []byte{7, 0x0A, 0x00, 0xA0}}
+	end := &RTPPacket{Timestamp: 1000, Payload: []byte{7, 0x8A, 0x03, 0x20}}
+	h.handleTelephoneEvent(start)
+	h.handleTelephoneEvent(end)
+	h.handleTelephoneEvent(end)
+	h.handleTelephoneEvent(end)
+	require.Len(t, got, 1)
+	assert.Equal(t, DTMFEvent{Digit: "7", Duration: 100}, got[0])
+
+	// same key again is a new event with a new timestamp
+	h.handleTelephoneEvent(&RTPPacket{Timestamp: 2600, Payload: []byte{7, 0x8A, 0x03, 0x20}})
+	assert.Len(t, got, 2)
+}
+
+func TestParseDTMFInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		digit       string
+		duration    int
+		ok          bool
+	}{
+		{"dtmf-relay", "application/dtmf-relay", "Signal=5\r\nDuration=160\r\n", "5", 160, true},
+		{"dtmf-relay numeric star", "application/dtmf-relay", "Signal=10\r\nDuration=100\r\n", "*", 100, true},
+		{"dtmf-relay with params", "Application/DTMF-Relay; charset=utf-8", "Signal= #\nDuration= 250", "#", 250, true},
+		{"dtmf", "application/dtmf", "9", "9", 0, true},
+		{"missing signal", "application/dtmf-relay", "Duration=160", "", 0, false},
+		{"other content", "application/json", `{"digit":"1"}`, "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := ParseDTMFInfo(tt.contentType, []byte(tt.body))
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.digit, event.Digit)
+				assert.Equal(t, tt.duration, event.Duration)
+			}
+		})
+	}
+}
+
+func TestDTMFFromEvent(t *testing.T) {
+	event, ok := DTMFFromEvent(NewEvent(EventTypeDTMF, "call-1", map[string]interface{}{"digit": "3", "duration_ms": 120}))
+	require.True(t, ok)
+	assert.Equal(t, DTMFEvent{Digit: "3", Duration: 120}, *event)
+
+	_, ok = DTMFFromEvent(NewEvent(EventTypeBye, "call-1", nil))
+	assert.False(t, ok)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/zaf/g711"
+)
+
+// Jitter buffer depth, in frames (20ms each for our codecs)
+const (
+	jitterMinDepth = 2  // 40ms
+	jitterMaxDepth = 10 // 200ms
+
+	// jitterDrainSlack frames above the target depth are tolerated before the
+	// buffer drops frames to bring latency back down.
+	jitterDrainSlack = 2
+
+	// jitterShrinkInterval paces depth reductions so a short calm period
+	// does not undo the depth a bursty link needs.
+	jitterShrinkInterval = 10 * time.Second
+
+	// jitterResyncDistance is the sequence jump treated as a stream restart
+	// rather than loss.
+	jitterResyncDistance = 1000
+
+	// plcMaxFrames consecutive lost frames are concealed by fading the last
+	// frame out, further losses play silence.
+	plcMaxFrames = 3
+)
+
+// jitterPop is the outcome of a playout tick.
+type jitterPop int
+
+const (
+	jitterPopNone   jitterPop = iota // buffering or underrun, nothing to play
+	jitterPopPacket                  // next packet in sequence order
+	jitterPopLost                    // next packet is missing, conceal it
+)
+
+// jitterBuffer reorders inbound RTP by sequence number and releases one
+// frame per playout tick once it holds its target depth. Telephone-events
+// share the sequence space, they are marked skipped so they don't read as loss.
+type jitterBuffer struct {
+	mu sync.Mutex
+
+	entries map[uint16]*RTPPacket // nil marks a skipped sequence number
+	next    uint16
+	started bool
+	primed  bool
+
+	depth      int
+	lastShrink time.Time
+
+	discarded uint64 // frames dropped to drain excess latency
+}
+
+func newJitterBuffer() *jitterBuffer {
+	return &jitterBuffer{
+		entries: make(map[uint16]*RTPPacket),
+		depth:   jitterMinDepth,
+	}
+}
+
+// seqBefore reports whether a precedes b, accounting for wrap-around.
+func seqBefore(a, b uint16) bool {
+	return int16(a-b) < 0
+}
+
+// Push adds a packet, reporting false when it arrived too late to be played
+// or is a duplicate.
+func (jb *jitterBuffer) Push(packet *RTPPacket) bool {
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+	return jb.insert(packet.SequenceNumber, packet)
+}
+
+// Skip marks a sequence number carried by a non-audio packet.
+func (jb *jitterBuffer) Skip(seq uint16) {
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+	jb.insert(seq, nil)
+}
+
+func (jb *jitterBuffer) insert(seq uint16, packet *RTPPacket) bool {
+	if !jb.started {
+		jb.next = seq
+		jb.started = true
+	}
+	distance := int(int16(seq - jb.next))
+	if distance > jitterResyncDistance || distance < -jitterResyncDistance {
+		jb.reset()
+		jb.next = seq
+		jb.started = true
+	} else if seqBefore(seq, jb.next) {
+		return false
+	}
+	if _, ok := jb.entries[seq]; ok {
+		return false
+	}
+	jb.entries[seq] = packet
+	return true
+}
+
+// Pop returns the next frame to play.
+func (jb *jitterBuffer) Pop() (*RTPPacket, jitterPop) {
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+
+	if !jb.primed {
+		if len(jb.entries) < jb.depth {
+			return nil, jitterPopNone
+		}
+		jb.primed = true
+	}
+
+	// drain one frame per tick while latency exceeds the target
+	if len(jb.entries) > jb.depth+jitterDrainSlack {
+		if _, ok := jb.entries[jb.next]; ok {
+			delete(jb.entries, jb.next)
+			jb.discarded++
+		}
+		jb.next++
+	}
+
+	for {
+		packet, ok := jb.entries[jb.next]
+		if ok {
+			delete(jb.entries, jb.next)
+			jb.next++
+			if packet == nil {
+				continue
+			}
+			return packet, jitterPopPacket
+		}
+		if len(jb.entries) == 0 {
+			// underrun: rebuffer, and hold more next time
+			jb.primed = false
+			if jb.depth < jitterMaxDepth {
+				jb.depth++
+			}
+			return nil, jitterPopNone
+		}
+		jb.next++
+		return nil, jitterPopLost
+	}
+}
+
+// Adapt moves the target depth towards target frames: growing at once,
+// shrinking one frame per jitterShrinkInterval.
+func (jb *jitterBuffer) Adapt(target int, now time.Time) {
+	target = max(jitterMinDepth, min(jitterMaxDepth, target))
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+	switch {
+	case target > jb.depth:
+		jb.depth = target
+		jb.lastShrink = now
+	case target < jb.depth && now.Sub(jb.lastShrink) >= jitterShrinkInterval:
+		jb.depth--
+		jb.lastShrink = now
+	}
+}
+
+// Depth returns the current target depth in frames.
+func (jb *jitterBuffer) Depth() int {
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+	return jb.depth
+}
+
+// Discarded returns the number of frames dropped to drain latency.
+func (jb *jitterBuffer) Discarded() uint64 {
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+	return jb.discarded
+}
+
+// Reset drops all buffered packets, used when the remote stream restarts.
+func (jb *jitterBuffer) Reset() {
+	jb.mu.Lock()
+	defer jb.mu.Unlock()
+	jb.reset()
+}
+
+func (jb *jitterBuffer) reset() {
+	clear(jb.entries)
+	jb.started = false
+	jb.primed = false
+}
+
+// jitterTargetDepth sizes the buffer to absorb four times the measured
+// interarrival jitter on top of one frame.
+func jitterTargetDepth(jitter, frame time.Duration) int {
+	if frame <= 0 {
+		return jitterMinDepth
+	}
+	return 1 + int(math.Ceil(float64(4*jitter)/float64(frame)))
+}
+
+// concealFrame replaces the n-th consecutive lost frame (1-based). G.711
+// frames repeat the last good frame with decreasing gain; past plcMaxFrames,
+// or for the predictive codecs (G.722, Opus), silence is played.
+func concealFrame(last []byte, n int, codec *Codec) []byte {
+	if n > plcMaxFrames || (!codec.Is(CodecPCMU) && !codec.Is(CodecPCMA)) {
+		return codec.silenceFrame()
+	}
+	frame := make([]byte, len(last))
+	gain := 1 - float64(n)/float64(plcMaxFrames+1)
+	for i, b := range last {
+		if codec.Is(CodecPCMA) {
+			frame[i] = g711.EncodeAlawFrame(int16(float64(g711.DecodeAlawFrame(b)) * gain))
+		} else {
+			frame[i] = g711.EncodeUlawFrame(int16(float64(g711.DecodeUlawFrame(b)) * gain))
+		}
+	}
+	return frame
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zaf/g711"
+)
+
+func testPacket(seq uint16) *RTPPacket {
+	return &RTPPacket{SequenceNumber: seq, Timestamp: uint32(seq) * 160, Payload: []byte{byte(seq)}}
+}
+
+func popSequence(t *testing.T, jb *jitterBuffer) uint16 {
+	t.Helper()
+	packet, state := jb.Pop()
+	require.Equal(t, jitterPopPacket, state)
+	return packet.SequenceNumber
+}
+
+func TestJitterBuffer_Reorders(t *testing.T) {
+	jb := newJitterBuffer()
+	assert.True(t, jb.Push(testPacket(10)))
+	_, state := jb.Pop()
+	assert.Equal(t, jitterPopNone, state, "buffer holds until primed")
+
+	assert.True(t, jb.Push(testPacket(12)))
+	assert.True(t, jb.Push(testPacket(11)))
+	assert.Equal(t, uint16(10), popSequence(t, jb))
+	assert.Equal(t, uint16(11), popSequence(t, jb))
+	assert.Equal(t, uint16(12), popSequence(t, jb))
+
+	// underrun rebuffers with a deeper target
+	_, state = jb.Pop()
+	assert.Equal(t, jitterPopNone, state)
+	assert.Equal(t, jitterMinDepth+1, jb.Depth())
+}
+
+func TestJitterBuffer_LossAndSkip(t *testing.T) {
+	jb := newJitterBuffer()
+	jb.Push(testPacket(65534))
+	jb.Skip(65535) // telephone-event
+	jb.Push(testPacket(1))
+
+	assert.Equal(t, uint16(65534), popSequence(t, jb))
+	// 65535 is skipped, 0 was lost
+	_, state := jb.Pop()
+	assert.Equal(t, jitterPopLost, state)
+	assert.Equal(t, uint16(1), popSequence(t, jb))
+}
+
+func TestJitterBuffer_LateAndDuplicate(t *testing.T) {
+	jb := newJitterBuffer()
+	jb.Push(testPacket(5))
+	jb.Push(testPacket(6))
+	assert.Equal(t, uint16(5), popSequence(t, jb))
+
+	assert.False(t, jb.Push(testPacket(4)), "already played")
+	assert.False(t, jb.Push(testPacket(6)), "duplicate")
+
+	// a far jump is a restart, not loss
+	assert.True(t, jb.Push(testPacket(40000)))
+	jb.Push(testPacket(40001))
+	assert.Equal(t, uint16(40000), popSequence(t, jb))
+}
+
+func TestJitterBuffer_DrainsExcessLatency(t *testing.T) {
+	jb := newJitterBuffer()
+	for seq := uint16(0); seq < 8; seq++ {
+		jb.Push(testPacket(seq))
+	}
+	// 8 frames against a target of 2 (+2 slack): drop one per tick
+	assert.Equal(t, uint16(1), popSequence(t, jb))
+	assert.Equal(t, uint16(3), popSequence(t, jb))
+	assert.Equal(t, uint64(2), jb.Discarded())
+	assert.Equal(t, uint16(4), popSequence(t, jb))
+}
+
+func TestJitterBuffer_Adapt(t *testing.T) {
+	jb := newJitterBuffer()
+	now := time.Now()
+
+	jb.Adapt(6, now)
+	assert.Equal(t, 6, jb.Depth())
+	jb.Adapt(2, now.Add(time.Second))
+	assert.Equal(t, 6, jb.Depth(), "shrinking is paced")
+	jb.Adapt(2, now.Add(jitterShrinkInterval))
+	assert.Equal(t, 5, jb.Depth())
+	jb.Adapt(50, now)
+	assert.Equal(t, jitterMaxDepth, jb.Depth())
+
+	assert.Equal(t, 1, jitterTargetDepth(0, 20*time.Millisecond))
+	assert.Equal(t, 4, jitterTargetDepth(15*time.Millisecond, 20*time.Millisecond))
+}
+
+func TestConcealFrame(t *testing.T) {
+	last := make([]byte, 160)
+	for i := range last {
+		last[i] = g711.EncodeUlawFrame(8000)
+	}
+
+	first := concealFrame(last, 1, &CodecPCMU)
+	require.Len(t, first, 160)
+	assert.InDelta(t, 6000, g711.DecodeUlawFrame(first[0]), 300)
+
+	third := concealFrame(last, 3, &CodecPCMU)
+	assert.InDelta(t, 2000, g711.DecodeUlawFrame(third[0]), 100)
+
+	assert.Equal(t, bytesOf(0xFF, 160), concealFrame(last, plcMaxFrames+1, &CodecPCMU))
+	assert.Equal(t, bytesOf(g722Silence, 160), concealFrame(last, 1, &CodecG722))
+	assert.Equal(t, opusSilenceFrame, concealFrame([]byte{1, 2, 3, 4}, 1, &CodecOpus))
+}
+
+func bytesOf(b byte, n int) []byte {
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = b
+	}
+	return out
+}
@@ -0,0 +1,515 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/emiago/sipgo"
+	"github.com/emiago/sipgo/sip"
+	"github.com/google/uuid"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+)
+
+// Registration timing
+const (
+	DefaultRegisterExpires = time.Hour
+	minRegisterExpires     = time.Minute
+	defaultRegisterTimeout = 10 * time.Second
+
+	// registerRefreshMargin is how long before expiry a binding is refreshed,
+	// bounded to half of the granted expiry for short registrations.
+	registerRefreshMargin = 30 * time.Second
+
+	// Failed registrations are retried with exponential backoff.
+	registerRetryMin = 5 * time.Second
+	registerRetryMax = 5 * time.Minute
+)
+
+// RegistrationState represents the state of an outbound registration
+type RegistrationState string
+
+const (
+	RegistrationStateRegistering  RegistrationState = "registering"
+	RegistrationStateRegistered   RegistrationState = "registered"
+	RegistrationStateFailed       RegistrationState = "failed"
+	RegistrationStateUnregistered RegistrationState = "unregistered"
+)
+
+// RegistrationConfig describes an outbound registration of the assistant as
+// an extension on a PBX or on a carrier trunk.
+type RegistrationConfig struct {
+	// ID identifies the registration on the server, defaults to the AOR.
+	ID string
+
+	// Config holds the registrar (Server, Port, Transport) and the account
+	// (Username, Password, Domain) to register.
+	Config *Config
+
+	// Expires is the requested binding lifetime, the registrar may grant less.
+	Expires time.Duration
+
+	// ContactUser is the user part of the Contact we register, the registrar
+	// sends calls for the AOR to it. Defaults to a unique token so inbound
+	// calls can be matched back to this registration.
+	ContactUser string
+
+	// AssistantID and Auth authenticate inbound calls delivered through this
+	// registration, in place of the sip:{assistantID}:{apiKey}@host URI. Auth
+	// is a service scope principal of the assistant's project, no caller
+	// token is kept for the lifetime of the registration.
+	AssistantID string
+	Auth        types.SimplePrinciple
+}
+
+// Validate validates the registration configuration
+func (c *RegistrationConfig) Validate() error {
+	if c.Config == nil {
+		return fmt.Errorf("%w: registration config is required", ErrInvalidConfig)
+	}
+	if c.Config.Server == "" {
+		return fmt.Errorf("%w: sip_server is required", ErrInvalidConfig)
+	}
+	if c.Config.Username == "" {
+		return fmt.Errorf("%w: sip_username is required", ErrInvalidConfig)
+	}
+	if !c.Config.Transport.IsValid() && c.Config.Transport != "" {
+		return fmt.Errorf("%w: invalid transport: %s", ErrInvalidConfig, c.Config.Transport)
+	}
+	return nil
+}
+
+// RegistrationInfo is a snapshot of an outbound registration
+type RegistrationInfo struct {
+	ID           string            `json:"id"`
+	AOR          string            `json:"aor"`
+	Registrar    string            `json:"registrar"`
+	Contact      string            `json:"contact"`
+	AssistantID  string            `json:"assistant_id,omitempty"`
+	State        RegistrationState `json:"state"`
+	Expires      time.Duration     `json:"expires,omitempty"`
+	RegisteredAt *time.Time        `json:"registered_at,omitempty"`
+	ExpiresAt    *time.Time        `json:"expires_at,omitempty"`
+	Failures     int               `json:"failures,omitempty"`
+	LastError    string            `json:"last_error,omitempty"`
+}
+
+// registration keeps one binding alive: it registers, refreshes before the
+// granted expiry and re-registers with backoff after failures.
+type registration struct {
+	mu      sync.RWMutex
+	logger  commons.Logger
+	client  *sipgo.Client
+	cfg     RegistrationConfig
+	contact sip.ContactHeader
+
+	// Call-ID and From tag stay the same for all REGISTERs of a binding,
+	// CSeq increases (RFC 3261 10.2).
+	callID  string
+	fromTag string
+	cseq    uint32
+
+	info RegistrationInfo
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newRegistration(logger commons.Logger, client *sipgo.Client, cfg RegistrationConfig, contactHost string, contactPort int) *registration {
+	scheme := "sip"
+	if cfg.Config.Transport == TransportTLS {
+		scheme = "sips"
+	}
+	contact := sip.ContactHeader{
+		Address: sip.Uri{
+			Scheme: scheme,
+			User:   cfg.ContactUser,
+			Host:   contactHost,
+			Port:   contactPort,
+		},
+		Params: sip.NewParams(),
+	}
+	if cfg.Config.Transport == TransportTLS || cfg.Config.Transport == TransportTCP {
+		contact.Address.UriParams = sip.NewParams()
+		contact.Address.UriParams.Add("transport", string(cfg.Config.Transport))
+	}
+
+	r := &registration{
+		logger:  logger,
+		client:  client,
+		cfg:     cfg,
+		contact: contact,
+		callID:  uuid.NewString(),
+		fromTag: sip.GenerateTagN(16),
+		cseq:    1,
+	}
+	aor, registrar := r.aor(), r.registrar()
+	r.info = RegistrationInfo{
+		ID:          cfg.ID,
+		AOR:         aor.String(),
+		Registrar:   registrar.String(),
+		Contact:     contact.Address.String(),
+		AssistantID: cfg.AssistantID,
+		State:       RegistrationStateRegistering,
+	}
+	return r
+}
+
+// aor is the address-of-record being registered: sip:username@domain
+func (r *registration) aor() sip.Uri {
+	domain := r.cfg.Config.Domain
+	if domain == "" {
+		domain = r.cfg.Config.Server
+	}
+	return sip.Uri{Scheme: r.contact.Address.Scheme, User: r.cfg.Config.Username, Host: domain}
+}
+
+// registrar is the Request-URI of the REGISTER, it carries no user part.
+func (r *registration) registrar() sip.Uri {
+	uri := sip.Uri{Scheme: r.contact.Address.Scheme, Host: r.cfg.Config.Server, Port: r.cfg.Config.Port}
+	if r.cfg.Config.Transport == TransportTLS || r.cfg.Config.Transport == TransportTCP {
+		uri.UriParams = sip.NewParams()
+		uri.UriParams.Add("transport", string(r.cfg.Config.Transport))
+	}
+	return uri
+}
+
+// Info returns a snapshot of the registration state
+func (r *registration) Info() RegistrationInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.info
+}
+
+// start runs the registration loop until ctx is cancelled or stop is called.
+func (r *registration) start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+	go r.run(ctx)
+}
+
+func (r *registration) run(ctx context.Context) {
+	defer close(r.done)
+
+	backoff := registerRetryMin
+	for {
+		var wait time.Duration
+		granted, err := r.register(ctx, r.requestedExpires())
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.setFailed(err)
+			wait = backoff
+			backoff = min(backoff*2, registerRetryMax)
+			r.logger.Warnw("SIP registration failed",
+				"id", r.cfg.ID,
+				"registrar", r.info.Registrar,
+				"retry_in", wait,
+				"error", err)
+		} else {
+			r.setRegistered(granted)
+			wait = refreshAfter(granted)
+			backoff = registerRetryMin
+			r.logger.Infow("SIP registration active",
+				"id", r.cfg.ID,
+				"aor", r.info.AOR,
+				"expires", granted,
+				"refresh_in", wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// stop ends the registration loop and removes the binding from the registrar.
+func (r *registration) stop(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+		<-r.done
+	}
+	if r.Info().State != RegistrationStateRegistered {
+		r.setState(RegistrationStateUnregistered)
+		return nil
+	}
+	_, err := r.register(ctx, 0)
+	r.setState(RegistrationStateUnregistered)
+	return err
+}
+
+func (r *registration) requestedExpires() time.Duration {
+	if r.cfg.Expires <= 0 {
+		return DefaultRegisterExpires
+	}
+	return max(r.cfg.Expires, minRegisterExpires)
+}
+
+// register sends one REGISTER, answering a digest challenge when asked, and
+// returns the expiry granted by the registrar. An expiry of 0 removes the binding.
+func (r *registration) register(ctx context.Context, expires time.Duration) (time.Duration, error) {
+	timeout := r.cfg.Config.RegisterTimeout
+	if timeout <= 0 {
+		timeout = defaultRegisterTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req := r.newRequest(expires)
+	res, err := r.client.Do(ctx, req)
+	if err != nil {
+		return 0, NewSIPError("Register", r.callID, "REGISTER failed", err)
+	}
+	if res.StatusCode == sip.StatusUnauthorized || res.StatusCode == sip.StatusProxyAuthRequired {
+		res, err = r.client.DoDigestAuth(ctx, req, res, DigestCredentials(r.cfg.Config))
+		if err != nil {
+			return 0, NewSIPError("Register", r.callID, "REGISTER digest auth failed", err)
+		}
+	}
+	r.mu.Lock()
+	r.cseq = req.CSeq().SeqNo + 1
+	r.mu.Unlock()
+
+	// 423 Interval Too Brief carries the shortest expiry the registrar accepts
+	if res.StatusCode == sip.StatusIntervalToBrief && expires > 0 {
+		if h := res.GetHeader("Min-Expires"); h != nil {
+			if v, err := strconv.Atoi(h.Value()); err == nil && time.Duration(v)*time.Second > expires {
+				r.mu.Lock()
+				r.cfg.Expires = time.Duration(v) * time.Second
+				r.mu.Unlock()
+				return r.register(ctx, time.Duration(v)*time.Second)
+			}
+		}
+	}
+	if !res.IsSuccess() {
+		sipErr := NewSIPError("Register", r.callID, fmt.Sprintf("REGISTER rejected with %d %s", res.StatusCode, res.Reason), ErrRegistrationRejected)
+		sipErr.Code = res.StatusCode
+		return 0, sipErr
+	}
+	return grantedExpires(res, r.contact.Address, expires), nil
+}
+
+func (r *registration) newRequest(expires time.Duration) *sip.Request {
+	r.mu.RLock()
+	cseq := r.cseq
+	r.mu.RUnlock()
+
+	req := sip.NewRequest(sip.REGISTER, r.registrar())
+	from := &sip.FromHeader{Address: r.aor(), Params: sip.NewParams()}
+	from.Params.Add("tag", r.fromTag)
+	to := &sip.ToHeader{Address: r.aor(), Params: sip.NewParams()}
+	callID := sip.CallIDHeader(r.callID)
+	expiresHDR := sip.ExpiresHeader(uint32(expires / time.Second))
+	req.AppendHeader(from)
+	req.AppendHeader(to)
+	req.AppendHeader(&callID)
+	req.AppendHeader(&sip.CSeqHeader{SeqNo: cseq, MethodName: sip.REGISTER})
+	req.AppendHeader(r.contact.Clone())
+	req.AppendHeader(&expiresHDR)
+	return req
+}
+
+func (r *registration) setRegistered(expires time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	expiresAt := now.Add(expires)
+	r.info.State = RegistrationStateRegistered
+	r.info.Expires = expires
+	r.info.RegisteredAt = &now
+	r.info.ExpiresAt = &expiresAt
+	r.info.Failures = 0
+	r.info.LastError = ""
+}
+
+func (r *registration) setFailed(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.info.State = RegistrationStateFailed
+	r.info.Failures++
+	r.info.LastError = err.Error()
+	r.info.ExpiresAt = nil
+}
+
+func (r *registration) setState(state RegistrationState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.info.State = state
+	if state == RegistrationStateUnregistered {
+		r.info.ExpiresAt = nil
+	}
+}
+
+// grantedExpires reads the expiry the registrar granted for our contact: the
+// expires param of the matching Contact, else the Expires header, else the
+// requested value.
+func grantedExpires(res *sip.Response, contact sip.Uri, requested time.Duration) time.Duration {
+	for _, h := range res.GetHeaders("Contact") {
+		// registrars list every binding of the AOR, possibly in one header
+		for _, value := range strings.Split(h.Value(), ",") {
+			var uri sip.Uri
+			params := sip.NewParams()
+			if _, err := sip.ParseAddressValue(strings.TrimSpace(value), &uri, params); err != nil {
+				continue
+			}
+			if uri.User != contact.User || uri.Host != contact.Host {
+				continue
+			}
+			if v, ok := params.Get("expires"); ok {
+				if secs, err := strconv.Atoi(v); err == nil {
+					return time.Duration(secs) * time.Second
+				}
+			}
+		}
+	}
+	if h := res.GetHeader("Expires"); h != nil {
+		if secs, err := strconv.Atoi(h.Value()); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	return requested
+}
+
+// refreshAfter returns when a binding granted for expires should be refreshed.
+func refreshAfter(expires time.Duration) time.Duration {
+	refresh := expires - registerRefreshMargin
+	if refresh < expires/2 {
+		refresh = expires / 2
+	}
+	return max(refresh, time.Second)
+}
+
+// Register starts an outbound registration and keeps it refreshed until
+// Unregister or Stop is called. The first REGISTER is sent in the background,
+// the returned info is the initial state.
+func (s *Server) Register(cfg *RegistrationConfig) (RegistrationInfo, error) {
+	if s.state.Load() != int32(ServerStateRunning) {
+		return RegistrationInfo{}, fmt.Errorf("SIP server is not running")
+	}
+	if err := cfg.Validate(); err != nil {
+		return RegistrationInfo{}, err
+	}
+
+	rc := *cfg
+	if rc.ID == "" {
+		rc.ID = fmt.Sprintf("%s@%s", rc.Config.Username, rc.Config.Server)
+	}
+	if rc.ContactUser == "" {
+		rc.ContactUser = "rapida-" + sip.GenerateTagN(12)
+	}
+
+	s.mu.Lock()
+	if _, ok := s.registrations[rc.ID]; ok {
+		s.mu.Unlock()
+		return RegistrationInfo{}, fmt.Errorf("%w: %s", ErrRegistrationExists, rc.ID)
+	}
+	reg := newRegistration(s.logger, s.client, rc, s.listenConfig.GetExternalIP(), s.listenConfig.Port)
+	s.registrations[rc.ID] = reg
+	s.mu.Unlock()
+
+	reg.start(s.ctx)
+	s.logger.Infow("SIP registration started",
+		"id", rc.ID,
+		"aor", reg.info.AOR,
+		"registrar", reg.info.Registrar,
+		"contact", reg.info.Contact)
+	return reg.Info(), nil
+}
+
+// Unregister stops refreshing the registration and removes its binding from
+// the registrar (REGISTER with Expires: 0).
+func (s *Server) Unregister(ctx context.Context, id string) error {
+	s.mu.Lock()
+	reg, ok := s.registrations[id]
+	delete(s.registrations, id)
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrRegistrationNotFound, id)
+	}
+	if err := reg.stop(ctx); err != nil {
+		s.logger.Warnw("SIP unregistration failed", "id", id, "error", err)
+		return err
+	}
+	s.logger.Infow("SIP registration removed", "id", id)
+	return nil
+}
+
+// GetRegistration returns the current state of a registration.
+func (s *Server) GetRegistration(id string) (RegistrationInfo, bool) {
+	s.mu.RLock()
+	reg, ok := s.registrations[id]
+	s.mu.RUnlock()
+	if !ok {
+		return RegistrationInfo{}, false
+	}
+	return reg.Info(), true
+}
+
+// Registrations returns the current state of all registrations, ordered by ID.
+func (s *Server) Registrations() []RegistrationInfo {
+	s.mu.RLock()
+	infos := make([]RegistrationInfo, 0, len(s.registrations))
+	for _, reg := range s.registrations {
+		infos = append(infos, reg.Info())
+	}
+	s.mu.RUnlock()
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// registrationForContact finds the registration whose Contact user an
+// inbound request was addressed to.
+func (s *Server) registrationForContact(user string) (*registration, bool) {
+	if user == "" {
+		return nil, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, reg := range s.registrations {
+		if reg.cfg.ContactUser == user {
+			return reg, true
+		}
+	}
+	return nil, false
+}
+
+// unregisterAll removes every binding, used when the server stops.
+func (s *Server) unregisterAll() {
+	s.mu.Lock()
+	regs := make([]*registration, 0, len(s.registrations))
+	for _, reg := range s.registrations {
+		regs = append(regs, reg)
+	}
+	s.registrations = make(map[string]*registration)
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, reg := range regs {
+		wg.Add(1)
+		go func(reg *registration) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), defaultRegisterTimeout)
+			defer cancel()
+			if err := reg.stop(ctx); err != nil {
+				s.logger.Warnw("SIP unregistration failed", "id", reg.cfg.ID, "error", err)
+			}
+		}(reg)
+	}
+	wg.Wait()
+}
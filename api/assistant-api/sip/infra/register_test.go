@@ -0,0 +1,171 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emiago/sipgo"
+	"github.com/emiago/sipgo/sip"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRegistrar challenges the first REGISTER of each Call-ID and grants
+// 120s to authenticated ones.
+type testRegistrar struct {
+	mu       sync.Mutex
+	expires  []string
+	authzed  int
+	contacts []string
+}
+
+func startTestRegistrar(t *testing.T) (*testRegistrar, int) {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := pc.LocalAddr().(*net.UDPAddr).Port
+	pc.Close()
+
+	ua, err := sipgo.NewUA()
+	require.NoError(t, err)
+	srv, err := sipgo.NewServer(ua)
+	require.NoError(t, err)
+
+	reg := &testRegistrar{}
+	srv.OnRegister(func(req *sip.Request, tx sip.ServerTransaction) {
+		if req.GetHeader("Authorization") == nil {
+			res := sip.NewResponseFromRequest(req, sip.StatusUnauthorized, "Unauthorized", nil)
+			res.AppendHeader(sip.NewHeader("WWW-Authenticate", `Digest realm="test", nonce="abc123", algorithm=MD5`))
+			tx.Respond(res)
+			return
+		}
+		reg.mu.Lock()
+		reg.authzed++
+		reg.expires = append(reg.expires, req.GetHeader("Expires").Value())
+		reg.contacts = append(reg.contacts, req.Contact().Address.User)
+		reg.mu.Unlock()
+
+		res := sip.NewResponseFromRequest(req, sip.StatusOK, "OK", nil)
+		contact := req.Contact().Clone()
+		contact.Params = sip.NewParams()
+		contact.Params.Add("expires", "120")
+		res.AppendHeader(contact)
+		tx.Respond(res)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		ua.Close()
+	})
+	go srv.ListenAndServe(ctx, "udp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	time.Sleep(50 * time.Millisecond)
+	return reg, port
+}
+
+func TestRegistration_RegisterWithDigestAuth(t *testing.T) {
+	registrar, port := startTestRegistrar(t)
+
+	ua, err := sipgo.NewUA()
+	require.NoError(t, err)
+	defer ua.Close()
+	client, err := sipgo.NewClient(ua, sipgo.WithClientHostname("127.0.0.1"))
+	require.NoError(t, err)
+	logger, _ := commons.NewApplicationLogger()
+
+	reg := newRegistration(logger, client, RegistrationConfig{
+		ID:          "ext-1001",
+		Config:      &Config{Server: "127.0.0.1", Port: port, Username: "1001", Password: "secret"},
+		ContactUser: "rapida-test",
+	}, "127.0.0.1", 5060)
+
+	granted, err := reg.register(context.Background(), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 120*time.Second, granted)
+
+	require.NoError(t, reg.stop(context.Background()))
+	// stop only unregisters an active binding
+	reg.setRegistered(granted)
+	require.NoError(t, reg.stop(context.Background()))
+	assert.Equal(t, RegistrationStateUnregistered, reg.Info().State)
+
+	registrar.mu.Lock()
+	defer registrar.mu.Unlock()
+	assert.Equal(t, 2, registrar.authzed)
+	assert.Equal(t, []string{"3600", "0"}, registrar.expires)
+	assert.Equal(t, []string{"rapida-test", "rapida-test"}, registrar.contacts)
+}
+
+func TestRegistration_Info(t *testing.T) {
+	reg := newRegistration(nil, nil, RegistrationConfig{
+		ID:          "trunk",
+		Config:      &Config{Server: "pbx.example.com", Port: 5061, Username: "1001", Domain: "example.com", Transport: TransportTLS},
+		ContactUser: "rapida-abc",
+		AssistantID: "42",
+	}, "203.0.113.10", 5060)
+
+	info := reg.Info()
+	assert.Equal(t, "sips:1001@example.com", info.AOR)
+	assert.Equal(t, "sips:pbx.example.com:5061;transport=tls", info.Registrar)
+	assert.Equal(t, "sips:rapida-abc@203.0.113.10:5060;transport=tls", info.Contact)
+	assert.Equal(t, RegistrationStateRegistering, info.State)
+
+	reg.setFailed(assert.AnError)
+	reg.setFailed(assert.AnError)
+	info = reg.Info()
+	assert.Equal(t, RegistrationStateFailed, info.State)
+	assert.Equal(t, 2, info.Failures)
+
+	reg.setRegistered(time.Minute)
+	info = reg.Info()
+	assert.Equal(t, RegistrationStateRegistered, info.State)
+	assert.Zero(t, info.Failures)
+	assert.NotNil(t, info.ExpiresAt)
+}
+
+func TestRefreshAfter(t *testing.T) {
+	assert.Equal(t, 3570*time.Second, refreshAfter(time.Hour))
+	assert.Equal(t, 30*time.Second, refreshAfter(time.Minute))
+	assert.Equal(t, 20*time.Second, refreshAfter(40*time.Second))
+	assert.Equal(t, time.Second, refreshAfter(0))
+}
+
+func TestGrantedExpires(t *testing.T) {
+	contact := sip.Uri{User: "rapida-abc", Host: "203.0.113.10"}
+	res := sip.NewResponse(sip.StatusOK, "OK")
+	res.AppendHeader(sip.NewHeader("Contact", "<sip:other@198.51.100.1>;expires=30, <sip:rapida-abc@203.0.113.10:5060>;expires=300"))
+	res.AppendHeader(sip.NewHeader("Expires", "600"))
+	assert.Equal(t, 300*time.Second, grantedExpires(res, contact, time.Hour))
+
+	res = sip.NewResponse(sip.StatusOK, "OK")
+	res.AppendHeader(sip.NewHeader("Expires", "600"))
+	assert.Equal(t, 600*time.Second, grantedExpires(res, contact, time.Hour))
+
+	assert.Equal(t, time.Hour, grantedExpires(sip.NewResponse(sip.StatusOK, "OK"), contact, time.Hour))
+}
+
+func TestCredentialMiddleware_RegistrationCredentials(t *testing.T) {
+	ctx := &SIPRequestContext{ToURI: "sip:1001@pbx.example.com", AssistantID: "42"}
+	ctx.Set("auth", &types.ServiceScope{})
+	result, err := CredentialMiddleware(ctx, func() (*InviteResult, error) { return Allow(&Config{}), nil })
+	require.NoError(t, err)
+	assert.True(t, result.ShouldAllow)
+	assert.Equal(t, "42", ctx.AssistantID)
+
+	ctx = &SIPRequestContext{ToURI: "sip:1001@pbx.example.com"}
+	result, err = CredentialMiddleware(ctx, func() (*InviteResult, error) { return Allow(&Config{}), nil })
+	require.NoError(t, err)
+	assert.False(t, result.ShouldAllow)
+}
@@ -0,0 +1,347 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/pion/rtcp"
+)
+
+const (
+	// rtcpReportInterval is the RFC 3550 minimum interval, each report is
+	// sent at a random point in [0.5, 1.5] times it.
+	rtcpReportInterval = 5 * time.Second
+
+	// RFC 3550 A.1 sequence validation limits
+	rtpMaxDropout  = 3000
+	rtpMaxMisorder = 100
+
+	// ntpEpochOffset is the number of seconds from 1900 (NTP) to 1970 (Unix).
+	ntpEpochOffset = 2208988800
+)
+
+// receptionStats tracks one remote source per RFC 3550 appendix A.
+type receptionStats struct {
+	ssrc        uint32
+	initialized bool
+
+	baseSeq  uint16
+	maxSeq   uint16
+	cycles   uint32
+	received uint32
+
+	expectedPrior uint32
+	receivedPrior uint32
+
+	// interarrival jitter, in timestamp units (A.8)
+	start       time.Time
+	transit     int64
+	hasTransit  bool
+	jitter      float64
+	lastSeq     uint16
+	lastTS      uint32
+	hasLastSeq  bool
+	frameLength time.Duration
+
+	// last SR from this source, for LSR/DLSR in our reports
+	lastSR   uint32
+	lastSRAt time.Time
+}
+
+// update accounts one received packet and reports whether the source
+// (re)started, i.e. its SSRC changed or its sequence jumped. Only media
+// packets feed the jitter estimate: telephone-events keep one timestamp for
+// the whole key press.
+func (r *receptionStats) update(packet *RTPPacket, arrival time.Time, clockRate uint32, media bool) bool {
+	seq := packet.SequenceNumber
+	restarted := false
+	if !r.initialized || packet.SSRC != r.ssrc {
+		*r = receptionStats{ssrc: packet.SSRC, initialized: true, baseSeq: seq, maxSeq: seq, start: arrival}
+		restarted = true
+	} else if delta := seq - r.maxSeq; delta < rtpMaxDropout {
+		if seq < r.maxSeq {
+			r.cycles += 1 << 16
+		}
+		r.maxSeq = seq
+	} else if delta <= 1<<16-rtpMaxMisorder {
+		// a large jump, the source restarted its sequence
+		lastSR, lastSRAt := r.lastSR, r.lastSRAt
+		*r = receptionStats{ssrc: packet.SSRC, initialized: true, baseSeq: seq, maxSeq: seq, start: arrival}
+		r.lastSR, r.lastSRAt = lastSR, lastSRAt
+		restarted = true
+	}
+	r.received++
+
+	if !media || clockRate == 0 {
+		return restarted
+	}
+	arrivalTS := int64(arrival.Sub(r.start) * time.Duration(clockRate) / time.Second)
+	transit := arrivalTS - int64(packet.Timestamp)
+	if r.hasTransit {
+		d := transit - r.transit
+		if d < 0 {
+			d = -d
+		}
+		r.jitter += (float64(d) - r.jitter) / 16
+	}
+	r.transit = transit
+	r.hasTransit = true
+
+	if r.hasLastSeq && seq == r.lastSeq+1 {
+		frame := time.Duration(packet.Timestamp-r.lastTS) * time.Second / time.Duration(clockRate)
+		if frame >= 10*time.Millisecond && frame <= 60*time.Millisecond {
+			r.frameLength = frame
+		}
+	}
+	r.lastSeq, r.lastTS, r.hasLastSeq = seq, packet.Timestamp, true
+	return restarted
+}
+
+func (r *receptionStats) extendedMax() uint32 {
+	return r.cycles + uint32(r.maxSeq)
+}
+
+func (r *receptionStats) expected() uint32 {
+	return r.extendedMax() - uint32(r.baseSeq) + 1
+}
+
+// lost is the cumulative number of packets lost, negative with duplicates.
+func (r *receptionStats) lost() int64 {
+	return int64(r.expected()) - int64(r.received)
+}
+
+// jitterDuration converts the jitter estimate to wall time.
+func (r *receptionStats) jitterDuration(clockRate uint32) time.Duration {
+	if clockRate == 0 {
+		return 0
+	}
+	return time.Duration(r.jitter * float64(time.Second) / float64(clockRate))
+}
+
+// report builds the reception report block for this source and starts a new
+// reporting interval (A.3).
+func (r *receptionStats) report(now time.Time) rtcp.ReceptionReport {
+	expected := r.expected()
+	expectedInterval := expected - r.expectedPrior
+	receivedInterval := r.received - r.receivedPrior
+	r.expectedPrior = expected
+	r.receivedPrior = r.received
+
+	var fraction uint8
+	if lostInterval := int64(expectedInterval) - int64(receivedInterval); expectedInterval > 0 && lostInterval > 0 {
+		fraction = uint8((lostInterval << 8) / int64(expectedInterval))
+	}
+	// cumulative loss is a signed 24-bit field
+	lost := max(min(r.lost(), 0x7FFFFF), -0x800000)
+
+	report := rtcp.ReceptionReport{
+		SSRC:               r.ssrc,
+		FractionLost:       fraction,
+		TotalLost:          uint32(lost) & 0xFFFFFF,
+		LastSequenceNumber: r.extendedMax(),
+		Jitter:             uint32(r.jitter),
+		LastSenderReport:   r.lastSR,
+	}
+	if r.lastSR != 0 {
+		report.Delay = uint32(now.Sub(r.lastSRAt) * 65536 / time.Second)
+	}
+	return report
+}
+
+// ntpTime converts t to a 64-bit NTP timestamp.
+func ntpTime(t time.Time) uint64 {
+	secs := uint64(t.Unix()) + ntpEpochOffset
+	frac := uint64(t.Nanosecond()) << 32 / uint64(time.Second)
+	return secs<<32 | frac
+}
+
+// ntpMiddle returns the middle 32 bits of t as NTP time, the unit of the
+// LSR field: 1/65536 seconds.
+func ntpMiddle(t time.Time) uint32 {
+	return uint32(ntpTime(t) >> 16)
+}
+
+// remoteReport is what the peer reported about our stream.
+type remoteReport struct {
+	reports      uint64
+	fractionLost uint8
+	totalLost    uint32
+	jitter       uint32 // timestamp units
+	roundTrip    time.Duration
+}
+
+func rtcpReportDelay() time.Duration {
+	return rtcpReportInterval/2 + time.Duration(rand.Int63n(int64(rtcpReportInterval)))
+}
+
+// rtcpLoop receives RTCP on the odd port next to RTP and sends a report
+// every rtcpReportInterval or so.
+func (h *RTPHandler) rtcpLoop() {
+	buf := make([]byte, rtpPacketMaxSize)
+	nextReport := time.Now().Add(rtcpReportDelay())
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		default:
+		}
+		if !h.running.Load() {
+			return
+		}
+
+		if now := time.Now(); !now.Before(nextReport) {
+			if err := h.sendRTCPReport(false); err != nil && h.logger != nil {
+				h.logger.Debugw("RTCP report not sent", "error", err)
+			}
+			nextReport = now.Add(rtcpReportDelay())
+		}
+
+		if err := h.rtcpConn.SetReadDeadline(time.Now().Add(rtpReadTimeout)); err != nil {
+			return
+		}
+		n, from, err := h.rtcpConn.ReadFromUDP(buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				continue
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		if err := h.handleRTCP(buf[:n], from, time.Now()); err != nil && h.logger != nil {
+			h.logger.Debugw("RTCP: ignoring packet", "error", err, "from", from.String())
+		}
+	}
+}
+
+// handleRTCP records SR timing from the peer and its reports on our stream.
+func (h *RTPHandler) handleRTCP(data []byte, from *net.UDPAddr, now time.Time) error {
+	h.mu.RLock()
+	decrypt := h.srtpDecrypt
+	clockRate := h.codec.ClockRate
+	h.mu.RUnlock()
+
+	if decrypt != nil {
+		var err error
+		if data, err = decrypt.decryptRTCP(data); err != nil {
+			return fmt.Errorf("srtcp: %w", err)
+		}
+	}
+	packets, err := rtcp.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+
+	// symmetric RTCP: answer where the peer sends from (NAT)
+	h.mu.Lock()
+	if from != nil && h.remoteAddr != nil && from.IP.Equal(h.remoteAddr.IP) {
+		h.remoteRTCPAddr = from
+	}
+	h.mu.Unlock()
+
+	h.statsMu.Lock()
+	defer h.statsMu.Unlock()
+	for _, packet := range packets {
+		var reports []rtcp.ReceptionReport
+		switch p := packet.(type) {
+		case *rtcp.SenderReport:
+			if h.reception.initialized && p.SSRC == h.reception.ssrc {
+				h.reception.lastSR = uint32(p.NTPTime >> 16)
+				h.reception.lastSRAt = now
+			}
+			reports = p.Reports
+		case *rtcp.ReceiverReport:
+			reports = p.Reports
+		}
+		for _, report := range reports {
+			if report.SSRC != h.ssrc {
+				continue
+			}
+			h.remote.reports++
+			h.remote.fractionLost = report.FractionLost
+			h.remote.totalLost = report.TotalLost
+			h.remote.jitter = report.Jitter
+			if report.LastSenderReport != 0 {
+				// RFC 3550 §6.4.1: RTT = A - LSR - DLSR
+				rtt := ntpMiddle(now) - report.LastSenderReport - report.Delay
+				if int32(rtt) >= 0 {
+					h.remote.roundTrip = time.Duration(rtt) * time.Second / 65536
+				}
+			}
+			if h.logger != nil {
+				h.logger.Debugw("RTCP report received",
+					"fraction_lost", report.FractionLost,
+					"total_lost", report.TotalLost,
+					"jitter_ms", time.Duration(report.Jitter)*time.Second/time.Duration(clockRate)/time.Millisecond,
+					"rtt", h.remote.roundTrip)
+			}
+		}
+	}
+	return nil
+}
+
+// buildRTCPReport returns our compound report: SR once we have sent media,
+// RR otherwise, followed by SDES CNAME and, when leaving, BYE.
+func (h *RTPHandler) buildRTCPReport(bye bool, now time.Time) []rtcp.Packet {
+	var reports []rtcp.ReceptionReport
+	h.statsMu.Lock()
+	if h.reception.initialized {
+		reports = append(reports, h.reception.report(now))
+	}
+	h.statsMu.Unlock()
+
+	h.mu.RLock()
+	ssrc, timestamp := h.ssrc, h.timestamp
+	h.mu.RUnlock()
+
+	var packets []rtcp.Packet
+	if sent := h.packetsSent.Load(); sent > 0 {
+		packets = append(packets, &rtcp.SenderReport{
+			SSRC:        ssrc,
+			NTPTime:     ntpTime(now),
+			RTPTime:     timestamp,
+			PacketCount: uint32(sent),
+			OctetCount:  uint32(h.bytesSent.Load()),
+			Reports:     reports,
+		})
+	} else {
+		packets = append(packets, &rtcp.ReceiverReport{SSRC: ssrc, Reports: reports})
+	}
+	packets = append(packets, rtcp.NewCNAMESourceDescription(ssrc, fmt.Sprintf("rapida-%08x", ssrc)))
+	if bye {
+		packets = append(packets, &rtcp.Goodbye{Sources: []uint32{ssrc}})
+	}
+	return packets
+}
+
+// sendRTCPReport sends our compound report to the peer's RTCP address.
+func (h *RTPHandler) sendRTCPReport(bye bool) error {
+	h.mu.RLock()
+	conn, remote, encrypt := h.rtcpConn, h.remoteRTCPAddr, h.srtpEncrypt
+	h.mu.RUnlock()
+	if conn == nil || remote == nil {
+		return nil
+	}
+
+	data, err := rtcp.Marshal(h.buildRTCPReport(bye, time.Now()))
+	if err != nil {
+		return err
+	}
+	if encrypt != nil {
+		if data, err = encrypt.encryptRTCP(data); err != nil {
+			return fmt.Errorf("srtcp: %w", err)
+		}
+	}
+	_, err = conn.WriteToUDP(data, remote)
+	return err
+}
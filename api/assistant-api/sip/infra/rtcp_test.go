@@ -0,0 +1,170 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pion/rtcp"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionStats_LossAndJitter(t *testing.T) {
+	var r receptionStats
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		if i == 3 || i == 4 {
+			continue
+		}
+		packet := &RTPPacket{SSRC: 7, SequenceNumber: uint16(100 + i), Timestamp: uint32(i * 160)}
+		restarted := r.update(packet, start.Add(time.Duration(i)*20*time.Millisecond), 8000, true)
+		assert.Equal(t, i == 0, restarted)
+	}
+	assert.Equal(t, uint32(10), r.expected())
+	assert.Equal(t, int64(2), r.lost())
+	assert.Less(t, r.jitterDuration(8000), time.Millisecond)
+	assert.Equal(t, 20*time.Millisecond, r.frameLength)
+
+	report := r.report(start)
+	assert.Equal(t, uint32(7), report.SSRC)
+	assert.Equal(t, uint8(2*256/10), report.FractionLost)
+	assert.Equal(t, uint32(2), report.TotalLost)
+	assert.Equal(t, uint32(109), report.LastSequenceNumber)
+
+	// nothing lost in the next interval
+	r.update(&RTPPacket{SSRC: 7, SequenceNumber: 110, Timestamp: 1600}, start.Add(200*time.Millisecond), 8000, true)
+	assert.Equal(t, uint8(0), r.report(start).FractionLost)
+
+	// a new SSRC starts over
+	assert.True(t, r.update(&RTPPacket{SSRC: 8, SequenceNumber: 1}, start, 8000, true))
+	assert.Equal(t, int64(0), r.lost())
+}
+
+func TestReceptionStats_Wrap(t *testing.T) {
+	var r receptionStats
+	now := time.Now()
+	for i, seq := range []uint16{65534, 65535, 0, 1} {
+		r.update(&RTPPacket{SSRC: 1, SequenceNumber: seq, Timestamp: uint32(i * 160)}, now.Add(time.Duration(i)*20*time.Millisecond), 8000, true)
+	}
+	assert.Equal(t, uint32(1<<16+1), r.extendedMax())
+	assert.Equal(t, uint32(4), r.expected())
+	assert.Equal(t, int64(0), r.lost())
+}
+
+func TestReceptionStats_Jitter(t *testing.T) {
+	var r receptionStats
+	start := time.Now()
+	for i := 0; i < 200; i++ {
+		// every other packet arrives 10ms late
+		arrival := start.Add(time.Duration(i) * 20 * time.Millisecond)
+		if i%2 == 1 {
+			arrival = arrival.Add(10 * time.Millisecond)
+		}
+		r.update(&RTPPacket{SSRC: 1, SequenceNumber: uint16(i), Timestamp: uint32(i * 160)}, arrival, 8000, true)
+	}
+	assert.InDelta(t, float64(10*time.Millisecond), float64(r.jitterDuration(8000)), float64(time.Millisecond))
+}
+
+func TestRTCP_ReportRoundTrip(t *testing.T) {
+	a := &RTPHandler{codec: &CodecPCMU, ssrc: 0xA}
+	b := &RTPHandler{codec: &CodecPCMU, ssrc: 0xB}
+	now := time.Now()
+
+	// a sends media to b
+	a.packetsSent.Store(50)
+	a.bytesSent.Store(8000)
+	for i := 0; i < 50; i++ {
+		b.reception.update(&RTPPacket{SSRC: 0xA, SequenceNumber: uint16(i), Timestamp: uint32(i * 160)}, now, 8000, true)
+	}
+
+	sr := a.buildRTCPReport(false, now)
+	require.Len(t, sr, 2)
+	require.IsType(t, &rtcp.SenderReport{}, sr[0])
+	assert.Equal(t, uint32(50), sr[0].(*rtcp.SenderReport).PacketCount)
+	assert.IsType(t, &rtcp.SourceDescription{}, sr[1])
+
+	data, err := rtcp.Marshal(sr)
+	require.NoError(t, err)
+	require.NoError(t, b.handleRTCP(data, nil, now.Add(30*time.Millisecond)))
+
+	// b answers 100ms later, the report reaches a after another 30ms
+	rr := b.buildRTCPReport(true, now.Add(130*time.Millisecond))
+	require.Len(t, rr, 3)
+	require.IsType(t, &rtcp.ReceiverReport{}, rr[0])
+	assert.IsType(t, &rtcp.Goodbye{}, rr[2])
+
+	data, err = rtcp.Marshal(rr)
+	require.NoError(t, err)
+	require.NoError(t, a.handleRTCP(data, nil, now.Add(160*time.Millisecond)))
+
+	assert.Equal(t, uint64(1), a.remote.reports)
+	assert.Equal(t, uint32(0), a.remote.totalLost)
+	assert.InDelta(t, float64(60*time.Millisecond), float64(a.remote.roundTrip), float64(time.Millisecond))
+
+	assert.Error(t, a.handleRTCP([]byte{0x80, 0xC8}, nil, now))
+}
+
+func TestRTPHandler_RTCPExchange(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	newHandler := func() *RTPHandler {
+		h, err := NewRTPHandler(context.Background(), &RTPConfig{LocalIP: "127.0.0.1", Logger: logger})
+		require.NoError(t, err)
+		t.Cleanup(func() { h.Stop() })
+		if h.rtcpConn == nil {
+			t.Skip("RTCP port unavailable")
+		}
+		return h
+	}
+	a, b := newHandler(), newHandler()
+	key, err := NewSDESCrypto(1)
+	require.NoError(t, err)
+	require.NoError(t, a.EnableSRTP(SDPProfileRTPSAVP, key, key))
+	require.NoError(t, b.EnableSRTP(SDPProfileRTPSAVP, key, key))
+
+	_, portA := a.LocalAddr()
+	_, portB := b.LocalAddr()
+	a.SetRemoteAddr("127.0.0.1", portB)
+	b.SetRemoteAddr("127.0.0.1", portA)
+	a.Start()
+	b.Start()
+
+	a.AudioOut() <- make([]byte, 320)
+	require.Eventually(t, func() bool {
+		return b.GetDetailedStats().PacketsReceived > 0
+	}, 2*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, b.sendRTCPReport(false))
+	require.Eventually(t, func() bool {
+		return a.GetDetailedStats().RTCPReportsReceived > 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestRTPStats_Metrics(t *testing.T) {
+	stats := &RTPStats{PacketsReceived: 98, PacketsLost: 2, Jitter: 12500 * time.Microsecond}
+	assert.InDelta(t, 0.02, stats.LossRate(), 1e-9)
+
+	values := map[string]string{}
+	for _, metric := range stats.Metrics() {
+		values[metric.Name] = metric.Value
+	}
+	assert.Equal(t, "2.00", values["RTP_PACKET_LOSS_RATE"])
+	assert.Equal(t, "12.5", values["RTP_JITTER"])
+	assert.NotContains(t, values, "RTP_REMOTE_PACKETS_LOST")
+	assert.NotContains(t, values, "RTP_ROUND_TRIP_TIME")
+
+	stats.RTCPReportsReceived, stats.RemotePacketsLost, stats.RoundTripTime = 3, 4, 42*time.Millisecond
+	values = map[string]string{}
+	for _, metric := range stats.Metrics() {
+		values[metric.Name] = metric.Value
+	}
+	assert.Equal(t, "4", values["RTP_REMOTE_PACKETS_LOST"])
+	assert.Equal(t, "42.0", values["RTP_ROUND_TRIP_TIME"])
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -35,6 +36,15 @@ const (
 	// Audio channel buffer sizes
 	rtpAudioInBufferSize  = 100
 	rtpAudioOutBufferSize = 100
+	rtpDTMFOutBufferSize  = 8
+
+	// Outbound RFC 4733 events: each key is held for dtmfToneDuration, its
+	// end packet is sent dtmfEndRetransmits times and keys are separated by
+	// dtmfPauseDuration of regular audio.
+	dtmfToneDuration   = 100 * time.Millisecond
+	dtmfPauseDuration  = 60 * time.Millisecond
+	dtmfEndRetransmits = 3
+	dtmfVolume         = 10
 )
 
 // RTPPacket represents an RTP packet
@@ -66,6 +76,11 @@ type RTPHandler struct {
 
 	remoteAddr *net.UDPAddr
 
+	// RTCP runs on the odd port next to RTP. rtcpConn is nil when that port
+	// could not be bound; the call then goes on without RTCP.
+	rtcpConn       *net.UDPConn
+	remoteRTCPAddr *net.UDPAddr
+
 	// RTP state
 	ssrc           uint32
 	sequenceNumber uint16
@@ -84,14 +99,42 @@ type RTPHandler struct {
 	// codec changes and regenerate its pre-computed silence chunk.
 	codecVersion uint32
 
+	// onDTMF receives RFC 4733 key presses. An event spans several packets
+	// sharing one RTP timestamp, dtmfTimestamp dedupes the end packets.
+	onDTMF        func(DTMFEvent)
+	dtmfTimestamp uint32
+	dtmfReported  bool
+
+	// dtmfOutChan queues event codes to send, the sendLoop replaces audio
+	// with telephone-event packets while a key is held.
+	dtmfOutChan chan []byte
+
+	// SDES-SRTP ciphers, nil for plain RTP. srtpEncrypt uses our key,
+	// srtpDecrypt the peer's; srtpProfile is echoed in SDP answers.
+	srtpProfile string
+	srtpEncrypt *srtpCipher
+	srtpDecrypt *srtpCipher
+
 	ctx    context.Context
 	cancel context.CancelFunc
 
+	// jitter reorders inbound media, the playoutLoop releases it at the
+	// stream's frame rate and conceals lost frames.
+	jitter *jitterBuffer
+
+	// RFC 3550 reception statistics of the remote source and what the
+	// peer's RTCP reports say about our stream.
+	statsMu   sync.Mutex
+	reception receptionStats
+	remote    remoteReport
+
 	// Statistics
-	packetsSent     atomic.Uint64
-	packetsReceived atomic.Uint64
-	bytesReceived   atomic.Uint64
-	bytesSent       atomic.Uint64
+	packetsSent      atomic.Uint64
+	packetsReceived  atomic.Uint64
+	bytesReceived    atomic.Uint64
+	bytesSent        atomic.Uint64
+	packetsLate      atomic.Uint64
+	packetsConcealed atomic.Uint64
 }
 
 // RTPConfig holds configuration for RTP handler
@@ -101,6 +144,10 @@ type RTPConfig struct {
 	PayloadType uint8  // 0 = PCMU, 8 = PCMA
 	ClockRate   uint32 // 8000 for G.711
 	Logger      commons.Logger
+
+	// Codec is the negotiated codec, it takes precedence over PayloadType
+	// as dynamic payload types (Opus) are assigned per call.
+	Codec *Codec
 }
 
 // Validate validates the RTP configuration
@@ -177,8 +224,23 @@ func NewRTPHandler(ctx context.Context, config *RTPConfig) (*RTPHandler, error)
 
 	localAddr := conn.LocalAddr().(*net.UDPAddr)
 
+	// RTCP socket on the next (odd) port. Not shared through SO_REUSEPORT:
+	// a second bind must fail rather than split another call's reports.
+	var rtcpConn *net.UDPConn
+	rtcpAddr := net.JoinHostPort(config.LocalIP, strconv.Itoa(localAddr.Port+1))
+	if rtcpPacketConn, err := (&net.ListenConfig{}).ListenPacket(handlerCtx, network, rtcpAddr); err != nil {
+		if config.Logger != nil {
+			config.Logger.Warnw("Failed to bind RTCP socket, continuing without RTCP", "addr", rtcpAddr, "error", err)
+		}
+	} else {
+		rtcpConn = rtcpPacketConn.(*net.UDPConn)
+	}
+
 	// Get codec from payload type or use default
-	codec := GetCodecByPayloadType(config.PayloadType)
+	codec := config.Codec
+	if codec == nil {
+		codec = GetCodecByPayloadType(config.PayloadType)
+	}
 	if codec == nil {
 		codec = &CodecPCMU
 	}
@@ -186,6 +248,7 @@ func NewRTPHandler(ctx context.Context, config *RTPConfig) (*RTPHandler, error)
 	handler := &RTPHandler{
 		logger:       config.Logger,
 		conn:         conn,
+		rtcpConn:     rtcpConn,
 		localIP:      localAddr.IP.String(),
 		localPort:    localAddr.Port,
 		ssrc:         rand.Uint32(),
@@ -193,6 +256,8 @@ func NewRTPHandler(ctx context.Context, config *RTPConfig) (*RTPHandler, error)
 		audioInChan:  make(chan []byte, rtpAudioInBufferSize),
 		audioOutChan: make(chan []byte, rtpAudioOutBufferSize),
 		flushAudioCh: make(chan struct{}, 1),
+		dtmfOutChan:  make(chan []byte, rtpDTMFOutBufferSize),
+		jitter:       newJitterBuffer(),
 		ctx:          handlerCtx,
 		cancel:       cancel,
 	}
@@ -222,7 +287,11 @@ func (h *RTPHandler) Start() {
 	h.sendInitialSilence()
 
 	go h.receiveLoop()
+	go h.playoutLoop()
 	go h.sendLoop()
+	if h.rtcpConn != nil {
+		go h.rtcpLoop()
+	}
 
 	h.logger.Infow("RTP handler started — sendLoop and receiveLoop launched",
 		"local_addr", fmt.Sprintf("%s:%d", h.localIP, h.localPort),
@@ -244,8 +313,7 @@ func (h *RTPHandler) sendInitialSilence() {
 		return
 	}
 
-	samplesPerPacket := int(h.codec.ClockRate * 20 / 1000)
-	chunk := h.createSilenceChunk(samplesPerPacket)
+	chunk := h.createSilenceChunk()
 	packet := h.createRTPPacket(chunk)
 	data := h.serializeRTPPacket(packet)
 
@@ -290,6 +358,11 @@ func (h *RTPHandler) Stop() error {
 		return nil // Already stopped
 	}
 
+	// Final report with BYE so the peer's statistics close cleanly
+	if err := h.sendRTCPReport(true); err != nil && h.logger != nil {
+		h.logger.Debugw("RTCP BYE not sent", "error", err)
+	}
+
 	h.cancel()
 
 	// Close channels safely
@@ -301,6 +374,9 @@ func (h *RTPHandler) Stop() error {
 		h.sendConn.Close()
 		h.sendConn = nil
 	}
+	if h.rtcpConn != nil {
+		h.rtcpConn.Close()
+	}
 	h.mu.Unlock()
 
 	var err error
@@ -348,6 +424,11 @@ func (h *RTPHandler) SetRemoteAddr(ip string, port int) {
 		IP:   parsedIP,
 		Port: port,
 	}
+	// RTCP goes to the next port until the peer's own RTCP shows otherwise
+	h.remoteRTCPAddr = &net.UDPAddr{
+		IP:   parsedIP,
+		Port: port + 1,
+	}
 
 	// Create a connected UDP socket for sending.
 	// net.DialUDP "connects" the UDP socket to the remote address, which:
@@ -498,6 +579,181 @@ func (h *RTPHandler) SetCodec(codec *Codec) {
 	}
 }
 
+// EnableSRTP switches the stream to SRTP, encrypting with local and
+// decrypting with remote. A nil local returns the stream to plain RTP, a nil
+// remote leaves inbound packets untouched (an offer awaiting its answer).
+// Ciphers whose key did not change keep their rollover state, so re-INVITEs
+// repeating the same keys do not disturb the stream.
+func (h *RTPHandler) EnableSRTP(profile string, local, remote *SDESCrypto) error {
+	h.mu.RLock()
+	encrypt, decrypt := h.srtpEncrypt, h.srtpDecrypt
+	h.mu.RUnlock()
+
+	var err error
+	if local == nil {
+		encrypt, decrypt = nil, nil
+	} else {
+		if encrypt == nil || !encrypt.crypto.Equal(local) {
+			if encrypt, err = newSRTPCipher(local); err != nil {
+				return err
+			}
+		}
+		if remote == nil {
+			decrypt = nil
+		} else if decrypt == nil || !decrypt.crypto.Equal(remote) {
+			if decrypt, err = newSRTPCipher(remote); err != nil {
+				return err
+			}
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.srtpProfile = profile
+	h.srtpEncrypt, h.srtpDecrypt = encrypt, decrypt
+	if h.logger != nil {
+		h.logger.Infow("RTP media security updated",
+			"srtp", encrypt != nil,
+			"profile", profile,
+			"remote_key", decrypt != nil)
+	}
+	return nil
+}
+
+// SRTP returns the SDP profile and our key for the current stream, the key
+// is nil for plain RTP.
+func (h *RTPHandler) SRTP() (string, *SDESCrypto) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.srtpEncrypt == nil {
+		return "", nil
+	}
+	return h.srtpProfile, h.srtpEncrypt.crypto
+}
+
+// SetOnDTMF registers the callback for RFC 4733 key presses received on
+// this stream. It is invoked once per key, when the end of event arrives.
+func (h *RTPHandler) SetOnDTMF(fn func(DTMFEvent)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onDTMF = fn
+}
+
+// SendDTMF queues digits (0-9, *, #, A-D) to be sent as RFC 4733
+// telephone-events. It returns once queued, keys go out back to back at the
+// pace of the stream.
+func (h *RTPHandler) SendDTMF(digits string) error {
+	codes, err := telephoneEventCodes(digits)
+	if err != nil {
+		return err
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	if !h.running.Load() {
+		return fmt.Errorf("RTP handler is not running")
+	}
+	select {
+	case h.dtmfOutChan <- codes:
+		return nil
+	default:
+		return fmt.Errorf("DTMF queue is full")
+	}
+}
+
+// outboundDTMF is the telephone-event currently being sent. All packets of an
+// event share the RTP timestamp of its start, duration grows with each packet.
+type outboundDTMF struct {
+	code      byte
+	timestamp uint32
+	duration  uint16
+	started   bool
+}
+
+// sendTelephoneEvent sends the next packet(s) of ev and reports whether the
+// event ended. step and length are in RTP timestamp units.
+func (h *RTPHandler) sendTelephoneEvent(ev *outboundDTMF, step, length int, remoteAddr *net.UDPAddr) bool {
+	ev.duration += uint16(step)
+	end := int(ev.duration) >= length
+	repeats := 1
+	if end {
+		repeats = dtmfEndRetransmits
+	}
+	for i := 0; i < repeats; i++ {
+		packet := h.createTelephoneEventPacket(ev, end)
+		data := h.serializeRTPPacket(packet)
+		if _, err := h.sendPacket(data, remoteAddr); err != nil {
+			if h.running.Load() && h.logger != nil {
+				h.logger.Warnw("RTP sendLoop: telephone-event send FAILED", "error", err, "dest", remoteAddr.String())
+			}
+			continue
+		}
+		h.packetsSent.Add(1)
+		h.bytesSent.Add(uint64(len(packet.Payload)))
+	}
+	if end {
+		// media resumes where the event ended
+		h.mu.Lock()
+		h.timestamp = ev.timestamp + uint32(ev.duration)
+		h.mu.Unlock()
+	}
+	return end
+}
+
+func (h *RTPHandler) createTelephoneEventPacket(ev *outboundDTMF, end bool) *RTPPacket {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !ev.started {
+		ev.timestamp = h.timestamp
+	}
+	packet := &RTPPacket{
+		Version:        rtpVersion,
+		Marker:         !ev.started,
+		PayloadType:    CodecTelephoneEvent.PayloadType,
+		SequenceNumber: h.sequenceNumber,
+		Timestamp:      ev.timestamp,
+		SSRC:           h.ssrc,
+		Payload:        encodeTelephoneEvent(ev.code, end, dtmfVolume, ev.duration),
+	}
+	ev.started = true
+	h.sequenceNumber++
+	return packet
+}
+
+// handleTelephoneEvent reports a key press on its first end packet; the end
+// packet is retransmitted (usually 3 times) with the same timestamp.
+func (h *RTPHandler) handleTelephoneEvent(packet *RTPPacket) {
+	event, err := parseTelephoneEvent(packet.Payload)
+	if err != nil {
+		if h.logger != nil {
+			h.logger.Debugw("RTP: ignoring telephone-event", "error", err)
+		}
+		return
+	}
+
+	h.mu.Lock()
+	if packet.Timestamp != h.dtmfTimestamp {
+		h.dtmfTimestamp = packet.Timestamp
+		h.dtmfReported = false
+	}
+	if !event.End || h.dtmfReported {
+		h.mu.Unlock()
+		return
+	}
+	h.dtmfReported = true
+	onDTMF := h.onDTMF
+	clockRate := h.codec.ClockRate
+	h.mu.Unlock()
+
+	if onDTMF == nil {
+		return
+	}
+	// telephone-event is advertised at the audio codec's clock rate
+	durationMs := int(uint32(event.Duration) * 1000 / clockRate)
+	onDTMF(DTMFEvent{Digit: event.Digit, Duration: durationMs})
+}
+
 func (h *RTPHandler) receiveLoop() {
 	// Safety net: recover from "send on closed channel" panic that can occur
 	// if Stop() closes audioInChan while this goroutine is mid-send.
@@ -599,7 +855,20 @@ func (h *RTPHandler) receiveLoop() {
 			continue
 		}
 
-		packet, err := h.parseRTPPacket(buf[:n])
+		data := buf[:n]
+		h.mu.RLock()
+		decrypt := h.srtpDecrypt
+		h.mu.RUnlock()
+		if decrypt != nil {
+			if data, err = decrypt.decrypt(data); err != nil {
+				if h.logger != nil {
+					h.logger.Debugw("RTP: dropping packet failing SRTP authentication", "error", err)
+				}
+				continue
+			}
+		}
+
+		packet, err := h.parseRTPPacket(data)
 		if err != nil {
 			if h.logger != nil {
 				h.logger.Warnw("Failed to parse RTP packet", "error", err)
@@ -622,18 +891,110 @@ func (h *RTPHandler) receiveLoop() {
 		// Update statistics
 		h.packetsReceived.Add(1)
 		h.bytesReceived.Add(uint64(len(packet.Payload)))
-		// running state and context together with the send.
+
+		// RFC 4733 telephone-events share the media stream but are not audio
+		isEvent := packet.PayloadType == CodecTelephoneEvent.PayloadType
+		h.recordReception(packet, time.Now(), !isEvent)
+		if isEvent {
+			h.jitter.Skip(packet.SequenceNumber)
+			h.handleTelephoneEvent(packet)
+			continue
+		}
+		if !h.jitter.Push(packet) {
+			h.packetsLate.Add(1)
+		}
+	}
+}
+
+// recordReception updates the RFC 3550 statistics and sizes the jitter
+// buffer to the measured jitter.
+func (h *RTPHandler) recordReception(packet *RTPPacket, arrival time.Time, media bool) {
+	h.mu.RLock()
+	clockRate := h.codec.ClockRate
+	h.mu.RUnlock()
+
+	h.statsMu.Lock()
+	restarted := h.reception.update(packet, arrival, clockRate, media)
+	jitter := h.reception.jitterDuration(clockRate)
+	frame := h.frameLengthLocked()
+	h.statsMu.Unlock()
+
+	if restarted {
+		h.jitter.Reset()
+	}
+	h.jitter.Adapt(jitterTargetDepth(jitter, frame), arrival)
+}
+
+// frameLengthLocked is the remote packetization time, statsMu must be held.
+func (h *RTPHandler) frameLengthLocked() time.Duration {
+	if h.reception.frameLength > 0 {
+		return h.reception.frameLength
+	}
+	return rtpPacketInterval
+}
+
+// playoutLoop releases buffered audio at the remote frame rate, concealing
+// lost frames, so consumers of AudioIn see packets in order.
+func (h *RTPHandler) playoutLoop() {
+	// Same safety net as receiveLoop: Stop() may close audioInChan mid-send.
+	defer func() {
+		if r := recover(); r != nil {
+			if h.logger != nil {
+				h.logger.Warnw("RTP playoutLoop recovered from panic", "panic", r)
+			}
+		}
+	}()
+
+	var last []byte
+	lostRun := 0
+	nextPlayout := time.Now()
+	for {
+		h.statsMu.Lock()
+		frame := h.frameLengthLocked()
+		h.statsMu.Unlock()
+
+		nextPlayout = nextPlayout.Add(frame)
+		if wait := time.Until(nextPlayout); wait > 0 {
+			select {
+			case <-h.ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		} else {
+			nextPlayout = time.Now()
+		}
 		if !h.running.Load() {
 			return
 		}
+
+		packet, state := h.jitter.Pop()
+		var payload []byte
+		switch state {
+		case jitterPopPacket:
+			payload = packet.Payload
+			last = payload
+			lostRun = 0
+		case jitterPopLost:
+			if last == nil {
+				continue
+			}
+			lostRun++
+			h.packetsConcealed.Add(1)
+			h.mu.RLock()
+			codec := h.codec
+			h.mu.RUnlock()
+			payload = concealFrame(last, lostRun, codec)
+		default:
+			continue
+		}
+
 		select {
 		case <-h.ctx.Done():
 			return
-		case h.audioInChan <- packet.Payload:
-			// Successfully sent to channel
+		case h.audioInChan <- payload:
 		default:
 			if h.logger != nil {
-				h.logger.Warnw("RTP: Audio input channel full, dropping packet", "seq", packet.SequenceNumber)
+				h.logger.Warnw("RTP: Audio input channel full, dropping frame")
 			}
 		}
 	}
@@ -642,14 +1003,21 @@ func (h *RTPHandler) receiveLoop() {
 func (h *RTPHandler) sendLoop() {
 	// Calculate samples per packet based on codec (20ms packets)
 	h.mu.RLock()
-	samplesPerPacket := int(h.codec.ClockRate * 20 / 1000) // e.g., 160 bytes for PCMU at 8kHz
+	samplesPerPacket := h.codec.samplesPerPacket() // e.g., 160 for PCMU at 8kHz
+	frameBytes := h.codec.frameBytes()
 	lastCodecVersion := h.codecVersion
 	h.mu.RUnlock()
 
 	// Pre-create silence chunk (μ-law silence is 0xFF, PCMA silence is 0xD5)
-	silenceChunk := h.createSilenceChunk(samplesPerPacket)
+	silenceChunk := h.createSilenceChunk()
 
+	// Fixed-size codecs (G.711, G.722) are sliced from a byte stream, Opus
+	// arrives as whole encoded frames, one per packet.
 	var pendingAudio []byte
+	var pendingFrames [][]byte
+	var dtmfQueue []byte
+	var dtmf *outboundDTMF
+	dtmfPause := 0
 	// First sendLoop packet should go out immediately (sendInitialSilence
 	// already sent packet #1, this will send packet #2 without delay).
 	nextSendTime := time.Now()
@@ -670,9 +1038,10 @@ func (h *RTPHandler) sendLoop() {
 		if cv != lastCodecVersion {
 			lastCodecVersion = cv
 			h.mu.RLock()
-			samplesPerPacket = int(h.codec.ClockRate * 20 / 1000)
+			samplesPerPacket = h.codec.samplesPerPacket()
+			frameBytes = h.codec.frameBytes()
+			silenceChunk = h.createSilenceChunk()
 			h.mu.RUnlock()
-			silenceChunk = h.createSilenceChunk(samplesPerPacket)
 		}
 
 		// Collect pending audio (non-blocking) or handle flush signal
@@ -680,6 +1049,7 @@ func (h *RTPHandler) sendLoop() {
 		case <-h.flushAudioCh:
 			// Interruption: discard all queued audio immediately
 			pendingAudio = nil
+			pendingFrames = nil
 			// Drain any remaining audio in the channel
 			for {
 				select {
@@ -689,9 +1059,13 @@ func (h *RTPHandler) sendLoop() {
 				}
 			}
 		case audio, ok := <-h.audioOutChan:
-			if ok {
+			if ok && frameBytes == 0 {
+				pendingFrames = append(pendingFrames, audio)
+			} else if ok {
 				pendingAudio = append(pendingAudio, audio...)
 			}
+		case codes := <-h.dtmfOutChan:
+			dtmfQueue = append(dtmfQueue, codes...)
 		default:
 		}
 	collectDone:
@@ -718,8 +1092,32 @@ func (h *RTPHandler) sendLoop() {
 			continue
 		}
 
+		// Outbound DTMF replaces audio while a key is held, pending audio
+		// is kept and resumes after the event.
+		if dtmf == nil && dtmfPause == 0 && len(dtmfQueue) > 0 {
+			dtmf = &outboundDTMF{code: dtmfQueue[0]}
+			dtmfQueue = dtmfQueue[1:]
+		}
+		if dtmf != nil {
+			eventLength := samplesPerPacket * int(dtmfToneDuration/rtpPacketInterval)
+			if h.sendTelephoneEvent(dtmf, samplesPerPacket, eventLength, remoteAddr) {
+				dtmf = nil
+				dtmfPause = int(dtmfPauseDuration / rtpPacketInterval)
+			}
+			continue
+		}
+		if dtmfPause > 0 {
+			dtmfPause--
+		}
+
 		// Get exactly ONE chunk: audio if available, otherwise silence
-		chunk := h.getAudioChunk(&pendingAudio, samplesPerPacket, silenceChunk)
+		chunk := silenceChunk
+		if frameBytes > 0 {
+			chunk = h.getAudioChunk(&pendingAudio, frameBytes, silenceChunk)
+		} else if len(pendingFrames) > 0 {
+			chunk = pendingFrames[0]
+			pendingFrames = pendingFrames[1:]
+		}
 
 		packet := h.createRTPPacket(chunk)
 		data := h.serializeRTPPacket(packet)
@@ -742,17 +1140,9 @@ func (h *RTPHandler) sendLoop() {
 	}
 }
 
-// createSilenceChunk creates a silence chunk for the codec
-func (h *RTPHandler) createSilenceChunk(size int) []byte {
-	chunk := make([]byte, size)
-	silenceValue := byte(0xFF) // μ-law silence
-	if h.codec.Name == "PCMA" {
-		silenceValue = 0xD5 // A-law silence
-	}
-	for i := range chunk {
-		chunk[i] = silenceValue
-	}
-	return chunk
+// createSilenceChunk creates a 20ms silence payload for the codec
+func (h *RTPHandler) createSilenceChunk() []byte {
+	return h.codec.silenceFrame()
 }
 
 // getAudioChunk extracts or creates an audio chunk for sending
@@ -827,11 +1217,22 @@ func (h *RTPHandler) parseRTPPacket(data []byte) (*RTPPacket, error) {
 // Prefers the connected send socket (h.sendConn) which produces correct UDP
 // checksums. Falls back to WriteToUDP on the receive socket if no connected
 // socket is available (e.g., DialUDP failed or remote not yet set).
+//
+// When SRTP is enabled the packet is encrypted here, covering audio and
+// telephone-events alike.
 func (h *RTPHandler) sendPacket(data []byte, remoteAddr *net.UDPAddr) (int, error) {
 	h.mu.RLock()
 	sendConn := h.sendConn
+	encrypt := h.srtpEncrypt
 	h.mu.RUnlock()
 
+	if encrypt != nil {
+		var err error
+		if data, err = encrypt.encrypt(data); err != nil {
+			return 0, fmt.Errorf("srtp encrypt: %w", err)
+		}
+	}
+
 	if sendConn != nil {
 		// Connected socket — Write() uses the pre-connected remote address.
 		// The kernel computes the correct UDP checksum because the source IP
@@ -857,7 +1258,8 @@ func (h *RTPHandler) createRTPPacket(payload []byte) *RTPPacket {
 	}
 
 	h.sequenceNumber++
-	h.timestamp += uint32(len(payload))
+	// every packet carries 20ms, whatever its payload size (Opus)
+	h.timestamp += uint32(h.codec.samplesPerPacket())
 
 	return packet
 }
@@ -900,10 +1302,36 @@ func (h *RTPHandler) GetStats() (sent, received uint64) {
 
 // GetDetailedStats returns detailed RTP statistics
 func (h *RTPHandler) GetDetailedStats() RTPStats {
-	return RTPStats{
-		PacketsSent:     h.packetsSent.Load(),
-		PacketsReceived: h.packetsReceived.Load(),
-		BytesSent:       h.bytesSent.Load(),
-		BytesReceived:   h.bytesReceived.Load(),
+	h.mu.RLock()
+	clockRate := h.codec.ClockRate
+	h.mu.RUnlock()
+
+	stats := RTPStats{
+		PacketsSent:      h.packetsSent.Load(),
+		PacketsReceived:  h.packetsReceived.Load(),
+		BytesSent:        h.bytesSent.Load(),
+		BytesReceived:    h.bytesReceived.Load(),
+		PacketsLate:      h.packetsLate.Load() + h.jitter.Discarded(),
+		PacketsConcealed: h.packetsConcealed.Load(),
+	}
+
+	h.statsMu.Lock()
+	defer h.statsMu.Unlock()
+	if h.reception.initialized {
+		if lost := h.reception.lost(); lost > 0 {
+			stats.PacketsLost = uint64(lost)
+		}
+		stats.Jitter = h.reception.jitterDuration(clockRate)
+	}
+	stats.JitterBufferDelay = time.Duration(h.jitter.Depth()) * h.frameLengthLocked()
+	// TotalLost is a signed 24-bit field
+	if lost := int32(h.remote.totalLost<<8) >> 8; lost > 0 {
+		stats.RemotePacketsLost = uint64(lost)
+	}
+	if clockRate > 0 {
+		stats.RemoteJitter = time.Duration(h.remote.jitter) * time.Second / time.Duration(clockRate)
 	}
+	stats.RoundTripTime = h.remote.roundTrip
+	stats.RTCPReportsReceived = h.remote.reports
+	return stats
 }
@@ -10,21 +10,30 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Codec represents an audio codec with its RTP configuration
 type Codec struct {
 	Name        string
 	PayloadType uint8
-	ClockRate   uint32
+	ClockRate   uint32 // RTP timestamp rate
+	SampleRate  uint32 // audio sampling rate, differs from ClockRate for G.722
 	Channels    int
 }
 
 // Common codecs used in telephony
 var (
-	CodecPCMU = Codec{Name: "PCMU", PayloadType: 0, ClockRate: 8000, Channels: 1}
-	CodecPCMA = Codec{Name: "PCMA", PayloadType: 8, ClockRate: 8000, Channels: 1}
-	CodecG722 = Codec{Name: "G722", PayloadType: 9, ClockRate: 8000, Channels: 1}
+	CodecPCMU = Codec{Name: "PCMU", PayloadType: 0, ClockRate: 8000, SampleRate: 8000, Channels: 1}
+	CodecPCMA = Codec{Name: "PCMA", PayloadType: 8, ClockRate: 8000, SampleRate: 8000, Channels: 1}
+
+	// CodecG722 samples at 16kHz but keeps an 8kHz RTP clock for historical
+	// reasons (RFC 3551 §4.5.2).
+	CodecG722 = Codec{Name: "G722", PayloadType: 9, ClockRate: 8000, SampleRate: 16000, Channels: 1}
+
+	// CodecOpus has a dynamic payload type, answers use the one the offer
+	// mapped. RFC 7587 requires opus/48000/2 in rtpmap, we still send mono.
+	CodecOpus = Codec{Name: "opus", PayloadType: 111, ClockRate: 48000, SampleRate: 48000, Channels: 2}
 
 	// CodecTelephoneEvent is RFC 4733 DTMF telephone-event.
 	// Nearly all SIP endpoints (Asterisk, FreeSWITCH, Twilio, Zoiper) require
@@ -33,8 +42,89 @@ var (
 	CodecTelephoneEvent = Codec{Name: "telephone-event", PayloadType: 101, ClockRate: 8000, Channels: 1}
 )
 
-// SupportedCodecs lists audio codecs in order of preference (excludes telephone-event)
-var SupportedCodecs = []Codec{CodecPCMU, CodecPCMA}
+// SupportedCodecs lists audio codecs in the default order of preference
+// (excludes telephone-event). Narrowband G.711 stays first so existing trunks
+// keep their codec, deployments prefer wideband through sip.codecs.
+var SupportedCodecs = []Codec{CodecPCMU, CodecPCMA, CodecG722, CodecOpus}
+
+// g722Silence is what a G.722 encoder produces for digital silence.
+const g722Silence = 0xFA
+
+// opusSilenceFrame is a 20ms fullband CELT frame of silence (RFC 6716).
+var opusSilenceFrame = []byte{0xF8, 0xFF, 0xFE}
+
+// Is reports whether c is the named codec, ignoring payload type and case.
+func (c *Codec) Is(other Codec) bool {
+	return strings.EqualFold(c.Name, other.Name) && c.ClockRate == other.ClockRate
+}
+
+// samplesPerPacket is the RTP timestamp advance of one 20ms packet.
+func (c *Codec) samplesPerPacket() int {
+	return int(time.Duration(c.ClockRate) * rtpPacketInterval / time.Second)
+}
+
+// frameBytes is the payload size of a 20ms packet, 0 for Opus whose frames
+// vary in size. G.711 and G.722 (4 bits at 16kHz) both carry one byte per
+// RTP clock tick.
+func (c *Codec) frameBytes() int {
+	if c.Is(CodecOpus) {
+		return 0
+	}
+	return c.samplesPerPacket()
+}
+
+// silenceFrame returns one 20ms payload of silence.
+func (c *Codec) silenceFrame() []byte {
+	if c.Is(CodecOpus) {
+		return append([]byte(nil), opusSilenceFrame...)
+	}
+	value := byte(0xFF) // μ-law silence
+	switch {
+	case c.Is(CodecPCMA):
+		value = 0xD5 // A-law silence
+	case c.Is(CodecG722):
+		value = g722Silence
+	}
+	frame := make([]byte, c.frameBytes())
+	for i := range frame {
+		frame[i] = value
+	}
+	return frame
+}
+
+// ParseCodecPreference parses a comma separated list of codec names, most
+// preferred first, e.g. "opus,g722,pcmu".
+func ParseCodecPreference(value string) ([]Codec, error) {
+	var codecs []Codec
+	for _, name := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		codec := GetCodecByName(name)
+		if codec == nil {
+			return nil, fmt.Errorf("%w: %q", ErrCodecNotSupported, name)
+		}
+		duplicate := false
+		for i := range codecs {
+			duplicate = duplicate || codecs[i].Is(*codec)
+		}
+		if !duplicate {
+			codecs = append(codecs, *codec)
+		}
+	}
+	return codecs, nil
+}
+
+// negotiateCodec picks the first of our preferred codecs the offer carries,
+// keeping the offer's payload type.
+func negotiateCodec(offered []Codec, preferred []Codec) *Codec {
+	for _, want := range preferred {
+		for i := range offered {
+			if offered[i].Is(want) {
+				codec := offered[i]
+				return &codec
+			}
+		}
+	}
+	return nil
+}
 
 // SDPDirection represents the media direction attribute in SDP
 type SDPDirection string
@@ -51,8 +141,11 @@ type SDPMediaInfo struct {
 	ConnectionIP   string
 	AudioPort      int
 	PayloadTypes   []uint8
+	Codecs         []Codec // supported audio codecs in offer order, with the peer's payload types
 	PreferredCodec *Codec
 	Direction      SDPDirection // sendrecv, sendonly, recvonly, inactive
+	Profile        string       // RTP/AVP or RTP/SAVP
+	Crypto         []SDESCrypto // SDES a=crypto attributes, in offer order
 }
 
 // IsHold returns true if the SDP indicates a hold condition.
@@ -75,6 +168,11 @@ type SDPConfig struct {
 	RTPPort     int
 	Codecs      []Codec
 	PTime       int // Packetization time in milliseconds
+
+	// Profile is the m= line transport, empty means RTP/AVP. Crypto adds an
+	// SDES a=crypto attribute carrying our SRTP key.
+	Profile string
+	Crypto  *SDESCrypto
 }
 
 // DefaultSDPConfig returns a default SDP configuration
@@ -148,20 +246,40 @@ func (s *Server) GenerateSDP(cfg *SDPConfig) string {
 	if !hasTelEvent {
 		payloadTypes = append(payloadTypes, strconv.Itoa(int(CodecTelephoneEvent.PayloadType)))
 	}
-	sb.WriteString(fmt.Sprintf("m=audio %d RTP/AVP %s\r\n", cfg.RTPPort, strings.Join(payloadTypes, " ")))
+	profile := cfg.Profile
+	if profile == "" {
+		profile = SDPProfileRTPAVP
+	}
+	sb.WriteString(fmt.Sprintf("m=audio %d %s %s\r\n", cfg.RTPPort, profile, strings.Join(payloadTypes, " ")))
 
 	// Codec attributes (rtpmap for each audio codec)
 	for _, codec := range cfg.Codecs {
-		sb.WriteString(fmt.Sprintf("a=rtpmap:%d %s/%d\r\n", codec.PayloadType, codec.Name, codec.ClockRate))
+		if codec.Channels > 1 {
+			sb.WriteString(fmt.Sprintf("a=rtpmap:%d %s/%d/%d\r\n", codec.PayloadType, codec.Name, codec.ClockRate, codec.Channels))
+		} else {
+			sb.WriteString(fmt.Sprintf("a=rtpmap:%d %s/%d\r\n", codec.PayloadType, codec.Name, codec.ClockRate))
+		}
+		if codec.Is(CodecOpus) {
+			sb.WriteString(fmt.Sprintf("a=fmtp:%d minptime=20;useinbandfec=1\r\n", codec.PayloadType))
+		}
 	}
 
-	// telephone-event rtpmap + fmtp (required by Asterisk, Zoiper, etc.)
+	// telephone-event rtpmap + fmtp (required by Asterisk, Zoiper, etc.).
+	// Its clock follows the first (negotiated) audio codec, 48kHz with Opus.
 	if !hasTelEvent {
+		eventClockRate := CodecTelephoneEvent.ClockRate
+		if len(cfg.Codecs) > 0 {
+			eventClockRate = cfg.Codecs[0].ClockRate
+		}
 		sb.WriteString(fmt.Sprintf("a=rtpmap:%d %s/%d\r\n",
-			CodecTelephoneEvent.PayloadType, CodecTelephoneEvent.Name, CodecTelephoneEvent.ClockRate))
+			CodecTelephoneEvent.PayloadType, CodecTelephoneEvent.Name, eventClockRate))
 		sb.WriteString(fmt.Sprintf("a=fmtp:%d 0-16\r\n", CodecTelephoneEvent.PayloadType))
 	}
 
+	if cfg.Crypto != nil {
+		sb.WriteString(fmt.Sprintf("a=crypto:%s\r\n", cfg.Crypto.String()))
+	}
+
 	// Packetization time
 	sb.WriteString(fmt.Sprintf("a=ptime:%d\r\n", cfg.PTime))
 
@@ -184,6 +302,7 @@ func (s *Server) ParseSDP(sdpBody []byte) (*SDPMediaInfo, error) {
 
 	sdpStr := string(sdpBody)
 	lines := strings.Split(sdpStr, "\n")
+	rtpMaps := make(map[uint8]Codec)
 
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -202,6 +321,7 @@ func (s *Server) ParseSDP(sdpBody []byte) (*SDPMediaInfo, error) {
 				if err == nil {
 					info.AudioPort = port
 				}
+				info.Profile = parts[2]
 				// Parse payload types
 				for i := 3; i < len(parts); i++ {
 					pt, err := strconv.Atoi(parts[i])
@@ -211,9 +331,19 @@ func (s *Server) ParseSDP(sdpBody []byte) (*SDPMediaInfo, error) {
 				}
 			}
 
+		case strings.HasPrefix(line, "a=crypto:"):
+			// SDES key: a=crypto:1 AES_CM_128_HMAC_SHA1_80 inline:<key||salt>
+			// Attributes we can't use are skipped, negotiation picks among the rest.
+			if crypto, err := parseSDESCrypto(strings.TrimPrefix(line, "a=crypto:")); err == nil {
+				info.Crypto = append(info.Crypto, *crypto)
+			}
+
 		case strings.HasPrefix(line, "a=rtpmap:"):
-			// RTP map: a=rtpmap:0 PCMU/8000
-			// We use this to confirm codec selection
+			// RTP map: a=rtpmap:0 PCMU/8000, a=rtpmap:111 opus/48000/2
+			// Needed for dynamic payload types (Opus), static ones may omit it.
+			if pt, codec, ok := parseRTPMap(strings.TrimPrefix(line, "a=rtpmap:")); ok {
+				rtpMaps[pt] = codec
+			}
 
 		// SDP direction attributes (RFC 3264)
 		// Used by all providers for hold/resume:
@@ -231,21 +361,31 @@ func (s *Server) ParseSDP(sdpBody []byte) (*SDPMediaInfo, error) {
 		}
 	}
 
-	// Determine preferred codec based on first matching payload type.
+	// Resolve the offered payload types to supported codecs, rtpmap first,
+	// static assignments otherwise. The preferred codec is the first match.
 	// Skip telephone-event (PT 101) — it is not an audio codec.
 	for _, pt := range info.PayloadTypes {
-		if pt == CodecTelephoneEvent.PayloadType {
+		mapped, hasMap := rtpMaps[pt]
+		if pt == CodecTelephoneEvent.PayloadType || (hasMap && strings.EqualFold(mapped.Name, CodecTelephoneEvent.Name)) {
 			continue // telephone-event is not an audio codec
 		}
-		for _, codec := range SupportedCodecs {
-			if codec.PayloadType == pt {
-				info.PreferredCodec = &codec
-				break
+		var codec *Codec
+		if hasMap {
+			codec = GetCodecByName(mapped.Name)
+			if codec != nil && codec.ClockRate != mapped.ClockRate {
+				codec = nil
 			}
+		} else if pt < 96 {
+			codec = GetCodecByPayloadType(pt)
 		}
-		if info.PreferredCodec != nil {
-			break
+		if codec == nil {
+			continue
 		}
+		codec.PayloadType = pt
+		info.Codecs = append(info.Codecs, *codec)
+	}
+	if len(info.Codecs) > 0 {
+		info.PreferredCodec = &info.Codecs[0]
 	}
 
 	// Default to PCMU if no match found
@@ -274,6 +414,33 @@ func (s *Server) NegotiateCodec(remotePayloadTypes []uint8) *Codec {
 	return &CodecPCMU
 }
 
+// parseRTPMap parses an rtpmap attribute value: <pt> <name>/<rate>[/<channels>].
+func parseRTPMap(value string) (uint8, Codec, bool) {
+	ptField, encoding, ok := strings.Cut(strings.TrimSpace(value), " ")
+	if !ok {
+		return 0, Codec{}, false
+	}
+	pt, err := strconv.Atoi(ptField)
+	if err != nil || pt < 0 || pt > 127 {
+		return 0, Codec{}, false
+	}
+	parts := strings.Split(strings.TrimSpace(encoding), "/")
+	if len(parts) < 2 {
+		return 0, Codec{}, false
+	}
+	rate, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, Codec{}, false
+	}
+	codec := Codec{Name: parts[0], PayloadType: uint8(pt), ClockRate: uint32(rate), Channels: 1}
+	if len(parts) > 2 {
+		if channels, err := strconv.Atoi(parts[2]); err == nil {
+			codec.Channels = channels
+		}
+	}
+	return uint8(pt), codec, true
+}
+
 // GetCodecByPayloadType returns a codec by its payload type
 func GetCodecByPayloadType(pt uint8) *Codec {
 	for _, codec := range SupportedCodecs {
@@ -284,11 +451,10 @@ func GetCodecByPayloadType(pt uint8) *Codec {
 	return nil
 }
 
-// GetCodecByName returns a codec by its name
+// GetCodecByName returns a codec by its name, case-insensitively
 func GetCodecByName(name string) *Codec {
-	name = strings.ToUpper(name)
 	for _, codec := range SupportedCodecs {
-		if codec.Name == name {
+		if strings.EqualFold(codec.Name, name) {
 			return &codec
 		}
 	}
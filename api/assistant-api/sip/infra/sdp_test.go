@@ -0,0 +1,102 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"testing"
+
+	"github.com/rapidaai/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSDP_DynamicCodecs(t *testing.T) {
+	s := &Server{}
+	body := "v=0\r\n" +
+		"c=IN IP4 10.0.0.2\r\n" +
+		"m=audio 30000 RTP/AVP 96 9 0 100\r\n" +
+		"a=rtpmap:96 opus/48000/2\r\n" +
+		"a=fmtp:96 minptime=10;useinbandfec=1\r\n" +
+		"a=rtpmap:9 G722/8000\r\n" +
+		"a=rtpmap:0 PCMU/8000\r\n" +
+		"a=rtpmap:100 telephone-event/48000\r\n"
+
+	info, err := s.ParseSDP([]byte(body))
+	require.NoError(t, err)
+	require.Len(t, info.Codecs, 3)
+	assert.Equal(t, "opus", info.Codecs[0].Name)
+	assert.Equal(t, uint8(96), info.Codecs[0].PayloadType, "keeps the peer's dynamic PT")
+	assert.Equal(t, 2, info.Codecs[0].Channels)
+	assert.Equal(t, "G722", info.Codecs[1].Name)
+	assert.Equal(t, "PCMU", info.Codecs[2].Name)
+	require.NotNil(t, info.PreferredCodec)
+	assert.Equal(t, "opus", info.PreferredCodec.Name)
+}
+
+func TestNegotiateCodec(t *testing.T) {
+	opus := CodecOpus
+	opus.PayloadType = 96
+	offered := []Codec{opus, CodecPCMU, CodecG722}
+
+	codec := negotiateCodec(offered, []Codec{CodecG722, CodecOpus})
+	require.NotNil(t, codec)
+	assert.Equal(t, "G722", codec.Name)
+
+	codec = negotiateCodec(offered, []Codec{CodecOpus})
+	require.NotNil(t, codec)
+	assert.Equal(t, uint8(96), codec.PayloadType, "answers with the offered PT")
+
+	assert.Nil(t, negotiateCodec(offered, []Codec{CodecPCMA}))
+}
+
+func TestParseCodecPreference(t *testing.T) {
+	codecs, err := ParseCodecPreference("g722, Opus pcmu")
+	require.NoError(t, err)
+	require.Len(t, codecs, 3)
+	assert.Equal(t, "G722", codecs[0].Name)
+	assert.Equal(t, "opus", codecs[1].Name)
+	assert.Equal(t, "PCMU", codecs[2].Name)
+
+	_, err = ParseCodecPreference("pcmu,amr")
+	assert.ErrorIs(t, err, ErrCodecNotSupported)
+}
+
+func TestConfig_CodecPreference(t *testing.T) {
+	cfg := &Config{}
+	assert.Equal(t, SupportedCodecs, cfg.PreferredCodecs())
+
+	require.NoError(t, cfg.ApplyCodecPreference(utils.Option{"sip.codecs": "opus,g722"}))
+	preferred := cfg.PreferredCodecs()
+	require.Len(t, preferred, 2)
+	assert.Equal(t, "opus", preferred[0].Name)
+	assert.Equal(t, "G722", preferred[1].Name)
+
+	assert.Error(t, cfg.ApplyCodecPreference(utils.Option{"sip.codecs": "gsm"}))
+}
+
+func TestGenerateSDP_WidebandCodecs(t *testing.T) {
+	s := &Server{}
+	cfg := DefaultSDPConfig("10.0.0.1", 20000)
+	cfg.Codecs = []Codec{CodecOpus, CodecG722}
+	body := s.GenerateSDP(cfg)
+
+	assert.Contains(t, body, "m=audio 20000 RTP/AVP 111 9 101\r\n")
+	assert.Contains(t, body, "a=rtpmap:111 opus/48000/2\r\n")
+	assert.Contains(t, body, "a=fmtp:111 minptime=20;useinbandfec=1\r\n")
+	// G.722 advertises 8000 for historical reasons (RFC 3551)
+	assert.Contains(t, body, "a=rtpmap:9 G722/8000\r\n")
+	assert.Contains(t, body, "a=rtpmap:101 telephone-event/48000\r\n")
+}
+
+func TestCodec_Framing(t *testing.T) {
+	assert.Equal(t, 160, CodecPCMU.frameBytes())
+	assert.Equal(t, 160, CodecG722.frameBytes())
+	assert.Equal(t, 0, CodecOpus.frameBytes())
+
+	assert.Equal(t, 160, CodecG722.samplesPerPacket(), "RTP clock is 8000 for G.722")
+	assert.Equal(t, 960, CodecOpus.samplesPerPacket())
+}
@@ -173,6 +173,10 @@ type Server struct {
 	sessions     map[string]*Session
 	sessionCount atomic.Int64
 
+	// Outbound registrations keyed by ID, inbound calls addressed to the
+	// Contact of a registration are routed to its assistant.
+	registrations map[string]*registration
+
 	// Multi-tenant config resolver - called for each incoming INVITE
 	configResolver ConfigResolver
 
@@ -352,6 +356,7 @@ func NewServer(ctx context.Context, cfg *ServerConfig) (*Server, error) {
 		dialogServerCache: dialogServerCache,
 		configResolver:    cfg.ConfigResolver,
 		sessions:          make(map[string]*Session),
+		registrations:     make(map[string]*registration),
 		ctx:               serverCtx,
 		cancel:            cancel,
 	}
@@ -435,7 +440,10 @@ func (s *Server) Stop() {
 
 	s.logger.Infow("Stopping SIP server")
 
-	// Cancel context first to stop accepting new calls
+	// Remove registrations while the transport is still up
+	s.unregisterAll()
+
+	// Cancel context to stop accepting new calls
 	s.cancel()
 
 	// End all active sessions
@@ -578,6 +586,12 @@ func (s *Server) handleInvite(req *sip.Request, tx sip.ServerTransaction) {
 			ToURI:   toURI,
 			SDPInfo: sdpInfo,
 		}
+		// Calls from a registrar are addressed to our registered Contact,
+		// the registration supplies the credentials.
+		if reg, ok := s.registrationForContact(req.Recipient.User); ok && reg.cfg.AssistantID != "" && reg.cfg.Auth != nil {
+			reqCtx.AssistantID = reg.cfg.AssistantID
+			reqCtx.Set("auth", reg.cfg.Auth)
+		}
 		result, err := resolver(reqCtx)
 		if err != nil {
 			s.logger.Error("SIP authentication/config resolution failed", "error", err, "call_id", callID)
@@ -614,8 +628,24 @@ func (s *Server) handleInvite(req *sip.Request, tx sip.ServerTransaction) {
 		tenantConfig.Server = s.listenConfig.GetExternalIP()
 	}
 
-	// Negotiate codec
-	negotiatedCodec := sdpInfo.PreferredCodec
+	// Negotiate media security before anything is allocated for the call
+	srtpLocal, srtpRemote, err := negotiateSRTPAnswer(tenantConfig.SRTP, sdpInfo, nil)
+	if err != nil {
+		s.logger.Warnw("Rejecting INVITE, SRTP policy not satisfied",
+			"call_id", callID,
+			"policy", tenantConfig.SRTP,
+			"profile", sdpInfo.Profile,
+			"error", err)
+		s.sendResponse(tx, req, 488) // Not Acceptable Here
+		return
+	}
+
+	// Negotiate codec: our preference order among the offered ones, the
+	// offer's first codec when none of ours is there
+	negotiatedCodec := negotiateCodec(sdpInfo.Codecs, tenantConfig.PreferredCodecs())
+	if negotiatedCodec == nil {
+		negotiatedCodec = sdpInfo.PreferredCodec
+	}
 	if negotiatedCodec == nil {
 		negotiatedCodec = &CodecPCMU
 	}
@@ -704,6 +734,7 @@ func (s *Server) handleInvite(req *sip.Request, tx sip.ServerTransaction) {
 		LocalPort:   rtpPort,
 		PayloadType: negotiatedCodec.PayloadType,
 		ClockRate:   negotiatedCodec.ClockRate,
+		Codec:       negotiatedCodec,
 		Logger:      s.logger,
 	})
 	if err != nil {
@@ -714,6 +745,17 @@ func (s *Server) handleInvite(req *sip.Request, tx sip.ServerTransaction) {
 		return
 	}
 
+	if srtpLocal != nil {
+		if err := rtpHandler.EnableSRTP(sdpInfo.Profile, srtpLocal, srtpRemote); err != nil {
+			rtpHandler.Stop()
+			s.rtpAllocator.Release(rtpPort)
+			s.logger.Error("Failed to set up SRTP", "error", err, "call_id", callID)
+			s.removeSession(callID)
+			s.sendResponse(tx, req, 488)
+			return
+		}
+	}
+
 	// Set remote RTP address from incoming SDP
 	if sdpInfo.ConnectionIP != "" && sdpInfo.AudioPort > 0 {
 		rtpHandler.SetRemoteAddr(sdpInfo.ConnectionIP, sdpInfo.AudioPort)
@@ -724,7 +766,7 @@ func (s *Server) handleInvite(req *sip.Request, tx sip.ServerTransaction) {
 	_, localPort := rtpHandler.LocalAddr()
 	externalIP := s.listenConfig.GetExternalIP()
 	session.SetLocalRTP(externalIP, localPort)
-	session.SetNegotiatedCodec(negotiatedCodec.Name, int(negotiatedCodec.ClockRate))
+	session.SetNegotiatedCodec(negotiatedCodec)
 
 	// Store the RTP handler in the session
 	session.SetRTPHandler(rtpHandler)
@@ -736,6 +778,7 @@ func (s *Server) handleInvite(req *sip.Request, tx sip.ServerTransaction) {
 	// Using NegotiatedSDPConfig ensures we confirm the codec we agreed upon,
 	// rather than re-offering all codecs which can confuse some PBXes.
 	sdpConfig := s.NegotiatedSDPConfig(externalIP, localPort, negotiatedCodec)
+	sdpConfig.Profile, sdpConfig.Crypto = rtpHandler.SRTP()
 	sdpBody := s.GenerateSDP(sdpConfig)
 
 	// Send 200 OK with SDP.
@@ -865,6 +908,12 @@ func (s *Server) handleReInvite(req *sip.Request, tx sip.ServerTransaction, sess
 		"sdp_port", sdpInfo.AudioPort,
 		"is_hold", sdpInfo.IsHold())
 
+	if err := s.renegotiateSRTP(session, sdpInfo); err != nil {
+		s.logger.Warnw("Rejecting re-INVITE, SRTP policy not satisfied", "error", err, "call_id", callID)
+		s.sendResponse(tx, req, 488)
+		return
+	}
+
 	// Only update remote RTP when SDP indicates active media (not hold).
 	// Hold signals:
 	//   - 0.0.0.0 connection IP (RFC 3264 §8.4) — used by Asterisk, FreeSWITCH
@@ -893,7 +942,7 @@ func (s *Server) handleReInvite(req *sip.Request, tx sip.ServerTransaction, sess
 				if rtpHandler != nil {
 					rtpHandler.SetCodec(sdpInfo.PreferredCodec)
 				}
-				session.SetNegotiatedCodec(sdpInfo.PreferredCodec.Name, int(sdpInfo.PreferredCodec.ClockRate))
+				session.SetNegotiatedCodec(sdpInfo.PreferredCodec)
 				s.logger.Infow("Codec updated from re-INVITE",
 					"call_id", callID,
 					"new_codec", sdpInfo.PreferredCodec.Name,
@@ -927,10 +976,32 @@ func (s *Server) respondWithCurrentSDP(tx sip.ServerTransaction, req *sip.Reques
 	}
 	codec := session.GetNegotiatedCodec()
 	sdpConfig := s.NegotiatedSDPConfig(localIP, localPort, codec)
+	if rtpHandler := session.GetRTPHandler(); rtpHandler != nil {
+		sdpConfig.Profile, sdpConfig.Crypto = rtpHandler.SRTP()
+	}
 	sdpBody := s.GenerateSDP(sdpConfig)
 	s.sendResponseWithSDPBody(tx, req, sdpBody)
 }
 
+// renegotiateSRTP applies the keys of a mid-call offer (re-INVITE, UPDATE).
+// Our key is kept so only a re-keying peer changes the stream; the error
+// means the offer violates the session's SRTP policy.
+func (s *Server) renegotiateSRTP(session *Session, offer *SDPMediaInfo) error {
+	rtpHandler := session.GetRTPHandler()
+	if rtpHandler == nil {
+		return nil
+	}
+	_, current := rtpHandler.SRTP()
+	local, remote, err := negotiateSRTPAnswer(session.config.SRTP, offer, current)
+	if err != nil {
+		return err
+	}
+	if local == nil {
+		return rtpHandler.EnableSRTP("", nil, nil)
+	}
+	return rtpHandler.EnableSRTP(offer.Profile, local, remote)
+}
+
 func (s *Server) handleAck(req *sip.Request, tx sip.ServerTransaction) {
 	callID := req.CallID().Value()
 
@@ -1149,6 +1220,12 @@ func (s *Server) handleUpdate(req *sip.Request, tx sip.ServerTransaction) {
 			"sdp_port", sdpInfo.AudioPort,
 			"is_hold", sdpInfo.IsHold())
 
+		if err := s.renegotiateSRTP(session, sdpInfo); err != nil {
+			s.logger.Warnw("Rejecting UPDATE, SRTP policy not satisfied", "error", err, "call_id", callID)
+			s.sendResponse(tx, req, 488)
+			return
+		}
+
 		// Only update remote RTP for active media (not hold)
 		if !sdpInfo.IsHold() {
 			rtpHandler := session.GetRTPHandler()
@@ -1169,7 +1246,7 @@ func (s *Server) handleUpdate(req *sip.Request, tx sip.ServerTransaction) {
 					if rtpHandler != nil {
 						rtpHandler.SetCodec(sdpInfo.PreferredCodec)
 					}
-					session.SetNegotiatedCodec(sdpInfo.PreferredCodec.Name, int(sdpInfo.PreferredCodec.ClockRate))
+					session.SetNegotiatedCodec(sdpInfo.PreferredCodec)
 					s.logger.Infow("Codec updated from UPDATE",
 						"call_id", callID,
 						"new_codec", sdpInfo.PreferredCodec.Name,
@@ -1205,6 +1282,11 @@ func (s *Server) handleInfo(req *sip.Request, tx sip.ServerTransaction) {
 	s.logger.Debugw("INFO received",
 		"call_id", callID,
 		"content_type", contentType)
+	if event, ok := ParseDTMFInfo(contentType, req.Body()); ok {
+		if session, exists := s.GetSession(callID); exists {
+			session.EmitDTMF(*event)
+		}
+	}
 	s.sendResponse(tx, req, 200)
 }
 
@@ -1222,12 +1304,20 @@ func (s *Server) handleNotify(req *sip.Request, tx sip.ServerTransaction) {
 	s.logger.Debugw("NOTIFY received",
 		"call_id", callID,
 		"event", eventHdr)
+	if strings.HasPrefix(strings.ToLower(eventHdr), "refer") {
+		if code, ok := ParseReferNotify(req.Body()); ok {
+			if session, exists := s.GetSession(callID); exists {
+				session.ReferProgress(code)
+			}
+		}
+	}
 	s.sendResponse(tx, req, 200)
 }
 
 // handleRefer processes SIP REFER requests (RFC 3515).
 // Used for call transfer requests by all providers.
-// We decline transfers since the AI pipeline doesn't support mid-call transfer.
+// We decline transfers initiated by the remote side, outbound transfers are
+// sent by TransferCall.
 func (s *Server) handleRefer(req *sip.Request, tx sip.ServerTransaction) {
 	callID := req.CallID().Value()
 	referTo := ""
@@ -1425,6 +1515,47 @@ func (s *Server) EndCall(session *Session) error {
 	return nil
 }
 
+// TransferCall performs a blind transfer (RFC 3515) by sending an in-dialog
+// REFER with the given Refer-To target to the remote party. The remote UA
+// places the new call itself; TransferCall returns once its NOTIFY reports
+// that the target answered and the caller is expected to tear down this leg
+// with EndCall.
+func (s *Server) TransferCall(ctx context.Context, session *Session, referTo string) error {
+	if session == nil {
+		return fmt.Errorf("session is nil")
+	}
+	if err := session.Transfer(ctx, referTo); err != nil {
+		s.logger.Warnw("transfer failed",
+			"call_id", session.GetCallID(),
+			"refer_to", referTo,
+			"error", err)
+		return err
+	}
+	s.logger.Infow("transfer completed",
+		"call_id", session.GetCallID(),
+		"refer_to", referTo)
+	return nil
+}
+
+// TransferTarget resolves a transfer destination into a Refer-To URI. SIP URIs
+// are used as-is, bare numbers are routed through the configured SIP server.
+func TransferTarget(cfg *Config, destination string) string {
+	if strings.HasPrefix(destination, "sip:") || strings.HasPrefix(destination, "sips:") || cfg == nil {
+		return destination
+	}
+	scheme := "sip"
+	if cfg.Transport == TransportTLS {
+		scheme = "sips"
+	}
+	target := sip.Uri{
+		Scheme: scheme,
+		Host:   cfg.Server,
+		Port:   cfg.Port,
+		User:   destination,
+	}
+	return target.String()
+}
+
 // MakeCall initiates an outbound SIP call using the DialogClientCache.
 // The cache stores the dialog so incoming BYE/re-INVITE are properly routed
 // to the correct DialogClientSession via handleBye → dialogClientCache.ReadBye.
@@ -1470,8 +1601,23 @@ func (s *Server) MakeCall(ctx context.Context, cfg *Config, toURI, fromURI strin
 		"listen_config_external_ip", s.listenConfig.ExternalIP,
 		"listen_config_address", s.listenConfig.Address)
 
+	// Offer our SRTP key per policy; the peer's key arrives with the answer,
+	// so only the encrypting side is set up for now.
+	offerProfile, offerCrypto, err := newSRTPOffer(cfg.SRTP)
+	if err == nil && offerCrypto != nil {
+		err = rtpHandler.EnableSRTP(offerProfile, offerCrypto, nil)
+	}
+	if err != nil {
+		rtpHandler.Stop()
+		s.rtpAllocator.Release(rtpPort)
+		return nil, fmt.Errorf("failed to set up SRTP: %w", err)
+	}
+
 	// Build SDP offer — advertise external IP so remote peer can reach us
-	sdpBody := s.GenerateSDP(DefaultSDPConfig(externalIP, localPort))
+	sdpConfig := DefaultSDPConfig(externalIP, localPort)
+	sdpConfig.Codecs = cfg.PreferredCodecs()
+	sdpConfig.Profile, sdpConfig.Crypto = offerProfile, offerCrypto
+	sdpBody := s.GenerateSDP(sdpConfig)
 
 	s.logger.Debugw("Outbound INVITE SDP offer",
 		"external_ip", externalIP,
@@ -1611,6 +1757,30 @@ func (s *Server) MakeCall(ctx context.Context, cfg *Config, toURI, fromURI strin
 	return session, nil
 }
 
+// completeOutboundSRTP applies the answer to our SRTP offer, falling back to
+// plain RTP when the policy allows it.
+func (s *Server) completeOutboundSRTP(session *Session, rtpHandler *RTPHandler, answer *SDPMediaInfo) error {
+	profile, local := rtpHandler.SRTP()
+	if local == nil {
+		return nil
+	}
+	if answer == nil {
+		answer = &SDPMediaInfo{}
+	}
+	remote, err := completeSRTPOffer(session.config.SRTP, local, answer)
+	if err != nil {
+		return err
+	}
+	if remote == nil {
+		s.logger.Infow("Outbound call answered without SRTP, using plain RTP", "call_id", session.GetCallID())
+		return rtpHandler.EnableSRTP("", nil, nil)
+	}
+	if answer.Profile != "" {
+		profile = answer.Profile
+	}
+	return rtpHandler.EnableSRTP(profile, local, remote)
+}
+
 // handleOutboundDialog processes the outbound dialog lifecycle
 func (s *Server) handleOutboundDialog(session *Session, rtpHandler *RTPHandler, dialogSession *sipgo.DialogClientSession) {
 	callID := session.GetCallID()
@@ -1748,12 +1918,16 @@ func (s *Server) handleOutboundDialog(session *Session, rtpHandler *RTPHandler,
 	// so subsequent re-INVITE responses advertise only the negotiated codec.
 	var remoteRTPIP string
 	var remoteRTPPort int
+	var answer *SDPMediaInfo
 	if dialogSession.InviteResponse != nil {
 		if body := dialogSession.InviteResponse.Body(); len(body) > 0 {
 			s.logger.Debugw("Outbound call 200 OK SDP answer (raw)",
 				"call_id", callID,
 				"sdp_body", string(body))
 			sdpInfo, parseErr := s.ParseSDP(body)
+			if parseErr == nil {
+				answer = sdpInfo
+			}
 			if parseErr == nil && sdpInfo.ConnectionIP != "" && sdpInfo.AudioPort > 0 {
 				remoteRTPIP = sdpInfo.ConnectionIP
 				remoteRTPPort = sdpInfo.AudioPort
@@ -1766,7 +1940,7 @@ func (s *Server) handleOutboundDialog(session *Session, rtpHandler *RTPHandler,
 				// the audio is garbled or the PBX drops the call immediately.
 				if sdpInfo.PreferredCodec != nil {
 					rtpHandler.SetCodec(sdpInfo.PreferredCodec)
-					session.SetNegotiatedCodec(sdpInfo.PreferredCodec.Name, int(sdpInfo.PreferredCodec.ClockRate))
+					session.SetNegotiatedCodec(sdpInfo.PreferredCodec)
 					s.logger.Infow("Outbound call codec negotiated from 200 OK",
 						"call_id", callID,
 						"codec", sdpInfo.PreferredCodec.Name,
@@ -1789,6 +1963,24 @@ func (s *Server) handleOutboundDialog(session *Session, rtpHandler *RTPHandler,
 		s.logger.Warnw("No InviteResponse available after WaitAnswer", "call_id", callID)
 	}
 
+	// Complete the SRTP exchange with the peer's key. A peer that answered
+	// without crypto when SRTP is required gets ACK + BYE and no media.
+	if err := s.completeOutboundSRTP(session, rtpHandler, answer); err != nil {
+		s.logger.Warnw("Outbound call answered without required SRTP, hanging up",
+			"call_id", callID,
+			"error", err)
+		if ackErr := dialogSession.Ack(session.ctx); ackErr == nil {
+			if byeErr := dialogSession.Bye(session.ctx); byeErr != nil {
+				s.logger.Warnw("Failed to send BYE", "error", byeErr, "call_id", callID)
+			}
+		}
+		session.SetState(CallStateFailed)
+		s.removeSession(callID)
+		rtpHandler.Stop()
+		session.End()
+		return
+	}
+
 	// Step 2: Start RTP — sends the first silence packet synchronously, then
 	// launches sendLoop. This fires BEFORE ACK so Asterisk sees media immediately.
 	rtpHandler.Start()
@@ -10,11 +10,14 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/emiago/sipgo"
+	"github.com/emiago/sipgo/sip"
 	"github.com/google/uuid"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/protos"
@@ -88,6 +91,10 @@ type Session struct {
 	// onDisconnect is called during Close/End to perform transport-level call teardown
 	// (e.g., sending SIP BYE). Set by the server that owns this session.
 	onDisconnect func(session *Session)
+
+	// referResult receives the final status of the call placed for the last
+	// REFER, reported by the remote side through NOTIFY (RFC 3515).
+	referResult chan int
 }
 
 // NewSession creates a new SIP session
@@ -128,7 +135,7 @@ func NewSession(ctx context.Context, cfg *SessionConfig) (*Session, error) {
 			Direction:  cfg.Direction,
 			StartTime:  time.Now(),
 			Codec:      codec.Name,
-			SampleRate: int(codec.ClockRate),
+			SampleRate: int(codec.SampleRate),
 		},
 		config:          cfg.Config,
 		ctx:             sessionCtx,
@@ -295,18 +302,18 @@ func (s *Session) GetRTPLocalPort() int {
 	return s.rtpLocalPort
 }
 
-// SetNegotiatedCodec sets the negotiated codec
-func (s *Session) SetNegotiatedCodec(codecName string, sampleRate int) {
+// SetNegotiatedCodec sets the negotiated codec, keeping its payload type
+// as dynamic ones (Opus) differ per call.
+func (s *Session) SetNegotiatedCodec(codec *Codec) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	codec := GetCodecByName(codecName)
 	if codec == nil {
 		codec = &CodecPCMU
 	}
 	s.negotiatedCodec = codec
 	s.info.Codec = codec.Name
-	s.info.SampleRate = sampleRate
+	s.info.SampleRate = int(codec.SampleRate)
 }
 
 // GetNegotiatedCodec returns the negotiated codec
@@ -322,6 +329,18 @@ func (s *Session) SetRTPHandler(handler *RTPHandler) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.rtpHandler = handler
+	if handler != nil {
+		handler.SetOnDTMF(s.EmitDTMF)
+	}
+}
+
+// EmitDTMF publishes a key press (from RTP telephone-events or SIP INFO) as
+// an EventTypeDTMF session event.
+func (s *Session) EmitDTMF(event DTMFEvent) {
+	s.emitEvent(EventTypeDTMF, map[string]interface{}{
+		"digit":       event.Digit,
+		"duration_ms": event.Duration,
+	})
 }
 
 // GetRTPHandler returns the RTP handler for this session
@@ -397,6 +416,147 @@ func (s *Session) GetDialogServerSession() *sipgo.DialogServerSession {
 	return s.dialogServerSession
 }
 
+// Refer sends an in-dialog REFER (RFC 3515) asking the remote party to call
+// referTo. Works for both outbound (UAC) and inbound (UAS) dialogs and returns
+// once the REFER is accepted; the outcome of the new call is reported by the
+// remote side through NOTIFY.
+func (s *Session) Refer(ctx context.Context, referTo string) error {
+	callID := s.GetCallID()
+
+	var target sip.Uri
+	if err := sip.ParseUri(referTo, &target); err != nil {
+		return NewSIPError("Refer", callID, fmt.Sprintf("invalid refer-to %q", referTo), err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	s.mu.Lock()
+	s.referResult = make(chan int, 1)
+	s.mu.Unlock()
+
+	var (
+		res *sip.Response
+		err error
+	)
+	if ds := s.GetDialogClientSession(); ds != nil {
+		recipient := ds.InviteRequest.Recipient
+		if cont := ds.InviteResponse.Contact(); cont != nil {
+			recipient = cont.Address
+		}
+		req := sip.NewRequest(sip.REFER, recipient)
+		req.SetTransport(ds.InviteRequest.Transport())
+		addReferHeaders(req, ds.InviteRequest.From().Address, target)
+		res, err = ds.Do(ctx, req)
+	} else if ds := s.GetDialogServerSession(); ds != nil {
+		cont := ds.InviteRequest.Contact()
+		if cont == nil {
+			return NewSIPError("Refer", callID, "remote contact missing", ErrSessionNotFound)
+		}
+		req := sip.NewRequest(sip.REFER, cont.Address)
+		req.SetTransport(ds.InviteRequest.Transport())
+		addReferHeaders(req, ds.InviteRequest.To().Address, target)
+		res, err = ds.Do(ctx, req)
+	} else {
+		return NewSIPError("Refer", callID, "no dialog for session", ErrSessionNotFound)
+	}
+	if err != nil {
+		return NewSIPError("Refer", callID, "REFER failed", err)
+	}
+	if !res.IsSuccess() {
+		sipErr := NewSIPError("Refer", callID, fmt.Sprintf("REFER rejected with %d %s", res.StatusCode, res.Reason), ErrTransferRejected)
+		sipErr.Code = res.StatusCode
+		return sipErr
+	}
+	return nil
+}
+
+// referNotifyTimeout bounds how long a transfer waits for the remote side to
+// report the outcome of the call placed for the REFER.
+const referNotifyTimeout = 30 * time.Second
+
+// Transfer blind-transfers the call to referTo. It sends a REFER and waits
+// for the NOTIFY carrying the final status of the new call, it returns nil
+// once the transfer target answered and the caller is then expected to hang
+// up this leg as the transferor.
+func (s *Session) Transfer(ctx context.Context, referTo string) error {
+	if err := s.Refer(ctx, referTo); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, referNotifyTimeout)
+	defer cancel()
+	return s.waitReferResult(ctx)
+}
+
+func (s *Session) waitReferResult(ctx context.Context) error {
+	callID := s.GetCallID()
+	s.mu.RLock()
+	result := s.referResult
+	s.mu.RUnlock()
+	if result == nil {
+		return NewSIPError("Transfer", callID, "no REFER sent", ErrTransferRejected)
+	}
+	select {
+	case code := <-result:
+		if code >= 300 {
+			sipErr := NewSIPError("Transfer", callID, fmt.Sprintf("transfer target failed with %d", code), ErrTransferRejected)
+			sipErr.Code = code
+			return sipErr
+		}
+		return nil
+	case <-s.ByeReceived():
+		// the transferee already hung up on us once the new call was set up
+		return nil
+	case <-ctx.Done():
+		return NewSIPError("Transfer", callID, "no final NOTIFY for REFER", ctx.Err())
+	}
+}
+
+// ReferProgress delivers the status of the call placed for the last REFER,
+// provisional statuses are ignored.
+func (s *Session) ReferProgress(code int) {
+	if code < 200 {
+		return
+	}
+	s.mu.RLock()
+	result := s.referResult
+	s.mu.RUnlock()
+	if result == nil {
+		return
+	}
+	select {
+	case result <- code:
+	default:
+	}
+}
+
+// ParseReferNotify extracts the status code from the message/sipfrag body of
+// a REFER NOTIFY, e.g. "SIP/2.0 200 OK".
+func ParseReferNotify(body []byte) (int, bool) {
+	line := strings.TrimSpace(string(body))
+	if i := strings.IndexAny(line, "\r\n"); i >= 0 {
+		line = line[:i]
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 2 || !strings.HasPrefix(strings.ToUpper(fields[0]), "SIP/") {
+		return 0, false
+	}
+	code, err := strconv.Atoi(fields[1])
+	if err != nil || code < 100 || code > 699 {
+		return 0, false
+	}
+	return code, true
+}
+
+// addReferHeaders sets Refer-To and, when known, Referred-By (RFC 3892) from
+// our own side of the dialog.
+func addReferHeaders(req *sip.Request, local sip.Uri, target sip.Uri) {
+	req.AppendHeader(sip.NewHeader("Refer-To", "<"+target.String()+">"))
+	if local.Host != "" {
+		req.AppendHeader(sip.NewHeader("Referred-By", "<"+local.String()+">"))
+	}
+}
+
 // SetOnDisconnect registers a callback that is invoked when the session is disconnected.
 // This allows the SIP server to inject transport-level call teardown (e.g., sending BYE)
 // without the session needing to know about SIP signaling internals.
@@ -573,9 +733,6 @@ func (s *Session) GetRTPStats() *RTPStats {
 		return nil
 	}
 
-	sent, received := rtpHandler.GetStats()
-	return &RTPStats{
-		PacketsSent:     sent,
-		PacketsReceived: received,
-	}
+	stats := rtpHandler.GetDetailedStats()
+	return &stats
 }
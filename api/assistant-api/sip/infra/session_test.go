@@ -0,0 +1,86 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReferNotify(t *testing.T) {
+	tests := []struct {
+		body string
+		code int
+		ok   bool
+	}{
+		{"SIP/2.0 200 OK\r\n", 200, true},
+		{"SIP/2.0 100 Trying", 100, true},
+		{"SIP/2.0 486 Busy Here\r\nContent-Length: 0\r\n", 486, true},
+		{"", 0, false},
+		{"hello world", 0, false},
+		{"SIP/2.0 abc", 0, false},
+	}
+	for _, tt := range tests {
+		code, ok := ParseReferNotify([]byte(tt.body))
+		assert.Equal(t, tt.ok, ok, tt.body)
+		assert.Equal(t, tt.code, code, tt.body)
+	}
+}
+
+func referringSession() *Session {
+	return &Session{
+		info:        SessionInfo{CallID: "call-1"},
+		byeReceived: make(chan struct{}),
+		referResult: make(chan int, 1),
+	}
+}
+
+func TestSession_WaitReferResult(t *testing.T) {
+	t.Run("target answered", func(t *testing.T) {
+		s := referringSession()
+		s.ReferProgress(100)
+		s.ReferProgress(180)
+		s.ReferProgress(200)
+		assert.NoError(t, s.waitReferResult(context.Background()))
+	})
+
+	t.Run("target failed", func(t *testing.T) {
+		s := referringSession()
+		s.ReferProgress(486)
+		err := s.waitReferResult(context.Background())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrTransferRejected))
+		var sipErr *SIPError
+		require.True(t, errors.As(err, &sipErr))
+		assert.Equal(t, 486, sipErr.Code)
+	})
+
+	t.Run("transferee hung up", func(t *testing.T) {
+		s := referringSession()
+		s.NotifyBye()
+		assert.NoError(t, s.waitReferResult(context.Background()))
+	})
+
+	t.Run("no final notify", func(t *testing.T) {
+		s := referringSession()
+		s.ReferProgress(180)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Error(t, s.waitReferResult(ctx))
+	})
+
+	t.Run("no refer sent", func(t *testing.T) {
+		s := referringSession()
+		s.referResult = nil
+		assert.Error(t, s.waitReferResult(context.Background()))
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pion/srtp/v3"
+)
+
+// SRTPPolicy decides whether a call's media must be encrypted with SDES-SRTP.
+type SRTPPolicy string
+
+const (
+	// SRTPPolicyDisabled sends plain RTP and rejects RTP/SAVP offers.
+	SRTPPolicyDisabled SRTPPolicy = "disabled"
+	// SRTPPolicyOptional encrypts when the peer offers or accepts crypto
+	// and falls back to plain RTP otherwise.
+	SRTPPolicyOptional SRTPPolicy = "optional"
+	// SRTPPolicyRequired rejects calls whose peer does not negotiate SRTP.
+	SRTPPolicyRequired SRTPPolicy = "required"
+)
+
+// ParseSRTPPolicy parses a policy name, empty means disabled.
+func ParseSRTPPolicy(value string) (SRTPPolicy, error) {
+	switch policy := SRTPPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "":
+		return SRTPPolicyDisabled, nil
+	case SRTPPolicyDisabled, SRTPPolicyOptional, SRTPPolicyRequired:
+		return policy, nil
+	}
+	return "", fmt.Errorf("%w: unknown srtp policy %q", ErrInvalidConfig, value)
+}
+
+// SDP media transport profiles (RFC 3551, RFC 3711)
+const (
+	SDPProfileRTPAVP  = "RTP/AVP"
+	SDPProfileRTPSAVP = "RTP/SAVP"
+)
+
+// SRTPSuiteAESCM128HMACSHA180 is the mandatory-to-implement SDES crypto suite
+// (RFC 4568 §6.2.1): 128-bit AES counter mode with an 80-bit HMAC-SHA1 tag.
+const SRTPSuiteAESCM128HMACSHA180 = "AES_CM_128_HMAC_SHA1_80"
+
+const (
+	srtpMasterKeyLen  = 16
+	srtpMasterSaltLen = 14
+)
+
+// SDESCrypto is one SDP a=crypto attribute (RFC 4568):
+//
+//	a=crypto:<tag> <suite> inline:<base64 key||salt>[|lifetime][|MKI:length]
+type SDESCrypto struct {
+	Tag   int
+	Suite string
+	Key   []byte // master key followed by master salt
+}
+
+// NewSDESCrypto returns an AES_CM_128_HMAC_SHA1_80 attribute with fresh key material.
+func NewSDESCrypto(tag int) (*SDESCrypto, error) {
+	key := make([]byte, srtpMasterKeyLen+srtpMasterSaltLen)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate SRTP master key: %w", err)
+	}
+	return &SDESCrypto{Tag: tag, Suite: SRTPSuiteAESCM128HMACSHA180, Key: key}, nil
+}
+
+// String returns the attribute value, without the "a=crypto:" prefix.
+func (c *SDESCrypto) String() string {
+	return fmt.Sprintf("%d %s inline:%s", c.Tag, c.Suite, base64.StdEncoding.EncodeToString(c.Key))
+}
+
+// Equal reports whether both attributes carry the same suite and key.
+func (c *SDESCrypto) Equal(other *SDESCrypto) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return c.Suite == other.Suite && bytes.Equal(c.Key, other.Key)
+}
+
+// parseSDESCrypto parses an a=crypto attribute value. Lifetime is ignored,
+// attributes with an MKI or session parameters are rejected as we never
+// negotiate them.
+func parseSDESCrypto(value string) (*SDESCrypto, error) {
+	fields := strings.Fields(value)
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("malformed crypto attribute %q", value)
+	}
+	if len(fields) > 3 {
+		return nil, fmt.Errorf("unsupported crypto session parameters %q", strings.Join(fields[3:], " "))
+	}
+	tag, err := strconv.Atoi(fields[0])
+	if err != nil || tag < 0 {
+		return nil, fmt.Errorf("invalid crypto tag %q", fields[0])
+	}
+	// several key params may be listed, we only use the first one
+	keyParam, _, _ := strings.Cut(fields[2], ";")
+	inline, ok := strings.CutPrefix(keyParam, "inline:")
+	if !ok {
+		return nil, fmt.Errorf("unsupported key method in %q", keyParam)
+	}
+	parts := strings.Split(inline, "|")
+	for _, part := range parts[1:] {
+		if strings.Contains(part, ":") {
+			return nil, fmt.Errorf("unsupported crypto MKI %q", part)
+		}
+	}
+	key, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		// some endpoints strip the base64 padding
+		if key, err = base64.RawStdEncoding.DecodeString(parts[0]); err != nil {
+			return nil, fmt.Errorf("invalid crypto key: %w", err)
+		}
+	}
+	return &SDESCrypto{Tag: tag, Suite: fields[1], Key: key}, nil
+}
+
+// supported reports whether we can run the attribute's suite and key.
+func (c *SDESCrypto) supported() bool {
+	return c.Suite == SRTPSuiteAESCM128HMACSHA180 && len(c.Key) == srtpMasterKeyLen+srtpMasterSaltLen
+}
+
+func (c *SDESCrypto) newContext() (*srtp.Context, error) {
+	if !c.supported() {
+		return nil, fmt.Errorf("unsupported crypto suite %s", c.Suite)
+	}
+	return srtp.CreateContext(c.Key[:srtpMasterKeyLen], c.Key[srtpMasterKeyLen:], srtp.ProtectionProfileAes128CmHmacSha1_80)
+}
+
+// negotiateSRTPAnswer picks the SRTP keys for answering offer under policy.
+// local is reused when set so re-INVITEs keep our key; nil keys mean plain
+// RTP. An error means the offer must be rejected with 488.
+func negotiateSRTPAnswer(policy SRTPPolicy, offer *SDPMediaInfo, local *SDESCrypto) (*SDESCrypto, *SDESCrypto, error) {
+	var remote *SDESCrypto
+	if policy != SRTPPolicyDisabled {
+		for i := range offer.Crypto {
+			if offer.Crypto[i].supported() {
+				remote = &offer.Crypto[i]
+				break
+			}
+		}
+	}
+	if remote == nil {
+		if offer.Profile == SDPProfileRTPSAVP {
+			return nil, nil, fmt.Errorf("%w: no acceptable crypto in RTP/SAVP offer", ErrSRTPNotNegotiated)
+		}
+		if policy == SRTPPolicyRequired {
+			return nil, nil, fmt.Errorf("%w: offer has no crypto", ErrSRTPNotNegotiated)
+		}
+		return nil, nil, nil
+	}
+
+	if local == nil || !local.supported() {
+		var err error
+		if local, err = NewSDESCrypto(remote.Tag); err != nil {
+			return nil, nil, err
+		}
+	}
+	// the answer echoes the tag of the accepted offer attribute
+	answer := *local
+	answer.Tag = remote.Tag
+	return &answer, remote, nil
+}
+
+// newSRTPOffer returns the profile and key to offer under policy. Optional
+// SRTP is offered as RTP/AVP with crypto so peers without SRTP still answer.
+func newSRTPOffer(policy SRTPPolicy) (string, *SDESCrypto, error) {
+	switch policy {
+	case SRTPPolicyRequired, SRTPPolicyOptional:
+		crypto, err := NewSDESCrypto(1)
+		if err != nil {
+			return "", nil, err
+		}
+		if policy == SRTPPolicyRequired {
+			return SDPProfileRTPSAVP, crypto, nil
+		}
+		return SDPProfileRTPAVP, crypto, nil
+	}
+	return SDPProfileRTPAVP, nil, nil
+}
+
+// completeSRTPOffer returns the peer's key from answer to our offered key
+// local, nil when the call falls back to plain RTP.
+func completeSRTPOffer(policy SRTPPolicy, local *SDESCrypto, answer *SDPMediaInfo) (*SDESCrypto, error) {
+	if local == nil {
+		return nil, nil
+	}
+	for i := range answer.Crypto {
+		if answer.Crypto[i].Tag == local.Tag && answer.Crypto[i].supported() {
+			return &answer.Crypto[i], nil
+		}
+	}
+	if policy == SRTPPolicyRequired {
+		return nil, fmt.Errorf("%w: answer has no crypto", ErrSRTPNotNegotiated)
+	}
+	return nil, nil
+}
+
+// srtpCipher serializes access to a pion SRTP context, which keeps per-SSRC
+// rollover state and is not safe for concurrent use.
+type srtpCipher struct {
+	mu     sync.Mutex
+	crypto *SDESCrypto
+	ctx    *srtp.Context
+}
+
+func newSRTPCipher(crypto *SDESCrypto) (*srtpCipher, error) {
+	ctx, err := crypto.newContext()
+	if err != nil {
+		return nil, err
+	}
+	return &srtpCipher{crypto: crypto, ctx: ctx}, nil
+}
+
+func (c *srtpCipher) encrypt(packet []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ctx.EncryptRTP(nil, packet, nil)
+}
+
+func (c *srtpCipher) decrypt(packet []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ctx.DecryptRTP(nil, packet, nil)
+}
+
+func (c *srtpCipher) encryptRTCP(packet []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ctx.EncryptRTCP(nil, packet, nil)
+}
+
+func (c *srtpCipher) decryptRTCP(packet []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ctx.DecryptRTCP(nil, packet, nil)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package sip_infra
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSRTPKey = "WVNfX19zZW1jdGwgKCkgewkyMjA7fQp9CnVubGVz"
+
+func TestParseSDESCrypto(t *testing.T) {
+	crypto, err := parseSDESCrypto("1 AES_CM_128_HMAC_SHA1_80 inline:" + testSRTPKey + "|2^20|")
+	require.NoError(t, err)
+	assert.Equal(t, 1, crypto.Tag)
+	assert.Equal(t, SRTPSuiteAESCM128HMACSHA180, crypto.Suite)
+	assert.Len(t, crypto.Key, 30)
+	assert.True(t, crypto.supported())
+	assert.Equal(t, "1 AES_CM_128_HMAC_SHA1_80 inline:"+testSRTPKey, crypto.String())
+
+	crypto, err = parseSDESCrypto("2 AES_256_CM_HMAC_SHA1_80 inline:" + testSRTPKey)
+	require.NoError(t, err)
+	assert.False(t, crypto.supported())
+
+	for _, value := range []string{
+		"1 AES_CM_128_HMAC_SHA1_80",
+		"x AES_CM_128_HMAC_SHA1_80 inline:" + testSRTPKey,
+		"1 AES_CM_128_HMAC_SHA1_80 inline:" + testSRTPKey + "|2^20|1:4",
+		"1 AES_CM_128_HMAC_SHA1_80 inline:" + testSRTPKey + " UNENCRYPTED_SRTP",
+		"1 AES_CM_128_HMAC_SHA1_80 inline:!!!",
+	} {
+		_, err := parseSDESCrypto(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestSDP_Crypto(t *testing.T) {
+	s := &Server{}
+	crypto, err := NewSDESCrypto(1)
+	require.NoError(t, err)
+
+	cfg := DefaultSDPConfig("10.0.0.1", 20000)
+	cfg.Profile, cfg.Crypto = SDPProfileRTPSAVP, crypto
+	body := s.GenerateSDP(cfg)
+	assert.Contains(t, body, "m=audio 20000 RTP/SAVP 0 8 9 111 101\r\n")
+	assert.Contains(t, body, "a=crypto:"+crypto.String()+"\r\n")
+
+	info, err := s.ParseSDP([]byte(body))
+	require.NoError(t, err)
+	assert.Equal(t, SDPProfileRTPSAVP, info.Profile)
+	require.Len(t, info.Crypto, 1)
+	assert.True(t, info.Crypto[0].Equal(crypto))
+
+	body = s.GenerateSDP(DefaultSDPConfig("10.0.0.1", 20000))
+	assert.Contains(t, body, "m=audio 20000 RTP/AVP 0 8 9 111 101\r\n")
+	assert.NotContains(t, body, "a=crypto")
+}
+
+func TestNegotiateSRTPAnswer(t *testing.T) {
+	offered, err := NewSDESCrypto(2)
+	require.NoError(t, err)
+	unsupported := SDESCrypto{Tag: 1, Suite: "AES_256_CM_HMAC_SHA1_80", Key: make([]byte, 46)}
+
+	secure := &SDPMediaInfo{Profile: SDPProfileRTPSAVP, Crypto: []SDESCrypto{unsupported, *offered}}
+	bestEffort := &SDPMediaInfo{Profile: SDPProfileRTPAVP, Crypto: []SDESCrypto{*offered}}
+	plain := &SDPMediaInfo{Profile: SDPProfileRTPAVP}
+
+	for _, policy := range []SRTPPolicy{SRTPPolicyOptional, SRTPPolicyRequired} {
+		local, remote, err := negotiateSRTPAnswer(policy, secure, nil)
+		require.NoError(t, err)
+		assert.True(t, remote.Equal(offered))
+		assert.Equal(t, 2, local.Tag)
+		assert.False(t, local.Equal(offered))
+
+		// re-INVITEs keep our key
+		again, _, err := negotiateSRTPAnswer(policy, bestEffort, local)
+		require.NoError(t, err)
+		assert.True(t, again.Equal(local))
+	}
+
+	local, _, err := negotiateSRTPAnswer(SRTPPolicyOptional, plain, nil)
+	require.NoError(t, err)
+	assert.Nil(t, local)
+
+	_, _, err = negotiateSRTPAnswer(SRTPPolicyRequired, plain, nil)
+	assert.ErrorIs(t, err, ErrSRTPNotNegotiated)
+
+	local, _, err = negotiateSRTPAnswer(SRTPPolicyDisabled, bestEffort, nil)
+	require.NoError(t, err)
+	assert.Nil(t, local)
+
+	_, _, err = negotiateSRTPAnswer(SRTPPolicyDisabled, secure, nil)
+	assert.ErrorIs(t, err, ErrSRTPNotNegotiated)
+}
+
+func TestSRTPOffer(t *testing.T) {
+	profile, crypto, err := newSRTPOffer(SRTPPolicyDisabled)
+	require.NoError(t, err)
+	assert.Equal(t, SDPProfileRTPAVP, profile)
+	assert.Nil(t, crypto)
+
+	profile, crypto, err = newSRTPOffer(SRTPPolicyRequired)
+	require.NoError(t, err)
+	assert.Equal(t, SDPProfileRTPSAVP, profile)
+	require.NotNil(t, crypto)
+
+	peer, err := NewSDESCrypto(crypto.Tag)
+	require.NoError(t, err)
+	remote, err := completeSRTPOffer(SRTPPolicyRequired, crypto, &SDPMediaInfo{Crypto: []SDESCrypto{*peer}})
+	require.NoError(t, err)
+	assert.True(t, remote.Equal(peer))
+
+	_, err = completeSRTPOffer(SRTPPolicyRequired, crypto, &SDPMediaInfo{})
+	assert.ErrorIs(t, err, ErrSRTPNotNegotiated)
+	remote, err = completeSRTPOffer(SRTPPolicyOptional, crypto, &SDPMediaInfo{})
+	require.NoError(t, err)
+	assert.Nil(t, remote)
+}
+
+func TestParseSRTPPolicy(t *testing.T) {
+	policy, err := ParseSRTPPolicy(" Required ")
+	require.NoError(t, err)
+	assert.Equal(t, SRTPPolicyRequired, policy)
+	policy, err = ParseSRTPPolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, SRTPPolicyDisabled, policy)
+	_, err = ParseSRTPPolicy("sometimes")
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestRTPHandler_SRTPRoundTrip(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	newHandler := func() *RTPHandler {
+		h, err := NewRTPHandler(context.Background(), &RTPConfig{LocalIP: "127.0.0.1", Logger: logger})
+		require.NoError(t, err)
+		t.Cleanup(func() { h.Stop() })
+		return h
+	}
+	a, b := newHandler(), newHandler()
+	keyA, err := NewSDESCrypto(1)
+	require.NoError(t, err)
+	keyB, err := NewSDESCrypto(1)
+	require.NoError(t, err)
+	require.NoError(t, a.EnableSRTP(SDPProfileRTPSAVP, keyA, keyB))
+	require.NoError(t, b.EnableSRTP(SDPProfileRTPSAVP, keyB, keyA))
+
+	_, portA := a.LocalAddr()
+	_, portB := b.LocalAddr()
+	a.SetRemoteAddr("127.0.0.1", portB)
+	b.SetRemoteAddr("127.0.0.1", portA)
+	a.Start()
+	b.Start()
+
+	tone := bytes.Repeat([]byte{0x55}, 160)
+	a.AudioOut() <- tone
+
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case payload := <-b.AudioIn():
+			if bytes.Equal(payload, tone) {
+				profile, local := b.SRTP()
+				assert.Equal(t, SDPProfileRTPSAVP, profile)
+				assert.True(t, local.Equal(keyB))
+				return
+			}
+		case <-deadline:
+			t.Fatal("encrypted audio was not received")
+		}
+	}
+}
+
+func TestRTPHandler_SRTPRejectsWrongKey(t *testing.T) {
+	sender, err := NewSDESCrypto(1)
+	require.NoError(t, err)
+	other, err := NewSDESCrypto(1)
+	require.NoError(t, err)
+
+	h := &RTPHandler{codec: &CodecPCMU}
+	require.NoError(t, h.EnableSRTP(SDPProfileRTPSAVP, sender, nil))
+	packet := h.serializeRTPPacket(h.createRTPPacket(bytes.Repeat([]byte{0x55}, 160)))
+	encrypted, err := h.srtpEncrypt.encrypt(packet)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(encrypted, bytes.Repeat([]byte{0x55}, 16)))
+
+	good, err := newSRTPCipher(sender)
+	require.NoError(t, err)
+	decrypted, err := good.decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, packet, decrypted)
+
+	bad, err := newSRTPCipher(other)
+	require.NoError(t, err)
+	_, err = bad.decrypt(encrypted)
+	assert.Error(t, err)
+
+	require.NoError(t, h.EnableSRTP("", nil, nil))
+	profile, local := h.SRTP()
+	assert.Empty(t, profile)
+	assert.Nil(t, local)
+}
@@ -10,10 +10,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	internal_type "github.com/rapidaai/api/assistant-api/internal/type"
 	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
 )
 
 // SIP-specific errors
@@ -25,6 +29,12 @@ var (
 	ErrSDPParseFailed    = errors.New("failed to parse SDP")
 	ErrCodecNotSupported = errors.New("codec not supported")
 	ErrConnectionFailed  = errors.New("SIP connection failed")
+	ErrTransferRejected  = errors.New("SIP transfer rejected")
+	ErrSRTPNotNegotiated = errors.New("SRTP not negotiated")
+
+	ErrRegistrationRejected = errors.New("SIP registration rejected")
+	ErrRegistrationNotFound = errors.New("SIP registration not found")
+	ErrRegistrationExists   = errors.New("SIP registration already exists")
 )
 
 // SIPError wraps SIP-specific errors with context
@@ -98,7 +108,14 @@ type Config struct {
 	Transport         Transport `json:"sip_transport" mapstructure:"sip_transport"`
 	RTPPortRangeStart int       `json:"rtp_port_range_start" mapstructure:"rtp_port_range_start"`
 	RTPPortRangeEnd   int       `json:"rtp_port_range_end" mapstructure:"rtp_port_range_end"`
-	SRTPEnabled       bool      `json:"srtp_enabled" mapstructure:"srtp_enabled"`
+
+	// SRTP is the per-deployment media encryption policy (SDES-SRTP),
+	// empty behaves as SRTPPolicyDisabled.
+	SRTP SRTPPolicy `json:"srtp_policy,omitempty" mapstructure:"srtp_policy"`
+
+	// Codecs is the audio codec preference, most preferred first (e.g.
+	// "G722", "opus", "PCMU"). Empty uses SupportedCodecs.
+	Codecs []string `json:"codecs,omitempty" mapstructure:"codecs"`
 
 	// Timeout settings — from app config
 	RegisterTimeout  time.Duration `json:"register_timeout,omitempty" mapstructure:"register_timeout"`
@@ -138,6 +155,53 @@ func (c *Config) ApplyOperationalDefaults(port int, transport Transport, rtpStar
 	}
 }
 
+// ApplySRTPPolicy sets the media encryption policy from the sip.srtp
+// deployment option: "required", "optional" or "disabled" (default).
+func (c *Config) ApplySRTPPolicy(opts utils.Option) error {
+	value, err := opts.GetString("sip.srtp")
+	if err != nil {
+		return nil
+	}
+	policy, err := ParseSRTPPolicy(value)
+	if err != nil {
+		return err
+	}
+	c.SRTP = policy
+	return nil
+}
+
+// ApplyCodecPreference sets the codec preference from the sip.codecs
+// deployment option, a comma separated list such as "g722,opus,pcmu".
+func (c *Config) ApplyCodecPreference(opts utils.Option) error {
+	value, err := opts.GetString("sip.codecs")
+	if err != nil || strings.TrimSpace(value) == "" {
+		return nil
+	}
+	codecs, err := ParseCodecPreference(value)
+	if err != nil {
+		return err
+	}
+	c.Codecs = make([]string, 0, len(codecs))
+	for _, codec := range codecs {
+		c.Codecs = append(c.Codecs, codec.Name)
+	}
+	return nil
+}
+
+// PreferredCodecs returns the codecs to offer and accept, most preferred first.
+func (c *Config) PreferredCodecs() []Codec {
+	codecs := make([]Codec, 0, len(c.Codecs))
+	for _, name := range c.Codecs {
+		if codec := GetCodecByName(name); codec != nil {
+			codecs = append(codecs, *codec)
+		}
+	}
+	if len(codecs) == 0 {
+		return SupportedCodecs
+	}
+	return codecs
+}
+
 // ValidateRTP validates the minimum config needed for inbound calls (server + RTP ports)
 func (c *Config) ValidateRTP() error {
 	if c.Server == "" {
@@ -296,6 +360,58 @@ type RTPStats struct {
 	BytesReceived   uint64        `json:"bytes_received"`
 	PacketsLost     uint64        `json:"packets_lost"`
 	Jitter          time.Duration `json:"jitter"`
+
+	// Receive side quality: late covers packets arriving after their
+	// playout slot and frames dropped to drain latency.
+	PacketsLate       uint64        `json:"packets_late"`
+	PacketsConcealed  uint64        `json:"packets_concealed"`
+	JitterBufferDelay time.Duration `json:"jitter_buffer_delay"`
+
+	// Send side quality, as reported by the peer's RTCP
+	RTCPReportsReceived uint64        `json:"rtcp_reports_received"`
+	RemotePacketsLost   uint64        `json:"remote_packets_lost"`
+	RemoteJitter        time.Duration `json:"remote_jitter"`
+	RoundTripTime       time.Duration `json:"round_trip_time"`
+}
+
+// LossRate returns the fraction of expected inbound packets that were lost.
+func (s *RTPStats) LossRate() float64 {
+	expected := s.PacketsReceived + s.PacketsLost
+	if expected == 0 {
+		return 0
+	}
+	return float64(s.PacketsLost) / float64(expected)
+}
+
+// Metrics returns the call quality as conversation metrics, durations in
+// milliseconds.
+func (s *RTPStats) Metrics() []*types.Metric {
+	ms := func(d time.Duration) string {
+		return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', 1, 64)
+	}
+	count := func(n uint64) string {
+		return strconv.FormatUint(n, 10)
+	}
+	metrics := []*types.Metric{
+		types.NewMetric(type_enums.RTP_PACKETS_SENT.String(), count(s.PacketsSent), utils.Ptr("RTP packets sent to the caller")),
+		types.NewMetric(type_enums.RTP_PACKETS_RECEIVED.String(), count(s.PacketsReceived), utils.Ptr("RTP packets received from the caller")),
+		types.NewMetric(type_enums.RTP_PACKETS_LOST.String(), count(s.PacketsLost), utils.Ptr("Inbound RTP packets lost")),
+		types.NewMetric(type_enums.RTP_PACKET_LOSS_RATE.String(), strconv.FormatFloat(s.LossRate()*100, 'f', 2, 64), utils.Ptr("Inbound RTP packet loss in percent")),
+		types.NewMetric(type_enums.RTP_PACKETS_LATE.String(), count(s.PacketsLate), utils.Ptr("Inbound RTP packets too late to be played")),
+		types.NewMetric(type_enums.RTP_PACKETS_CONCEALED.String(), count(s.PacketsConcealed), utils.Ptr("Lost inbound frames replaced by concealment")),
+		types.NewMetric(type_enums.RTP_JITTER.String(), ms(s.Jitter), utils.Ptr("Inbound interarrival jitter in milliseconds")),
+		types.NewMetric(type_enums.RTP_JITTER_BUFFER_DELAY.String(), ms(s.JitterBufferDelay), utils.Ptr("Final jitter buffer depth in milliseconds")),
+	}
+	if s.RTCPReportsReceived > 0 {
+		metrics = append(metrics,
+			types.NewMetric(type_enums.RTP_REMOTE_PACKETS_LOST.String(), count(s.RemotePacketsLost), utils.Ptr("Outbound RTP packets lost, as reported by the caller")),
+			types.NewMetric(type_enums.RTP_REMOTE_JITTER.String(), ms(s.RemoteJitter), utils.Ptr("Outbound jitter reported by the caller in milliseconds")),
+		)
+	}
+	if s.RoundTripTime > 0 {
+		metrics = append(metrics, types.NewMetric(type_enums.RTP_ROUND_TRIP_TIME.String(), ms(s.RoundTripTime), utils.Ptr("Media round trip time from RTCP in milliseconds")))
+	}
+	return metrics
 }
 
 // SIPSession represents an active SIP call session (used by SIP manager)
@@ -8,12 +8,14 @@ package assistant_sip
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rapidaai/api/assistant-api/config"
 	internal_adapter "github.com/rapidaai/api/assistant-api/internal/adapters"
@@ -50,12 +52,19 @@ type SIPEngine struct {
 	postgres   connectors.PostgresConnector
 	redis      connectors.RedisConnector
 	opensearch connectors.OpenSearchConnector
+	vectordb   connectors.VectorConnector
 	storage    storages.Storage
 
 	assistantConversationService internal_services.AssistantConversationService
 	assistantService             internal_services.AssistantService
+	deploymentService            internal_services.AssistantDeploymentService
 	vaultClient                  web_client.VaultClient
 	authClient                   web_client.AuthClient
+
+	// registered is the phone deployment each assistant is registered with,
+	// a new deployment replaces the registrations of the previous one.
+	registered map[uint64]uint64
+	syncMu     sync.Mutex
 }
 
 // SIPEngine creates a new SIP manager
@@ -71,12 +80,15 @@ func NewSIPEngine(config *config.AssistantConfig, logger commons.Logger,
 		postgres:                     postgres,
 		redis:                        redis,
 		opensearch:                   opensearch,
+		vectordb:                     vectordb,
 		assistantConversationService: internal_assistant_service.NewAssistantConversationService(logger, postgres, storage_files.NewStorage(config.AssetStoreConfig, logger)),
 		assistantService:             internal_assistant_service.NewAssistantService(config, logger, postgres, opensearch),
+		deploymentService:            internal_assistant_service.NewAssistantDeploymentService(config, logger, postgres),
 		storage:                      storage_files.NewStorage(config.AssetStoreConfig, logger),
 		vaultClient:                  web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
 		authClient:                   web_client.NewAuthenticator(&config.AppConfig, logger, redis),
 		sessions:                     make(map[string]*sip_infra.SIPSession),
+		registered:                   make(map[uint64]uint64),
 	}
 }
 
@@ -148,6 +160,12 @@ func (m *SIPEngine) Connect(ctx context.Context) error {
 		return fmt.Errorf("failed to start SIP server: %w", err)
 	}
 	m.server = server
+
+	// restore the registrations of phone deployments and keep them in sync
+	// with deployments created or removed through any instance.
+	utils.Go(m.ctx, func() {
+		m.syncRegistrationsLoop(m.ctx)
+	})
 	return nil
 }
 
@@ -165,6 +183,10 @@ func (m *SIPEngine) GetServer() *sip_infra.Server {
 // URI format: sip:{assistantID}:{apiKey}@aws.ap-south-east-01.rapida.ai
 //   - apiKey is a project-scoped token (rpd-prj-xxx or raw key)
 func (m *SIPEngine) authMiddleware(ctx *sip_infra.SIPRequestContext, next func() (*sip_infra.InviteResult, error)) (*sip_infra.InviteResult, error) {
+	// calls delivered through a registration carry its principal
+	if _, ok := ctx.Get("auth"); ok {
+		return next()
+	}
 	if ctx.APIKey == "" {
 		m.logger.Warnw("SIP: missing API key", "call_id", ctx.CallID, "method", ctx.Method, "from", ctx.FromURI)
 		return sip_infra.Reject(401, "Missing credentials. Use sip:{assistantID}:{apiKey}@host"), nil
@@ -585,6 +607,7 @@ func (m *SIPEngine) startCall(ctx context.Context, session *sip_infra.Session, c
 		m.logger,
 		m.postgres,
 		m.opensearch,
+		m.vectordb,
 		m.redis,
 		m.storage,
 		streamer,
@@ -609,6 +632,13 @@ func (m *SIPEngine) startCall(ctx context.Context, session *sip_infra.Session, c
 		m.logger.Warnw("SIP talker exited", "error", err, "call_id", callID)
 	}
 
+	// Per-call media quality measured by the RTP handler (loss, jitter, RTT)
+	if stats := session.GetRTPStats(); stats != nil {
+		if _, err := m.assistantConversationService.ApplyConversationMetrics(ctx, auth, cc.AssistantID, cc.ConversationID, stats.Metrics()); err != nil {
+			m.logger.Warnw("Failed to store SIP media quality metrics", "error", err, "call_id", callID)
+		}
+	}
+
 	m.logger.Infow("SIP call ended", "call_id", callID)
 }
 
@@ -738,6 +768,7 @@ func (m *SIPEngine) HandleIncomingCall(
 		m.logger,
 		m.postgres,
 		m.opensearch,
+		m.vectordb,
 		m.redis,
 		m.storage,
 		streamer,
@@ -859,6 +890,204 @@ func (m *SIPEngine) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// RegisterAssistant registers the assistant's phone deployment as an extension
+// on its PBX or carrier so inbound calls are routed without a URI carrying the
+// API key. Each vault credential in sip.registration_credential_ids (comma
+// separated) becomes one registration; with sip.register enabled the
+// deployment credential (rapida.credential_id) is registered when none are
+// listed. Inbound calls are authenticated with a service scope principal of
+// the assistant's project.
+func (m *SIPEngine) RegisterAssistant(assistant *internal_assistant_entity.Assistant) ([]sip_infra.RegistrationInfo, error) {
+	server := m.GetServer()
+	if server == nil {
+		return nil, fmt.Errorf("SIP server is not running")
+	}
+	if assistant.AssistantPhoneDeployment == nil {
+		return nil, fmt.Errorf("assistant has no phone deployment configured")
+	}
+
+	opts := assistant.AssistantPhoneDeployment.GetOptions()
+	var credentialIDs []uint64
+	if ids, err := opts.GetString("sip.registration_credential_ids"); err == nil {
+		for _, id := range strings.Split(ids, ",") {
+			if id = strings.TrimSpace(id); id == "" {
+				continue
+			}
+			credentialID, err := strconv.ParseUint(id, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid credential id %q in sip.registration_credential_ids", id)
+			}
+			credentialIDs = append(credentialIDs, credentialID)
+		}
+	}
+	if len(credentialIDs) == 0 {
+		if register, _ := opts.GetBool("sip.register"); !register {
+			return nil, fmt.Errorf("registration is not enabled for the phone deployment")
+		}
+		credentialID, err := opts.GetUint64("rapida.credential_id")
+		if err != nil {
+			return nil, fmt.Errorf("no credential_id in phone deployment: %w", err)
+		}
+		credentialIDs = append(credentialIDs, credentialID)
+	}
+
+	var expires time.Duration
+	if secs, err := opts.GetUint64("sip.register_expires"); err == nil {
+		expires = time.Duration(secs) * time.Second
+	}
+
+	auth := &types.ServiceScope{
+		ProjectId:      utils.Ptr(assistant.ProjectId),
+		OrganizationId: utils.Ptr(assistant.OrganizationId),
+	}
+	infos := make([]sip_infra.RegistrationInfo, 0, len(credentialIDs))
+	for _, credentialID := range credentialIDs {
+		vaultCred, err := m.vaultClient.GetCredential(m.ctx, auth, credentialID)
+		if err != nil {
+			return infos, fmt.Errorf("failed to fetch vault credential %d: %w", credentialID, err)
+		}
+		sipConfig, err := GetSIPConfigFromVault(vaultCred)
+		if err != nil {
+			return infos, fmt.Errorf("failed to parse SIP config from vault: %w", err)
+		}
+		if m.cfg.SIPConfig != nil {
+			sipConfig.ApplyOperationalDefaults(
+				m.cfg.SIPConfig.Port,
+				sip_infra.Transport(m.cfg.SIPConfig.Transport),
+				m.cfg.SIPConfig.RTPPortRangeStart,
+				m.cfg.SIPConfig.RTPPortRangeEnd,
+			)
+		}
+		info, err := server.Register(&sip_infra.RegistrationConfig{
+			ID:          registrationID(assistant.Id, credentialID),
+			Config:      sipConfig,
+			Expires:     expires,
+			AssistantID: strconv.FormatUint(assistant.Id, 10),
+			Auth:        auth,
+		})
+		if err != nil {
+			return infos, fmt.Errorf("failed to register credential %d: %w", credentialID, err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// UnregisterAssistant removes all registrations of the assistant.
+func (m *SIPEngine) UnregisterAssistant(ctx context.Context, assistantID uint64) error {
+	server := m.GetServer()
+	if server == nil {
+		return nil
+	}
+	var errs []error
+	for _, info := range m.AssistantRegistrations(assistantID) {
+		if err := server.Unregister(ctx, info.ID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AssistantRegistrations returns the state of the assistant's registrations.
+func (m *SIPEngine) AssistantRegistrations(assistantID uint64) []sip_infra.RegistrationInfo {
+	server := m.GetServer()
+	if server == nil {
+		return nil
+	}
+	id := strconv.FormatUint(assistantID, 10)
+	var infos []sip_infra.RegistrationInfo
+	for _, info := range server.Registrations() {
+		if info.AssistantID == id {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// registrationSyncInterval is how often registrations are reconciled with the
+// phone deployments, deployments changed through another instance are picked
+// up within it.
+const registrationSyncInterval = time.Minute
+
+func (m *SIPEngine) syncRegistrationsLoop(ctx context.Context) {
+	ticker := time.NewTicker(registrationSyncInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.SyncRegistrations(ctx); err != nil {
+			m.logger.Errorf("SIP: unable to sync registrations %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncRegistrations registers the latest SIP phone deployment of every active
+// assistant that enables registration, and removes the registrations of
+// assistants that were deleted or whose deployment changed or no longer
+// registers. It runs when the server starts, periodically after, and when a
+// phone deployment or assistant is changed through this instance.
+func (m *SIPEngine) SyncRegistrations(ctx context.Context) error {
+	if m.GetServer() == nil {
+		return nil
+	}
+	m.syncMu.Lock()
+	defer m.syncMu.Unlock()
+
+	assistants, err := m.deploymentService.GetAllAssistantWithPhoneDeployment(ctx, string(internal_telephony.SIP))
+	if err != nil {
+		return fmt.Errorf("failed to list SIP phone deployments: %w", err)
+	}
+	wanted := make(map[uint64]*internal_assistant_entity.Assistant, len(assistants))
+	for _, assistant := range assistants {
+		if assistant.AssistantPhoneDeployment != nil && registrationEnabled(assistant.AssistantPhoneDeployment.GetOptions()) {
+			wanted[assistant.Id] = assistant
+		}
+	}
+
+	var errs []error
+	for assistantID, deploymentID := range m.registered {
+		if assistant, ok := wanted[assistantID]; ok && assistant.AssistantPhoneDeployment.Id == deploymentID {
+			continue
+		}
+		if err := m.UnregisterAssistant(ctx, assistantID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to unregister assistant %d: %w", assistantID, err))
+		}
+		delete(m.registered, assistantID)
+	}
+	for assistantID, assistant := range wanted {
+		if _, ok := m.registered[assistantID]; ok {
+			continue
+		}
+		if _, err := m.RegisterAssistant(assistant); err != nil {
+			errs = append(errs, fmt.Errorf("failed to register assistant %d: %w", assistantID, err))
+			// drop the credentials that did register, the next sync retries all of them
+			if err := m.UnregisterAssistant(ctx, assistantID); err != nil {
+				m.logger.Warnw("SIP: failed to remove partial registration", "assistant_id", assistantID, "error", err)
+			}
+			continue
+		}
+		m.registered[assistantID] = assistant.AssistantPhoneDeployment.Id
+	}
+	return errors.Join(errs...)
+}
+
+// registrationEnabled reports whether a phone deployment asks to be registered
+// on its PBX or carrier.
+func registrationEnabled(opts utils.Option) bool {
+	if ids, err := opts.GetString("sip.registration_credential_ids"); err == nil && strings.TrimSpace(ids) != "" {
+		return true
+	}
+	register, _ := opts.GetBool("sip.register")
+	return register
+}
+
+func registrationID(assistantID, credentialID uint64) string {
+	return fmt.Sprintf("assistant/%d/credential/%d", assistantID, credentialID)
+}
+
 // fetchSIPConfigFromVault fetches SIP provider credentials from vault, then overlays
 // platform operational settings (port, transport, RTP range) from app config.
 // Twilio/providers give: sip_uri, sip_username, sip_password
@@ -894,6 +1123,12 @@ func (m *SIPEngine) fetchSIPConfigFromVault(auth types.SimplePrinciple, assistan
 			m.cfg.SIPConfig.RTPPortRangeEnd,
 		)
 	}
+	if err := sipConfig.ApplySRTPPolicy(opts); err != nil {
+		return nil, err
+	}
+	if err := sipConfig.ApplyCodecPreference(opts); err != nil {
+		return nil, err
+	}
 
 	return sipConfig, nil
 }
@@ -931,6 +1166,12 @@ func (m *SIPEngine) fetchSIPConfigAndVaultCredential(auth types.SimplePrinciple,
 			m.cfg.SIPConfig.RTPPortRangeEnd,
 		)
 	}
+	if err := sipConfig.ApplySRTPPolicy(opts); err != nil {
+		return nil, nil, err
+	}
+	if err := sipConfig.ApplyCodecPreference(opts); err != nil {
+		return nil, nil, err
+	}
 
 	return sipConfig, vaultCred, nil
 }
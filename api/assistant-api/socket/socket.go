@@ -42,6 +42,7 @@ type audioSocketEngine struct {
 	postgres   connectors.PostgresConnector
 	redis      connectors.RedisConnector
 	opensearch connectors.OpenSearchConnector
+	vectordb   connectors.VectorConnector
 	storage    storages.Storage
 
 	inboundDispatcher *internal_telephony.InboundDispatcher
@@ -54,6 +55,7 @@ func NewAudioSocketEngine(config *config.AssistantConfig, logger commons.Logger,
 	postgres connectors.PostgresConnector,
 	redis connectors.RedisConnector,
 	opensearch connectors.OpenSearchConnector,
+	vectordb connectors.VectorConnector,
 ) *audioSocketEngine {
 	store := callcontext.NewStore(postgres, logger)
 	vaultClient := web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis)
@@ -76,6 +78,7 @@ func NewAudioSocketEngine(config *config.AssistantConfig, logger commons.Logger,
 		postgres:          postgres,
 		redis:             redis,
 		opensearch:        opensearch,
+		vectordb:          vectordb,
 		storage:           fileStorage,
 		inboundDispatcher: dispatcher,
 	}
@@ -175,6 +178,7 @@ func (m *audioSocketEngine) handleConnection(ctx context.Context, conn net.Conn)
 		m.logger,
 		m.postgres,
 		m.opensearch,
+		m.vectordb,
 		m.redis,
 		m.storage,
 		streamer,
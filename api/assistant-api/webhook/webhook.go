@@ -0,0 +1,23 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+package assistant_webhook
+
+import (
+	"github.com/rapidaai/api/assistant-api/config"
+	internal_webhook "github.com/rapidaai/api/assistant-api/internal/webhook"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+// NewWebhookDispatcher creates the dispatcher delivering the webhooks queued
+// by conversations.
+func NewWebhookDispatcher(cfg *config.AssistantConfig, logger commons.Logger,
+	postgres connectors.PostgresConnector,
+	redis connectors.RedisConnector,
+) *internal_webhook.Dispatcher {
+	return internal_webhook.NewDispatcher(cfg, logger, postgres, redis)
+}
@@ -22,6 +22,7 @@ from app.configs.internal_service_config import InternalServiceConfig
 from app.configs.postgres_config import PostgresConfig
 from app.configs.redis_config import RedisConfig
 from app.configs.storage_config import AssetStoreConfig
+from app.configs.weaviate_config import WeaviateConfig
 
 _log = logging.getLogger("app.config")
 
@@ -91,6 +92,21 @@ class ApplicationSettings(BaseSettings):
         default=None, title="Elastic search configs"
     )
 
+    # vector database the chunks are indexed into, opensearch, pgvector or weaviate
+    # must match vector_store of assistant-api which retrieves them
+    vector_store: str = "opensearch"
+
+    # weaviate connection configuration, needed when vector_store is weaviate
+    weaviate: Optional[WeaviateConfig] = Field(
+        default=None, title="Weaviate configs"
+    )
+
+    # separate pgvector database, needed when vector_store is pgvector and the
+    # chunks should not live in the postgres above
+    pgvector: Optional[PostgresConfig] = Field(
+        default=None, title="Pgvector connection config"
+    )
+
     # Client authentication
     internal_service: Optional[InternalServiceConfig] = Field(
         default=None, title="All the internal service config"
@@ -0,0 +1,36 @@
+"""
+Copyright (c) 2023-2025 RapidaAI
+Author: Prashant Srivastav <prashant@rapida.ai>
+
+Licensed under GPL-2.0 with Rapida Additional Terms.
+See LICENSE.md for details or contact sales@rapida.ai for commercial use.
+"""
+
+from typing import Optional
+
+from pydantic import SecretStr
+
+from app.configs import ExternalDatasourceModel
+
+
+class WeaviateConfig(ExternalDatasourceModel):
+    """
+    Weaviate configuration template
+    """
+
+    # Host with port, e.g. localhost:8080
+    host: str
+
+    # default schema is https can be override from env
+    scheme: str = "https"
+
+    # api key sent as bearer token, anonymous access when empty
+    # print safe
+    api_key: Optional[SecretStr] = None
+
+    # request timeout in seconds
+    timeout: float = 30.0
+
+    class Config:
+        env_nested_delimiter = "__"
+        env_file_encoding = "utf-8"
@@ -126,6 +126,28 @@ async def get_me_postgres(request) -> "PostgresConnector":
         )
 
 
+async def get_pgvector(request: Request) -> "PostgresConnector":
+    return await get_me_pgvector(request)
+
+
+async def get_me_pgvector(request) -> "PostgresConnector":
+    """
+    Return the pgvector postgres connection from request context, the
+    application postgres when no separate pgvector database is configured
+    :param request: request context
+    :return: :class:`PostgresConnector`.
+    """
+    key = "pgvector"
+    datasource = (
+        request.state.datasource
+        if isinstance(request, Request)
+        else request.state["datasource"]
+    )
+    if key in datasource:
+        return datasource[key]
+    return await get_me_postgres(request)
+
+
 def get_me(connection_name: str) -> Callable[[Request], Connector]:
     """
     get connection from configurable connector
@@ -21,7 +21,12 @@ from app.core.docstore.postgres_knowledge_document_store import (
 )
 from app.core.embedding.plain_embedding import PlainEmbedder
 from app.core.model_runtime.model_manager import ModelManager
+from app.config import get_settings
+from app.core.rag.datasource.vdb.constants import VectorType
 from app.core.rag.datasource.vdb.opensearch.opensearch_vector import OpenSearchVector
+from app.core.rag.datasource.vdb.pgvector.pgvector_vector import PgVectorVector
+from app.core.rag.datasource.vdb.vector_base import BaseVector
+from app.core.rag.datasource.vdb.weaviate.weaviate_vector import WeaviateVector
 from app.core.rag.index_processor.index_processor_base import BaseIndexProcessor
 from app.core.rag.index_processor.index_processor_factory import IndexProcessorFactory
 from app.core.rag.models.document import Document
@@ -63,6 +68,9 @@ class IndexingRunner:
     postgres: PostgresConnector
     elastic_search: ElasticSearchConnector
 
+    # postgres holding the vector_store schema when vector_store is pgvector
+    pgvector: Optional[PostgresConnector]
+
     def __init__(
             self,
             storage: Storage,
@@ -73,6 +81,7 @@ class IndexingRunner:
             integration_client: IntegrationBridge,
             vault_client: VaultBridge,
             index_type="paragraph-index",
+            pgvector: Optional[PostgresConnector] = None,
     ):
         """
         The function initializes an object with references to a storage object and a PostgreSQL connector
@@ -91,6 +100,7 @@ class IndexingRunner:
         self.storage = storage
         self.postgres = postgres
         self.elastic_search = elastic_search
+        self.pgvector = pgvector or postgres
         self.knowledge_service = KnowledgeService(postgres)
         self.index_type = index_type
         self.knowledge = knowledge
@@ -237,9 +247,7 @@ class IndexingRunner:
             knowledge_document=self.knowledge_document,
             documents=chunk_documents,
             embedder=PlainEmbedder(model_manager),
-            vector_processor=OpenSearchVector(
-                collection_name=collection_name, opensearch=self.elastic_search
-            ),
+            vector_processor=self._vector_processor(collection_name),
         )
 
         document_ids = [document.document_id for document in chunk_documents]
@@ -248,6 +256,23 @@ class IndexingRunner:
         )
         return tokens
 
+    def _vector_processor(self, collection_name: str) -> BaseVector:
+        """
+        vector database the chunks are written to, selected by vector_store
+        """
+        settings = get_settings()
+        if settings.vector_store == VectorType.WEAVIATE.value:
+            return WeaviateVector(
+                collection_name=collection_name, weaviate=settings.weaviate
+            )
+        if settings.vector_store == VectorType.PGVECTOR.value:
+            return PgVectorVector(
+                collection_name=collection_name, postgres=self.pgvector
+            )
+        return OpenSearchVector(
+            collection_name=collection_name, opensearch=self.elastic_search
+        )
+
     async def _update_document_index_status(
             self, after_indexing_status: str, extra_update_params: Optional[dict] = None
     ) -> None:
@@ -18,3 +18,4 @@ class Field(str, Enum):
 class VectorType(str, Enum):
     WEAVIATE = "weaviate"
     OPENSEARCH = "opensearch"
+    PGVECTOR = "pgvector"
@@ -0,0 +1,163 @@
+import json
+import logging
+import re
+
+from sqlalchemy import text
+from sqlalchemy.exc import SQLAlchemyError
+
+from app.connectors.postgres_connector import PostgresConnector
+from app.core.rag.datasource.vdb import constants
+from app.core.rag.datasource.vdb.constants import VectorType
+from app.core.rag.datasource.vdb.vector_base import BaseVector
+from app.core.rag.models.document import Document
+from app.exceptions.pipeline_exception import VectorDatabaseIndexingException
+from app.utils.general import generate_text_hash
+
+logger = logging.getLogger(__name__)
+
+# schema, text search config and naming are shared with the assistant-api
+# pgvector connector which retrieves the chunks (pkg/connectors/pgvector.go)
+SCHEMA = "vector_store"
+TEXT_SEARCH_CONFIG = "simple"
+MAX_IDENTIFIER = 63
+
+
+def _fnv1a(data: bytes, offset: int, prime: int, mask: int) -> int:
+    h = offset
+    for b in data:
+        h = ((h ^ b) * prime) & mask
+    return h
+
+
+def table_name(collection_name: str) -> str:
+    """
+    Table of a namespace within postgres' identifier limit, long namespaces
+    keep a readable prefix and a hash
+    """
+    name = re.sub(r"[^a-z0-9_]", "_", collection_name.lower())
+    if len(name) <= MAX_IDENTIFIER:
+        return name
+    h = _fnv1a(name.encode(), 0xCBF29CE484222325, 0x100000001B3, 0xFFFFFFFFFFFFFFFF)
+    suffix = f"_{h:016x}"
+    return name[: MAX_IDENTIFIER - len(suffix)] + suffix
+
+
+def index_name(table: str, column: str) -> str:
+    h = _fnv1a(table.encode(), 0x811C9DC5, 0x01000193, 0xFFFFFFFF)
+    return f"vs_{h:08x}_{column}_idx"
+
+
+def quote_identifier(name: str) -> str:
+    return '"' + name.replace('"', '""') + '"'
+
+
+def vector_literal(vector: list[float]) -> str:
+    """
+    pgvector's text form, [1,2,3]
+    """
+    return "[" + ",".join(str(float(v)) for v in vector) + "]"
+
+
+class PgVectorVector(BaseVector):
+    # The `postgres` attribute holds a connected postgres connector, either
+    # the application database or the separate pgvector one.
+    postgres: PostgresConnector
+
+    def __init__(self, collection_name: str, postgres: PostgresConnector):
+        super().__init__(collection_name)
+        self.postgres = postgres
+        self._table_name = table_name(collection_name)
+        self._table = f"{SCHEMA}.{quote_identifier(self._table_name)}"
+
+    async def get_type(self) -> str:
+        return VectorType.PGVECTOR
+
+    async def create(
+            self, texts: list[Document], embeddings: list[list[float]], **kwargs
+    ):
+        await self.create_collection(len(embeddings[0]))
+        await self.add_texts(texts, embeddings)
+
+    async def add_texts(
+            self, documents: list[Document], embeddings: list[list[float]], **kwargs
+    ):
+        rows = []
+        for i in range(len(documents)):
+            document_id = generate_text_hash(
+                documents[i].page_content
+            )  # Use hash as the document ID
+            rows.append(
+                {
+                    "id": document_id,
+                    constants.Field.DOCUMENT_ID_KEY.value: document_id,
+                    constants.Field.TEXT_KEY.value: documents[i].page_content,
+                    constants.Field.METADATA_KEY.value: json.dumps(
+                        documents[i].metadata or {}, default=str
+                    ),
+                    constants.Field.ENTITIES_KEY.value: json.dumps(
+                        documents[i].entities or {}, default=str
+                    ),
+                    "embedding": vector_literal(embeddings[i]),
+                }
+            )
+        if not rows:
+            return
+
+        statement = text(
+            f"""INSERT INTO {self._table} (id, document_id, text, metadata, entities, embedding)
+            VALUES (:id, :document_id, :text, CAST(:metadata AS jsonb), CAST(:entities AS jsonb), CAST(:embedding AS vector))
+            ON CONFLICT (id) DO UPDATE SET
+                document_id = EXCLUDED.document_id,
+                text = EXCLUDED.text,
+                metadata = EXCLUDED.metadata,
+                entities = EXCLUDED.entities,
+                embedding = EXCLUDED.embedding"""
+        )
+        try:
+            with self.postgres.session as session:
+                session.execute(statement, rows)
+                session.commit()
+        except SQLAlchemyError as ex:
+            logger.error("Upsert into %s failed: %s", self._table, ex)
+            raise VectorDatabaseIndexingException("unable to index the document")
+
+    async def create_collection(self, dimension: int):
+        statements = [
+            "CREATE EXTENSION IF NOT EXISTS vector",
+            f"CREATE SCHEMA IF NOT EXISTS {SCHEMA}",
+            f"""CREATE TABLE IF NOT EXISTS {self._table} (
+                id text PRIMARY KEY,
+                document_id text NOT NULL DEFAULT '',
+                text text NOT NULL DEFAULT '',
+                metadata jsonb NOT NULL DEFAULT '{{}}',
+                entities jsonb NOT NULL DEFAULT '{{}}',
+                embedding vector({int(dimension)}) NOT NULL,
+                tsv tsvector GENERATED ALWAYS AS (to_tsvector('{TEXT_SEARCH_CONFIG}', text)) STORED
+            )""",
+            f"CREATE INDEX IF NOT EXISTS {quote_identifier(index_name(self._table_name, 'embedding'))} "
+            f"ON {self._table} USING hnsw (embedding vector_cosine_ops)",
+            f"CREATE INDEX IF NOT EXISTS {quote_identifier(index_name(self._table_name, 'tsv'))} "
+            f"ON {self._table} USING gin (tsv)",
+            f"CREATE INDEX IF NOT EXISTS {quote_identifier(index_name(self._table_name, 'metadata'))} "
+            f"ON {self._table} USING gin (metadata jsonb_path_ops)",
+        ]
+        try:
+            with self.postgres.session as session:
+                for statement in statements:
+                    session.execute(text(statement))
+                session.commit()
+        except SQLAlchemyError as ex:
+            logger.error("Unable to create pgvector table %s: %s", self._table, ex)
+            raise VectorDatabaseIndexingException("unable to create the collection")
+
+    async def text_exists(self, id: str) -> bool:
+        try:
+            with self.postgres.session as session:
+                return (
+                    session.execute(
+                        text(f"SELECT 1 FROM {self._table} WHERE id = :id"), {"id": id}
+                    ).first()
+                    is not None
+                )
+        except SQLAlchemyError:
+            return False
@@ -0,0 +1,182 @@
+import json
+import logging
+import re
+import uuid
+
+import httpx
+
+from app.configs.weaviate_config import WeaviateConfig
+from app.core.rag.datasource.vdb import constants
+from app.core.rag.datasource.vdb.constants import VectorType
+from app.core.rag.datasource.vdb.vector_base import BaseVector
+from app.core.rag.models.document import Document
+from app.exceptions.pipeline_exception import VectorDatabaseIndexingException
+from app.utils.general import generate_text_hash
+
+logger = logging.getLogger(__name__)
+
+# flattened, filterable copies of metadata fields, the same set the
+# assistant-api weaviate connector filters with (pkg/connectors/weaviate.go)
+METADATA_PREFIX = "metadata_"
+FILTER_FIELDS = [
+    "category",
+    "document_id",
+    "document_name",
+    "knowledge_document_id",
+    "knowledge_id",
+    "organization_id",
+    "project_id",
+]
+
+
+def class_name(collection_name: str) -> str:
+    """
+    Weaviate class of a namespace, class names must match [A-Z][_0-9A-Za-z]*
+    prod__vs__1__2__3 becomes Prod__vs__1__2__3
+    """
+    name = re.sub(r"[^_0-9A-Za-z]", "_", collection_name)
+    if "a" <= name[0] <= "z":
+        return name[0].upper() + name[1:]
+    if not "A" <= name[0] <= "Z":
+        return "V" + name
+    return name
+
+
+def object_id(chunk_id: str) -> str:
+    """
+    Weaviate object ids are uuids, chunk ids are hashes
+    """
+    try:
+        return str(uuid.UUID(chunk_id))
+    except ValueError:
+        return str(uuid.uuid5(uuid.NAMESPACE_DNS, chunk_id))
+
+
+class WeaviateVector(BaseVector):
+    # The `weaviate` attribute holds the connection config, a client is
+    # created per call as indexing runs in short lived tasks.
+    weaviate: WeaviateConfig
+
+    def __init__(self, collection_name: str, weaviate: WeaviateConfig):
+        super().__init__(collection_name)
+        self.weaviate = weaviate
+        self._class_name = class_name(collection_name)
+
+    async def get_type(self) -> str:
+        return VectorType.WEAVIATE
+
+    def _client(self) -> httpx.AsyncClient:
+        headers = {}
+        if self.weaviate.api_key:
+            headers["Authorization"] = (
+                f"Bearer {self.weaviate.api_key.get_secret_value()}"
+            )
+        return httpx.AsyncClient(
+            base_url=f"{self.weaviate.scheme}://{self.weaviate.host}",
+            headers=headers,
+            timeout=self.weaviate.timeout,
+        )
+
+    async def create(
+            self, texts: list[Document], embeddings: list[list[float]], **kwargs
+    ):
+        await self.create_collection()
+        await self.add_texts(texts, embeddings)
+
+    async def add_texts(
+            self, documents: list[Document], embeddings: list[list[float]], **kwargs
+    ):
+        objects = []
+        for i in range(len(documents)):
+            document_id = generate_text_hash(
+                documents[i].page_content
+            )  # Use hash as the document ID
+            metadata = documents[i].metadata or {}
+            properties = {
+                constants.Field.TEXT_KEY.value: documents[i].page_content,
+                constants.Field.DOCUMENT_ID_KEY.value: document_id,
+                "chunk_id": document_id,
+                constants.Field.METADATA_KEY.value: json.dumps(metadata, default=str),
+                constants.Field.ENTITIES_KEY.value: json.dumps(
+                    documents[i].entities or {}, default=str
+                ),
+            }
+            for field in FILTER_FIELDS:
+                if metadata.get(field) is not None:
+                    properties[METADATA_PREFIX + field] = str(metadata[field])
+            objects.append(
+                {
+                    "class": self._class_name,
+                    "id": object_id(document_id),
+                    "properties": properties,
+                    "vector": embeddings[i],
+                }
+            )
+
+        async with self._client() as client:
+            response = await client.post("/v1/batch/objects", json={"objects": objects})
+        if response.status_code != 200:
+            logger.error("Batch insert failed: %s", response.text)
+            raise VectorDatabaseIndexingException("unable to index the document")
+
+        # the batch succeeds as a whole, failures are reported per object
+        errors = [
+            item["result"]["errors"]
+            for item in response.json()
+            if item.get("result", {}).get("errors")
+        ]
+        if errors:
+            logger.error("Batch insert encountered errors: %s", errors)
+            raise VectorDatabaseIndexingException("unable to index the document")
+        return response
+
+    async def create_collection(self):
+        async with self._client() as client:
+            response = await client.get(f"/v1/schema/{self._class_name}")
+            if response.status_code == 200:
+                return
+            properties = [
+                {"name": constants.Field.TEXT_KEY.value, "dataType": ["text"], "tokenization": "word"},
+                {"name": constants.Field.DOCUMENT_ID_KEY.value, "dataType": ["text"], "tokenization": "field"},
+                {"name": "chunk_id", "dataType": ["text"], "tokenization": "field"},
+                {
+                    "name": constants.Field.METADATA_KEY.value,
+                    "dataType": ["text"],
+                    "indexFilterable": False,
+                    "indexSearchable": False,
+                },
+                {
+                    "name": constants.Field.ENTITIES_KEY.value,
+                    "dataType": ["text"],
+                    "indexFilterable": False,
+                    "indexSearchable": False,
+                },
+            ] + [
+                {"name": METADATA_PREFIX + field, "dataType": ["text"], "tokenization": "field"}
+                for field in FILTER_FIELDS
+            ]
+            response = await client.post(
+                "/v1/schema",
+                json={
+                    "class": self._class_name,
+                    "vectorizer": "none",
+                    "vectorIndexConfig": {"distance": "cosine"},
+                    "properties": properties,
+                },
+            )
+        # created concurrently by another chunk
+        if response.status_code == 422 and "already exists" in response.text:
+            return
+        if response.status_code != 200:
+            logger.error("Unable to create weaviate class: %s", response.text)
+            raise VectorDatabaseIndexingException("unable to create the collection")
+
+    async def text_exists(self, id: str) -> bool:
+        try:
+            async with self._client() as client:
+                response = await client.get(
+                    f"/v1/objects/{self._class_name}/{object_id(id)}"
+                )
+            return response.status_code == 200
+        except httpx.HTTPError:
+            return False
@@ -19,6 +19,7 @@ from app.bridges.internals.vault_bridge import VaultBridge
 from app.commons.j_response import JResponse
 from app.connectors.connector_factory import (
     get_elastic_search,
+    get_pgvector,
     get_postgres,
 )
 from app.connectors.elstic_search_connector import ElasticSearchConnector
@@ -53,6 +54,7 @@ async def index_document(
         background_tasks: BackgroundTasks,
         postgres: PostgresConnector = Depends(get_postgres),
         elastic_search: ElasticSearchConnector = Depends(get_elastic_search),
+        pgvector: PostgresConnector = Depends(get_pgvector),
         storage: Storage = Depends(get_storage),
         integration_client: IntegrationBridge = Depends(get_integration_client),
         vault_client: VaultBridge = Depends(get_vault_service_client),
@@ -83,6 +85,7 @@ async def index_document(
             runner = IndexingRunner(
                 postgres=postgres,
                 elastic_search=elastic_search,
+                pgvector=pgvector,
                 storage=storage,
                 knowledge=knowledge_service.get_knowledge(document.knowledge_id),
                 knowledge_document=document,
@@ -17,7 +17,11 @@ from app.bridges.bridge_factory import (
 )
 from app.celery_worker import celery_app
 from app.config import get_settings
-from app.connectors.connector_factory import get_me_elastic_search, get_me_postgres
+from app.connectors.connector_factory import (
+    get_me_elastic_search,
+    get_me_pgvector,
+    get_me_postgres,
+)
 from app.core.indexing_runner import IndexingRunner
 from app.exceptions.document_exception import DocumentIsPausedException
 from app.services.knowledge_service import KnowledgeService
@@ -39,6 +43,7 @@ async def __index_document(request, data: Dict):
     try:
         postgres = await get_me_postgres(request)
         elastic_search = await get_me_elastic_search(request)
+        pgvector = await get_me_pgvector(request)
         storage = await get_me_storage(request)
         integration_client = get_me_integration_client(get_settings().internal_service.integration_host)
         vault_client = get_me_vault_service_client(get_settings().internal_service.web_host)
@@ -98,6 +103,7 @@ async def __index_document(request, data: Dict):
             await IndexingRunner(
                 postgres=postgres,
                 elastic_search=elastic_search,
+                pgvector=pgvector,
                 storage=storage,
                 knowledge=knowledge_service.get_knowledge(document.knowledge_id),
                 knowledge_document=document,
@@ -0,0 +1,77 @@
+"""
+Tests for pgvector_vector.py
+
+Covers:
+- table and index names agree with the assistant-api pgvector connector
+- indexing creates the namespace table and upserts chunks the connector reads
+"""
+from unittest.mock import MagicMock
+
+import pytest
+
+from app.core.rag.datasource.vdb.pgvector.pgvector_vector import (
+    PgVectorVector,
+    index_name,
+    table_name,
+    vector_literal,
+)
+from app.core.rag.models.document import Document
+
+
+class TestPgVectorNaming:
+
+    def test_table_name_lowercases_namespace(self):
+        assert table_name("prod__vs__1__2__3") == "prod__vs__1__2__3"
+        assert table_name("Prod-VS.1") == "prod_vs_1"
+
+    def test_long_table_name_is_hashed(self):
+        # same name as pgVectorTableName in pkg/connectors
+        name = table_name("prod__vs__" + "a" * 76)
+        assert name == "prod__vs__aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa_7e9aa103b410b7ff"
+        assert len(name) == 63
+
+    def test_index_name_matches_connector(self):
+        assert index_name("prod__vs__1__2__3", "embedding") == "vs_114c3339_embedding_idx"
+
+    def test_vector_literal(self):
+        assert vector_literal([0.5, 0.25, 1]) == "[0.5,0.25,1.0]"
+
+
+def _postgres(session: MagicMock) -> MagicMock:
+    postgres = MagicMock()
+    postgres.session.__enter__.return_value = session
+    return postgres
+
+
+class TestPgVectorIndexing:
+
+    @pytest.mark.asyncio
+    async def test_create_then_text_exists(self):
+        session = MagicMock()
+        vector = PgVectorVector("prod__vs__1__2__3", _postgres(session))
+
+        await vector.create(
+            [Document(page_content="refunds take five days", metadata={"knowledge_id": 7})],
+            [[0.5, 0.25, 1.0]],
+        )
+
+        statements = [str(call.args[0]) for call in session.execute.call_args_list]
+        assert "CREATE EXTENSION IF NOT EXISTS vector" in statements[0]
+        assert 'CREATE TABLE IF NOT EXISTS vector_store."prod__vs__1__2__3"' in statements[2]
+        assert "embedding vector(3) NOT NULL" in statements[2]
+        assert '"vs_114c3339_embedding_idx"' in statements[3]
+        assert "ON CONFLICT (id) DO UPDATE" in statements[-1]
+
+        rows = session.execute.call_args_list[-1].args[1]
+        assert len(rows) == 1
+        assert rows[0]["text"] == "refunds take five days"
+        assert rows[0]["metadata"] == '{"knowledge_id": 7}'
+        assert rows[0]["embedding"] == "[0.5,0.25,1.0]"
+        assert session.commit.call_count == 2
+
+        session.execute.return_value.first.return_value = (1,)
+        assert await vector.text_exists(rows[0]["id"])
+        assert session.execute.call_args.args[1] == {"id": rows[0]["id"]}
+
+        session.execute.return_value.first.return_value = None
+        assert not await vector.text_exists("missing")
@@ -0,0 +1,27 @@
+"""
+Tests for weaviate_vector.py
+
+Covers:
+- class names and object ids agree with the assistant-api weaviate connector
+"""
+from app.core.rag.datasource.vdb.weaviate.weaviate_vector import class_name, object_id
+
+
+class TestWeaviateNaming:
+
+    def test_class_name_capitalizes_namespace(self):
+        assert class_name("prod__vs__1__2__3") == "Prod__vs__1__2__3"
+
+    def test_class_name_replaces_invalid_characters(self):
+        assert class_name("a-b") == "A_b"
+        assert class_name("1-ns") == "V1_ns"
+
+    def test_object_id_is_uuid5_of_chunk_id(self):
+        # same id as weaviateObjectID("c1") in pkg/connectors
+        assert object_id("c1") == "027eb444-bfc5-5f85-9f34-eb09aaaad16d"
+
+    def test_object_id_keeps_uuid(self):
+        assert (
+            object_id("1b4e28ba-2fa1-11d2-883f-0016d3cca427")
+            == "1b4e28ba-2fa1-11d2-883f-0016d3cca427"
+        )
@@ -7,12 +7,23 @@ package endpoint_api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
 	config "github.com/rapidaai/api/endpoint-api/config"
+	internal_entity "github.com/rapidaai/api/endpoint-api/internal/entity"
 	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	internal_cache_service "github.com/rapidaai/api/endpoint-api/internal/service/cache"
 	internal_endpoint_service "github.com/rapidaai/api/endpoint-api/internal/service/endpoint"
 	internal_log_service "github.com/rapidaai/api/endpoint-api/internal/service/log"
 	integration_client "github.com/rapidaai/pkg/clients/integration"
@@ -22,19 +33,24 @@ import (
 	"github.com/rapidaai/pkg/connectors"
 	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
 	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
 	invoker_api "github.com/rapidaai/protos"
 )
 
+var httpStatusPattern = regexp.MustCompile(`\b[45]\d{2}\b`)
+
 type invokerApi struct {
-	cfg                *config.EndpointConfig
-	logger             commons.Logger
-	postgres           connectors.PostgresConnector
-	endpointService    internal_services.EndpointService
-	endpointLogService internal_services.EndpointLogService
-	integrationClient  integration_client.IntegrationServiceClient
-	inputBuilder       integration_client_builders.InputChatBuilder
-	vaultClient        web_client.VaultClient
+	cfg                  *config.EndpointConfig
+	logger               commons.Logger
+	postgres             connectors.PostgresConnector
+	endpointService      internal_services.EndpointService
+	endpointLogService   internal_services.EndpointLogService
+	endpointCacheService internal_services.EndpointCacheService
+	integrationClient    integration_client.IntegrationServiceClient
+	inputBuilder         integration_client_builders.InputChatBuilder
+	embeddingBuilder     integration_client_builders.InputEmbeddingBuilder
+	vaultClient          web_client.VaultClient
 }
 
 type invokerGRPCApi struct {
@@ -46,14 +62,16 @@ func NewInvokerGRPCApi(config *config.EndpointConfig, logger commons.Logger,
 ) invoker_api.DeploymentServer {
 	return &invokerGRPCApi{
 		invokerApi{
-			cfg:                config,
-			logger:             logger,
-			postgres:           postgres,
-			endpointService:    internal_endpoint_service.NewEndpointService(config, logger, postgres),
-			integrationClient:  integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
-			inputBuilder:       integration_client_builders.NewChatInputBuilder(logger),
-			vaultClient:        web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
-			endpointLogService: internal_log_service.NewEndpointLogService(logger, postgres),
+			cfg:                  config,
+			logger:               logger,
+			postgres:             postgres,
+			endpointService:      internal_endpoint_service.NewEndpointService(config, logger, postgres),
+			integrationClient:    integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
+			inputBuilder:         integration_client_builders.NewChatInputBuilder(logger),
+			embeddingBuilder:     integration_client_builders.NewEmbeddingInputBuilder(logger),
+			vaultClient:          web_client.NewVaultClientGRPC(&config.AppConfig, logger, redis),
+			endpointLogService:   internal_log_service.NewEndpointLogService(logger, postgres),
+			endpointCacheService: internal_cache_service.NewEndpointCacheService(logger, redis),
 		},
 	}
 }
@@ -89,7 +107,10 @@ func (invokeApi *invokerGRPCApi) Invoke(ctx context.Context, iRequest *invoker_a
 		iAuth,
 		iRequest.GetEndpoint().GetEndpointId(),
 		utils.GetVersionDefinition(iRequest.GetEndpoint().GetVersion()),
-		internal_services.NewGetEndpointOption())
+		&internal_services.GetEndpointOption{
+			InjectRetry:   true,
+			InjectCaching: true,
+		})
 
 	if err != nil {
 		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check endpoint configuration and try again.")
@@ -118,59 +139,63 @@ func (invokeApi *invokerGRPCApi) Invoke(ctx context.Context, iRequest *invoker_a
 		return utils.ErrorWithCode[invoker_api.InvokeResponse](400, err, "Please check credential for provider and update it.")
 	}
 
-	output, err := invokeApi.
-		integrationClient.
-		Chat(ctx,
-			iAuth,
+	messages := invokeApi.
+		inputBuilder.
+		Message(
 			endpoint.
 				EndpointProviderModel.
-				ModelProviderName,
+				Request.
+				GetTextChatCompleteTemplate().
+				Prompt,
 			invokeApi.
 				inputBuilder.
-				Chat(
-					fmt.Sprintf("%d", requestID),
-					&invoker_api.Credential{
-						Id:    vlt.GetId(),
-						Value: vlt.GetValue(),
-					},
-					invokeApi.
-						inputBuilder.
-						Options(
-							endpoint.
-								EndpointProviderModel.
-								GetOptions(),
-							iRequest.
-								GetOptions(),
-						),
-					nil,
-					map[string]string{
-						"endpoint_id":                fmt.Sprintf("%d", endpoint.Id),
-						"vault_id":                   fmt.Sprintf("%d", vlt.Id),
-						"endpoint_provider_model_id": fmt.Sprintf("%d", endpoint.EndpointProviderModel.Id),
-					},
-					invokeApi.
-						inputBuilder.
-						Message(
-							endpoint.
-								EndpointProviderModel.
-								Request.
-								GetTextChatCompleteTemplate().
-								Prompt,
-							invokeApi.
-								inputBuilder.
-								Arguments(endpoint.
-									EndpointProviderModel.
-									Request.
-									GetTextChatCompleteTemplate().Variables, iRequest.GetArgs()),
-						)...,
-				))
+				Arguments(endpoint.
+					EndpointProviderModel.
+					Request.
+					GetTextChatCompleteTemplate().Variables, iRequest.GetArgs()),
+		)
+
+	request := invokeApi.chatRequest(requestID, endpoint, vlt, iRequest, messages)
 
+	//
+	// serve from cache when the endpoint has caching configured
+	caching, prompt, embedding, cached := invokeApi.cacheLookup(ctx, iAuth, endpoint, vlt, messages, request)
+	if cached != nil {
+		metrics := invokeApi.cacheHitMetrics(cached, start)
+		utils.Go(context.Background(), func() {
+			invokeApi.endpointLogService.UpdateEndpointLog(
+				context.Background(),
+				iAuth,
+				requestID,
+				metrics,
+				uint64(time.Since(start)),
+			)
+		})
+		return &invoker_api.InvokeResponse{
+			RequestId: requestID,
+			Code:      200,
+			Success:   true,
+			TimeTaken: uint64(time.Since(start).Microseconds()),
+			Data:      cached.Data,
+			Metrics:   metrics,
+		}, nil
+	}
+
+	output, attempts, err := invokeApi.chat(ctx, iAuth, endpoint, request)
+
+	metrics := append([]*invoker_api.Metric{}, output.GetMetrics()...)
+	if endpoint.RetryEnable {
+		metrics = append(metrics, types.NewMetric(type_enums.RETRY_ATTEMPTS.String(), fmt.Sprintf("%d", attempts-1), utils.Ptr("Number of retries made for the request")).ToProto())
+	}
+	if caching != nil {
+		metrics = append(metrics, types.NewMetric(type_enums.CACHE_HIT.String(), "false", utils.Ptr("Response served from endpoint cache")).ToProto())
+	}
 	utils.Go(context.Background(), func() {
 		invokeApi.endpointLogService.UpdateEndpointLog(
 			context.Background(),
 			iAuth,
 			requestID,
-			output.GetMetrics(),
+			metrics,
 			uint64(time.Since(start)),
 		)
 	})
@@ -182,28 +207,559 @@ func (invokeApi *invokerGRPCApi) Invoke(ctx context.Context, iRequest *invoker_a
 	if output.GetData() != nil && output.GetData().GetAssistant() != nil {
 		data = output.GetData().GetAssistant().GetContents()
 	}
+	invokeApi.cacheStore(caching, endpoint, request, prompt, embedding, requestID, data)
 	return &invoker_api.InvokeResponse{
 		RequestId: requestID,
 		Code:      200,
 		Success:   true,
 		TimeTaken: uint64(time.Since(start).Microseconds()),
 		Data:      data,
-		Metrics:   output.GetMetrics(),
+		Metrics:   metrics,
 	}, nil
 }
 
-func (endpoint *invokerGRPCApi) Probe(ctx context.Context, rpv *invoker_api.ProbeRequest) (*invoker_api.ProbeResponse, error) {
+// InvokeStream executes the endpoint over provider stream, every delta is sent as it arrives
+// and the stream is closed with a final response carrying complete data and metrics.
+// retry and caching policies apply as in Invoke, a cached response is sent as the final response
+func (invokeApi *invokerGRPCApi) InvokeStream(iRequest *invoker_api.InvokeRequest, stream invoker_api.Deployment_InvokeStreamServer) error {
+	start := time.Now()
+	ctx := stream.Context()
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		return invokeApi.streamError(stream, 0, 401, errors.New("unauthenticated request"), "Unauthenticated requet, please try again with valid authentication.")
+	}
+
+	requestID := gorm_generator.ID()
+	clientSource, ok := utils.GetClientSource(ctx)
+	if !ok {
+		clientSource = utils.SDK
+	}
+
+	arguments, err := utils.AnyMapToInterfaceMap(iRequest.GetArgs())
+	if err != nil {
+		return invokeApi.streamError(stream, 0, 400, err, "Please check and provide a valid arguments.")
+	}
+	mtds, err := utils.AnyMapToInterfaceMap(iRequest.GetMetadata())
+	if err != nil {
+		return invokeApi.streamError(stream, 0, 400, err, "Please check and provide a valid metadata.")
+	}
+	opts, err := utils.AnyMapToInterfaceMap(iRequest.GetOptions())
+	if err != nil {
+		return invokeApi.streamError(stream, 0, 400, err, "Please check and provide a valid options.")
+	}
+
+	endpoint, err := invokeApi.endpointService.Get(ctx,
+		iAuth,
+		iRequest.GetEndpoint().GetEndpointId(),
+		utils.GetVersionDefinition(iRequest.GetEndpoint().GetVersion()),
+		&internal_services.GetEndpointOption{
+			InjectRetry:   true,
+			InjectCaching: true,
+		})
+	if err != nil {
+		return invokeApi.streamError(stream, 0, 400, err, "Please check endpoint configuration and try again.")
+	}
+
+	utils.Go(ctx, func() {
+		invokeApi.endpointLogService.CreateEndpointLog(
+			ctx,
+			iAuth,
+			clientSource,
+			endpoint.Id,
+			endpoint.EndpointProviderModelId,
+			requestID,
+			arguments, mtds, opts,
+		)
+	})
+
+	var metrics []*invoker_api.Metric
+	defer func() {
+		utils.Go(context.Background(), func() {
+			invokeApi.endpointLogService.UpdateEndpointLog(
+				context.Background(),
+				iAuth,
+				requestID,
+				metrics,
+				uint64(time.Since(start)),
+			)
+		})
+	}()
+
+	credentialID, err := endpoint.
+		EndpointProviderModel.
+		GetOptions().GetUint64("rapida.credential_id")
+	if err != nil {
+		return invokeApi.streamError(stream, requestID, 400, errors.New("rapida.credential_id not found in model options"), "Please check endpoint configuration and try again.")
+	}
+	vlt, err := invokeApi.vaultClient.GetCredential(ctx, iAuth, credentialID)
+	if err != nil {
+		return invokeApi.streamError(stream, requestID, 400, err, "Please check credential for provider and update it.")
+	}
+
+	template := endpoint.EndpointProviderModel.Request.GetTextChatCompleteTemplate()
+	messages := invokeApi.inputBuilder.Message(
+		template.Prompt,
+		invokeApi.inputBuilder.Arguments(template.Variables, iRequest.GetArgs()),
+	)
+
+	request := invokeApi.chatRequest(requestID, endpoint, vlt, iRequest, messages)
+	caching, prompt, embedding, cached := invokeApi.cacheLookup(ctx, iAuth, endpoint, vlt, messages, request)
+	if cached != nil {
+		metrics = invokeApi.cacheHitMetrics(cached, start)
+		return stream.Send(&invoker_api.InvokeResponse{
+			RequestId: requestID,
+			Code:      200,
+			Success:   true,
+			TimeTaken: uint64(time.Since(start).Microseconds()),
+			Data:      cached.Data,
+			Metrics:   metrics,
+		})
+	}
+
+	// a failed attempt is only retried while nothing has been sent to the caller
+	maxAttempts := 1
+	if endpoint.RetryEnable {
+		maxAttempts = endpoint.EndpointRetry.Attempts()
+	}
+	attempt := 1
+	for {
+		output, delivered, err := invokeApi.streamChat(ctx, iAuth, endpoint, request, stream, requestID)
+		metrics = append([]*invoker_api.Metric{}, output.GetMetrics()...)
+		if endpoint.RetryEnable {
+			metrics = append(metrics, types.NewMetric(type_enums.RETRY_ATTEMPTS.String(), fmt.Sprintf("%d", attempt-1), utils.Ptr("Number of retries made for the request")).ToProto())
+		}
+		if caching != nil {
+			metrics = append(metrics, types.NewMetric(type_enums.CACHE_HIT.String(), "false", utils.Ptr("Response served from endpoint cache")).ToProto())
+		}
+		if err == nil {
+			var data []string
+			if output.GetData() != nil && output.GetData().GetAssistant() != nil {
+				data = output.GetData().GetAssistant().GetContents()
+			}
+			invokeApi.cacheStore(caching, endpoint, request, prompt, embedding, requestID, data)
+			return stream.Send(&invoker_api.InvokeResponse{
+				RequestId: requestID,
+				Code:      200,
+				Success:   true,
+				TimeTaken: uint64(time.Since(start).Microseconds()),
+				Data:      data,
+				Metrics:   metrics,
+			})
+		}
+		if errors.Is(err, errStreamSend) {
+			return err
+		}
+		code := invokeStatusCode(output, err)
+		if delivered || attempt >= maxAttempts || !endpoint.EndpointRetry.IsRetryable(code) {
+			return invokeApi.streamError(stream, requestID, code, err, "Unable to execute the endpoint, please check and try again.")
+		}
+		delay := endpoint.EndpointRetry.Backoff(attempt)
+		invokeApi.logger.Warnf("endpoint %d stream attempt %d failed with status %d, retrying in %v: %v", endpoint.Id, attempt, code, delay, err)
+		select {
+		case <-ctx.Done():
+			return invokeApi.streamError(stream, requestID, invokeStatusCode(nil, ctx.Err()), ctx.Err(), "Unable to execute the endpoint, please check and try again.")
+		case <-time.After(delay):
+		}
+		attempt++
+	}
+}
+
+// errStreamSend marks failure to send a delta to the caller, the invoke stream is gone and nothing is retried
+var errStreamSend = errors.New("unable to send to invoke stream")
+
+// streamChat runs one attempt over provider stream and forwards every delta to the caller,
+// returns the final output carrying complete data and metrics and whether any delta reached the caller
+func (invokeApi *invokerGRPCApi) streamChat(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_entity.Endpoint,
+	request *invoker_api.ChatRequest,
+	stream invoker_api.Deployment_InvokeStreamServer,
+	requestID uint64,
+) (*invoker_api.ChatResponse, bool, error) {
+	providerStream, err := invokeApi.integrationClient.StreamChat(ctx, auth, endpoint.EndpointProviderModel.ModelProviderName)
+	if err != nil {
+		return nil, false, err
+	}
+	defer providerStream.CloseSend()
+
+	if err := providerStream.Send(request); err != nil {
+		return nil, false, err
+	}
+
+	delivered := false
+	for {
+		output, err := providerStream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = errors.New("provider stream closed before completion")
+			}
+			return nil, delivered, err
+		}
+		if !output.GetSuccess() && output.GetError() != nil {
+			return output, delivered, fmt.Errorf("provider request failed: %s", output.GetError().GetErrorMessage())
+		}
+
+		// provider sends metrics only with the final message which carries complete response
+		if len(output.GetMetrics()) > 0 {
+			return output, delivered, nil
+		}
+		if output.GetData() == nil || output.GetData().GetAssistant() == nil || len(output.GetData().GetAssistant().GetContents()) == 0 {
+			continue
+		}
+		if err := stream.Send(&invoker_api.InvokeResponse{
+			RequestId: requestID,
+			Code:      200,
+			Success:   true,
+			Data:      output.GetData().GetAssistant().GetContents(),
+		}); err != nil {
+			invokeApi.logger.Errorf("unable to send delta for request %d %v", requestID, err)
+			return nil, delivered, fmt.Errorf("%w: %v", errStreamSend, err)
+		}
+		delivered = true
+	}
+}
+
+// streamError sends a failed response as last message of invoke stream
+func (invokeApi *invokerGRPCApi) streamError(stream invoker_api.Deployment_InvokeStreamServer, requestID uint64, code int32, err error, humanMessage string) error {
+	invokeApi.logger.Errorf("invoke stream failed for request %d with %v", requestID, err)
+	return stream.Send(&invoker_api.InvokeResponse{
+		RequestId: requestID,
+		Code:      code,
+		Success:   false,
+		Error:     utils.JustError(code, err, humanMessage),
+	})
+}
+
+// chatRequest builds provider chat request for the endpoint version
+func (invokeApi *invokerGRPCApi) chatRequest(requestID uint64,
+	endpoint *internal_entity.Endpoint,
+	vlt *invoker_api.VaultCredential,
+	iRequest *invoker_api.InvokeRequest,
+	messages []*invoker_api.Message,
+) *invoker_api.ChatRequest {
+	return invokeApi.
+		inputBuilder.
+		Chat(
+			fmt.Sprintf("%d", requestID),
+			&invoker_api.Credential{
+				Id:    vlt.GetId(),
+				Value: vlt.GetValue(),
+			},
+			invokeApi.
+				inputBuilder.
+				Options(
+					endpoint.
+						EndpointProviderModel.
+						GetOptions(),
+					iRequest.
+						GetOptions(),
+				),
+			nil,
+			map[string]string{
+				"endpoint_id":                fmt.Sprintf("%d", endpoint.Id),
+				"vault_id":                   fmt.Sprintf("%d", vlt.Id),
+				"endpoint_provider_model_id": fmt.Sprintf("%d", endpoint.EndpointProviderModel.Id),
+			},
+			messages...,
+		)
+}
+
+// chat calls the provider and retries failed attempts as per endpoint retry policy
+// returns number of attempts made along with the last output
+func (invokeApi *invokerGRPCApi) chat(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_entity.Endpoint,
+	request *invoker_api.ChatRequest,
+) (*invoker_api.ChatResponse, int, error) {
+	maxAttempts := 1
+	if endpoint.RetryEnable {
+		maxAttempts = endpoint.EndpointRetry.Attempts()
+	}
+	attempt := 1
+	for {
+		output, err := invokeApi.
+			integrationClient.
+			Chat(ctx, auth, endpoint.EndpointProviderModel.ModelProviderName, request)
+		if err == nil && output.GetSuccess() {
+			return output, attempt, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("provider request failed: %s", output.GetError().GetErrorMessage())
+		}
+		code := invokeStatusCode(output, err)
+		if attempt >= maxAttempts || !endpoint.EndpointRetry.IsRetryable(code) {
+			return output, attempt, err
+		}
+		delay := endpoint.EndpointRetry.Backoff(attempt)
+		invokeApi.logger.Warnf("endpoint %d attempt %d failed with status %d, retrying in %v: %v", endpoint.Id, attempt, code, delay, err)
+		select {
+		case <-ctx.Done():
+			return output, attempt, ctx.Err()
+		case <-time.After(delay):
+		}
+		attempt++
+	}
+}
+
+// cacheLookup resolves the cache identity of the request and returns the cached response when one matches,
+// caching is nil when the endpoint does not cache responses
+func (invokeApi *invokerGRPCApi) cacheLookup(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_entity.Endpoint,
+	vlt *invoker_api.VaultCredential,
+	messages []*invoker_api.Message,
+	request *invoker_api.ChatRequest,
+) (caching *internal_entity.EndpointCaching, prompt string, embedding []float64, cached *internal_services.CachedResponse) {
+	if !endpoint.CacheEnable || !endpoint.EndpointCaching.IsEnabled() {
+		return nil, "", nil, nil
+	}
+	caching = endpoint.EndpointCaching
+	prompt = invokeApi.renderPrompt(messages)
+	if caching.CacheType == internal_entity.SEMENTIC_CACHE {
+		embedding = invokeApi.promptEmbedding(ctx, auth, endpoint, vlt, prompt)
+	}
+	cached, err := invokeApi.endpointCacheService.Lookup(ctx, caching, endpoint.EndpointProviderModelId, request.GetModelParameters(), prompt, embedding)
+	if err != nil {
+		invokeApi.logger.Warnf("unable to lookup endpoint cache, continue without cache %v", err)
+	}
+	return caching, prompt, embedding, cached
+}
+
+// cacheHitMetrics are the metrics of a response served from endpoint cache
+func (invokeApi *invokerGRPCApi) cacheHitMetrics(cached *internal_services.CachedResponse, start time.Time) []*invoker_api.Metric {
+	return []*invoker_api.Metric{
+		types.NewMetric(type_enums.CACHE_HIT.String(), "true", utils.Ptr("Response served from endpoint cache")).ToProto(),
+		types.NewMetric(type_enums.CACHE_SCORE.String(), fmt.Sprintf("%.4f", cached.Score), utils.Ptr("Similarity of the cached prompt")).ToProto(),
+		types.NewTimeTakenMetric(time.Since(start)).ToProto(),
+	}
+}
+
+// cacheStore keeps the response of the request in background when the endpoint caches responses
+func (invokeApi *invokerGRPCApi) cacheStore(caching *internal_entity.EndpointCaching,
+	endpoint *internal_entity.Endpoint,
+	request *invoker_api.ChatRequest,
+	prompt string,
+	embedding []float64,
+	requestID uint64,
+	data []string,
+) {
+	if caching == nil || len(data) == 0 {
+		return
+	}
+	utils.Go(context.Background(), func() {
+		if err := invokeApi.endpointCacheService.Store(context.Background(), caching, endpoint.EndpointProviderModelId, request.GetModelParameters(), prompt, embedding, &internal_services.CachedResponse{
+			RequestId: requestID,
+			Data:      data,
+		}); err != nil {
+			invokeApi.logger.Errorf("unable to store response in endpoint cache %v", err)
+		}
+	})
+}
+
+// renderPrompt flattens rendered messages into the text used as cache identity
+func (invokeApi *invokerGRPCApi) renderPrompt(messages []*invoker_api.Message) string {
+	var builder strings.Builder
+	for _, msg := range messages {
+		builder.WriteString(msg.GetRole())
+		builder.WriteString(": ")
+		switch {
+		case msg.GetUser() != nil:
+			builder.WriteString(msg.GetUser().GetContent())
+		case msg.GetSystem() != nil:
+			builder.WriteString(msg.GetSystem().GetContent())
+		case msg.GetAssistant() != nil:
+			builder.WriteString(strings.Join(msg.GetAssistant().GetContents(), " "))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// promptEmbedding returns embedding of the rendered prompt for sementic caching,
+// embedding model is configured with rapida.cache_embedding_* options and fallback to endpoint provider and credential
+func (invokeApi *invokerGRPCApi) promptEmbedding(ctx context.Context,
+	auth types.SimplePrinciple,
+	endpoint *internal_entity.Endpoint,
+	vlt *invoker_api.VaultCredential,
+	prompt string,
+) []float64 {
+	options := endpoint.EndpointProviderModel.GetOptions()
+	providerName, err := options.GetString("rapida.cache_embedding_provider")
+	if err != nil {
+		providerName = endpoint.EndpointProviderModel.ModelProviderName
+	}
+	embeddingOpts := map[string]interface{}{}
+	if model, err := options.GetString("rapida.cache_embedding_model"); err == nil {
+		embeddingOpts["model.name"] = model
+	}
+	if credentialID, err := options.GetUint64("rapida.cache_embedding_credential_id"); err == nil && credentialID != vlt.GetId() {
+		vlt, err = invokeApi.vaultClient.GetCredential(ctx, auth, credentialID)
+		if err != nil {
+			invokeApi.logger.Warnf("unable to get credential for cache embedding %v", err)
+			return nil
+		}
+	}
+	res, err := invokeApi.integrationClient.Embedding(ctx, auth, providerName,
+		invokeApi.embeddingBuilder.Embedding(
+			invokeApi.embeddingBuilder.Credential(vlt.GetId(), vlt.GetValue()),
+			invokeApi.embeddingBuilder.Options(embeddingOpts, nil),
+			map[string]string{
+				"endpoint_id": fmt.Sprintf("%d", endpoint.Id),
+			},
+			map[int32]string{0: prompt},
+		))
+	if err != nil || len(res.GetData()) == 0 {
+		invokeApi.logger.Warnf("unable to get prompt embedding for sementic cache %v", err)
+		return nil
+	}
+	return res.GetData()[len(res.GetData())-1].GetEmbedding()
+}
+
+// invokeStatusCode maps provider failure to http status used to match retryables
+func invokeStatusCode(output *invoker_api.ChatResponse, err error) int32 {
+	if output != nil && output.GetCode() != 0 && output.GetCode() != 200 {
+		return output.GetCode()
+	}
+	if err == nil {
+		return 200
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return 500
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return 400
+	case codes.Unauthenticated:
+		return 401
+	case codes.PermissionDenied:
+		return 403
+	case codes.NotFound:
+		return 404
+	case codes.ResourceExhausted:
+		return 429
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return 501
+	case codes.Unavailable:
+		return 503
+	case codes.DeadlineExceeded:
+		return 504
+	}
+	// provider errors are forwarded as message, look for http status inside it
+	if match := httpStatusPattern.FindString(st.Message()); match != "" {
+		if code, err := strconv.Atoi(match); err == nil {
+			return int32(code)
+		}
+	}
+	return 500
+}
+
+// Probe resolves the endpoint version that served the given request and returns its configuration,
+// the vault credential of the endpoint is verified with provider to tell if endpoint is still invokable
+func (invokeApi *invokerGRPCApi) Probe(ctx context.Context, rpv *invoker_api.ProbeRequest) (*invoker_api.ProbeResponse, error) {
 	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
 	if !isAuthenticated || iAuth.GetCurrentProjectId() == nil {
 		return utils.AuthenticateError[invoker_api.ProbeResponse]()
 	}
-	return nil, nil
+	endpointLog, err := invokeApi.endpointLogService.GetEndpointLogById(ctx, iAuth, rpv.GetRequestId())
+	if err != nil {
+		return utils.ErrorWithCode[invoker_api.ProbeResponse](404, err, "Unable to find the request, please check the request id and try again.")
+	}
+	endpointProviderModelId := endpointLog.EndpointProviderModelId
+	endpoint, err := invokeApi.endpointService.Get(ctx,
+		iAuth,
+		endpointLog.EndpointId,
+		&endpointProviderModelId,
+		&internal_services.GetEndpointOption{
+			InjectRetry:   true,
+			InjectCaching: true,
+		})
+	if err != nil {
+		return utils.ErrorWithCode[invoker_api.ProbeResponse](400, err, "Please check endpoint configuration and try again.")
+	}
+
+	credential := map[string]interface{}{
+		"verified": false,
+	}
+	if credentialID, err := endpoint.EndpointProviderModel.GetOptions().GetUint64("rapida.credential_id"); err != nil {
+		credential["error"] = "rapida.credential_id not found in model options"
+	} else {
+		credential["id"] = credentialID
+		vlt, err := invokeApi.vaultClient.GetCredential(ctx, iAuth, credentialID)
+		if err != nil {
+			credential["error"] = err.Error()
+		} else {
+			verification, err := invokeApi.integrationClient.VerifyCredential(ctx, iAuth,
+				endpoint.EndpointProviderModel.ModelProviderName,
+				&invoker_api.Credential{
+					Id:    vlt.GetId(),
+					Value: vlt.GetValue(),
+				})
+			switch {
+			case err != nil:
+				credential["error"] = err.Error()
+			case !verification.GetSuccess():
+				credential["error"] = verification.GetErrorMessage()
+			default:
+				credential["verified"] = true
+			}
+		}
+	}
+
+	template := endpoint.EndpointProviderModel.Request.GetTextChatCompleteTemplate()
+	probe := map[string]interface{}{
+		"endpointId":              endpoint.Id,
+		"endpointProviderModelId": endpoint.EndpointProviderModel.Id,
+		"provider":                endpoint.EndpointProviderModel.ModelProviderName,
+		"model":                   endpoint.EndpointProviderModel.GetOptions()["model.name"],
+		"options":                 endpoint.EndpointProviderModel.GetOptions(),
+		"variables":               template.Variables,
+		"retryEnable":             endpoint.RetryEnable,
+		"retry":                   endpoint.EndpointRetry,
+		"cacheEnable":             endpoint.CacheEnable,
+		"caching":                 endpoint.EndpointCaching,
+		"credential":              credential,
+	}
+	// round trip through json so entity and slice values become struct compatible
+	raw, err := json.Marshal(probe)
+	if err == nil {
+		err = json.Unmarshal(raw, &probe)
+	}
+	if err != nil {
+		return utils.ErrorWithCode[invoker_api.ProbeResponse](500, err, "Unable to resolve endpoint configuration, please try again.")
+	}
+	data, err := structpb.NewStruct(probe)
+	if err != nil {
+		return utils.ErrorWithCode[invoker_api.ProbeResponse](500, err, "Unable to resolve endpoint configuration, please try again.")
+	}
+	return &invoker_api.ProbeResponse{
+		Code:    200,
+		Success: true,
+		Data:    data,
+	}, nil
 }
 
-func (endpoint *invokerGRPCApi) Update(ctx context.Context, ur *invoker_api.UpdateRequest) (*invoker_api.UpdateResponse, error) {
+// Update attaches caller feedback or corrected output to an existing request in endpoint log
+func (invokeApi *invokerGRPCApi) Update(ctx context.Context, ur *invoker_api.UpdateRequest) (*invoker_api.UpdateResponse, error) {
 	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
 	if !isAuthenticated || iAuth.GetCurrentProjectId() == nil {
 		return utils.AuthenticateError[invoker_api.UpdateResponse]()
 	}
-	return nil, nil
+	metadata := ur.GetMetadata().AsMap()
+	if len(metadata) == 0 {
+		return utils.ErrorWithCode[invoker_api.UpdateResponse](400, errors.New("empty metadata"), "Please provide feedback or output to update the request.")
+	}
+	endpointLog, err := invokeApi.endpointLogService.GetEndpointLogById(ctx, iAuth, ur.GetRequestId())
+	if err != nil {
+		return utils.ErrorWithCode[invoker_api.UpdateResponse](404, err, "Unable to find the request, please check the request id and try again.")
+	}
+	if _, err := invokeApi.endpointLogService.ApplyMetadata(ctx, iAuth, endpointLog.Id, metadata); err != nil {
+		return utils.ErrorWithCode[invoker_api.UpdateResponse](400, err, "Unable to update the request, please try again.")
+	}
+	return &invoker_api.UpdateResponse{
+		Code:    200,
+		Success: true,
+	}, nil
 }
@@ -0,0 +1,554 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package endpoint_api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	internal_entity "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_services "github.com/rapidaai/api/endpoint-api/internal/service"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
+	integration_client_builders "github.com/rapidaai/pkg/clients/integration/builders"
+	web_client "github.com/rapidaai/pkg/clients/web"
+	"github.com/rapidaai/pkg/commons"
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	"github.com/rapidaai/pkg/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// fakeIntegration answers chat calls from a script, one reply per attempt
+type fakeIntegration struct {
+	integration_client.IntegrationServiceClient
+	replies   []chatReply
+	calls     int
+	verify    *protos.VerifyCredentialResponse
+	verifyErr error
+	streams   []*fakeProviderStream
+}
+
+type chatReply struct {
+	output *protos.ChatResponse
+	err    error
+}
+
+func (f *fakeIntegration) Chat(ctx context.Context, auth types.SimplePrinciple, providerName string, request *protos.ChatRequest) (*protos.ChatResponse, error) {
+	reply := f.replies[f.calls]
+	f.calls++
+	return reply.output, reply.err
+}
+
+func newTestInvoker(t *testing.T) *invokerGRPCApi {
+	logger, err := commons.NewApplicationLogger()
+	require.NoError(t, err)
+	return &invokerGRPCApi{invokerApi{
+		logger:       logger,
+		inputBuilder: integration_client_builders.NewChatInputBuilder(logger),
+	}}
+}
+
+func failed(code int32) chatReply {
+	return chatReply{output: &protos.ChatResponse{Code: code, Success: false, Error: &protos.Error{ErrorMessage: "failed"}}}
+}
+
+func succeeded() chatReply {
+	return chatReply{output: &protos.ChatResponse{Code: 200, Success: true}}
+}
+
+func TestInvoker_ChatRetry(t *testing.T) {
+	retry := &internal_entity.EndpointRetry{
+		RetryType:   internal_entity.STATUS_RETRY,
+		MaxAttempts: 3,
+		Retryables:  gorm_types.StringArray{"5XX", "429"},
+	}
+	tests := []struct {
+		name        string
+		retryEnable bool
+		replies     []chatReply
+		attempts    int
+		success     bool
+	}{
+		{"success on first attempt", true, []chatReply{succeeded()}, 1, true},
+		{"retry until success", true, []chatReply{failed(503), failed(429), succeeded()}, 3, true},
+		{"give up after max attempts", true, []chatReply{failed(500), failed(502), failed(503)}, 3, false},
+		{"no retry of other status", true, []chatReply{failed(400)}, 1, false},
+		{"retry on grpc status", true, []chatReply{{err: status.Error(codes.Unavailable, "down")}, succeeded()}, 2, true},
+		{"retry disabled", false, []chatReply{failed(503)}, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoker := newTestInvoker(t)
+			integration := &fakeIntegration{replies: tt.replies}
+			invoker.integrationClient = integration
+			endpoint := &internal_entity.Endpoint{
+				RetryEnable:           tt.retryEnable,
+				EndpointRetry:         retry,
+				EndpointProviderModel: &internal_entity.EndpointProviderModel{},
+			}
+
+			output, attempts, err := invoker.chat(context.Background(), nil, endpoint, &protos.ChatRequest{})
+			assert.Equal(t, tt.attempts, attempts)
+			assert.Equal(t, tt.attempts, integration.calls)
+			if tt.success {
+				assert.NoError(t, err)
+				assert.True(t, output.GetSuccess())
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestInvoker_ChatStopsRetryWhenCancelled(t *testing.T) {
+	invoker := newTestInvoker(t)
+	invoker.integrationClient = &fakeIntegration{replies: []chatReply{failed(503), succeeded()}}
+	endpoint := &internal_entity.Endpoint{
+		RetryEnable: true,
+		EndpointRetry: &internal_entity.EndpointRetry{
+			RetryType:    internal_entity.STATUS_RETRY,
+			MaxAttempts:  2,
+			DelaySeconds: 60,
+			Retryables:   gorm_types.StringArray{"5XX"},
+		},
+		EndpointProviderModel: &internal_entity.EndpointProviderModel{},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, attempts, err := invoker.chat(ctx, nil, endpoint, &protos.ChatRequest{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
+
+func (f *fakeIntegration) VerifyCredential(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.Credential) (*protos.VerifyCredentialResponse, error) {
+	return f.verify, f.verifyErr
+}
+
+type fakeEndpointService struct {
+	internal_services.EndpointService
+	endpoint *internal_entity.Endpoint
+	err      error
+	opts     *internal_services.GetEndpointOption
+}
+
+func (f *fakeEndpointService) Get(ctx context.Context, auth types.SimplePrinciple, endpointId uint64, endpointProviderModelId *uint64, opts *internal_services.GetEndpointOption) (*internal_entity.Endpoint, error) {
+	f.opts = opts
+	return f.endpoint, f.err
+}
+
+type fakeEndpointLogService struct {
+	internal_services.EndpointLogService
+	log      *internal_entity.EndpointLog
+	applied  map[string]interface{}
+	applyErr error
+}
+
+func (f *fakeEndpointLogService) GetEndpointLogById(ctx context.Context, auth types.SimplePrinciple, logId uint64) (*internal_entity.EndpointLog, error) {
+	if f.log == nil || f.log.Id != logId {
+		return nil, errors.New("record not found")
+	}
+	return f.log, nil
+}
+
+func (f *fakeEndpointLogService) ApplyMetadata(ctx context.Context, auth types.SimplePrinciple, logId uint64, metadata map[string]interface{}) ([]*internal_entity.EndpointLogMetadata, error) {
+	if f.applyErr != nil {
+		return nil, f.applyErr
+	}
+	f.applied = metadata
+	return nil, nil
+}
+
+type fakeVault struct {
+	web_client.VaultClient
+	credential *protos.VaultCredential
+	err        error
+}
+
+func (f *fakeVault) GetCredential(ctx context.Context, auth types.SimplePrinciple, vaultId uint64) (*protos.VaultCredential, error) {
+	return f.credential, f.err
+}
+
+func authenticated() context.Context {
+	projectId, organizationId := uint64(1), uint64(2)
+	return context.WithValue(context.Background(), types.CTX_, &types.PlainClaimPrinciple[*types.ProjectScope]{
+		Info: &types.ProjectScope{
+			ProjectId:      &projectId,
+			OrganizationId: &organizationId,
+			Status:         type_enums.RECORD_ACTIVE.String(),
+			CurrentToken:   "api-key",
+		},
+	})
+}
+
+func testEndpoint(options ...*internal_entity.EndpointProviderModelOption) *internal_entity.Endpoint {
+	model := &internal_entity.EndpointProviderModel{
+		ModelProviderName:            "openai",
+		EndpointProviderModelOptions: options,
+	}
+	model.Id = 11
+	model.SetPrompt(`{"prompt":[{"role":"user","content":"{{question}}"}],"promptVariables":[{"name":"question","type":"string"}]}`)
+	endpoint := &internal_entity.Endpoint{
+		EndpointProviderModelId: model.Id,
+		EndpointProviderModel:   model,
+	}
+	endpoint.Id = 10
+	return endpoint
+}
+
+func modelOption(key, value string) *internal_entity.EndpointProviderModelOption {
+	return &internal_entity.EndpointProviderModelOption{Metadata: gorm_model.Metadata{Key: key, Value: value}}
+}
+
+func TestInvoker_Probe(t *testing.T) {
+	requestLog := &internal_entity.EndpointLog{EndpointId: 10, EndpointProviderModelId: 11}
+	requestLog.Id = 99
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		requestId  uint64
+		endpoint   *internal_entity.Endpoint
+		vault      *fakeVault
+		verify     *protos.VerifyCredentialResponse
+		verifyErr  error
+		code       int32
+		verified   bool
+		credential string
+	}{
+		{
+			name:      "unauthenticated",
+			ctx:       context.Background(),
+			requestId: 99,
+			code:      401,
+		},
+		{
+			name:      "unknown request",
+			ctx:       authenticated(),
+			requestId: 100,
+			code:      404,
+		},
+		{
+			name:      "verified credential",
+			ctx:       authenticated(),
+			requestId: 99,
+			endpoint:  testEndpoint(modelOption("rapida.credential_id", "5"), modelOption("model.name", "gpt-4o")),
+			vault:     &fakeVault{credential: &protos.VaultCredential{Id: 5}},
+			verify:    &protos.VerifyCredentialResponse{Success: true},
+			code:      200,
+			verified:  true,
+		},
+		{
+			name:       "credential refused by provider",
+			ctx:        authenticated(),
+			requestId:  99,
+			endpoint:   testEndpoint(modelOption("rapida.credential_id", "5")),
+			vault:      &fakeVault{credential: &protos.VaultCredential{Id: 5}},
+			verify:     &protos.VerifyCredentialResponse{Success: false, ErrorMessage: "invalid api key"},
+			code:       200,
+			credential: "invalid api key",
+		},
+		{
+			name:       "provider unreachable",
+			ctx:        authenticated(),
+			requestId:  99,
+			endpoint:   testEndpoint(modelOption("rapida.credential_id", "5")),
+			vault:      &fakeVault{credential: &protos.VaultCredential{Id: 5}},
+			verifyErr:  errors.New("connection refused"),
+			code:       200,
+			credential: "connection refused",
+		},
+		{
+			name:       "credential missing in vault",
+			ctx:        authenticated(),
+			requestId:  99,
+			endpoint:   testEndpoint(modelOption("rapida.credential_id", "5")),
+			vault:      &fakeVault{err: errors.New("credential not found")},
+			code:       200,
+			credential: "credential not found",
+		},
+		{
+			name:       "endpoint without credential",
+			ctx:        authenticated(),
+			requestId:  99,
+			endpoint:   testEndpoint(),
+			code:       200,
+			credential: "rapida.credential_id not found in model options",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoker := newTestInvoker(t)
+			endpoints := &fakeEndpointService{endpoint: tt.endpoint}
+			invoker.endpointService = endpoints
+			invoker.endpointLogService = &fakeEndpointLogService{log: requestLog}
+			invoker.vaultClient = tt.vault
+			invoker.integrationClient = &fakeIntegration{verify: tt.verify, verifyErr: tt.verifyErr}
+
+			res, err := invoker.Probe(tt.ctx, &protos.ProbeRequest{RequestId: tt.requestId})
+			assert.Equal(t, tt.code, res.GetCode())
+			if tt.code != 200 {
+				assert.Error(t, err)
+				assert.False(t, res.GetSuccess())
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, endpoints.opts.InjectRetry && endpoints.opts.InjectCaching)
+			data := res.GetData().AsMap()
+			assert.Equal(t, float64(10), data["endpointId"])
+			assert.Equal(t, float64(11), data["endpointProviderModelId"])
+			assert.Equal(t, "openai", data["provider"])
+			credential := data["credential"].(map[string]interface{})
+			assert.Equal(t, tt.verified, credential["verified"])
+			if tt.credential != "" {
+				assert.Equal(t, tt.credential, credential["error"])
+			}
+		})
+	}
+}
+
+func TestInvoker_Update(t *testing.T) {
+	requestLog := &internal_entity.EndpointLog{EndpointId: 10, EndpointProviderModelId: 11}
+	requestLog.Id = 99
+	feedback, _ := structpb.NewStruct(map[string]interface{}{"feedback": "helpful"})
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		requestId uint64
+		metadata  *structpb.Struct
+		applyErr  error
+		code      int32
+	}{
+		{"unauthenticated", context.Background(), 99, feedback, nil, 401},
+		{"empty metadata", authenticated(), 99, &structpb.Struct{}, nil, 400},
+		{"unknown request", authenticated(), 100, feedback, nil, 404},
+		{"unable to apply", authenticated(), 99, feedback, errors.New("database unavailable"), 400},
+		{"applied", authenticated(), 99, feedback, nil, 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoker := newTestInvoker(t)
+			logs := &fakeEndpointLogService{log: requestLog, applyErr: tt.applyErr}
+			invoker.endpointLogService = logs
+
+			res, err := invoker.Update(tt.ctx, &protos.UpdateRequest{RequestId: tt.requestId, Metadata: tt.metadata})
+			assert.Equal(t, tt.code == 200, err == nil)
+			assert.Equal(t, tt.code, res.GetCode())
+			assert.Equal(t, tt.code == 200, res.GetSuccess())
+			if tt.code == 200 {
+				assert.Equal(t, map[string]interface{}{"feedback": "helpful"}, logs.applied)
+			} else {
+				assert.Nil(t, logs.applied)
+			}
+		})
+	}
+}
+
+func (f *fakeEndpointLogService) CreateEndpointLog(ctx context.Context, auth types.SimplePrinciple, source utils.RapidaSource, endpointId, endpointProviderModelId uint64, logId uint64, arguments, metadata, options map[string]interface{}) (*internal_entity.EndpointLog, error) {
+	return &internal_entity.EndpointLog{}, nil
+}
+
+func (f *fakeEndpointLogService) UpdateEndpointLog(ctx context.Context, auth types.SimplePrinciple, logId uint64, metrics []*protos.Metric, timeTaken uint64) (*internal_entity.EndpointLog, error) {
+	return &internal_entity.EndpointLog{}, nil
+}
+
+// fakeProviderStream replays one provider attempt, an attempt ends with err when set
+type fakeProviderStream struct {
+	grpc.ClientStream
+	outputs []*protos.ChatResponse
+	err     error
+}
+
+func (f *fakeProviderStream) Send(*protos.ChatRequest) error { return nil }
+
+func (f *fakeProviderStream) CloseSend() error { return nil }
+
+func (f *fakeProviderStream) Recv() (*protos.ChatResponse, error) {
+	if len(f.outputs) == 0 {
+		if f.err != nil {
+			return nil, f.err
+		}
+		return nil, io.EOF
+	}
+	output := f.outputs[0]
+	f.outputs = f.outputs[1:]
+	return output, nil
+}
+
+func (f *fakeIntegration) StreamChat(ctx context.Context, auth types.SimplePrinciple, providerName string) (grpc.BidiStreamingClient[protos.ChatRequest, protos.ChatResponse], error) {
+	attempt := f.streams[f.calls]
+	f.calls++
+	return attempt, nil
+}
+
+type fakeInvokeStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*protos.InvokeResponse
+}
+
+func (f *fakeInvokeStream) Context() context.Context { return f.ctx }
+
+func (f *fakeInvokeStream) Send(res *protos.InvokeResponse) error {
+	f.responses = append(f.responses, res)
+	return nil
+}
+
+type fakeCache struct {
+	internal_services.EndpointCacheService
+	cached *internal_services.CachedResponse
+}
+
+func (f *fakeCache) Lookup(ctx context.Context, caching *internal_entity.EndpointCaching, endpointProviderModelId uint64, options map[string]*anypb.Any, prompt string, embedding []float64) (*internal_services.CachedResponse, error) {
+	return f.cached, nil
+}
+
+func (f *fakeCache) Store(ctx context.Context, caching *internal_entity.EndpointCaching, endpointProviderModelId uint64, options map[string]*anypb.Any, prompt string, embedding []float64, response *internal_services.CachedResponse) error {
+	return nil
+}
+
+func delta(content string) *protos.ChatResponse {
+	return &protos.ChatResponse{Success: true, Data: &protos.Message{Role: "assistant", Message: &protos.Message_Assistant{Assistant: &protos.AssistantMessage{Contents: []string{content}}}}}
+}
+
+func final(content string) *protos.ChatResponse {
+	output := delta(content)
+	output.Metrics = []*protos.Metric{{Name: "TOTAL_TOKEN", Value: "12"}}
+	return output
+}
+
+func unavailable() *fakeProviderStream {
+	return &fakeProviderStream{outputs: []*protos.ChatResponse{{Code: 503, Success: false, Error: &protos.Error{ErrorMessage: "overloaded"}}}}
+}
+
+func metricValue(metrics []*protos.Metric, name string) string {
+	for _, metric := range metrics {
+		if metric.GetName() == name {
+			return metric.GetValue()
+		}
+	}
+	return ""
+}
+
+func TestInvoker_InvokeStream(t *testing.T) {
+	retry := &internal_entity.EndpointRetry{
+		RetryType:   internal_entity.STATUS_RETRY,
+		MaxAttempts: 2,
+		Retryables:  gorm_types.StringArray{"5XX"},
+	}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		retry    bool
+		streams  []*fakeProviderStream
+		cached   *internal_services.CachedResponse
+		attempts int
+		data     [][]string
+		code     int32
+		retries  string
+	}{
+		{
+			name: "unauthenticated",
+			ctx:  context.Background(),
+			data: [][]string{nil},
+			code: 401,
+		},
+		{
+			name:     "deltas then final response",
+			ctx:      authenticated(),
+			streams:  []*fakeProviderStream{{outputs: []*protos.ChatResponse{delta("Hel"), delta("lo"), final("Hello")}}},
+			attempts: 1,
+			data:     [][]string{{"Hel"}, {"lo"}, {"Hello"}},
+			code:     200,
+		},
+		{
+			name:     "retry failed attempt before any delta",
+			ctx:      authenticated(),
+			retry:    true,
+			streams:  []*fakeProviderStream{unavailable(), {outputs: []*protos.ChatResponse{final("Hello")}}},
+			attempts: 2,
+			data:     [][]string{{"Hello"}},
+			code:     200,
+			retries:  "1",
+		},
+		{
+			name:     "no retry once a delta was sent",
+			ctx:      authenticated(),
+			retry:    true,
+			streams:  []*fakeProviderStream{{outputs: []*protos.ChatResponse{delta("Hel")}, err: status.Error(codes.Unavailable, "down")}},
+			attempts: 1,
+			data:     [][]string{{"Hel"}, nil},
+			code:     503,
+		},
+		{
+			name:     "give up after max attempts",
+			ctx:      authenticated(),
+			retry:    true,
+			streams:  []*fakeProviderStream{unavailable(), unavailable()},
+			attempts: 2,
+			data:     [][]string{nil},
+			code:     503,
+		},
+		{
+			name:     "served from cache",
+			ctx:      authenticated(),
+			cached:   &internal_services.CachedResponse{Data: []string{"cached"}, Score: 1},
+			attempts: 0,
+			data:     [][]string{{"cached"}},
+			code:     200,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoker := newTestInvoker(t)
+			endpoint := testEndpoint(modelOption("rapida.credential_id", "5"))
+			endpoint.RetryEnable = tt.retry
+			endpoint.EndpointRetry = retry
+			if tt.cached != nil {
+				endpoint.CacheEnable = true
+				endpoint.EndpointCaching = &internal_entity.EndpointCaching{CacheType: internal_entity.STANDARD_CACHE, ExpiryInterval: 60}
+			}
+			endpoints := &fakeEndpointService{endpoint: endpoint}
+			integration := &fakeIntegration{streams: tt.streams}
+			invoker.endpointService = endpoints
+			invoker.endpointLogService = &fakeEndpointLogService{}
+			invoker.endpointCacheService = &fakeCache{cached: tt.cached}
+			invoker.vaultClient = &fakeVault{credential: &protos.VaultCredential{Id: 5}}
+			invoker.integrationClient = integration
+			stream := &fakeInvokeStream{ctx: tt.ctx}
+
+			require.NoError(t, invoker.InvokeStream(&protos.InvokeRequest{Endpoint: &protos.EndpointDefinition{EndpointId: 10}}, stream))
+			assert.Equal(t, tt.attempts, integration.calls)
+			require.Len(t, stream.responses, len(tt.data))
+			for i, res := range stream.responses {
+				assert.Equal(t, tt.data[i], res.GetData())
+			}
+			last := stream.responses[len(stream.responses)-1]
+			assert.Equal(t, tt.code, last.GetCode())
+			assert.Equal(t, tt.code == 200, last.GetSuccess())
+			if tt.code != 401 {
+				assert.True(t, endpoints.opts.InjectRetry && endpoints.opts.InjectCaching, "stream must load retry and caching policies")
+			}
+			if tt.code == 200 {
+				assert.NotEmpty(t, last.GetMetrics())
+			}
+			if tt.retries != "" {
+				assert.Equal(t, tt.retries, metricValue(last.GetMetrics(), type_enums.RETRY_ATTEMPTS.String()))
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ type EndpointConfig struct {
 	PostgresConfig   configs.PostgresConfig   `mapstructure:"postgres" validate:"required"`
 	RedisConfig      configs.RedisConfig      `mapstructure:"redis" validate:"required"`
 	AssetStoreConfig configs.AssetStoreConfig `mapstructure:"asset_store" validate:"required"`
+	RateLimitConfig  *configs.RateLimitConfig `mapstructure:"rate_limit"`
 }
 
 // reading config and intializing configs for application
@@ -3,6 +3,7 @@ package internal_entity
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"time"
 
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
 )
@@ -33,3 +34,16 @@ type EndpointCaching struct {
 	CreatedBy      uint64  `json:"createdBy" gorm:"type:bigint;size:20;not null"`
 	UpdatedBy      uint64  `json:"updatedBy" gorm:"type:bigint;size:20;"`
 }
+
+// Expiry returns how long a cached response stays valid, expiry interval is configured in seconds.
+func (c *EndpointCaching) Expiry() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return time.Duration(c.ExpiryInterval) * time.Second
+}
+
+// IsEnabled reports whether responses should be served from cache.
+func (c *EndpointCaching) IsEnabled() bool {
+	return c != nil && (c.CacheType == STANDARD_CACHE || c.CacheType == SEMENTIC_CACHE) && c.ExpiryInterval > 0
+}
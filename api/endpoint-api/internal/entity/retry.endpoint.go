@@ -3,6 +3,9 @@ package internal_entity
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
 
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
 	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
@@ -35,3 +38,42 @@ type EndpointRetry struct {
 	// in case retry is status then it will be [4XX, 5XX]
 	Retryables gorm_types.StringArray `json:"retryables" gorm:"type:text;size:1000;null"`
 }
+
+// Attempts returns the total number of calls allowed by the policy including the first one.
+func (r *EndpointRetry) Attempts() int {
+	if r == nil || r.RetryType != STATUS_RETRY || r.MaxAttempts == 0 {
+		return 1
+	}
+	return int(r.MaxAttempts)
+}
+
+// IsRetryable reports whether a failed call with given status code should be attempted again.
+// retryables can hold exact codes (429, 503) or classes (4XX, 5XX)
+func (r *EndpointRetry) IsRetryable(code int32) bool {
+	if r == nil || r.RetryType != STATUS_RETRY {
+		return false
+	}
+	status := strconv.Itoa(int(code))
+	for _, rt := range r.Retryables {
+		rt = strings.ToUpper(strings.TrimSpace(rt))
+		if rt == status {
+			return true
+		}
+		if len(rt) == 3 && strings.HasSuffix(rt, "XX") && len(status) == 3 && rt[0] == status[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the wait before the given retry, attempt starts from 1 for the first retry.
+func (r *EndpointRetry) Backoff(attempt int) time.Duration {
+	if r == nil || attempt < 1 {
+		return 0
+	}
+	delay := time.Duration(r.DelaySeconds) * time.Second
+	if r.ExponentialBackoff {
+		delay = delay * time.Duration(1<<uint(attempt-1))
+	}
+	return delay
+}
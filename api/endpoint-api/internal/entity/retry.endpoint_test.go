@@ -0,0 +1,61 @@
+package internal_entity
+
+import (
+	"testing"
+	"time"
+
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+func TestEndpointRetry_IsRetryable(t *testing.T) {
+	retry := &EndpointRetry{
+		RetryType:  STATUS_RETRY,
+		Retryables: gorm_types.StringArray{"5XX", "429"},
+	}
+	tests := []struct {
+		code     int32
+		expected bool
+	}{
+		{500, true},
+		{503, true},
+		{429, true},
+		{400, false},
+		{404, false},
+	}
+	for _, tt := range tests {
+		if got := retry.IsRetryable(tt.code); got != tt.expected {
+			t.Errorf("IsRetryable(%d) = %v, want %v", tt.code, got, tt.expected)
+		}
+	}
+
+	var never *EndpointRetry
+	if never.IsRetryable(500) {
+		t.Errorf("nil retry policy should never retry")
+	}
+	if (&EndpointRetry{RetryType: NEVER_RETRY, Retryables: gorm_types.StringArray{"5XX"}}).IsRetryable(500) {
+		t.Errorf("no-retry policy should never retry")
+	}
+}
+
+func TestEndpointRetry_Attempts(t *testing.T) {
+	var never *EndpointRetry
+	if got := never.Attempts(); got != 1 {
+		t.Errorf("Attempts() = %d, want 1", got)
+	}
+	if got := (&EndpointRetry{RetryType: STATUS_RETRY, MaxAttempts: 3}).Attempts(); got != 3 {
+		t.Errorf("Attempts() = %d, want 3", got)
+	}
+}
+
+func TestEndpointRetry_Backoff(t *testing.T) {
+	fixed := &EndpointRetry{DelaySeconds: 2}
+	if got := fixed.Backoff(3); got != 2*time.Second {
+		t.Errorf("Backoff(3) = %v, want %v", got, 2*time.Second)
+	}
+	exponential := &EndpointRetry{DelaySeconds: 1, ExponentialBackoff: true}
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second} {
+		if got := exponential.Backoff(attempt); got != expected {
+			t.Errorf("Backoff(%d) = %v, want %v", attempt, got, expected)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package internal_service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+)
+
+// CachedResponse is the part of an invoke response that can be replayed for a matching prompt
+type CachedResponse struct {
+	RequestId uint64    `json:"requestId"`
+	Data      []string  `json:"data"`
+	Embedding []float64 `json:"embedding,omitempty"`
+	ExpiresAt int64     `json:"expiresAt"`
+	// similarity of the matched prompt, always 1 for standard cache
+	Score float64 `json:"-"`
+}
+
+type EndpointCacheService interface {
+	// Lookup returns cached response for the rendered prompt, nil when nothing matched.
+	// options are the effective model options of the request, responses are only shared
+	// between requests with the same options. embedding is only used by sementic caching
+	Lookup(ctx context.Context,
+		caching *internal_gorm.EndpointCaching,
+		endpointProviderModelId uint64,
+		options map[string]*anypb.Any,
+		prompt string,
+		embedding []float64,
+	) (*CachedResponse, error)
+
+	Store(ctx context.Context,
+		caching *internal_gorm.EndpointCaching,
+		endpointProviderModelId uint64,
+		options map[string]*anypb.Any,
+		prompt string,
+		embedding []float64,
+		response *CachedResponse,
+	) error
+}
@@ -0,0 +1,250 @@
+package internal_cache_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_service "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/ciphers"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+const (
+	standardCachePrefix = "ENDPOINT::CACHE::STANDARD"
+	sementicCachePrefix = "ENDPOINT::CACHE::SEMENTIC"
+	// upper bound of prompts kept per endpoint version for similarity scan,
+	// least recently used prompts are evicted beyond it
+	maxSementicEntries = 500
+)
+
+// sementicStoreLuaScript stores an entry under its own key with its own expiry and
+// indexes it in a sorted set scored by last use, entries beyond the bound are evicted
+// oldest first.
+var sementicStoreLuaScript = redis.NewScript(`
+	local now = tonumber(ARGV[1])
+	local ttl = tonumber(ARGV[4])
+	local max = tonumber(ARGV[5])
+	redis.call('SET', KEYS[2], ARGV[3], 'PX', ttl)
+	redis.call('ZADD', KEYS[1], now, ARGV[2])
+	redis.call('PEXPIRE', KEYS[1], ttl)
+	local over = redis.call('ZCARD', KEYS[1]) - max
+	if over > 0 then
+		local evicted = redis.call('ZRANGE', KEYS[1], 0, over - 1)
+		for _, field in ipairs(evicted) do
+			redis.call('DEL', KEYS[1] .. '::' .. field)
+		end
+		redis.call('ZREMRANGEBYRANK', KEYS[1], 0, over - 1)
+	end
+	return over
+`)
+
+type endpointCacheService struct {
+	logger commons.Logger
+	redis  connectors.RedisConnector
+	now    func() time.Time
+}
+
+func NewEndpointCacheService(logger commons.Logger, redis connectors.RedisConnector) internal_service.EndpointCacheService {
+	return &endpointCacheService{
+		logger: logger,
+		redis:  redis,
+		now:    time.Now,
+	}
+}
+
+func (ecs *endpointCacheService) standardKey(endpointProviderModelId uint64, options map[string]*anypb.Any, prompt string) string {
+	return fmt.Sprintf("%s::%d::%s::%s", standardCachePrefix, endpointProviderModelId, optionsHash(options), ciphers.Hash(prompt))
+}
+
+// sementicKey is the index of sementic entries, each entry is kept at index key suffixed with its prompt hash
+func (ecs *endpointCacheService) sementicKey(endpointProviderModelId uint64, options map[string]*anypb.Any) string {
+	return fmt.Sprintf("%s::%d::%s", sementicCachePrefix, endpointProviderModelId, optionsHash(options))
+}
+
+func (ecs *endpointCacheService) sementicEntryKey(index, field string) string {
+	return fmt.Sprintf("%s::%s", index, field)
+}
+
+func (ecs *endpointCacheService) Lookup(ctx context.Context,
+	caching *internal_gorm.EndpointCaching,
+	endpointProviderModelId uint64,
+	options map[string]*anypb.Any,
+	prompt string,
+	embedding []float64,
+) (*internal_service.CachedResponse, error) {
+	start := time.Now()
+	defer func() {
+		ecs.logger.Benchmark("endpointCacheService.Lookup", time.Since(start))
+	}()
+	if !caching.IsEnabled() {
+		return nil, nil
+	}
+	switch caching.CacheType {
+	case internal_gorm.STANDARD_CACHE:
+		raw, err := ecs.redis.GetConnection().Get(ctx, ecs.standardKey(endpointProviderModelId, options, prompt)).Result()
+		if err == redis.Nil {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var cached internal_service.CachedResponse
+		if err := json.Unmarshal([]byte(raw), &cached); err != nil {
+			return nil, err
+		}
+		cached.Score = 1
+		return &cached, nil
+	case internal_gorm.SEMENTIC_CACHE:
+		if len(embedding) == 0 {
+			return nil, nil
+		}
+		index := ecs.sementicKey(endpointProviderModelId, options)
+		conn := ecs.redis.GetConnection()
+		fields, err := conn.ZRange(ctx, index, 0, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			return nil, nil
+		}
+		keys := make([]string, len(fields))
+		for i, field := range fields {
+			keys[i] = ecs.sementicEntryKey(index, field)
+		}
+		entries, err := conn.MGet(ctx, keys...).Result()
+		if err != nil {
+			return nil, err
+		}
+		var (
+			best      *internal_service.CachedResponse
+			bestField string
+			expired   []interface{}
+		)
+		for i, entry := range entries {
+			raw, ok := entry.(string)
+			if !ok {
+				expired = append(expired, fields[i])
+				continue
+			}
+			var cached internal_service.CachedResponse
+			if err := json.Unmarshal([]byte(raw), &cached); err != nil {
+				expired = append(expired, fields[i])
+				continue
+			}
+			score := cosineSimilarity(embedding, cached.Embedding)
+			if score < float64(caching.MatchThreshold) {
+				continue
+			}
+			if best == nil || score > best.Score {
+				cached.Score = score
+				best = &cached
+				bestField = fields[i]
+			}
+		}
+		if len(expired) > 0 {
+			if err := conn.ZRem(ctx, index, expired...).Err(); err != nil {
+				ecs.logger.Warnf("unable to evict expired sementic cache entries %v", err)
+			}
+		}
+		if best != nil {
+			// matched entry becomes most recently used
+			if err := conn.ZAddXX(ctx, index, redis.Z{Score: float64(ecs.now().UnixMilli()), Member: bestField}).Err(); err != nil {
+				ecs.logger.Warnf("unable to touch sementic cache entry %v", err)
+			}
+		}
+		return best, nil
+	}
+	return nil, nil
+}
+
+func (ecs *endpointCacheService) Store(ctx context.Context,
+	caching *internal_gorm.EndpointCaching,
+	endpointProviderModelId uint64,
+	options map[string]*anypb.Any,
+	prompt string,
+	embedding []float64,
+	response *internal_service.CachedResponse,
+) error {
+	if !caching.IsEnabled() || response == nil {
+		return nil
+	}
+	response.ExpiresAt = ecs.now().Add(caching.Expiry()).Unix()
+	switch caching.CacheType {
+	case internal_gorm.STANDARD_CACHE:
+		data, err := json.Marshal(response)
+		if err != nil {
+			return err
+		}
+		return ecs.redis.GetConnection().Set(ctx, ecs.standardKey(endpointProviderModelId, options, prompt), string(data), caching.Expiry()).Err()
+	case internal_gorm.SEMENTIC_CACHE:
+		if len(embedding) == 0 {
+			return nil
+		}
+		response.Embedding = embedding
+		data, err := json.Marshal(response)
+		if err != nil {
+			return err
+		}
+		index := ecs.sementicKey(endpointProviderModelId, options)
+		field := ciphers.Hash(prompt)
+		evicted, err := sementicStoreLuaScript.Run(ctx, ecs.redis.GetConnection(),
+			[]string{index, ecs.sementicEntryKey(index, field)},
+			ecs.now().UnixMilli(), field, string(data), caching.Expiry().Milliseconds(), maxSementicEntries,
+		).Int64()
+		if err != nil {
+			return err
+		}
+		if evicted > 0 {
+			ecs.logger.Debugf("evicted %d least recently used sementic cache entries of endpoint provider model %d", evicted, endpointProviderModelId)
+		}
+		return nil
+	}
+	return nil
+}
+
+// optionsHash identifies the effective model options of a request, keys are sorted
+// and values marshalled deterministically so equal options always hash the same
+func optionsHash(options map[string]*anypb.Any) string {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var builder strings.Builder
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteString("=")
+		if raw, err := (proto.MarshalOptions{Deterministic: true}).Marshal(options[key]); err == nil {
+			builder.Write(raw)
+		}
+		builder.WriteString(";")
+	}
+	return ciphers.Hash(builder.String())
+}
+
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,163 @@
+package internal_cache_service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	redismock "github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	internal_gorm "github.com/rapidaai/api/endpoint-api/internal/entity"
+	internal_service "github.com/rapidaai/api/endpoint-api/internal/service"
+	"github.com/rapidaai/pkg/ciphers"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+)
+
+type mockRedis struct {
+	connectors.RedisConnector
+	client *redis.Client
+}
+
+func (m *mockRedis) GetConnection() *redis.Client {
+	return m.client
+}
+
+var now = time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+
+func newTestCacheService(t *testing.T) (*endpointCacheService, redismock.ClientMock) {
+	logger, _ := commons.NewApplicationLogger()
+	client, mock := redismock.NewClientMock()
+	ecs := NewEndpointCacheService(logger, &mockRedis{client: client}).(*endpointCacheService)
+	ecs.now = func() time.Time { return now }
+	return ecs, mock
+}
+
+func options(t *testing.T, values map[string]interface{}) map[string]*anypb.Any {
+	opts := map[string]*anypb.Any{}
+	for key, value := range values {
+		v, err := structpb.NewValue(value)
+		require.NoError(t, err)
+		a, err := anypb.New(v)
+		require.NoError(t, err)
+		opts[key] = a
+	}
+	return opts
+}
+
+func TestOptionsHash(t *testing.T) {
+	base := options(t, map[string]interface{}{"model.name": "gpt-4o", "model.temperature": 0.2})
+	tests := []struct {
+		name  string
+		other map[string]*anypb.Any
+		same  bool
+	}{
+		{"same options", options(t, map[string]interface{}{"model.temperature": 0.2, "model.name": "gpt-4o"}), true},
+		{"different value", options(t, map[string]interface{}{"model.name": "gpt-4o", "model.temperature": 0.9}), false},
+		{"extra option", options(t, map[string]interface{}{"model.name": "gpt-4o", "model.temperature": 0.2, "model.max_tokens": 10}), false},
+		{"no options", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.same, optionsHash(base) == optionsHash(tt.other))
+		})
+	}
+}
+
+func TestStandardCache_KeyedByOptions(t *testing.T) {
+	ecs, mock := newTestCacheService(t)
+	caching := &internal_gorm.EndpointCaching{CacheType: internal_gorm.STANDARD_CACHE, ExpiryInterval: 60}
+	cold := options(t, map[string]interface{}{"model.temperature": 0.0})
+	hot := options(t, map[string]interface{}{"model.temperature": 1.0})
+	ctx := context.Background()
+
+	data, _ := json.Marshal(&internal_service.CachedResponse{RequestId: 1, Data: []string{"cold"}, ExpiresAt: now.Add(time.Minute).Unix()})
+	mock.ExpectSet(ecs.standardKey(7, cold, "prompt"), string(data), time.Minute).SetVal("OK")
+	mock.ExpectGet(ecs.standardKey(7, hot, "prompt")).RedisNil()
+	mock.ExpectGet(ecs.standardKey(7, cold, "prompt")).SetVal(string(data))
+
+	require.NoError(t, ecs.Store(ctx, caching, 7, cold, "prompt", nil, &internal_service.CachedResponse{RequestId: 1, Data: []string{"cold"}}))
+	cached, err := ecs.Lookup(ctx, caching, 7, hot, "prompt", nil)
+	require.NoError(t, err)
+	assert.Nil(t, cached, "request with other options must not share the cached answer")
+	cached, err = ecs.Lookup(ctx, caching, 7, cold, "prompt", nil)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	assert.Equal(t, []string{"cold"}, cached.Data)
+	assert.Equal(t, float64(1), cached.Score)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSementicCache_StoreEvictsLeastRecentlyUsed(t *testing.T) {
+	ecs, mock := newTestCacheService(t)
+	caching := &internal_gorm.EndpointCaching{CacheType: internal_gorm.SEMENTIC_CACHE, ExpiryInterval: 60, MatchThreshold: 0.9}
+	index := ecs.sementicKey(7, nil)
+	field := ciphers.Hash("prompt")
+	data, _ := json.Marshal(&internal_service.CachedResponse{
+		RequestId: 1,
+		Data:      []string{"answer"},
+		Embedding: []float64{1, 0},
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	})
+	mock.ExpectEvalSha(sementicStoreLuaScript.Hash(),
+		[]string{index, ecs.sementicEntryKey(index, field)},
+		now.UnixMilli(), field, string(data), int64(60000), maxSementicEntries,
+	).SetVal(int64(1))
+
+	require.NoError(t, ecs.Store(context.Background(), caching, 7, nil, "prompt", []float64{1, 0},
+		&internal_service.CachedResponse{RequestId: 1, Data: []string{"answer"}}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSementicCache_Lookup(t *testing.T) {
+	caching := &internal_gorm.EndpointCaching{CacheType: internal_gorm.SEMENTIC_CACHE, ExpiryInterval: 60, MatchThreshold: 0.9}
+	entry := func(requestId uint64, embedding []float64) string {
+		data, _ := json.Marshal(&internal_service.CachedResponse{RequestId: requestId, Data: []string{"answer"}, Embedding: embedding})
+		return string(data)
+	}
+
+	t.Run("returns closest match and touches it", func(t *testing.T) {
+		ecs, mock := newTestCacheService(t)
+		index := ecs.sementicKey(7, nil)
+		mock.ExpectZRange(index, 0, -1).SetVal([]string{"near", "far", "gone"})
+		mock.ExpectMGet(ecs.sementicEntryKey(index, "near"), ecs.sementicEntryKey(index, "far"), ecs.sementicEntryKey(index, "gone")).
+			SetVal([]interface{}{entry(1, []float64{1, 0.1}), entry(2, []float64{0, 1}), nil})
+		mock.ExpectZRem(index, "gone").SetVal(1)
+		mock.ExpectZAddXX(index, redis.Z{Score: float64(now.UnixMilli()), Member: "near"}).SetVal(0)
+
+		cached, err := ecs.Lookup(context.Background(), caching, 7, nil, "prompt", []float64{1, 0})
+		require.NoError(t, err)
+		require.NotNil(t, cached)
+		assert.Equal(t, uint64(1), cached.RequestId)
+		assert.Greater(t, cached.Score, 0.9)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("nothing above threshold", func(t *testing.T) {
+		ecs, mock := newTestCacheService(t)
+		index := ecs.sementicKey(7, nil)
+		mock.ExpectZRange(index, 0, -1).SetVal([]string{"far"})
+		mock.ExpectMGet(ecs.sementicEntryKey(index, "far")).SetVal([]interface{}{entry(2, []float64{0, 1})})
+
+		cached, err := ecs.Lookup(context.Background(), caching, 7, nil, "prompt", []float64{1, 0})
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty index", func(t *testing.T) {
+		ecs, mock := newTestCacheService(t)
+		mock.ExpectZRange(ecs.sementicKey(7, nil), 0, -1).SetVal([]string{})
+
+		cached, err := ecs.Lookup(context.Background(), caching, 7, nil, "prompt", []float64{1, 0})
+		require.NoError(t, err)
+		assert.Nil(t, cached)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
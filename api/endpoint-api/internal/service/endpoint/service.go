@@ -44,13 +44,13 @@ func (eService *endpointService) Get(ctx context.Context,
 
 	tx := db
 	if opts.InjectCaching {
-		tx.Preload("EndpointCaching")
+		tx = tx.Preload("EndpointCaching")
 	}
 	if opts.InjectRetry {
-		tx.Preload("EndpointRetry")
+		tx = tx.Preload("EndpointRetry")
 	}
 	if opts.InjectTag {
-		tx.Preload("EndpointTag")
+		tx = tx.Preload("EndpointTag")
 	}
 
 	if endpointProviderModelId != nil {
@@ -32,5 +32,8 @@ type EndpointLogService interface {
 		endpointId uint64,
 		criteria []*endpoint_grpc_api.Criteria, paginate *endpoint_grpc_api.Paginate) (int64, []*internal_gorm.EndpointLog, error)
 	GetEndpointLog(ctx context.Context, auth types.SimplePrinciple, logId, endpointId uint64) (*internal_gorm.EndpointLog, error)
+	// lookup by request id alone, used by invoker where caller only knows the request id
+	GetEndpointLogById(ctx context.Context, auth types.SimplePrinciple, logId uint64) (*internal_gorm.EndpointLog, error)
+	ApplyMetadata(ctx context.Context, auth types.SimplePrinciple, logId uint64, metadata map[string]interface{}) ([]*internal_gorm.EndpointLogMetadata, error)
 	GetAggregatedEndpointAnalytics(ctx context.Context, auth types.SimplePrinciple, endpointId uint64) *protos.AggregatedEndpointAnalytics
 }
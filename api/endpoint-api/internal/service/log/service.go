@@ -346,6 +346,23 @@ func (els *endpointLogService) GetEndpointLog(ctx context.Context, auth types.Si
 	return wkg, nil
 }
 
+func (els *endpointLogService) GetEndpointLogById(ctx context.Context, auth types.SimplePrinciple, logId uint64) (*internal_gorm.EndpointLog, error) {
+	start := time.Now()
+	db := els.postgres.DB(ctx)
+	var endpointLog *internal_gorm.EndpointLog
+	tx := db.
+		Preload("Metadata").
+		Where("id = ? AND organization_id = ? AND project_id = ?", logId, *auth.GetCurrentOrganizationId(), *auth.GetCurrentProjectId()).
+		First(&endpointLog)
+	if tx.Error != nil {
+		els.logger.Benchmark("EndpointLogService.GetEndpointLogById", time.Since(start))
+		els.logger.Errorf("not able to find endpoint log %d %v", logId, tx.Error)
+		return nil, tx.Error
+	}
+	els.logger.Benchmark("EndpointLogService.GetEndpointLogById", time.Since(start))
+	return endpointLog, nil
+}
+
 func (els *endpointLogService) GetAggregatedEndpointAnalytics(ctx context.Context, auth types.SimplePrinciple, endpointId uint64) *endpoint_grpc_api.AggregatedEndpointAnalytics {
 	criteria := []*endpoint_grpc_api.Criteria{{
 		Key:   "created_date",
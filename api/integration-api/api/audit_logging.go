@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	config "github.com/rapidaai/api/integration-api/config"
+	internal_gorm "github.com/rapidaai/api/integration-api/internal/entity"
 	commons "github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
 	"github.com/rapidaai/pkg/types"
@@ -150,3 +151,73 @@ func (als *auditLoggingGRPCApi) CreateMetadata(c context.Context, cmr *integrati
 
 	return utils.Success[integration_api.CreateMetadataResponse, *integration_api.AuditLog](out)
 }
+
+// CreateAuthorizationDenial implements protos.AuditLoggingServiceServer. It is
+// called by the services with the denials of their authorization middleware.
+func (als *auditLoggingGRPCApi) CreateAuthorizationDenial(c context.Context, ir *integration_api.CreateAuthorizationDenialRequest) (*integration_api.CreateAuthorizationDenialResponse, error) {
+	// the service scope of a denial outside an organization is not
+	// authenticated, it is still signed by a trusted service
+	iAuth, _ := types.GetSimplePrincipleGRPC(c)
+	if iAuth == nil || iAuth.Type() != "service" {
+		als.logger.Errorf("unauthenticated request for create authorization denial")
+		return utils.AuthenticateError[integration_api.CreateAuthorizationDenialResponse]()
+	}
+	in := ir.GetDenial()
+	if in == nil || in.GetMethod() == "" {
+		return utils.Error[integration_api.CreateAuthorizationDenialResponse](
+			errors.New("missing denial"),
+			"Please provide the denied method.",
+		)
+	}
+
+	denial, err := als.auditService.CreateDenial(c, &internal_gorm.AuthorizationDenial{
+		Method:           in.GetMethod(),
+		Permission:       in.GetPermission(),
+		PrincipalType:    in.GetPrincipalType(),
+		UserId:           in.GetUserId(),
+		OrganizationId:   in.GetOrganizationId(),
+		ProjectId:        in.GetProjectId(),
+		OrganizationRole: in.GetOrganizationRole(),
+		ProjectRole:      in.GetProjectRole(),
+	})
+	if err != nil {
+		return utils.ErrorWithCode[integration_api.CreateAuthorizationDenialResponse](500, err,
+			"Unable to record the authorization denial, please try again in sometime.",
+		)
+	}
+
+	out := &integration_api.AuthorizationDenial{}
+	err = utils.Cast(denial, out)
+	if err != nil {
+		als.logger.Errorf("unable to cast the information to generic struct %v", err)
+	}
+	return utils.Success[integration_api.CreateAuthorizationDenialResponse, *integration_api.AuthorizationDenial](out)
+}
+
+// GetAllAuthorizationDenial implements protos.AuditLoggingServiceServer.
+func (als *auditLoggingGRPCApi) GetAllAuthorizationDenial(c context.Context, ir *integration_api.GetAllAuthorizationDenialRequest) (*integration_api.GetAllAuthorizationDenialResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(c)
+	if !isAuthenticated || !iAuth.HasOrganization() {
+		als.logger.Errorf("unauthenticated request for get all authorization denial")
+		return utils.AuthenticateError[integration_api.GetAllAuthorizationDenialResponse]()
+	}
+
+	cnt, denials, err := als.auditService.GetAllDenial(c,
+		*iAuth.GetCurrentOrganizationId(), ir.GetPaginate(), ir.GetCriterias())
+	if err != nil {
+		return utils.ErrorWithCode[integration_api.GetAllAuthorizationDenialResponse](500, err,
+			"Unable to get the authorization denials, please try again in sometime.",
+		)
+	}
+
+	out := make([]*integration_api.AuthorizationDenial, 0)
+	err = utils.Cast(denials, &out)
+	if err != nil {
+		als.logger.Errorf("unable to cast the information to generic struct %v", err)
+	}
+
+	return utils.PaginatedSuccess[integration_api.GetAllAuthorizationDenialResponse](
+		uint32(cnt),
+		ir.GetPaginate().GetPage(),
+		out)
+}
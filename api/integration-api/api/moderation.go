@@ -0,0 +1,74 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package integration_api
+
+import (
+	"context"
+	"errors"
+
+	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	integration_api "github.com/rapidaai/protos"
+)
+
+// Moderation implements GetModeration for the providers with a moderation model.
+func (iApi *integrationApi) Moderation(
+	c context.Context,
+	irRequest *integration_api.GetModerationRequest,
+	tag string,
+	moderationCaller internal_callers.ModerationCaller,
+) (*integration_api.GetModerationResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(c)
+	if !isAuthenticated || !iAuth.HasProject() {
+		iApi.logger.Errorf("unauthenticated request for invoke")
+		return utils.Error[integration_api.GetModerationResponse](
+			errors.New("unauthenticated request for moderation"),
+			"Please provider valid service credentials to perfom invoke, read docs @ docs.rapida.ai",
+		)
+	}
+	uuID := iApi.RequestId()
+
+	if irRequest.AdditionalData == nil {
+		irRequest.AdditionalData = map[string]string{}
+	}
+	irRequest.AdditionalData["provider_name"] = tag
+	if model, ok := irRequest.ModelParameters["model.name"]; ok {
+		if mdl, err := utils.AnyToString(model); err == nil {
+			irRequest.AdditionalData["model_name"] = mdl
+		}
+	}
+
+	source, ok := utils.GetClientSource(c)
+	if ok {
+		irRequest.AdditionalData["source"] = source.Get()
+	}
+
+	clientEnv, ok := utils.GetClientEnvironment(c)
+	if ok {
+		irRequest.AdditionalData["env"] = clientEnv.Get()
+	}
+
+	moderations, metrics, err := moderationCaller.GetModeration(
+		c,
+		irRequest.GetContent(),
+		internal_callers.NewModerationOptions(
+			uuID,
+			irRequest,
+			iApi.PreHook(c, iAuth, irRequest, uuID, tag),
+			iApi.PostHook(c, iAuth, irRequest, uuID, tag),
+		),
+	)
+	if err != nil {
+		return utils.Error[integration_api.GetModerationResponse](err, "Unable to moderate the content, please try again")
+	}
+
+	return &integration_api.GetModerationResponse{
+		Code:      200,
+		Success:   true,
+		RequestId: uuID,
+		Data:      moderations,
+		Metrics:   metrics,
+	}, nil
+}
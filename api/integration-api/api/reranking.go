@@ -68,7 +68,7 @@ func (iApi *integrationApi) Reranking(
 			iApi.PostHook(c, iAuth, irRequest, uuID, tag),
 		),
 	)
-	if err == nil {
+	if err != nil {
 		return utils.Error[integration_api.RerankingResponse](errors.New("illegal token while processing request"), "Illegal request, please try again")
 	}
 
@@ -103,3 +103,12 @@ type RerankingCaller interface {
 		options *RerankerOptions,
 	) ([]*protos.Reranking, []*protos.Metric, error)
 }
+
+// ModerationCaller is an interface for moderation models.
+// - GetModeration: Classifies the content and returns whether it is flagged along with the flagged categories and their scores.
+type ModerationCaller interface {
+	GetModeration(ctx context.Context,
+		content string,
+		options *ModerationOptions,
+	) ([]*protos.Moderation, []*protos.Metric, error)
+}
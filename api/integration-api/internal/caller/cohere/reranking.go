@@ -95,15 +95,15 @@ func (rr *rerankingCaller) GetReranking(ctx context.Context,
 		return nil, metrics.Build(), err
 	}
 	metrics.OnSuccess()
-	output := make([]*protos.Reranking, len(resp.Results))
-
+	// results are ordered by relevance and may be fewer than the documents
+	// when top_n is set, Index points back to the input chunk
+	output := make([]*protos.Reranking, 0, len(resp.Results))
 	for _, rerankedData := range resp.Results {
-		// preserve the index of the chunk
-		output[rerankedData.Index] = &protos.Reranking{
+		output = append(output, &protos.Reranking{
 			Index:          int32(rerankedData.Index),
 			Content:        content[int32(rerankedData.Index)],
 			RelevanceScore: rerankedData.RelevanceScore,
-		}
+		})
 	}
 	options.PostHook(map[string]interface{}{
 		"result": resp,
@@ -2,13 +2,16 @@ package internal_openai_callers
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
+	"strconv"
 	"time"
 
+	"github.com/openai/openai-go"
+
 	internal_callers "github.com/rapidaai/api/integration-api/internal/caller"
+	internal_caller_metrics "github.com/rapidaai/api/integration-api/internal/caller/metrics"
 	"github.com/rapidaai/pkg/commons"
-	"github.com/rapidaai/pkg/types"
-	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
 	integration_api "github.com/rapidaai/protos"
 )
@@ -17,31 +20,84 @@ type ModerationsCaller struct {
 	OpenAI
 }
 
-func NewModerationsCaller(logger commons.Logger, credential *integration_api.Credential) *ModerationsCaller {
+func NewModerationsCaller(logger commons.Logger, credential *integration_api.Credential) internal_callers.ModerationCaller {
 	return &ModerationsCaller{
 		OpenAI: openAI(logger, credential),
 	}
 }
 
+func (stc *ModerationsCaller) GetModerationNewParams(opts *internal_callers.ModerationOptions) openai.ModerationNewParams {
+	options := openai.ModerationNewParams{
+		Model: openai.ModerationModelOmniModerationLatest,
+	}
+	for key, value := range opts.ModelParameter {
+		switch key {
+		case "model.name":
+			if modelName, err := utils.AnyToString(value); err == nil && modelName != "" {
+				options.Model = openai.ModerationModel(modelName)
+			}
+		}
+	}
+	return options
+}
+
+// GetModeration returns a "flagged" entry followed by every category the
+// model flagged with its score.
 func (stc *ModerationsCaller) GetModeration(ctx context.Context,
-	content *types.Content, options *internal_callers.ModerationOptions) (*types.Content, []*protos.Metric, error) {
-	//
-	// Working with chat completion with vision
-	//
-	start := time.Now()
-	// client, err := stc.GetClient()
-	timeMetric := &protos.Metric{
-		Name:        type_enums.TIME_TAKEN.String(),
-		Value:       fmt.Sprintf("%d", int64(time.Since(start))),
-		Description: "Time taken to serve the llm request",
+	content string, options *internal_callers.ModerationOptions) ([]*protos.Moderation, []*protos.Metric, error) {
+	mertics := internal_caller_metrics.NewMetricBuilder(options.RequestId)
+	mertics.OnStart()
+
+	client, err := stc.GetClient()
+	if err != nil {
+		return nil, mertics.OnFailure().Build(), err
+	}
+
+	// moderation sits in the conversation path, keep the budget short
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	opts := stc.GetModerationNewParams(options)
+	opts.Input = openai.ModerationNewParamsInputUnion{OfString: openai.String(content)}
+
+	options.PreHook(map[string]interface{}{"input": opts})
+	resp, err := client.Moderations.New(ctx, opts)
+	if err != nil {
+		options.PostHook(map[string]interface{}{
+			"result": resp,
+			"error":  err,
+		}, mertics.OnFailure().Build())
+		return nil, mertics.Build(), err
+	}
+	mertics.OnSuccess()
+
+	output := []*protos.Moderation{{Name: "flagged", Value: "false"}}
+	for _, result := range resp.Results {
+		if !result.Flagged {
+			continue
+		}
+		output[0].Value = "true"
+
+		var categories map[string]bool
+		var scores map[string]float64
+		if err := json.Unmarshal([]byte(result.Categories.RawJSON()), &categories); err != nil {
+			stc.logger.Warnf("unable to read moderation categories %v", err)
+		}
+		if err := json.Unmarshal([]byte(result.CategoryScores.RawJSON()), &scores); err != nil {
+			stc.logger.Warnf("unable to read moderation category scores %v", err)
+		}
+		for category, flagged := range categories {
+			if flagged {
+				output = append(output, &protos.Moderation{
+					Name:  category,
+					Value: strconv.FormatFloat(scores[category], 'f', 4, 64),
+				})
+			}
+		}
 	}
-	// if err != nil {
-	// 	return nil, types.Metrics{timeMetric}, err
-	// }
-	// Will need moderation for chat in future
-
-	return &types.Content{
-		ContentType:   "text",
-		ContentFormat: "raw",
-	}, []*protos.Metric{timeMetric}, nil
+	options.PostHook(map[string]interface{}{
+		"result": resp,
+	}, mertics.Build())
+	stc.logger.Debugf("moderation with %s flagged=%s", resp.Model, output[0].Value)
+	return output, mertics.Build(), nil
 }
@@ -67,15 +67,15 @@ func (rr *rerankingCaller) GetReranking(ctx context.Context,
 		return nil, metrics.Build(), err
 	}
 
-	output := make([]*integration_api.Reranking, len(resp.Data))
-
+	// results are ordered by relevance and may be fewer than the documents
+	// when top_k is set, Index points back to the input chunk
+	output := make([]*integration_api.Reranking, 0, len(resp.Data))
 	for _, rerankedData := range resp.Data {
-		// preserve the index of the chunk
-		output[rerankedData.Index] = &integration_api.Reranking{
+		output = append(output, &integration_api.Reranking{
 			Index:          int32(rerankedData.Index),
 			Content:        content[rerankedData.Index],
 			RelevanceScore: rerankedData.RelevanceScore,
-		}
+		})
 	}
 	options.PostHook(map[string]interface{}{
 		"result": res,
@@ -0,0 +1,23 @@
+// Rapida – Open Source Voice AI Orchestration Platform
+// Copyright (C) 2023-2025 Prashant Srivastav <prashant@rapida.ai>
+// Licensed under a modified GPL-2.0. See the LICENSE file for details.
+package internal_entity
+
+import (
+	gorm_model "github.com/rapidaai/pkg/models/gorm"
+)
+
+// AuthorizationDenial is a request refused by the authorization middleware
+// of a service, listed per organization. Users refused before onboarding
+// completes have no organization.
+type AuthorizationDenial struct {
+	gorm_model.Audited
+	Method           string `json:"method" gorm:"type:string;size:200;not null"`
+	Permission       string `json:"permission" gorm:"type:string;size:50;not null"`
+	PrincipalType    string `json:"principalType" gorm:"type:string;size:50;not null"`
+	UserId           uint64 `json:"userId" gorm:"type:bigint;not null;default:0"`
+	OrganizationId   uint64 `json:"organizationId" gorm:"type:bigint;not null;default:0"`
+	ProjectId        uint64 `json:"projectId" gorm:"type:bigint;not null;default:0"`
+	OrganizationRole string `json:"organizationRole" gorm:"type:string;size:50;not null;default:''"`
+	ProjectRole      string `json:"projectRole" gorm:"type:string;size:50;not null;default:''"`
+}
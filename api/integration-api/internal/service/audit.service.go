@@ -17,4 +17,9 @@ type AuditService interface {
 	Create(ctx context.Context, requestId, organizationId, projectId, credentialId uint64, intName string, assetPrefix string, metrics []*protos.Metric, status type_enums.RecordState) (*internal_gorm.ExternalAudit, error)
 	CreateMetadata(c context.Context, auditId uint64, metadata map[string]string) ([]*internal_gorm.ExternalAuditMetadata, error)
 	UpdateMetadata(c context.Context, auditId uint64, metadata map[string]string) ([]*internal_gorm.ExternalAuditMetadata, error)
+
+	// CreateDenial records a request refused by the authorization of a service.
+	CreateDenial(ctx context.Context, denial *internal_gorm.AuthorizationDenial) (*internal_gorm.AuthorizationDenial, error)
+	// GetAllDenial lists the denied requests of an organization, newest first.
+	GetAllDenial(ctx context.Context, organizationId uint64, paginate *protos.Paginate, opts []*protos.Criteria) (int64, []*internal_gorm.AuthorizationDenial, error)
 }
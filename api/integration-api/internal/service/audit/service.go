@@ -175,3 +175,46 @@ func (aS *auditService) Get(ctx context.Context, organizationId uint64, projectI
 
 	return audit, nil
 }
+
+func (aS *auditService) CreateDenial(ctx context.Context, denial *internal_gorm.AuthorizationDenial) (*internal_gorm.AuthorizationDenial, error) {
+	db := aS.postgres.DB(ctx)
+	tx := db.Create(denial)
+	if tx.Error != nil {
+		aS.logger.Errorf("unable to insert into authorization denial table %v", tx.Error)
+		return nil, tx.Error
+	}
+	return denial, nil
+}
+
+func (aS *auditService) GetAllDenial(ctx context.Context, organizationId uint64, paginate *integration_api.Paginate, ctrs []*integration_api.Criteria) (int64, []*internal_gorm.AuthorizationDenial, error) {
+	db := aS.postgres.DB(ctx)
+	var cnt int64
+	denials := make([]*internal_gorm.AuthorizationDenial, 0)
+	qry := db.
+		Model(internal_gorm.AuthorizationDenial{}).
+		Where("organization_id = ?", organizationId)
+
+	for _, ct := range ctrs {
+		qry.Where(fmt.Sprintf("%s %s ?", ct.GetKey(), ct.GetLogic()), ct.GetValue())
+	}
+
+	tx := qry.
+		Scopes(gorm_models.
+			Paginate(gorm_models.
+				NewPaginated(
+					int(paginate.GetPage()),
+					int(paginate.GetPageSize()),
+					&cnt,
+					qry))).
+		Order(clause.OrderByColumn{
+			Column: clause.Column{Name: "created_date"},
+			Desc:   true,
+		}).
+		Find(&denials)
+	if tx.Error != nil {
+		aS.logger.Errorf("error while quering authorization denials %v", tx.Error)
+		return cnt, nil, tx.Error
+	}
+
+	return cnt, denials, nil
+}
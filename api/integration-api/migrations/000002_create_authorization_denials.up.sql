@@ -0,0 +1,20 @@
+-- Requests refused by the authorization middleware of the services, listed
+-- per organization.
+CREATE TABLE public.authorization_denials (
+    id bigint NOT NULL,
+    created_date timestamp without time zone DEFAULT now() NOT NULL,
+    updated_date timestamp without time zone,
+    method character varying(200) NOT NULL,
+    permission character varying(50) NOT NULL,
+    principal_type character varying(50) NOT NULL,
+    user_id bigint DEFAULT 0 NOT NULL,
+    organization_id bigint DEFAULT 0 NOT NULL,
+    project_id bigint DEFAULT 0 NOT NULL,
+    organization_role character varying(50) DEFAULT ''::character varying NOT NULL,
+    project_role character varying(50) DEFAULT ''::character varying NOT NULL
+);
+
+ALTER TABLE ONLY public.authorization_denials
+    ADD CONSTRAINT authorization_denials_pkey PRIMARY KEY (id);
+
+CREATE INDEX authorization_denials_organization_idx ON public.authorization_denials (organization_id, created_date DESC);
@@ -14,6 +14,7 @@ import (
 	internal_vault_service "github.com/rapidaai/api/web-api/internal/service/vault"
 	commons "github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	protos "github.com/rapidaai/protos"
@@ -148,7 +149,7 @@ func NewConnectRPC(config *config.WebAppConfig, oauthCfg *config.OAuth2Config, l
 			microsoftSharepointConnect: internal_connects.NewMicrosoftSharepointConnect(config, oauthCfg, logger, postgres),
 			microsoftOnedriveConnect:   internal_connects.NewMicrosoftOnedriveConnect(config, oauthCfg, logger, postgres),
 			//
-			vaultService: internal_vault_service.NewVaultService(logger, postgres),
+			vaultService: internal_vault_service.NewVaultService(logger, postgres, kms.NewKeyManager(config.KMSConfig, config.Secret, logger)),
 
 			slackConnect:   internal_connects.NewSlackActionConnect(config, oauthCfg, logger, postgres),
 			jiraConnect:    internal_connects.NewJiraConnect(config, oauthCfg, logger, postgres),
@@ -166,7 +167,7 @@ func NewConnectGRPC(config *config.WebAppConfig,
 			cfg:          config,
 			logger:       logger,
 			postgres:     postgres,
-			vaultService: internal_vault_service.NewVaultService(logger, postgres),
+			vaultService: internal_vault_service.NewVaultService(logger, postgres, kms.NewKeyManager(config.KMSConfig, config.Secret, logger)),
 
 			githubCodeConnect:  internal_connects.NewGithubCodeConnect(config, oauthCfg, logger, postgres),
 			gitlabCodeConnect:  internal_connects.NewGitlabCodeConnect(config, oauthCfg, logger, postgres),
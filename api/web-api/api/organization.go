@@ -15,6 +15,7 @@ import (
 	internal_vault_service "github.com/rapidaai/api/web-api/internal/service/vault"
 	commons "github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	"github.com/rapidaai/pkg/utils"
@@ -68,7 +69,7 @@ func NewOrganizationGRPC(config *config.WebAppConfig, logger commons.Logger,
 			organizationService: internal_organization_service.NewOrganizationService(logger, postgres),
 			userService:         internal_user_service.NewUserService(logger, postgres),
 			projectService:      internal_project_service.NewProjectService(logger, postgres),
-			vaultService:        internal_vault_service.NewVaultService(logger, postgres),
+			vaultService:        internal_vault_service.NewVaultService(logger, postgres, kms.NewKeyManager(config.KMSConfig, config.Secret, logger)),
 		},
 	}
 }
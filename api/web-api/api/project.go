@@ -10,6 +10,7 @@ import (
 	"github.com/rapidaai/pkg/ciphers"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -34,6 +35,7 @@ type webProjectApi struct {
 	emailerClient       external_clients.Emailer
 	userService         internal_service.UserService
 	organizationService internal_service.OrganizationService
+	limiter             limiters.Limiter
 }
 
 type webProjectRPCApi struct {
@@ -68,6 +70,7 @@ func NewProjectGRPC(config *config.WebAppConfig, logger commons.Logger, postgres
 			userService:         internal_user_service.NewUserService(logger, postgres),
 			emailerClient:       external_emailer.NewEmailer(config.EmailerConfig, logger),
 			organizationService: internal_organization_service.NewOrganizationService(logger, postgres),
+			limiter:             limiters.NewLimiter(logger, redis, config.RateLimitConfig),
 		},
 	}
 }
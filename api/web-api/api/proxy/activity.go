@@ -14,6 +14,7 @@ import (
 	config "github.com/rapidaai/api/web-api/config"
 	commons "github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
 	"github.com/rapidaai/pkg/types"
 )
 
@@ -40,7 +41,7 @@ func NewActivityGRPC(config *config.WebAppConfig, logger commons.Logger, postgre
 			postgres:     postgres,
 			redis:        redis,
 			auditClient:  integration_client.NewAuditServiceClient(&config.AppConfig, logger, redis),
-			vaultService: internal_vault_service.NewVaultService(logger, postgres),
+			vaultService: internal_vault_service.NewVaultService(logger, postgres, kms.NewKeyManager(config.KMSConfig, config.Secret, logger)),
 		},
 	}
 }
@@ -70,3 +71,19 @@ func (wActivity *webActivityGRPCApi) GetAllAuditLog(c context.Context, irRequest
 func (wActivity *webActivityGRPCApi) CreateMetadata(c context.Context, irRequest *protos.CreateMetadataRequest) (*protos.CreateMetadataResponse, error) {
 	return nil, fmt.Errorf("unimplimented method")
 }
+
+// CreateAuthorizationDenial is only called by the services on the
+// integration-api, denials are not recorded through the web-api.
+func (wActivity *webActivityGRPCApi) CreateAuthorizationDenial(c context.Context, irRequest *protos.CreateAuthorizationDenialRequest) (*protos.CreateAuthorizationDenialResponse, error) {
+	return nil, fmt.Errorf("unimplimented method")
+}
+
+func (wActivity *webActivityGRPCApi) GetAllAuthorizationDenial(c context.Context, irRequest *protos.GetAllAuthorizationDenialRequest) (*protos.GetAllAuthorizationDenialResponse, error) {
+	wActivity.logger.Debugf("GetAllAuthorizationDenial from grpc with requestPayload %v, %v", irRequest, c)
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(c)
+	if !isAuthenticated || !iAuth.HasOrganization() {
+		wActivity.logger.Errorf("unauthenticated request for get authorization denials")
+		return nil, errors.New("unauthenticated request")
+	}
+	return wActivity.auditClient.GetAllAuthorizationDenial(c, iAuth, irRequest)
+}
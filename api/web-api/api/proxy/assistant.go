@@ -365,6 +365,16 @@ func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantWebhookLog(ctx context.
 	return assistantGRPCApi.assistantClient.GetAssistantWebhookLog(ctx, iAuth, iRequest)
 }
 
+func (assistantGRPCApi *webAssistantGRPCApi) RedeliverAssistantWebhookLog(ctx context.Context, iRequest *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to redeliver assistant webhook log")
+		return nil, errors.New("unauthenticated request")
+	}
+
+	return assistantGRPCApi.assistantClient.RedeliverAssistantWebhookLog(ctx, iAuth, iRequest)
+}
+
 // GetAssistantWebhook implements protos.AssistantServiceServer.
 func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantWebhook(ctx context.Context, iRequest *protos.GetAssistantWebhookRequest) (*protos.GetAssistantWebhookResponse, error) {
 	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
@@ -386,6 +396,16 @@ func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantConversation(ctx contex
 	return assistantGRPCApi.assistantClient.GetAssistantConversation(ctx, iAuth, iRequest)
 }
 
+// GetAssistantEvaluationSummary implements protos.AssistantServiceServer.
+func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantEvaluationSummary(ctx context.Context, iRequest *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to get assistant evaluation summary")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.GetAssistantEvaluationSummary(ctx, iAuth, iRequest)
+}
+
 func (assistantGRPCApi *webAssistantGRPCApi) DeleteAssistant(ctx context.Context, iRequest *protos.DeleteAssistantRequest) (*protos.GetAssistantResponse, error) {
 	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
 	if !isAuthenticated {
@@ -566,6 +586,100 @@ func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantAnalysis(ctx context.Co
 	return assistantGRPCApi.assistantClient.GetAssistantAnalysis(ctx, iAuth, iRequest)
 }
 
+func (assistantGRPCApi *webAssistantGRPCApi) CreateAssistantModerator(ctx context.Context, iRequest *protos.CreateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to create assistant moderator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.CreateAssistantModerator(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) UpdateAssistantModerator(ctx context.Context, iRequest *protos.UpdateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to update assistant moderator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.UpdateAssistantModerator(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) DeleteAssistantModerator(ctx context.Context, iRequest *protos.DeleteAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to delete assistant moderator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.DeleteAssistantModerator(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantModerator(ctx context.Context, iRequest *protos.GetAllAssistantModeratorRequest) (*protos.GetAllAssistantModeratorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantModerator")
+		return nil, errors.New("unauthenticated request")
+	}
+
+	page, out, err := assistantGRPCApi.assistantClient.GetAllAssistantModerator(ctx, iAuth, iRequest.GetAssistantId(), iRequest.GetCriterias(), iRequest.GetPaginate())
+	if err != nil {
+		return utils.Error[protos.GetAllAssistantModeratorResponse](
+			err,
+			"Unable to get all the assistant moderators, please try again later.",
+		)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantModeratorResponse, []*protos.AssistantModerator](
+		page.GetTotalItem(), page.GetCurrentPage(),
+		out)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) CreateAssistantEvaluator(ctx context.Context, iRequest *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to create assistant evaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.CreateAssistantEvaluator(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) UpdateAssistantEvaluator(ctx context.Context, iRequest *protos.UpdateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to update assistant evaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.UpdateAssistantEvaluator(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) DeleteAssistantEvaluator(ctx context.Context, iRequest *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to delete assistant evaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+	return assistantGRPCApi.assistantClient.DeleteAssistantEvaluator(ctx, iAuth, iRequest)
+}
+
+func (assistantGRPCApi *webAssistantGRPCApi) GetAllAssistantEvaluator(ctx context.Context, iRequest *protos.GetAllAssistantEvaluatorRequest) (*protos.GetAllAssistantEvaluatorResponse, error) {
+	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
+	if !isAuthenticated {
+		assistantGRPCApi.logger.Errorf("unauthenticated request to GetAllAssistantEvaluator")
+		return nil, errors.New("unauthenticated request")
+	}
+
+	page, out, err := assistantGRPCApi.assistantClient.GetAllAssistantEvaluator(ctx, iAuth, iRequest.GetAssistantId(), iRequest.GetCriterias(), iRequest.GetPaginate())
+	if err != nil {
+		return utils.Error[protos.GetAllAssistantEvaluatorResponse](
+			err,
+			"Unable to get all the assistant evaluators, please try again later.",
+		)
+	}
+
+	return utils.PaginatedSuccess[protos.GetAllAssistantEvaluatorResponse, []*protos.AssistantEvaluator](
+		page.GetTotalItem(), page.GetCurrentPage(),
+		out)
+}
+
 func (assistantGRPCApi *webAssistantGRPCApi) GetAssistantToolLog(ctx context.Context, iRequest *protos.GetAssistantToolLogRequest) (*protos.GetAssistantToolLogResponse, error) {
 	iAuth, isAuthenticated := types.GetAuthPrincipleGPRC(ctx)
 	if !isAuthenticated {
@@ -3,6 +3,7 @@ package web_proxy_api
 import (
 	"context"
 	"errors"
+	"io"
 
 	endpoint_client "github.com/rapidaai/pkg/clients/endpoint"
 	protos "github.com/rapidaai/protos"
@@ -53,3 +54,27 @@ func (endpointGRPCApi *webInvokeGRPCApi) Invoke(ctx context.Context, iRequest *p
 	}
 	return endpointGRPCApi.deployServiceClient.Invoke(ctx, iAuth, iRequest)
 }
+
+func (endpointGRPCApi *webInvokeGRPCApi) InvokeStream(iRequest *protos.InvokeRequest, stream protos.Deployment_InvokeStreamServer) error {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(stream.Context())
+	if !isAuthenticated {
+		endpointGRPCApi.logger.Errorf("unauthenticated request to invoke stream")
+		return errors.New("unauthenticated request")
+	}
+	upstream, err := endpointGRPCApi.deployServiceClient.InvokeStream(stream.Context(), iAuth, iRequest)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package web_api
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+// GetProjectQuota returns the usage and quotas of the current project in this
+// month.
+func (wProjectApi *webProjectGRPCApi) GetProjectQuota(ctx context.Context, irRequest *protos.GetProjectQuotaRequest) (*protos.GetProjectQuotaResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated {
+		wProjectApi.logger.Errorf("GetProjectQuota from grpc with unauthenticated request")
+		return utils.AuthenticateError[protos.GetProjectQuotaResponse]()
+	}
+	projectId := iAuth.GetCurrentProjectId()
+	if projectId == nil {
+		return utils.Error[protos.GetProjectQuotaResponse](
+			errors.New("project is not selected"),
+			"Please select a project to get its quota.",
+		)
+	}
+	status, err := wProjectApi.limiter.Quota(ctx, *projectId)
+	if err != nil {
+		wProjectApi.logger.Errorf("unable to get quota of project %d %v", *projectId, err)
+		return utils.ErrorWithCode[protos.GetProjectQuotaResponse](500,
+			err,
+			"Unable to get quota of the project, please try again later.",
+		)
+	}
+	return utils.Success[protos.GetProjectQuotaResponse](&protos.QuotaStatus{
+		ProjectId:   status.ProjectId,
+		Period:      status.Period,
+		ResetAt:     timestamppb.New(status.ResetAt),
+		TokensUsed:  status.TokensUsed,
+		TokenQuota:  status.TokenQuota,
+		MinutesUsed: status.MinutesUsed,
+		MinuteQuota: status.MinuteQuota,
+		Exceeded:    status.Exceeded,
+	})
+}
@@ -10,6 +10,7 @@ import (
 	integration_client "github.com/rapidaai/pkg/clients/integration"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
 	"github.com/rapidaai/pkg/types"
 	"github.com/rapidaai/pkg/utils"
 	"github.com/rapidaai/protos"
@@ -21,6 +22,7 @@ type webVaultApi struct {
 	postgres          connectors.PostgresConnector
 	redis             connectors.RedisConnector
 	vaultService      internal_service.VaultService
+	vaultKeyService   internal_service.VaultKeyService
 	integrationClient integration_client.IntegrationServiceClient
 	hubspotConnect    internal_connects.HubspotConnect
 }
@@ -39,7 +41,7 @@ func NewVaultRPC(config *config.WebAppConfig, oauthCfg *config.OAuth2Config, log
 			cfg:               config,
 			logger:            logger,
 			postgres:          postgres,
-			vaultService:      internal_vault_service.NewVaultService(logger, postgres),
+			vaultService:      internal_vault_service.NewVaultService(logger, postgres, kms.NewKeyManager(config.KMSConfig, config.Secret, logger)),
 			integrationClient: integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
 			hubspotConnect:    internal_connects.NewHubspotConnect(config, oauthCfg, logger, postgres),
 		},
@@ -47,13 +49,15 @@ func NewVaultRPC(config *config.WebAppConfig, oauthCfg *config.OAuth2Config, log
 }
 
 func NewVaultGRPC(config *config.WebAppConfig, oauthCfg *config.OAuth2Config, logger commons.Logger, postgres connectors.PostgresConnector, redis connectors.RedisConnector) protos.VaultServiceServer {
+	keyManager := kms.NewKeyManager(config.KMSConfig, config.Secret, logger)
 	return &webVaultGRPCApi{
 		webVaultApi{
 			cfg:               config,
 			logger:            logger,
 			postgres:          postgres,
 			redis:             redis,
-			vaultService:      internal_vault_service.NewVaultService(logger, postgres),
+			vaultService:      internal_vault_service.NewVaultService(logger, postgres, keyManager),
+			vaultKeyService:   internal_vault_service.NewVaultKeyService(logger, postgres, keyManager),
 			integrationClient: integration_client.NewIntegrationServiceClientGRPC(&config.AppConfig, logger, redis),
 			hubspotConnect:    internal_connects.NewHubspotConnect(config, oauthCfg, logger, postgres),
 		},
@@ -150,6 +154,10 @@ func (wVault *webVaultGRPCApi) GetOauth2Credential(ctx context.Context, request
 		wVault.logger.Errorf("GetAllProviderCredential from grpc with unauthenticated request")
 		return utils.AuthenticateError[protos.GetCredentialResponse]()
 	}
+	if !isServiceCaller(iAuth) {
+		wVault.logger.Errorf("GetOauth2Credential from grpc with non service request")
+		return utils.AuthenticateError[protos.GetCredentialResponse]()
+	}
 	vlt, err := wVault.vaultService.Get(
 		ctx, iAuth, request.GetVaultId())
 
@@ -192,5 +200,37 @@ func (wVault *webVaultGRPCApi) GetCredential(ctx context.Context, request *proto
 	if err != nil {
 		wVault.logger.Errorf("unable to cast vault object to proto %v", err)
 	}
+	// decrypted credentials are only shared with internal services
+	if !isServiceCaller(iAuth) {
+		out.Value = nil
+	}
 	return utils.Success[protos.GetCredentialResponse, *protos.VaultCredential](&out)
 }
+
+// isServiceCaller reports whether the request is authenticated with a service
+// scope token of an internal service.
+func isServiceCaller(auth types.SimplePrinciple) bool {
+	return auth.Type() == "service"
+}
+
+// RotateCredentialKey retires the data key of the organization of the caller
+// and re-encrypts its credentials with a new one.
+func (wVault *webVaultGRPCApi) RotateCredentialKey(ctx context.Context, request *protos.RotateCredentialKeyRequest) (*protos.RotateCredentialKeyResponse, error) {
+	iAuth, isAuthenticated := types.GetSimplePrincipleGRPC(ctx)
+	if !isAuthenticated || !iAuth.HasOrganization() {
+		wVault.logger.Errorf("RotateCredentialKey from grpc with unauthenticated request")
+		return utils.AuthenticateError[protos.RotateCredentialKeyResponse]()
+	}
+	organizationId := *iAuth.GetCurrentOrganizationId()
+	if _, err := wVault.vaultKeyService.Rotate(ctx, organizationId); err != nil {
+		wVault.logger.Errorf("unable to rotate vault key of organization %d: %v", organizationId, err)
+		return utils.Error[protos.RotateCredentialKeyResponse](err, "Unable to rotate credential key, please try again")
+	}
+	// credentials left on the retired key are re-encrypted on the next startup
+	reencrypted, err := wVault.vaultKeyService.Reencrypt(ctx, organizationId)
+	if err != nil {
+		wVault.logger.Errorf("unable to re-encrypt credentials of organization %d: %v", organizationId, err)
+		return utils.Error[protos.RotateCredentialKeyResponse](err, "Unable to re-encrypt credentials, please try again")
+	}
+	return utils.Success[protos.RotateCredentialKeyResponse](uint64(reencrypted))
+}
@@ -51,6 +51,8 @@ type WebAppConfig struct {
 	RedisConfig      configs.RedisConfig      `mapstructure:"redis" validate:"required"`
 	AssetStoreConfig configs.AssetStoreConfig `mapstructure:"asset_store" validate:"required"`
 	OAuthConfig      OAuth2Config             `mapstructure:"oauth2" validate:"required"`
+	KMSConfig        configs.KMSConfig        `mapstructure:"kms"`
+	RateLimitConfig  *configs.RateLimitConfig `mapstructure:"rate_limit"`
 	//
 	EmailerConfig *configs.EmailerConfig `mapstructure:"emailer"`
 }
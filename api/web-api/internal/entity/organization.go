@@ -3,6 +3,7 @@ package internal_entity
 import (
 	gorm_model "github.com/rapidaai/pkg/models/gorm"
 	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
 )
 
 type Organization struct {
@@ -22,6 +23,22 @@ type Vault struct {
 	Provider string                  `json:"provider" gorm:"type:string;size:200;not null"`
 	Name     string                  `json:"name" gorm:"type:string;size:200;not null"`
 	Value    gorm_types.InterfaceMap `json:"value" gorm:"type:string;size:50;not null;default:active"`
+
+	// EncryptedValue is Value sealed with the data key of VaultKeyId, Value
+	// is only stored for credentials that are not encrypted yet.
+	VaultKeyId     *uint64 `json:"-" gorm:"type:bigint"`
+	EncryptedValue string  `json:"-" gorm:"type:text"`
+}
+
+// VaultKey is the data key of an organization, wrapped with the master key of
+// MasterKeyId. New credentials are sealed with the active key, retired keys
+// are inactive and only open credentials sealed before a rotation.
+type VaultKey struct {
+	gorm_model.Audited
+	OrganizationId uint64                 `json:"organizationId" gorm:"type:bigint;size:20;not null"`
+	MasterKeyId    string                 `json:"masterKeyId" gorm:"type:string;size:2048;not null"`
+	WrappedKey     string                 `json:"-" gorm:"type:text;not null"`
+	Status         type_enums.RecordState `json:"status" gorm:"type:string;size:50;not null;default:ACTIVE"`
 }
 
 type Project struct {
@@ -18,3 +18,18 @@ type VaultService interface {
 	Delete(ctx context.Context, auth types.Principle, vaultId uint64) (*internal_entity.Vault, error)
 	GetAllOrganizationCredential(ctx context.Context, auth types.SimplePrinciple, criteria []*web_api.Criteria, paginate *web_api.Paginate) (int64, []*internal_entity.Vault, error)
 }
+
+// VaultKeyService manages the data keys credentials are encrypted with.
+type VaultKeyService interface {
+	// Rewrap wraps data keys wrapped with a previous master key with the
+	// current one, after a rotation of the master key.
+	Rewrap(ctx context.Context) (int, error)
+	// Rotate retires the active data key of the given organizations, or of
+	// every organization when none are given, their credentials are
+	// encrypted with new data keys by Reencrypt.
+	Rotate(ctx context.Context, organizationIds ...uint64) (int, error)
+	// Reencrypt encrypts credentials stored in plain text and credentials of
+	// retired data keys with the active data key of their organization,
+	// limited to the given organizations when any are given.
+	Reencrypt(ctx context.Context, organizationIds ...uint64) (int, error)
+}
@@ -0,0 +1,133 @@
+package internal_vault_service
+
+import (
+	"context"
+	"encoding/base64"
+
+	internal_entity "github.com/rapidaai/api/web-api/internal/entity"
+	internal_services "github.com/rapidaai/api/web-api/internal/service"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+// reencryptBatchSize is the number of vaults re-encrypted per query.
+const reencryptBatchSize = 100
+
+type vaultKeyService struct {
+	logger   commons.Logger
+	postgres connectors.PostgresConnector
+	keyring  *keyring
+}
+
+func NewVaultKeyService(logger commons.Logger, postgres connectors.PostgresConnector, keyManager kms.KeyManager) internal_services.VaultKeyService {
+	return &vaultKeyService{
+		logger:   logger,
+		postgres: postgres,
+		keyring:  newKeyring(logger, postgres, keyManager),
+	}
+}
+
+func (vks *vaultKeyService) Rewrap(ctx context.Context) (int, error) {
+	db := vks.postgres.DB(ctx)
+	keyManager := vks.keyring.keyManager
+	var vaultKeys []*internal_entity.VaultKey
+	tx := db.Where("master_key_id <> ?", keyManager.KeyId()).Find(&vaultKeys)
+	if tx.Error != nil {
+		vks.logger.Errorf("unable to get vault keys to rewrap %v", tx.Error)
+		return 0, tx.Error
+	}
+
+	rewrapped := 0
+	for _, vaultKey := range vaultKeys {
+		wrapped, err := base64.StdEncoding.DecodeString(vaultKey.WrappedKey)
+		if err != nil {
+			vks.logger.Errorf("illegal wrapped key of vault key %d: %v", vaultKey.Id, err)
+			return rewrapped, err
+		}
+		dataKey, err := keyManager.Unwrap(ctx, vaultKey.MasterKeyId, wrapped)
+		if err != nil {
+			vks.logger.Errorf("unable to unwrap vault key %d: %v", vaultKey.Id, err)
+			return rewrapped, err
+		}
+		wrapped, err = keyManager.Wrap(ctx, dataKey)
+		if err != nil {
+			vks.logger.Errorf("unable to wrap vault key %d: %v", vaultKey.Id, err)
+			return rewrapped, err
+		}
+		tx := db.Model(&internal_entity.VaultKey{}).
+			Where("id = ? AND master_key_id = ?", vaultKey.Id, vaultKey.MasterKeyId).
+			Updates(map[string]interface{}{
+				"master_key_id": keyManager.KeyId(),
+				"wrapped_key":   base64.StdEncoding.EncodeToString(wrapped),
+			})
+		if tx.Error != nil {
+			vks.logger.Errorf("unable to rewrap vault key %d: %v", vaultKey.Id, tx.Error)
+			return rewrapped, tx.Error
+		}
+		rewrapped += int(tx.RowsAffected)
+	}
+	return rewrapped, nil
+}
+
+func (vks *vaultKeyService) Rotate(ctx context.Context, organizationIds ...uint64) (int, error) {
+	qry := vks.postgres.DB(ctx).Model(&internal_entity.VaultKey{}).
+		Where("status = ?", type_enums.RECORD_ACTIVE.String())
+	if len(organizationIds) > 0 {
+		qry = qry.Where("organization_id IN ?", organizationIds)
+	}
+	tx := qry.Update("status", type_enums.RECORD_INACTIVE.String())
+	if tx.Error != nil {
+		vks.logger.Errorf("unable to retire vault keys %v", tx.Error)
+		return 0, tx.Error
+	}
+	return int(tx.RowsAffected), nil
+}
+
+func (vks *vaultKeyService) Reencrypt(ctx context.Context, organizationIds ...uint64) (int, error) {
+	db := vks.postgres.DB(ctx)
+	reencrypted := 0
+	lastId := uint64(0)
+	for {
+		var vaults []*internal_entity.Vault
+		qry := db.Where("id > ?", lastId).
+			Where("(vault_key_id IS NULL OR vault_key_id IN (?))",
+				db.Model(&internal_entity.VaultKey{}).Select("id").Where("status <> ?", type_enums.RECORD_ACTIVE.String()))
+		if len(organizationIds) > 0 {
+			qry = qry.Where("organization_id IN ?", organizationIds)
+		}
+		tx := qry.Order("id").Limit(reencryptBatchSize).Find(&vaults)
+		if tx.Error != nil {
+			vks.logger.Errorf("unable to get vaults to re-encrypt %v", tx.Error)
+			return reencrypted, tx.Error
+		}
+		if len(vaults) == 0 {
+			return reencrypted, nil
+		}
+
+		for _, vlt := range vaults {
+			lastId = vlt.Id
+			previous := vlt.VaultKeyId
+			if err := vks.keyring.open(ctx, vlt); err != nil {
+				return reencrypted, err
+			}
+			if err := vks.keyring.seal(ctx, vlt); err != nil {
+				return reencrypted, err
+			}
+			// a vault re-encrypted concurrently is left as is
+			tx := db.Model(&internal_entity.Vault{}).
+				Where("id = ? AND vault_key_id IS NOT DISTINCT FROM ?", vlt.Id, previous).
+				Updates(map[string]interface{}{
+					"vault_key_id":    vlt.VaultKeyId,
+					"encrypted_value": vlt.EncryptedValue,
+					"value":           vlt.Value,
+				})
+			if tx.Error != nil {
+				vks.logger.Errorf("unable to re-encrypt vault %d: %v", vlt.Id, tx.Error)
+				return reencrypted, tx.Error
+			}
+			reencrypted += int(tx.RowsAffected)
+		}
+	}
+}
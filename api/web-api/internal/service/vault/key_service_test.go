@@ -0,0 +1,136 @@
+package internal_vault_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	internal_entity "github.com/rapidaai/api/web-api/internal/entity"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/kms"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+func newTestKeyService(postgres *testPostgres, keyManager kms.KeyManager) *vaultKeyService {
+	logger, _ := commons.NewApplicationLogger()
+	return NewVaultKeyService(logger, postgres, keyManager).(*vaultKeyService)
+}
+
+// storedVaults returns the vaults as stored, opened with the keyring of vks.
+func storedVaults(t *testing.T, postgres *testPostgres, vks *vaultKeyService) map[uint64]*internal_entity.Vault {
+	var vaults []*internal_entity.Vault
+	require.NoError(t, postgres.db.Order("id").Find(&vaults).Error)
+	out := make(map[uint64]*internal_entity.Vault, len(vaults))
+	for _, vlt := range vaults {
+		require.NotNil(t, vlt.VaultKeyId, "vault %d is not encrypted", vlt.Id)
+		keyId := *vlt.VaultKeyId
+		require.NoError(t, vks.keyring.open(context.Background(), vlt))
+		vlt.VaultKeyId = &keyId
+		out[vlt.Id] = vlt
+	}
+	return out
+}
+
+func activeKeys(t *testing.T, postgres *testPostgres) map[uint64]uint64 {
+	var vaultKeys []*internal_entity.VaultKey
+	require.NoError(t, postgres.db.Where("status = ?", type_enums.RECORD_ACTIVE.String()).Find(&vaultKeys).Error)
+	out := make(map[uint64]uint64, len(vaultKeys))
+	for _, vaultKey := range vaultKeys {
+		out[vaultKey.OrganizationId] = vaultKey.Id
+	}
+	return out
+}
+
+func TestVaultKeyService_ReencryptPlaintext(t *testing.T) {
+	ctx := context.Background()
+	postgres := newTestPostgres(t)
+	vks := newTestKeyService(postgres, newTestKeyManager("k1:"+masterKey(1), ""))
+	for i, organizationId := range []uint64{10, 10, 20} {
+		require.NoError(t, postgres.db.Create(testVault(uint64(i+1), organizationId, gorm_types.InterfaceMap{"key": "secret"})).Error)
+	}
+
+	reencrypted, err := vks.Reencrypt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, reencrypted)
+
+	vaults := storedVaults(t, postgres, vks)
+	keys := activeKeys(t, postgres)
+	for id, organizationId := range map[uint64]uint64{1: 10, 2: 10, 3: 20} {
+		assert.Equal(t, "secret", vaults[id].Value["key"])
+		assert.Equal(t, keys[organizationId], *vaults[id].VaultKeyId)
+	}
+
+	// encrypted vaults are left as they are
+	reencrypted, err = vks.Reencrypt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, reencrypted)
+}
+
+func TestVaultKeyService_RotateOrganization(t *testing.T) {
+	ctx := context.Background()
+	postgres := newTestPostgres(t)
+	vks := newTestKeyService(postgres, newTestKeyManager("k1:"+masterKey(1), ""))
+	for i, organizationId := range []uint64{10, 10, 20} {
+		require.NoError(t, postgres.db.Create(testVault(uint64(i+1), organizationId, gorm_types.InterfaceMap{"key": "secret"})).Error)
+	}
+	_, err := vks.Reencrypt(ctx)
+	require.NoError(t, err)
+	before := activeKeys(t, postgres)
+
+	// only the key of the organization is retired and replaced
+	rotated, err := vks.Rotate(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rotated)
+	reencrypted, err := vks.Reencrypt(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, reencrypted)
+
+	after := activeKeys(t, postgres)
+	assert.NotEqual(t, before[10], after[10])
+	assert.Equal(t, before[20], after[20])
+	vaults := storedVaults(t, postgres, vks)
+	for _, id := range []uint64{1, 2} {
+		assert.Equal(t, after[10], *vaults[id].VaultKeyId)
+		assert.Equal(t, "secret", vaults[id].Value["key"])
+	}
+	assert.Equal(t, before[20], *vaults[3].VaultKeyId)
+
+	// without organizations every key is retired
+	rotated, err = vks.Rotate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	reencrypted, err = vks.Reencrypt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, reencrypted)
+	for id, vlt := range storedVaults(t, postgres, vks) {
+		assert.NotEqual(t, before[vlt.OrganizationId], *vlt.VaultKeyId, "vault %d", id)
+		assert.NotEqual(t, after[vlt.OrganizationId], *vlt.VaultKeyId, "vault %d", id)
+		assert.Equal(t, "secret", vlt.Value["key"])
+	}
+}
+
+func TestVaultKeyService_Rewrap(t *testing.T) {
+	ctx := context.Background()
+	postgres := newTestPostgres(t)
+	previous := newTestKeyService(postgres, newTestKeyManager("k1:"+masterKey(1), ""))
+	require.NoError(t, postgres.db.Create(testVault(1, 10, gorm_types.InterfaceMap{"key": "secret"})).Error)
+	_, err := previous.Reencrypt(ctx)
+	require.NoError(t, err)
+
+	// after a rotation of the master key data keys are wrapped with the new one
+	current := newTestKeyService(postgres, newTestKeyManager("k1:"+masterKey(1)+",k2:"+masterKey(2), "k2"))
+	rewrapped, err := current.Rewrap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rewrapped)
+
+	var vaultKey internal_entity.VaultKey
+	require.NoError(t, postgres.db.First(&vaultKey).Error)
+	assert.Equal(t, "k2", vaultKey.MasterKeyId)
+
+	// the data key is unchanged, credentials open without the previous master key
+	only := newTestKeyService(postgres, newTestKeyManager("k2:"+masterKey(2), ""))
+	assert.Equal(t, "secret", storedVaults(t, postgres, only)[1].Value["key"])
+}
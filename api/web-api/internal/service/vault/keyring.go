@@ -0,0 +1,191 @@
+package internal_vault_service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm/clause"
+
+	internal_entity "github.com/rapidaai/api/web-api/internal/entity"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+	type_enums "github.com/rapidaai/pkg/types/enums"
+)
+
+const dataKeySize = 32
+
+// keyring seals and opens credentials with the data keys of organizations,
+// unwrapped data keys are kept in memory by vault key id.
+type keyring struct {
+	logger     commons.Logger
+	postgres   connectors.PostgresConnector
+	keyManager kms.KeyManager
+
+	mu   sync.RWMutex
+	keys map[uint64]cipher.AEAD
+}
+
+func newKeyring(logger commons.Logger, postgres connectors.PostgresConnector, keyManager kms.KeyManager) *keyring {
+	return &keyring{
+		logger:     logger,
+		postgres:   postgres,
+		keyManager: keyManager,
+		keys:       make(map[uint64]cipher.AEAD),
+	}
+}
+
+// seal encrypts the value of a vault with the active data key of its
+// organization and clears its plain text value.
+func (k *keyring) seal(ctx context.Context, vlt *internal_entity.Vault) error {
+	vaultKey, err := k.active(ctx, vlt.OrganizationId)
+	if err != nil {
+		return err
+	}
+	aead, err := k.aead(ctx, vaultKey)
+	if err != nil {
+		return err
+	}
+	plaintext, err := json.Marshal(vlt.Value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal credential: %w", err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, additionalData(vlt, vaultKey.Id))
+	vlt.VaultKeyId = &vaultKey.Id
+	vlt.EncryptedValue = base64.StdEncoding.EncodeToString(sealed)
+	vlt.Value = gorm_types.InterfaceMap{}
+	return nil
+}
+
+// open decrypts the value of a vault, values of vaults that are not
+// encrypted yet are left as stored.
+func (k *keyring) open(ctx context.Context, vlt *internal_entity.Vault) error {
+	if vlt.VaultKeyId == nil {
+		return nil
+	}
+	aead, err := k.byId(ctx, *vlt.VaultKeyId)
+	if err != nil {
+		return err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(vlt.EncryptedValue)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return fmt.Errorf("illegal encrypted value of vault %d", vlt.Id)
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData(vlt, *vlt.VaultKeyId))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt vault %d: %w", vlt.Id, err)
+	}
+	value := gorm_types.InterfaceMap{}
+	if err := json.Unmarshal(plaintext, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal credential: %w", err)
+	}
+	vlt.Value = value
+	return nil
+}
+
+// additionalData binds a sealed value to its vault, organization and data
+// key so it can not be copied into another row.
+func additionalData(vlt *internal_entity.Vault, vaultKeyId uint64) []byte {
+	return []byte(fmt.Sprintf("vault:%d:%d:%d", vlt.Id, vlt.OrganizationId, vaultKeyId))
+}
+
+// active returns the active data key of an organization, creating it on
+// first use or after a rotation.
+func (k *keyring) active(ctx context.Context, organizationId uint64) (*internal_entity.VaultKey, error) {
+	db := k.postgres.DB(ctx)
+	var vaultKey internal_entity.VaultKey
+	tx := db.Where("organization_id = ? AND status = ?", organizationId, type_enums.RECORD_ACTIVE.String()).
+		Limit(1).Find(&vaultKey)
+	if tx.Error != nil {
+		k.logger.Errorf("unable to get active vault key of organization %d: %v", organizationId, tx.Error)
+		return nil, tx.Error
+	}
+	if tx.RowsAffected > 0 {
+		return &vaultKey, nil
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	wrapped, err := k.keyManager.Wrap(ctx, dataKey)
+	if err != nil {
+		k.logger.Errorf("unable to wrap data key of organization %d: %v", organizationId, err)
+		return nil, err
+	}
+	vaultKey = internal_entity.VaultKey{
+		OrganizationId: organizationId,
+		MasterKeyId:    k.keyManager.KeyId(),
+		WrappedKey:     base64.StdEncoding.EncodeToString(wrapped),
+		Status:         type_enums.RECORD_ACTIVE,
+	}
+	// an organization has a single active key, a key created concurrently wins
+	tx = db.Clauses(clause.OnConflict{DoNothing: true}).Create(&vaultKey)
+	if tx.Error != nil {
+		k.logger.Errorf("unable to create vault key of organization %d: %v", organizationId, tx.Error)
+		return nil, tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return k.active(ctx, organizationId)
+	}
+	return &vaultKey, nil
+}
+
+func (k *keyring) byId(ctx context.Context, vaultKeyId uint64) (cipher.AEAD, error) {
+	k.mu.RLock()
+	aead, ok := k.keys[vaultKeyId]
+	k.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+	var vaultKey internal_entity.VaultKey
+	tx := k.postgres.DB(ctx).Where("id = ?", vaultKeyId).First(&vaultKey)
+	if tx.Error != nil {
+		k.logger.Errorf("unable to get vault key %d: %v", vaultKeyId, tx.Error)
+		return nil, tx.Error
+	}
+	return k.aead(ctx, &vaultKey)
+}
+
+// aead unwraps a data key with the master key it was wrapped with.
+func (k *keyring) aead(ctx context.Context, vaultKey *internal_entity.VaultKey) (cipher.AEAD, error) {
+	k.mu.RLock()
+	aead, ok := k.keys[vaultKey.Id]
+	k.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(vaultKey.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("illegal wrapped key of vault key %d: %w", vaultKey.Id, err)
+	}
+	dataKey, err := k.keyManager.Unwrap(ctx, vaultKey.MasterKeyId, wrapped)
+	if err != nil {
+		k.logger.Errorf("unable to unwrap vault key %d: %v", vaultKey.Id, err)
+		return nil, err
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("illegal data key of vault key %d: %w", vaultKey.Id, err)
+	}
+	aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("illegal data key of vault key %d: %w", vaultKey.Id, err)
+	}
+	k.mu.Lock()
+	k.keys[vaultKey.Id] = aead
+	k.mu.Unlock()
+	return aead, nil
+}
@@ -0,0 +1,141 @@
+package internal_vault_service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	internal_entity "github.com/rapidaai/api/web-api/internal/entity"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
+	gorm_types "github.com/rapidaai/pkg/models/gorm/types"
+)
+
+// testPostgres serves an in-memory sqlite database as the postgres connector.
+type testPostgres struct {
+	connectors.PostgresConnector
+	db *gorm.DB
+}
+
+func (p *testPostgres) DB(ctx context.Context) *gorm.DB {
+	return p.db.WithContext(ctx)
+}
+
+func newTestPostgres(t *testing.T) *testPostgres {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	// the tables of the migrations, with their single active key of an organization
+	for _, ddl := range []string{
+		`CREATE TABLE vaults (
+			id bigint PRIMARY KEY, created_date timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_date timestamp,
+			status varchar(50) NOT NULL DEFAULT 'ACTIVE', created_by bigint NOT NULL DEFAULT 0, updated_by bigint,
+			project_id bigint NOT NULL DEFAULT 0, organization_id bigint NOT NULL,
+			provider varchar(200) NOT NULL, name varchar(200) NOT NULL, value text NOT NULL,
+			vault_key_id bigint, encrypted_value text)`,
+		`CREATE TABLE vault_keys (
+			id bigint PRIMARY KEY, created_date timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, updated_date timestamp,
+			organization_id bigint NOT NULL, master_key_id varchar(2048) NOT NULL, wrapped_key text NOT NULL,
+			status varchar(50) NOT NULL DEFAULT 'ACTIVE')`,
+		`CREATE UNIQUE INDEX idx_vault_keys_active ON vault_keys (organization_id) WHERE status = 'ACTIVE'`,
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+	return &testPostgres{db: db}
+}
+
+func masterKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32))
+}
+
+func newTestKeyManager(localKeys, keyId string) kms.KeyManager {
+	logger, _ := commons.NewApplicationLogger()
+	return kms.NewLocalKeyManager(configs.KMSConfig{LocalKeys: localKeys, KeyId: keyId}, "", logger)
+}
+
+func newTestKeyring(postgres connectors.PostgresConnector) *keyring {
+	logger, _ := commons.NewApplicationLogger()
+	return newKeyring(logger, postgres, newTestKeyManager("k1:"+masterKey(1), ""))
+}
+
+func testVault(id, organizationId uint64, value gorm_types.InterfaceMap) *internal_entity.Vault {
+	vlt := &internal_entity.Vault{Provider: "openai", Name: "key", Value: value}
+	vlt.Id = id
+	vlt.OrganizationId = organizationId
+	return vlt
+}
+
+func TestKeyring_SealOpen(t *testing.T) {
+	ctx := context.Background()
+	postgres := newTestPostgres(t)
+	k := newTestKeyring(postgres)
+
+	vlt := testVault(1, 10, gorm_types.InterfaceMap{"key": "secret"})
+	require.NoError(t, k.seal(ctx, vlt))
+	require.NotNil(t, vlt.VaultKeyId)
+	assert.Empty(t, vlt.Value)
+	assert.NotContains(t, vlt.EncryptedValue, "secret")
+
+	require.NoError(t, k.open(ctx, vlt))
+	assert.Equal(t, "secret", vlt.Value["key"])
+
+	// a keyring without the data key in memory unwraps it from its row
+	fresh := newTestKeyring(postgres)
+	opened := testVault(1, 10, nil)
+	opened.VaultKeyId, opened.EncryptedValue = vlt.VaultKeyId, vlt.EncryptedValue
+	require.NoError(t, fresh.open(ctx, opened))
+	assert.Equal(t, "secret", opened.Value["key"])
+}
+
+func TestKeyring_OpenPlaintext(t *testing.T) {
+	k := newTestKeyring(newTestPostgres(t))
+	vlt := testVault(1, 10, gorm_types.InterfaceMap{"key": "secret"})
+	require.NoError(t, k.open(context.Background(), vlt))
+	assert.Equal(t, "secret", vlt.Value["key"])
+}
+
+func TestKeyring_KeyPerOrganization(t *testing.T) {
+	ctx := context.Background()
+	k := newTestKeyring(newTestPostgres(t))
+
+	first, second, other := testVault(1, 10, nil), testVault(2, 10, nil), testVault(3, 20, nil)
+	for _, vlt := range []*internal_entity.Vault{first, second, other} {
+		require.NoError(t, k.seal(ctx, vlt))
+	}
+	assert.Equal(t, *first.VaultKeyId, *second.VaultKeyId)
+	assert.NotEqual(t, *first.VaultKeyId, *other.VaultKeyId)
+}
+
+func TestKeyring_SealedValueIsBoundToItsVault(t *testing.T) {
+	ctx := context.Background()
+	k := newTestKeyring(newTestPostgres(t))
+
+	vlt := testVault(1, 10, gorm_types.InterfaceMap{"key": "secret"})
+	require.NoError(t, k.seal(ctx, vlt))
+
+	// the sealed value copied into another vault of the organization
+	copied := testVault(2, 10, nil)
+	copied.VaultKeyId, copied.EncryptedValue = vlt.VaultKeyId, vlt.EncryptedValue
+	assert.Error(t, k.open(ctx, copied))
+	assert.Empty(t, copied.Value)
+
+	// or into a vault of the same id claiming another organization
+	moved := testVault(1, 20, nil)
+	moved.VaultKeyId, moved.EncryptedValue = vlt.VaultKeyId, vlt.EncryptedValue
+	assert.Error(t, k.open(ctx, moved))
+
+	// or tampered with
+	tampered := testVault(1, 10, nil)
+	sealed, err := base64.StdEncoding.DecodeString(vlt.EncryptedValue)
+	require.NoError(t, err)
+	sealed[len(sealed)-1] ^= 1
+	tampered.VaultKeyId, tampered.EncryptedValue = vlt.VaultKeyId, base64.StdEncoding.EncodeToString(sealed)
+	assert.Error(t, k.open(ctx, tampered))
+}
@@ -10,7 +10,9 @@ import (
 	internal_services "github.com/rapidaai/api/web-api/internal/service"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
 	gorm_models "github.com/rapidaai/pkg/models/gorm"
+	gorm_generator "github.com/rapidaai/pkg/models/gorm/generators"
 	"github.com/rapidaai/pkg/types"
 	type_enums "github.com/rapidaai/pkg/types/enums"
 	web_api "github.com/rapidaai/protos"
@@ -19,12 +21,16 @@ import (
 type vaultService struct {
 	logger   commons.Logger
 	postgres connectors.PostgresConnector
+	keyring  *keyring
 }
 
-func NewVaultService(logger commons.Logger, postgres connectors.PostgresConnector) internal_services.VaultService {
+// NewVaultService creates the vault service, credentials are encrypted at rest
+// with data keys wrapped by the master key of keyManager.
+func NewVaultService(logger commons.Logger, postgres connectors.PostgresConnector, keyManager kms.KeyManager) internal_services.VaultService {
 	return &vaultService{
 		logger:   logger,
 		postgres: postgres,
+		keyring:  newKeyring(logger, postgres, keyManager),
 	}
 }
 
@@ -34,6 +40,10 @@ func (vs *vaultService) Create(ctx context.Context,
 	name string, credential map[string]interface{}) (*internal_entity.Vault, error) {
 	db := vs.postgres.DB(ctx)
 	vlt := &internal_entity.Vault{
+		// the id is part of the sealed value
+		Audited: gorm_models.Audited{
+			Id: gorm_generator.ID(),
+		},
 		Mutable: gorm_models.Mutable{
 			CreatedBy: *auth.GetUserId(),
 		},
@@ -45,12 +55,17 @@ func (vs *vaultService) Create(ctx context.Context,
 		Provider: provider,
 		Value:    credential,
 	}
+	if err := vs.keyring.seal(ctx, vlt); err != nil {
+		vs.logger.Errorf("unable to encrypt organization credentials %v", err)
+		return nil, err
+	}
 
-	tx := db.Save(vlt)
+	tx := db.Create(vlt)
 	if err := tx.Error; err != nil {
 		vs.logger.Debugf("unable to create organization credentials for tool %v", err)
 		return nil, err
 	}
+	vlt.Value = credential
 	return vlt, nil
 }
 
@@ -124,6 +139,10 @@ func (vS *vaultService) Get(ctx context.Context, auth types.SimplePrinciple, id
 		vS.logger.Errorf("get credential error  %v", tx.Error)
 		return nil, tx.Error
 	}
+	if err := vS.keyring.open(ctx, &vault); err != nil {
+		vS.logger.Errorf("unable to decrypt credential %v", err)
+		return nil, err
+	}
 	return &vault, nil
 }
 
@@ -139,5 +158,9 @@ func (vS *vaultService) GetProviderCredential(ctx context.Context, auth types.Si
 		vS.logger.Errorf("get credential error  %v", tx.Error)
 		return nil, tx.Error
 	}
+	if err := vS.keyring.open(ctx, &vault); err != nil {
+		vS.logger.Errorf("unable to decrypt credential %v", err)
+		return nil, err
+	}
 	return &vault, nil
 }
@@ -0,0 +1,6 @@
+-- credentials encrypted since this migration only exist in encrypted_value,
+-- they are lost when rolling back.
+DROP INDEX IF EXISTS idx_vlts_vault_key_id;
+ALTER TABLE public.vaults DROP COLUMN IF EXISTS encrypted_value;
+ALTER TABLE public.vaults DROP COLUMN IF EXISTS vault_key_id;
+DROP TABLE IF EXISTS public.vault_keys;
@@ -0,0 +1,23 @@
+CREATE TABLE public.vault_keys (
+    id bigint NOT NULL,
+    created_date timestamp without time zone DEFAULT now() NOT NULL,
+    updated_date timestamp without time zone,
+    organization_id bigint NOT NULL,
+    master_key_id character varying(2048) NOT NULL,
+    wrapped_key text NOT NULL,
+    status character varying(50) DEFAULT 'ACTIVE'::character varying NOT NULL
+);
+
+ALTER TABLE ONLY public.vault_keys
+    ADD CONSTRAINT vault_keys_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_vault_keys_organization_id ON public.vault_keys USING btree (organization_id);
+-- an organization seals new credentials with its only active data key
+CREATE UNIQUE INDEX idx_vault_keys_active ON public.vault_keys USING btree (organization_id) WHERE status = 'ACTIVE';
+
+-- encrypted credentials keep an empty value, plain text credentials are
+-- encrypted on startup
+ALTER TABLE public.vaults ADD COLUMN vault_key_id bigint;
+ALTER TABLE public.vaults ADD COLUMN encrypted_value text;
+
+CREATE INDEX idx_vlts_vault_key_id ON public.vaults USING btree (vault_key_id);
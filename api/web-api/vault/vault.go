@@ -0,0 +1,20 @@
+package web_vault
+
+import (
+	"github.com/rapidaai/api/web-api/config"
+	internal_service "github.com/rapidaai/api/web-api/internal/service"
+	internal_vault_service "github.com/rapidaai/api/web-api/internal/service/vault"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/kms"
+)
+
+// GetKeyManager returns the key manager of the master key credentials are
+// encrypted with.
+func GetKeyManager(cfg *config.WebAppConfig, logger commons.Logger) kms.KeyManager {
+	return kms.NewKeyManager(cfg.KMSConfig, cfg.Secret, logger)
+}
+
+func GetVaultKeyService(cfg *config.WebAppConfig, logger commons.Logger, postgres connectors.PostgresConnector) internal_service.VaultKeyService {
+	return internal_vault_service.NewVaultKeyService(logger, postgres, GetKeyManager(cfg, logger))
+}
@@ -23,15 +23,20 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	assistant_campaign "github.com/rapidaai/api/assistant-api/campaign"
 	"github.com/rapidaai/api/assistant-api/config"
 	router "github.com/rapidaai/api/assistant-api/router"
 	assistant_sip "github.com/rapidaai/api/assistant-api/sip"
 	sip_infra "github.com/rapidaai/api/assistant-api/sip/infra"
 	assistant_socket "github.com/rapidaai/api/assistant-api/socket"
+	assistant_webhook "github.com/rapidaai/api/assistant-api/webhook"
 	"github.com/rapidaai/pkg/authenticators"
+	"github.com/rapidaai/pkg/authorizers"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
 	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/middlewares"
 	"github.com/soheilhy/cmux"
 	"golang.org/x/sync/errgroup"
@@ -43,11 +48,13 @@ type AppRunner struct {
 	E          *gin.Engine
 	S          *grpc.Server
 	SIP        *sip_infra.Server
+	SIPEngine  *assistant_sip.SIPEngine
 	Cfg        *config.AssistantConfig
 	Logger     commons.Logger
 	Postgres   connectors.PostgresConnector
 	Redis      connectors.RedisConnector
 	Opensearch connectors.OpenSearchConnector
+	VectorDB   connectors.VectorConnector
 	Closeable  []func(context.Context) error
 }
 
@@ -76,6 +83,10 @@ func main() {
 
 	// init
 	authClient := web_client.NewAuthenticator(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	// denials are stored in the audit log of the integration-api
+	auditClient := integration_client.NewAuditServiceClient(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	authorizer := authorizers.NewAuthorizer(appRunner.Logger, authorizers.NewAuditLogAuditor(appRunner.Logger, auditClient))
+	limiter := limiters.NewLimiter(appRunner.Logger, appRunner.Redis, appRunner.Cfg.RateLimitConfig)
 	appRunner.S = grpc.NewServer(
 		grpc.ChainStreamInterceptor(
 			middlewares.NewRequestLoggerStreamServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -98,6 +109,8 @@ func main() {
 			middlewares.NewClientInformationStreamServerMiddleware(
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationStreamServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewRateLimitStreamServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.ChainUnaryInterceptor(
 			middlewares.NewRequestLoggerUnaryServerMiddleware(appRunner.Cfg.AppConfig.Name, appRunner.Logger),
@@ -120,6 +133,8 @@ func main() {
 			middlewares.NewClientInformationUnaryServerMiddleware(
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationUnaryServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewRateLimitUnaryServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.MaxRecvMsgSize(commons.MaxRecvMsgSize), // 10 MB
 		grpc.MaxSendMsgSize(commons.MaxSendMsgSize), // 10 MB
@@ -228,7 +243,20 @@ func (g *AppRunner) AllConnectors() {
 	if g.Cfg.OpenSearchConfig != nil {
 		g.Opensearch = connectors.NewOpenSearchConnector(g.Cfg.OpenSearchConfig, g.Logger)
 	}
-	// g.Weaviate = vdb_connectors.NewWeaviateConnector(&g.Cfg.WeaviateConfig, g.Logger)
+	switch g.Cfg.VectorStore {
+	case config.VectorStorePgVector:
+		if g.Cfg.PgVectorConfig != nil {
+			g.VectorDB = connectors.NewPgVectorConnector(connectors.NewPostgresConnector(g.Cfg.PgVectorConfig, g.Logger), g.Logger)
+		} else {
+			g.VectorDB = connectors.NewPgVectorConnector(g.Postgres, g.Logger)
+		}
+	case config.VectorStoreWeaviate:
+		g.VectorDB = connectors.NewWeaviateConnector(&g.Cfg.WeaviateConfig, g.Logger)
+	default:
+		if g.Opensearch != nil {
+			g.VectorDB = g.Opensearch
+		}
+	}
 }
 
 // initialize the config of application using viper and return loaded appconfig to be used in
@@ -278,6 +306,16 @@ func (app *AppRunner) Init(ctx context.Context) error {
 		}
 		app.Closeable = append(app.Closeable, app.Opensearch.Disconnect)
 	}
+
+	// opensearch as vector store is connected above
+	if app.Cfg.VectorStore != config.VectorStoreOpenSearch {
+		err = app.VectorDB.Connect(ctx)
+		if err != nil {
+			app.Logger.Error("error while connecting to vector store.", app.Cfg.VectorStore, err)
+			return err
+		}
+		app.Closeable = append(app.Closeable, app.VectorDB.Disconnect)
+	}
 	app.Closeable = append(app.Closeable, app.Postgres.Disconnect)
 	app.Closeable = append(app.Closeable, app.Redis.Disconnect)
 
@@ -299,15 +337,16 @@ func (app *AppRunner) Close(ctx context.Context) {
 
 // all router initialize
 func (g *AppRunner) AllRouters(ctx context.Context) error {
-	router.AssistantApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch)
+	router.AssistantApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch, g.VectorDB, g.SIPEngine)
 	router.HealthCheckRoutes(g.Cfg, g.E, g.Logger, g.Postgres)
-	if g.Opensearch != nil {
-		router.KnowledgeApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch)
-		router.DocumentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch)
+	if g.VectorDB != nil {
+		router.KnowledgeApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.VectorDB)
+		router.DocumentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.VectorDB)
 	}
-	router.AssistantConversationApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch, g.SIP)
-	router.AssistantDeploymentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres)
-	router.TalkCallbackApiRoute(g.Cfg, g.E, g.Logger, g.Postgres, g.Redis, g.Opensearch, g.SIP)
+	router.AssistantConversationApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.Redis, g.Opensearch, g.VectorDB, g.SIP)
+	router.AssistantDeploymentApiRoute(g.Cfg, g.S, g.Logger, g.Postgres, g.SIPEngine)
+	router.TalkCallbackApiRoute(g.Cfg, g.E, g.Logger, g.Postgres, g.Redis, g.Opensearch, g.VectorDB, g.SIP)
+	router.WhatsappApiRoute(g.Cfg, g.E, g.Logger, g.Postgres, g.Redis, g.Opensearch, g.VectorDB, g.SIP)
 	return nil
 }
 
@@ -316,23 +355,38 @@ func (app *AppRunner) AllEngine(ctx context.Context) error {
 
 	// SIP is optional and only started if configured. It listens for SIP calls from telephony providers for both inbound call handling and outbound call dispatch.
 	if app.Cfg.SIPConfig != nil {
-		sipManager := assistant_sip.NewSIPEngine(app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch, app.Opensearch)
+		sipManager := assistant_sip.NewSIPEngine(app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch, app.VectorDB)
 		if err := sipManager.Connect(ctx); err != nil {
 			app.Logger.Errorf("Failed to start SIP server: %v", err)
 			return err
 		}
 		app.SIP = sipManager.GetServer()
+		app.SIPEngine = sipManager
 		app.Closeable = append(app.Closeable, sipManager.Disconnect)
 	}
 	// AudioSocket is optional and only started if configured. It listens for TCP connections from telephony providers for audio streaming in calls.
 	if app.Cfg.AudioSocketConfig != nil {
-		socketEngine := assistant_socket.NewAudioSocketEngine(app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch)
+		socketEngine := assistant_socket.NewAudioSocketEngine(app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch, app.VectorDB)
 		if err := socketEngine.Connect(ctx); err != nil {
 			return err
 		}
 		app.Closeable = append(app.Closeable, socketEngine.Disconnect)
 	}
 
+	// webhook dispatcher delivers the webhooks queued by conversations, with retries and dead-lettering.
+	webhookDispatcher := assistant_webhook.NewWebhookDispatcher(app.Cfg, app.Logger, app.Postgres, app.Redis)
+	if err := webhookDispatcher.Connect(ctx); err != nil {
+		return err
+	}
+	app.Closeable = append(app.Closeable, webhookDispatcher.Disconnect)
+
+	// campaign engine places the calls of outbound campaigns, it dials SIP trunks through the shared SIP server.
+	campaignEngine := assistant_campaign.NewCampaignEngine(app.Cfg, app.Logger, app.Postgres, app.Redis, app.Opensearch, app.SIP)
+	if err := campaignEngine.Connect(ctx); err != nil {
+		return err
+	}
+	app.Closeable = append(app.Closeable, campaignEngine.Disconnect)
+
 	return nil
 }
 
@@ -342,6 +396,7 @@ func (g *AppRunner) AllMiddlewares(ctx context.Context) error {
 	g.CorsMiddleware()
 	g.RequestLoggerMiddleware()
 	g.AuthenticationMiddleware()
+	g.RateLimitMiddleware()
 	return nil
 }
 
@@ -350,6 +405,11 @@ func (g *AppRunner) RecoveryMiddleware() {
 	g.E.Use(gin.Recovery())
 }
 
+// RateLimitMiddleware applies the request limits of projects to the rest apis, after authentication.
+func (g *AppRunner) RateLimitMiddleware() {
+	g.E.Use(middlewares.NewRateLimitMiddleware(limiters.NewLimiter(g.Logger, g.Redis, g.Cfg.RateLimitConfig), g.Logger))
+}
+
 func (g *AppRunner) AuthenticationMiddleware() {
 	g.E.Use(middlewares.NewAuthenticationMiddleware(
 		authenticators.NewUserAuthenticator(&g.Cfg.AppConfig,
@@ -30,9 +30,12 @@ import (
 	"github.com/rapidaai/api/endpoint-api/config"
 	router "github.com/rapidaai/api/endpoint-api/router"
 	authenticators "github.com/rapidaai/pkg/authenticators"
+	"github.com/rapidaai/pkg/authorizers"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
 	web_client "github.com/rapidaai/pkg/clients/web"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/middlewares"
 )
 
@@ -72,6 +75,10 @@ func main() {
 
 	// init
 	authClient := web_client.NewAuthenticator(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	// denials are stored in the audit log of the integration-api
+	auditClient := integration_client.NewAuditServiceClient(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	authorizer := authorizers.NewAuthorizer(appRunner.Logger, authorizers.NewAuditLogAuditor(appRunner.Logger, auditClient))
+	limiter := limiters.NewLimiter(appRunner.Logger, appRunner.Redis, appRunner.Cfg.RateLimitConfig)
 	appRunner.S = grpc.NewServer(
 		grpc.ChainStreamInterceptor(
 			middlewares.NewRequestLoggerStreamServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -91,6 +98,8 @@ func main() {
 			middlewares.NewClientInformationStreamServerMiddleware(
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationStreamServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewRateLimitStreamServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.ChainUnaryInterceptor(
 			middlewares.NewRequestLoggerUnaryServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -110,6 +119,8 @@ func main() {
 			middlewares.NewClientInformationUnaryServerMiddleware(
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationUnaryServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewRateLimitUnaryServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.MaxRecvMsgSize(commons.MaxRecvMsgSize), // 10 MB
 		grpc.MaxSendMsgSize(commons.MaxSendMsgSize), // 10 MB
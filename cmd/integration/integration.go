@@ -34,8 +34,11 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/rapidaai/pkg/authenticators"
+	"github.com/rapidaai/pkg/authorizers"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/limiters"
 )
 
 // wrapper for gin engine
@@ -74,6 +77,10 @@ func main() {
 
 	// interservice communication is authenticated now
 	authClient := web_client.NewAuthenticator(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	// denials are stored in the audit log of the integration-api
+	auditClient := integration_client.NewAuditServiceClient(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	authorizer := authorizers.NewAuthorizer(appRunner.Logger, authorizers.NewAuditLogAuditor(appRunner.Logger, auditClient))
+	limiter := limiters.NewLimiter(appRunner.Logger, appRunner.Redis, appRunner.Cfg.RateLimitConfig)
 	appRunner.S = grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			middlewares.NewRequestLoggerUnaryServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -86,6 +93,8 @@ func main() {
 				authenticators.NewProjectAuthenticator(&appRunner.Cfg.AppConfig, appRunner.Logger, authClient),
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationUnaryServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewUsageUnaryServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.ChainStreamInterceptor(
 			middlewares.NewRequestLoggerStreamServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -98,6 +107,8 @@ func main() {
 				authenticators.NewProjectAuthenticator(&appRunner.Cfg.AppConfig, appRunner.Logger, authClient),
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationStreamServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewUsageStreamServerMiddleware(limiter, appRunner.Logger),
 		),
 	)
 	// init
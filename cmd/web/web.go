@@ -31,12 +31,18 @@ import (
 	web_authenticators "github.com/rapidaai/api/web-api/authenticator"
 	"github.com/rapidaai/api/web-api/config"
 	web_router "github.com/rapidaai/api/web-api/router"
+	web_vault "github.com/rapidaai/api/web-api/vault"
 	"github.com/rapidaai/pkg/authenticators"
+	"github.com/rapidaai/pkg/authorizers"
+	integration_client "github.com/rapidaai/pkg/clients/integration"
 	"github.com/rapidaai/pkg/commons"
 	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/limiters"
 	"github.com/rapidaai/pkg/middlewares"
 )
 
+var rotateVaultKeys = flag.Bool("rotate-vault-keys", false, "Rotate the data keys of vault credentials on startup, eg: -rotate-vault-keys")
+
 // wrapper for gin engine
 type AppRunner struct {
 	E         *gin.Engine
@@ -72,6 +78,14 @@ func main() {
 	}
 
 	// init
+	// denials are stored in the audit log of the integration-api
+	auditClient := integration_client.NewAuditServiceClient(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Redis)
+	authorizer := authorizers.NewAuthorizer(appRunner.Logger, authorizers.NewAuditLogAuditor(appRunner.Logger, auditClient))
+	limiter := limiters.NewLimiter(appRunner.Logger, appRunner.Redis, appRunner.Cfg.RateLimitConfig)
+	// the monthly quotas of this service are enforced by every service
+	if err := limiter.PublishQuota(context.Background()); err != nil {
+		appRunner.Logger.Errorf("unable to publish monthly quotas %v", err)
+	}
 	appRunner.S = grpc.NewServer(
 		grpc.ChainStreamInterceptor(
 			middlewares.NewRequestLoggerStreamServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -81,6 +95,8 @@ func main() {
 				appRunner.Logger),
 			middlewares.NewProjectAuthenticatorStreamServerMiddleware(web_authenticators.GetProjectAuthenticator(appRunner.Logger, appRunner.Postgres),
 				appRunner.Logger),
+			middlewares.NewAuthorizationStreamServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewRateLimitStreamServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.ChainUnaryInterceptor(
 			middlewares.NewRequestLoggerUnaryServerMiddleware(appRunner.Cfg.Name, appRunner.Logger),
@@ -94,6 +110,8 @@ func main() {
 				authenticators.NewServiceAuthenticator(&appRunner.Cfg.AppConfig, appRunner.Logger, appRunner.Postgres),
 				appRunner.Logger,
 			),
+			middlewares.NewAuthorizationUnaryServerMiddleware(authorizer, appRunner.Logger),
+			middlewares.NewRateLimitUnaryServerMiddleware(limiter, appRunner.Logger),
 		),
 		grpc.MaxRecvMsgSize(commons.MaxRecvMsgSize), // 10 MB
 		grpc.MaxSendMsgSize(commons.MaxSendMsgSize), // 10 MB
@@ -102,6 +120,12 @@ func main() {
 		panic(err)
 	}
 
+	// encrypt vault credentials stored in plain text or with retired keys
+	if err := appRunner.EncryptVault(ctx); err != nil {
+		appRunner.Logger.Errorf("Vault encryption failed: %v", err)
+		panic(err)
+	}
+
 	// add all middleware depends on configurations
 	appRunner.AllMiddlewares()
 
@@ -263,6 +287,7 @@ func (g *AppRunner) AllMiddlewares() {
 	g.CorsMiddleware()
 	g.RequestLoggerMiddleware()
 	g.E.Use(middlewares.NewAuthenticationMiddleware(web_authenticators.GetUserAuthenticator(g.Logger, g.Postgres), g.Logger))
+	g.E.Use(middlewares.NewRateLimitMiddleware(limiters.NewLimiter(g.Logger, g.Redis, g.Cfg.RateLimitConfig), g.Logger))
 }
 
 // Recovery middleware
@@ -289,6 +314,30 @@ func (g *AppRunner) RequestLoggerMiddleware() {
 	g.E.Use(middlewares.NewRequestLoggerMiddleware(g.Cfg.Name, g.Logger))
 }
 
+// EncryptVault wraps data keys with the current master key and encrypts
+// credentials that are stored in plain text or with a retired data key,
+// rotating every data key first when -rotate-vault-keys is provided.
+func (app *AppRunner) EncryptVault(ctx context.Context) error {
+	keyService := web_vault.GetVaultKeyService(app.Cfg, app.Logger, app.Postgres)
+	rewrapped, err := keyService.Rewrap(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to rewrap vault keys: %w", err)
+	}
+	if *rotateVaultKeys {
+		rotated, err := keyService.Rotate(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to rotate vault keys: %w", err)
+		}
+		app.Logger.Infof("Rotated %d vault keys.", rotated)
+	}
+	reencrypted, err := keyService.Reencrypt(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt vault credentials: %w", err)
+	}
+	app.Logger.Infof("Vault encryption completed, rewrapped %d keys and encrypted %d credentials.", rewrapped, reencrypted)
+	return nil
+}
+
 func (app *AppRunner) Migrate() error {
 	skipMigration := flag.Bool("skip-migration", false, "Skip migration when provided, eg: -skip-migration")
 	flag.Parse()
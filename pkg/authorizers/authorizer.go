@@ -0,0 +1,159 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package authorizers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/pkg/utils"
+	"github.com/rapidaai/protos"
+)
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// Denial describes a request refused for a missing permission.
+type Denial struct {
+	Method           string
+	Permission       Permission
+	PrincipalType    string
+	UserId           *uint64
+	OrganizationId   *uint64
+	ProjectId        *uint64
+	OrganizationRole string
+	ProjectRole      string
+}
+
+// Auditor records denied requests in the audit log.
+type Auditor interface {
+	Denied(ctx context.Context, denial *Denial)
+}
+
+type Authorizer interface {
+	// Authorize returns ErrPermissionDenied when auth does not hold the
+	// permission required by the gRPC method.
+	Authorize(ctx context.Context, auth types.SimplePrinciple, method string) error
+}
+
+type authorizer struct {
+	logger      commons.Logger
+	auditor     Auditor
+	permissions map[string]Permission
+}
+
+// NewAuthorizer creates an authorizer enforcing MethodPermissions.
+func NewAuthorizer(logger commons.Logger, auditor Auditor) Authorizer {
+	return &authorizer{
+		logger:      logger,
+		auditor:     auditor,
+		permissions: MethodPermissions,
+	}
+}
+
+func (a *authorizer) Authorize(ctx context.Context, auth types.SimplePrinciple, method string) error {
+	required, ok := a.permissions[method]
+	if !ok {
+		// trusted internal services may call methods outside of the policy
+		if auth.Type() == "service" {
+			return nil
+		}
+		a.logger.Warnf("no permission is declared for method %s", method)
+	} else if Permissions(auth)[required] {
+		return nil
+	}
+
+	organizationRole, projectRole := Roles(auth)
+	denial := &Denial{
+		Method:           method,
+		Permission:       required,
+		PrincipalType:    auth.Type(),
+		UserId:           auth.GetUserId(),
+		ProjectId:        auth.GetCurrentProjectId(),
+		OrganizationRole: organizationRole,
+		ProjectRole:      projectRole,
+	}
+	// users are not part of an organization until onboarding completes
+	if auth.HasOrganization() {
+		denial.OrganizationId = auth.GetCurrentOrganizationId()
+	}
+	a.auditor.Denied(ctx, denial)
+	return fmt.Errorf("%w: %s requires %s", ErrPermissionDenied, method, required)
+}
+
+type logAuditor struct {
+	logger commons.Logger
+}
+
+// NewLogAuditor creates an auditor writing denials as structured audit
+// entries of the application log.
+func NewLogAuditor(logger commons.Logger) Auditor {
+	return &logAuditor{logger: logger}
+}
+
+func (la *logAuditor) Denied(ctx context.Context, denial *Denial) {
+	la.logger.Warnw("audit: permission denied",
+		"method", denial.Method,
+		"permission", denial.Permission,
+		"principal", denial.PrincipalType,
+		"userId", value(denial.UserId),
+		"organizationId", value(denial.OrganizationId),
+		"projectId", value(denial.ProjectId),
+		"organizationRole", denial.OrganizationRole,
+		"projectRole", denial.ProjectRole,
+	)
+}
+
+// DenialStore persists denials, the integration-api audit client stores them
+// in its audit log.
+type DenialStore interface {
+	CreateAuthorizationDenial(ctx context.Context, auth types.SimplePrinciple, denial *protos.AuthorizationDenial) (*protos.CreateAuthorizationDenialResponse, error)
+}
+
+type auditLogAuditor struct {
+	logAuditor
+	store DenialStore
+}
+
+// NewAuditLogAuditor creates an auditor logging denials and storing them in
+// the audit log, where they are listed per organization.
+func NewAuditLogAuditor(logger commons.Logger, store DenialStore) Auditor {
+	return &auditLogAuditor{logAuditor: logAuditor{logger: logger}, store: store}
+}
+
+func (aa *auditLogAuditor) Denied(ctx context.Context, denial *Denial) {
+	aa.logAuditor.Denied(ctx, denial)
+	auth := &types.ServiceScope{
+		UserId:         denial.UserId,
+		ProjectId:      denial.ProjectId,
+		OrganizationId: denial.OrganizationId,
+	}
+	in := &protos.AuthorizationDenial{
+		Method:           denial.Method,
+		Permission:       string(denial.Permission),
+		PrincipalType:    denial.PrincipalType,
+		UserId:           value(denial.UserId),
+		OrganizationId:   value(denial.OrganizationId),
+		ProjectId:        value(denial.ProjectId),
+		OrganizationRole: denial.OrganizationRole,
+		ProjectRole:      denial.ProjectRole,
+	}
+	// the denied request does not wait for the audit log
+	utils.Go(context.Background(), func() {
+		if _, err := aa.store.CreateAuthorizationDenial(context.Background(), auth, in); err != nil {
+			aa.logger.Errorf("unable to store permission denial of %s: %v", denial.Method, err)
+		}
+	})
+}
+
+func value(id *uint64) uint64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
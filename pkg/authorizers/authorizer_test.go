@@ -0,0 +1,157 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package authorizers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+)
+
+type recordingAuditor struct {
+	denials []*Denial
+}
+
+func (ra *recordingAuditor) Denied(ctx context.Context, denial *Denial) {
+	ra.denials = append(ra.denials, denial)
+}
+
+type channelDenialStore struct {
+	created chan *protos.AuthorizationDenial
+	auth    chan types.SimplePrinciple
+}
+
+func (cs *channelDenialStore) CreateAuthorizationDenial(ctx context.Context, auth types.SimplePrinciple, denial *protos.AuthorizationDenial) (*protos.CreateAuthorizationDenialResponse, error) {
+	cs.auth <- auth
+	cs.created <- denial
+	return &protos.CreateAuthorizationDenialResponse{Success: true}, nil
+}
+
+func user(organizationRole, projectRole string) *types.PlainAuthPrinciple {
+	auth := &types.PlainAuthPrinciple{User: types.UserInfo{Id: 1}}
+	if organizationRole != "" {
+		auth.OrganizationRole = &types.OrganizaitonRole{OrganizationId: 2, Role: organizationRole}
+	}
+	if projectRole != "" {
+		auth.CurrentProjectRole = &types.ProjectRole{ProjectId: 3, Role: projectRole}
+	}
+	return auth
+}
+
+func TestPermissions_Roles(t *testing.T) {
+	tests := []struct {
+		name    string
+		auth    types.SimplePrinciple
+		granted []Permission
+		denied  []Permission
+	}{
+		{"owner", user("owner", ""), allPermissions, nil},
+		{"super admin", user("Super Admin", ""), allPermissions, nil},
+		{"admin", user("admin", ""), []Permission{READ, WRITE, DELETE, CREDENTIAL_MANAGE, PROJECT_MANAGE}, []Permission{ORGANIZATION_MANAGE}},
+		{"writer", user("", "writer"), []Permission{READ, WRITE}, []Permission{DELETE, CREDENTIAL_MANAGE}},
+		{"reader", user("", "reader"), []Permission{READ}, []Permission{WRITE, DELETE}},
+		{"project role widens organization role", user("reader", "admin"), []Permission{WRITE, DELETE, CREDENTIAL_MANAGE}, []Permission{ORGANIZATION_MANAGE}},
+		{"unknown role", user("guest", ""), []Permission{AUTHENTICATED}, []Permission{READ}},
+		{"service", &types.ServiceScope{}, allPermissions, nil},
+		{"organization key", &types.OrganizationScope{}, []Permission{READ, CREDENTIAL_MANAGE, PROJECT_MANAGE}, []Permission{ORGANIZATION_MANAGE}},
+		{"project key", &types.ProjectScope{}, []Permission{READ, WRITE, DELETE}, []Permission{CREDENTIAL_MANAGE, PROJECT_MANAGE}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			granted := Permissions(tt.auth)
+			assert.True(t, granted[AUTHENTICATED])
+			for _, p := range tt.granted {
+				assert.True(t, granted[p], "expected %s", p)
+			}
+			for _, p := range tt.denied {
+				assert.False(t, granted[p], "unexpected %s", p)
+			}
+		})
+	}
+}
+
+func TestMethodPermissions_CoverServices(t *testing.T) {
+	descs := []grpc.ServiceDesc{
+		protos.AgentKit_ServiceDesc, protos.AnthropicService_ServiceDesc, protos.AssistantDeploymentService_ServiceDesc,
+		protos.AssistantService_ServiceDesc, protos.AuditLoggingService_ServiceDesc, protos.AuthenticationService_ServiceDesc,
+		protos.AzureService_ServiceDesc, protos.BedrockService_ServiceDesc, protos.CohereService_ServiceDesc,
+		protos.ConnectService_ServiceDesc, protos.DeepInfraService_ServiceDesc, protos.Deployment_ServiceDesc,
+		protos.DocumentService_ServiceDesc, protos.EndpointService_ServiceDesc, protos.GeminiService_ServiceDesc,
+		protos.HuggingfaceService_ServiceDesc, protos.KnowledgeService_ServiceDesc, protos.MistralService_ServiceDesc,
+		protos.NotificationService_ServiceDesc, protos.OpenAiService_ServiceDesc, protos.OrganizationService_ServiceDesc,
+		protos.ProjectService_ServiceDesc, protos.ReplicateService_ServiceDesc, protos.StabilityAiService_ServiceDesc,
+		protos.TalkService_ServiceDesc, protos.TogetherAiService_ServiceDesc, protos.VaultService_ServiceDesc,
+		protos.VertexAiService_ServiceDesc, protos.VoyageAiService_ServiceDesc, protos.WebRTC_ServiceDesc,
+	}
+	for _, desc := range descs {
+		for _, method := range desc.Methods {
+			_, ok := MethodPermissions["/"+desc.ServiceName+"/"+method.MethodName]
+			assert.True(t, ok, "no permission for /%s/%s", desc.ServiceName, method.MethodName)
+		}
+		for _, stream := range desc.Streams {
+			_, ok := MethodPermissions["/"+desc.ServiceName+"/"+stream.StreamName]
+			assert.True(t, ok, "no permission for /%s/%s", desc.ServiceName, stream.StreamName)
+		}
+	}
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	auditor := &recordingAuditor{}
+	authorizer := NewAuthorizer(logger, auditor)
+	ctx := context.Background()
+
+	assert.NoError(t, authorizer.Authorize(ctx, user("", "reader"), "/assistant_api.AssistantService/GetAllAssistant"))
+	assert.NoError(t, authorizer.Authorize(ctx, user("", "writer"), "/assistant_api.AssistantService/CreateAssistant"))
+	assert.NoError(t, authorizer.Authorize(ctx, &types.ServiceScope{}, "/unknown.Service/Method"))
+	assert.Empty(t, auditor.denials)
+
+	err := authorizer.Authorize(ctx, user("", "reader"), "/assistant_api.AssistantService/DeleteAssistant")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+	require.Len(t, auditor.denials, 1)
+	assert.Equal(t, "/assistant_api.AssistantService/DeleteAssistant", auditor.denials[0].Method)
+	assert.Equal(t, DELETE, auditor.denials[0].Permission)
+	assert.Equal(t, "reader", auditor.denials[0].ProjectRole)
+
+	err = authorizer.Authorize(ctx, user("owner", ""), "/unknown.Service/Method")
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+	assert.Len(t, auditor.denials, 2)
+}
+
+func TestAuditLogAuditor_StoresDenials(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	store := &channelDenialStore{created: make(chan *protos.AuthorizationDenial, 1), auth: make(chan types.SimplePrinciple, 1)}
+	authorizer := NewAuthorizer(logger, NewAuditLogAuditor(logger, store))
+
+	err := authorizer.Authorize(context.Background(), user("reader", "reader"), "/assistant_api.AssistantService/DeleteAssistant")
+	require.True(t, errors.Is(err, ErrPermissionDenied))
+
+	select {
+	case denial := <-store.created:
+		assert.Equal(t, "/assistant_api.AssistantService/DeleteAssistant", denial.GetMethod())
+		assert.Equal(t, string(DELETE), denial.GetPermission())
+		assert.Equal(t, uint64(1), denial.GetUserId())
+		assert.Equal(t, uint64(2), denial.GetOrganizationId())
+		assert.Equal(t, uint64(3), denial.GetProjectId())
+		assert.Equal(t, "reader", denial.GetProjectRole())
+	case <-time.After(time.Second):
+		t.Fatal("denial was not stored")
+	}
+	// the denial is stored as the service, scoped to the denied organization
+	auth := <-store.auth
+	assert.Equal(t, "service", auth.Type())
+	assert.Equal(t, uint64(2), *auth.GetCurrentOrganizationId())
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package authorizers
+
+import (
+	"strings"
+
+	"github.com/rapidaai/pkg/types"
+)
+
+type Permission string
+
+const (
+	// AUTHENTICATED is held by every authenticated principal, for methods
+	// acting on the principal itself such as its profile or onboarding.
+	AUTHENTICATED Permission = "authenticated"
+
+	READ   Permission = "read"
+	WRITE  Permission = "write"
+	DELETE Permission = "delete"
+
+	// CREDENTIAL_MANAGE covers provider credentials in the vault and the api
+	// keys of projects.
+	CREDENTIAL_MANAGE Permission = "credential.manage"
+	// PROJECT_MANAGE covers projects and their members.
+	PROJECT_MANAGE Permission = "project.manage"
+	// ORGANIZATION_MANAGE covers the organization and its billing.
+	ORGANIZATION_MANAGE Permission = "organization.manage"
+	// INTERNAL is only held by internal services, for methods they call on
+	// each other.
+	INTERNAL Permission = "internal"
+)
+
+var allPermissions = []Permission{READ, WRITE, DELETE, CREDENTIAL_MANAGE, PROJECT_MANAGE, ORGANIZATION_MANAGE}
+
+// RolePermissions are the permissions of organization and project roles,
+// roles are matched case insensitive with spaces as underscores.
+var RolePermissions = map[string][]Permission{
+	"owner":       allPermissions,
+	"super_admin": allPermissions,
+	"admin":       {READ, WRITE, DELETE, CREDENTIAL_MANAGE, PROJECT_MANAGE},
+	"writer":      {READ, WRITE},
+	"reader":      {READ},
+}
+
+// ScopePermissions are the permissions of principals without a role, api keys
+// act within their project or organization, internal services are trusted.
+var ScopePermissions = map[string][]Permission{
+	"service":      append([]Permission{INTERNAL}, allPermissions...),
+	"organization": {READ, WRITE, DELETE, CREDENTIAL_MANAGE, PROJECT_MANAGE},
+	"project":      {READ, WRITE, DELETE},
+}
+
+// normalizeRole maps a stored role such as "Super Admin" to its key.
+func normalizeRole(role string) string {
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(strings.TrimSpace(role)))
+}
+
+// Roles returns the organization role and the role in the current project of
+// a user principal.
+func Roles(auth types.SimplePrinciple) (organizationRole string, projectRole string) {
+	principle, ok := auth.(types.Principle)
+	if !ok {
+		return "", ""
+	}
+	if role := principle.GetOrganizationRole(); role != nil {
+		organizationRole = role.Role
+	}
+	if role := principle.GetCurrentProjectRole(); role != nil {
+		projectRole = role.GetRole()
+	}
+	return organizationRole, projectRole
+}
+
+// Permissions returns the permissions of a principal. A user holds the
+// permissions of both its organization role and its current project role.
+func Permissions(auth types.SimplePrinciple) map[Permission]bool {
+	granted := map[Permission]bool{AUTHENTICATED: true}
+	var permissions []Permission
+	if _, ok := auth.(types.Principle); ok {
+		organizationRole, projectRole := Roles(auth)
+		permissions = append(permissions, RolePermissions[normalizeRole(organizationRole)]...)
+		permissions = append(permissions, RolePermissions[normalizeRole(projectRole)]...)
+	} else {
+		permissions = ScopePermissions[auth.Type()]
+	}
+	for _, p := range permissions {
+		granted[p] = true
+	}
+	return granted
+}
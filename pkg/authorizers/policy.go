@@ -0,0 +1,263 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package authorizers
+
+// MethodPermissions is the permission required by every gRPC method, methods
+// that are not listed are denied to all but internal services.
+var MethodPermissions = map[string]Permission{
+	// assistant_api.AssistantDeploymentService
+	"/assistant_api.AssistantDeploymentService/CreateAssistantApiDeployment":       WRITE,
+	"/assistant_api.AssistantDeploymentService/CreateAssistantDebuggerDeployment":  WRITE,
+	"/assistant_api.AssistantDeploymentService/CreateAssistantPhoneDeployment":     WRITE,
+	"/assistant_api.AssistantDeploymentService/CreateAssistantWebpluginDeployment": WRITE,
+	"/assistant_api.AssistantDeploymentService/CreateAssistantWhatsappDeployment":  WRITE,
+	"/assistant_api.AssistantDeploymentService/GetAssistantApiDeployment":          READ,
+	"/assistant_api.AssistantDeploymentService/GetAssistantDebuggerDeployment":     READ,
+	"/assistant_api.AssistantDeploymentService/GetAssistantPhoneDeployment":        READ,
+	"/assistant_api.AssistantDeploymentService/GetAssistantWebpluginDeployment":    READ,
+	"/assistant_api.AssistantDeploymentService/GetAssistantWhatsappDeployment":     READ,
+
+	// assistant_api.AssistantService
+	"/assistant_api.AssistantService/CreateAssistant":               WRITE,
+	"/assistant_api.AssistantService/CreateAssistantAnalysis":       WRITE,
+	"/assistant_api.AssistantService/CreateAssistantEvaluator":      WRITE,
+	"/assistant_api.AssistantService/CreateAssistantKnowledge":      WRITE,
+	"/assistant_api.AssistantService/CreateAssistantModerator":      WRITE,
+	"/assistant_api.AssistantService/CreateAssistantProvider":       WRITE,
+	"/assistant_api.AssistantService/CreateAssistantTag":            WRITE,
+	"/assistant_api.AssistantService/CreateAssistantTool":           WRITE,
+	"/assistant_api.AssistantService/CreateAssistantWebhook":        WRITE,
+	"/assistant_api.AssistantService/DeleteAssistant":               DELETE,
+	"/assistant_api.AssistantService/DeleteAssistantAnalysis":       DELETE,
+	"/assistant_api.AssistantService/DeleteAssistantEvaluator":      DELETE,
+	"/assistant_api.AssistantService/DeleteAssistantKnowledge":      DELETE,
+	"/assistant_api.AssistantService/DeleteAssistantModerator":      DELETE,
+	"/assistant_api.AssistantService/DeleteAssistantTool":           DELETE,
+	"/assistant_api.AssistantService/DeleteAssistantWebhook":        DELETE,
+	"/assistant_api.AssistantService/GetAllAssistant":               READ,
+	"/assistant_api.AssistantService/GetAllAssistantAnalysis":       READ,
+	"/assistant_api.AssistantService/GetAllAssistantConversation":   READ,
+	"/assistant_api.AssistantService/GetAllAssistantEvaluator":      READ,
+	"/assistant_api.AssistantService/GetAllAssistantKnowledge":      READ,
+	"/assistant_api.AssistantService/GetAllAssistantMessage":        READ,
+	"/assistant_api.AssistantService/GetAllAssistantModerator":      READ,
+	"/assistant_api.AssistantService/GetAllAssistantProvider":       READ,
+	"/assistant_api.AssistantService/GetAllAssistantTelemetry":      READ,
+	"/assistant_api.AssistantService/GetAllAssistantTool":           READ,
+	"/assistant_api.AssistantService/GetAllAssistantToolLog":        READ,
+	"/assistant_api.AssistantService/GetAllAssistantWebhook":        READ,
+	"/assistant_api.AssistantService/GetAllAssistantWebhookLog":     READ,
+	"/assistant_api.AssistantService/GetAllConversationMessage":     READ,
+	"/assistant_api.AssistantService/GetAllMessage":                 READ,
+	"/assistant_api.AssistantService/GetAssistant":                  READ,
+	"/assistant_api.AssistantService/GetAssistantAnalysis":          READ,
+	"/assistant_api.AssistantService/GetAssistantConversation":      READ,
+	"/assistant_api.AssistantService/GetAssistantEvaluationSummary": READ,
+	"/assistant_api.AssistantService/GetAssistantKnowledge":         READ,
+	"/assistant_api.AssistantService/GetAssistantTool":              READ,
+	"/assistant_api.AssistantService/GetAssistantToolLog":           READ,
+	"/assistant_api.AssistantService/GetAssistantWebhook":           READ,
+	"/assistant_api.AssistantService/GetAssistantWebhookLog":        READ,
+	"/assistant_api.AssistantService/RedeliverAssistantWebhookLog":  WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantAnalysis":       WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantDetail":         WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantEvaluator":      WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantKnowledge":      WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantModerator":      WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantTool":           WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantVersion":        WRITE,
+	"/assistant_api.AssistantService/UpdateAssistantWebhook":        WRITE,
+
+	// connect_api.ConnectService
+	"/connect_api.ConnectService/GeneralConnect":    CREDENTIAL_MANAGE,
+	"/connect_api.ConnectService/GetConnectorFiles": READ,
+
+	// document_api.DocumentService
+	"/document_api.DocumentService/IndexKnowledgeDocument": WRITE,
+
+	// endpoint_api.Deployment
+	"/endpoint_api.Deployment/Invoke":       WRITE,
+	"/endpoint_api.Deployment/InvokeStream": WRITE,
+	"/endpoint_api.Deployment/Probe":        READ,
+	"/endpoint_api.Deployment/Update":       WRITE,
+
+	// endpoint_api.EndpointService
+	"/endpoint_api.EndpointService/CreateEndpoint":                   WRITE,
+	"/endpoint_api.EndpointService/CreateEndpointCacheConfiguration": WRITE,
+	"/endpoint_api.EndpointService/CreateEndpointProviderModel":      WRITE,
+	"/endpoint_api.EndpointService/CreateEndpointRetryConfiguration": WRITE,
+	"/endpoint_api.EndpointService/CreateEndpointTag":                WRITE,
+	"/endpoint_api.EndpointService/ForkEndpoint":                     WRITE,
+	"/endpoint_api.EndpointService/GetAllEndpoint":                   READ,
+	"/endpoint_api.EndpointService/GetAllEndpointLog":                READ,
+	"/endpoint_api.EndpointService/GetAllEndpointProviderModel":      READ,
+	"/endpoint_api.EndpointService/GetEndpoint":                      READ,
+	"/endpoint_api.EndpointService/GetEndpointLog":                   READ,
+	"/endpoint_api.EndpointService/UpdateEndpointDetail":             WRITE,
+	"/endpoint_api.EndpointService/UpdateEndpointVersion":            WRITE,
+
+	// integration_api.AnthropicService
+	"/integration_api.AnthropicService/Chat":             WRITE,
+	"/integration_api.AnthropicService/StreamChat":       WRITE,
+	"/integration_api.AnthropicService/VerifyCredential": WRITE,
+
+	// integration_api.AuditLoggingService
+	"/integration_api.AuditLoggingService/CreateAuthorizationDenial": INTERNAL,
+	"/integration_api.AuditLoggingService/CreateMetadata":            WRITE,
+	"/integration_api.AuditLoggingService/GetAllAuditLog":            READ,
+	"/integration_api.AuditLoggingService/GetAllAuthorizationDenial": ORGANIZATION_MANAGE,
+	"/integration_api.AuditLoggingService/GetAuditLog":               READ,
+
+	// integration_api.AzureService
+	"/integration_api.AzureService/Chat":             WRITE,
+	"/integration_api.AzureService/Embedding":        WRITE,
+	"/integration_api.AzureService/GetModeration":    WRITE,
+	"/integration_api.AzureService/StreamChat":       WRITE,
+	"/integration_api.AzureService/VerifyCredential": WRITE,
+
+	// integration_api.BedrockService
+	"/integration_api.BedrockService/Chat":             WRITE,
+	"/integration_api.BedrockService/Embedding":        WRITE,
+	"/integration_api.BedrockService/VerifyCredential": WRITE,
+
+	// integration_api.CohereService
+	"/integration_api.CohereService/Chat":             WRITE,
+	"/integration_api.CohereService/Embedding":        WRITE,
+	"/integration_api.CohereService/Reranking":        WRITE,
+	"/integration_api.CohereService/StreamChat":       WRITE,
+	"/integration_api.CohereService/VerifyCredential": WRITE,
+
+	// integration_api.DeepInfraService
+	"/integration_api.DeepInfraService/VerifyCredential": WRITE,
+
+	// integration_api.GeminiService
+	"/integration_api.GeminiService/Chat":             WRITE,
+	"/integration_api.GeminiService/Embedding":        WRITE,
+	"/integration_api.GeminiService/StreamChat":       WRITE,
+	"/integration_api.GeminiService/VerifyCredential": WRITE,
+
+	// integration_api.HuggingfaceService
+	"/integration_api.HuggingfaceService/Chat":             WRITE,
+	"/integration_api.HuggingfaceService/VerifyCredential": WRITE,
+
+	// integration_api.MistralService
+	"/integration_api.MistralService/Chat":             WRITE,
+	"/integration_api.MistralService/StreamChat":       WRITE,
+	"/integration_api.MistralService/VerifyCredential": WRITE,
+
+	// integration_api.OpenAiService
+	"/integration_api.OpenAiService/Chat":             WRITE,
+	"/integration_api.OpenAiService/Embedding":        WRITE,
+	"/integration_api.OpenAiService/GetModeration":    WRITE,
+	"/integration_api.OpenAiService/StreamChat":       WRITE,
+	"/integration_api.OpenAiService/VerifyCredential": WRITE,
+
+	// integration_api.ReplicateService
+	"/integration_api.ReplicateService/Chat":             WRITE,
+	"/integration_api.ReplicateService/StreamChat":       WRITE,
+	"/integration_api.ReplicateService/VerifyCredential": WRITE,
+
+	// integration_api.StabilityAiService
+	"/integration_api.StabilityAiService/VerifyCredential": WRITE,
+
+	// integration_api.TogetherAiService
+	"/integration_api.TogetherAiService/Chat":             WRITE,
+	"/integration_api.TogetherAiService/VerifyCredential": WRITE,
+
+	// integration_api.VertexAiService
+	"/integration_api.VertexAiService/Chat":             WRITE,
+	"/integration_api.VertexAiService/Embedding":        WRITE,
+	"/integration_api.VertexAiService/StreamChat":       WRITE,
+	"/integration_api.VertexAiService/VerifyCredential": WRITE,
+
+	// integration_api.VoyageAiService
+	"/integration_api.VoyageAiService/Embedding":        WRITE,
+	"/integration_api.VoyageAiService/Reranking":        WRITE,
+	"/integration_api.VoyageAiService/VerifyCredential": WRITE,
+
+	// knowledge_api.KnowledgeService
+	"/knowledge_api.KnowledgeService/CreateKnowledge":                WRITE,
+	"/knowledge_api.KnowledgeService/CreateKnowledgeDocument":        WRITE,
+	"/knowledge_api.KnowledgeService/CreateKnowledgeTag":             WRITE,
+	"/knowledge_api.KnowledgeService/DeleteKnowledgeDocumentSegment": DELETE,
+	"/knowledge_api.KnowledgeService/GetAllKnowledge":                READ,
+	"/knowledge_api.KnowledgeService/GetAllKnowledgeDocument":        READ,
+	"/knowledge_api.KnowledgeService/GetAllKnowledgeDocumentSegment": READ,
+	"/knowledge_api.KnowledgeService/GetAllKnowledgeLog":             READ,
+	"/knowledge_api.KnowledgeService/GetKnowledge":                   READ,
+	"/knowledge_api.KnowledgeService/GetKnowledgeLog":                READ,
+	"/knowledge_api.KnowledgeService/UpdateKnowledgeDetail":          WRITE,
+	"/knowledge_api.KnowledgeService/UpdateKnowledgeDocumentSegment": WRITE,
+
+	// notification_api.NotificationService
+	"/notification_api.NotificationService/GetNotificationSettting":   AUTHENTICATED,
+	"/notification_api.NotificationService/UpdateNotificationSetting": AUTHENTICATED,
+
+	// talk_api.AgentKit
+	"/talk_api.AgentKit/Talk": WRITE,
+
+	// talk_api.TalkService
+	"/talk_api.TalkService/AddCampaignContacts":         WRITE,
+	"/talk_api.TalkService/AddDoNotCallNumbers":         WRITE,
+	"/talk_api.TalkService/AssistantTalk":               WRITE,
+	"/talk_api.TalkService/CancelCampaign":              WRITE,
+	"/talk_api.TalkService/CreateBulkPhoneCall":         WRITE,
+	"/talk_api.TalkService/CreateCampaign":              WRITE,
+	"/talk_api.TalkService/CreateConversationMetric":    WRITE,
+	"/talk_api.TalkService/CreateMessageMetric":         WRITE,
+	"/talk_api.TalkService/CreatePhoneCall":             WRITE,
+	"/talk_api.TalkService/GetAllAssistantConversation": READ,
+	"/talk_api.TalkService/GetAllConversationMessage":   READ,
+	"/talk_api.TalkService/GetCampaign":                 READ,
+	"/talk_api.TalkService/PauseCampaign":               WRITE,
+	"/talk_api.TalkService/RemoveDoNotCallNumbers":      WRITE,
+	"/talk_api.TalkService/ResumeCampaign":              WRITE,
+	"/talk_api.TalkService/SetCredentialLimit":          WRITE,
+
+	// talk_api.WebRTC
+	"/talk_api.WebRTC/WebTalk": WRITE,
+
+	// vault_api.VaultService
+	"/vault_api.VaultService/CreateProviderCredential":     CREDENTIAL_MANAGE,
+	"/vault_api.VaultService/DeleteCredential":             CREDENTIAL_MANAGE,
+	"/vault_api.VaultService/GetAllOrganizationCredential": READ,
+	"/vault_api.VaultService/GetCredential":                READ,
+	"/vault_api.VaultService/GetOauth2Credential":          READ,
+	"/vault_api.VaultService/RotateCredentialKey":          CREDENTIAL_MANAGE,
+
+	// web_api.AuthenticationService
+	"/web_api.AuthenticationService/Authenticate":   AUTHENTICATED,
+	"/web_api.AuthenticationService/Authorize":      AUTHENTICATED,
+	"/web_api.AuthenticationService/ChangePassword": AUTHENTICATED,
+	"/web_api.AuthenticationService/CreatePassword": AUTHENTICATED,
+	"/web_api.AuthenticationService/ForgotPassword": AUTHENTICATED,
+	"/web_api.AuthenticationService/GetAllUser":     READ,
+	"/web_api.AuthenticationService/GetUser":        AUTHENTICATED,
+	"/web_api.AuthenticationService/Github":         AUTHENTICATED,
+	"/web_api.AuthenticationService/Google":         AUTHENTICATED,
+	"/web_api.AuthenticationService/Linkedin":       AUTHENTICATED,
+	"/web_api.AuthenticationService/RegisterUser":   AUTHENTICATED,
+	"/web_api.AuthenticationService/ScopeAuthorize": AUTHENTICATED,
+	"/web_api.AuthenticationService/UpdateUser":     AUTHENTICATED,
+	"/web_api.AuthenticationService/VerifyToken":    AUTHENTICATED,
+
+	// web_api.OrganizationService
+	"/web_api.OrganizationService/CreateOrganization":       AUTHENTICATED,
+	"/web_api.OrganizationService/GetOrganization":          AUTHENTICATED,
+	"/web_api.OrganizationService/UpdateBillingInformation": ORGANIZATION_MANAGE,
+	"/web_api.OrganizationService/UpdateOrganization":       ORGANIZATION_MANAGE,
+
+	// web_api.ProjectService
+	"/web_api.ProjectService/AddUsersToProject":       PROJECT_MANAGE,
+	"/web_api.ProjectService/ArchiveProject":          PROJECT_MANAGE,
+	"/web_api.ProjectService/CreateProject":           PROJECT_MANAGE,
+	"/web_api.ProjectService/CreateProjectCredential": CREDENTIAL_MANAGE,
+	"/web_api.ProjectService/GetAllProject":           READ,
+	"/web_api.ProjectService/GetAllProjectCredential": CREDENTIAL_MANAGE,
+	"/web_api.ProjectService/GetProject":              READ,
+	"/web_api.ProjectService/GetProjectQuota":         READ,
+	"/web_api.ProjectService/UpdateProject":           PROJECT_MANAGE,
+}
@@ -21,6 +21,7 @@ import (
 
 type DeploymentServiceClient interface {
 	Invoke(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.InvokeRequest) (*endpoint_api.InvokeResponse, error)
+	InvokeStream(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.InvokeRequest) (grpc.ServerStreamingClient[endpoint_api.InvokeResponse], error)
 }
 
 type deploymentServiceClient struct {
@@ -62,3 +63,13 @@ func (dsc *deploymentServiceClient) Invoke(ctx context.Context, auth types.Simpl
 
 	return res, nil
 }
+
+func (dsc *deploymentServiceClient) InvokeStream(ctx context.Context, auth types.SimplePrinciple, iRequest *endpoint_api.InvokeRequest) (grpc.ServerStreamingClient[endpoint_api.InvokeResponse], error) {
+	dsc.logger.Debugf("invoke stream api for endpoint")
+	stream, err := dsc.deploymentClient.InvokeStream(dsc.WithAuth(ctx, auth), iRequest)
+	if err != nil {
+		dsc.logger.Errorf("error while calling invoke stream endpoint %v", err)
+		return nil, err
+	}
+	return stream, nil
+}
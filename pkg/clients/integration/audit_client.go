@@ -7,6 +7,7 @@ package integration_client
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -24,6 +25,8 @@ import (
 type AuditServiceClient interface {
 	GetAuditLog(c context.Context, auth types.SimplePrinciple, auditId uint64) (*protos.GetAuditLogResponse, error)
 	GetAllAuditLog(c context.Context, auth types.SimplePrinciple, req *protos.GetAllAuditLogRequest) (*protos.GetAllAuditLogResponse, error)
+	CreateAuthorizationDenial(c context.Context, auth types.SimplePrinciple, denial *protos.AuthorizationDenial) (*protos.CreateAuthorizationDenialResponse, error)
+	GetAllAuthorizationDenial(c context.Context, auth types.SimplePrinciple, req *protos.GetAllAuthorizationDenialRequest) (*protos.GetAllAuthorizationDenialResponse, error)
 }
 
 type auditServiceClient struct {
@@ -81,3 +84,30 @@ func (client *auditServiceClient) GetAllAuditLog(c context.Context, auth types.S
 	client.logger.Debugf("Benchmarking: auditServiceClient.GetAllAuditLog time taken %v", time.Since(start))
 	return res, nil
 }
+
+func (client *auditServiceClient) CreateAuthorizationDenial(c context.Context, auth types.SimplePrinciple, denial *protos.AuthorizationDenial) (*protos.CreateAuthorizationDenialResponse, error) {
+	start := time.Now()
+	res, err := client.auditLoggingClient.CreateAuthorizationDenial(client.WithAuth(c, auth), &protos.CreateAuthorizationDenialRequest{
+		Denial: denial,
+	})
+	if err != nil {
+		client.logger.Errorf("error while creating authorization denial error %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		return res, fmt.Errorf("unable to create authorization denial: %s", res.GetError().GetHumanMessage())
+	}
+	client.logger.Debugf("Benchmarking: auditServiceClient.CreateAuthorizationDenial time taken %v", time.Since(start))
+	return res, nil
+}
+
+func (client *auditServiceClient) GetAllAuthorizationDenial(c context.Context, auth types.SimplePrinciple, req *protos.GetAllAuthorizationDenialRequest) (*protos.GetAllAuthorizationDenialResponse, error) {
+	start := time.Now()
+	res, err := client.auditLoggingClient.GetAllAuthorizationDenial(client.WithAuth(c, auth), req)
+	if err != nil {
+		client.logger.Errorf("error while getting authorization denials error %v", err)
+		return nil, err
+	}
+	client.logger.Debugf("Benchmarking: auditServiceClient.GetAllAuthorizationDenial time taken %v", time.Since(start))
+	return res, nil
+}
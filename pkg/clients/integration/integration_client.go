@@ -34,6 +34,7 @@ type IntegrationServiceClient interface {
 	StreamChat(c context.Context, auth types.SimplePrinciple, providerName string) (grpc.BidiStreamingClient[protos.ChatRequest, protos.ChatResponse], error)
 	Embedding(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.EmbeddingRequest) (*protos.EmbeddingResponse, error)
 	Reranking(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.RerankingRequest) (*protos.RerankingResponse, error)
+	Moderation(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.GetModerationRequest) (*protos.GetModerationResponse, error)
 	VerifyCredential(ctx context.Context, auth types.SimplePrinciple, providerName string, in *protos.Credential) (*protos.VerifyCredentialResponse, error)
 }
 
@@ -121,6 +122,18 @@ func (client *integrationServiceClient) Reranking(c context.Context,
 	}
 }
 
+func (client *integrationServiceClient) Moderation(c context.Context,
+	auth types.SimplePrinciple,
+	providerName string,
+	request *protos.GetModerationRequest) (*protos.GetModerationResponse, error) {
+	switch providerName := strings.ToLower(providerName); providerName {
+	case "openai":
+		return client.openAiClient.GetModeration(client.WithAuth(c, auth), request)
+	default:
+		return nil, errors.New("illegal provider for moderation request")
+	}
+}
+
 func (client *integrationServiceClient) Chat(c context.Context,
 	auth types.SimplePrinciple,
 	providerName string,
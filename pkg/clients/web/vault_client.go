@@ -7,12 +7,18 @@ package web_client
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/rapidaai/config"
 	clients "github.com/rapidaai/pkg/clients"
@@ -93,8 +99,13 @@ func (client *vaultServiceClient) GetCredential(c context.Context, auth types.Si
 		client.logger.Errorf("Cache missed for the request: %v", cachedValue.Err)
 	}
 
-	data := &vault_api.VaultCredential{}
-	err := cachedValue.ResultStruct(data)
+	// the cached credential is sealed, entries of other secrets miss
+	var sealed string
+	err := cachedValue.ResultStruct(&sealed)
+	var data *vault_api.VaultCredential
+	if err == nil {
+		data, err = openCredential(client.cfg.Secret, cacheKey, sealed)
+	}
 
 	// Start a goroutine to fetch from API and update cache
 	var apiData = make(chan *vault_api.VaultCredential, 1)
@@ -110,7 +121,11 @@ func (client *vaultServiceClient) GetCredential(c context.Context, auth types.Si
 		}
 
 		if res.GetSuccess() && res.GetData() != nil {
-			client.Cache(bgCtx, cacheKey, res.GetData())
+			if sealed, err := sealCredential(client.cfg.Secret, cacheKey, res.GetData()); err != nil {
+				client.logger.Errorf("Unable to seal credentials for cache: %v", err)
+			} else {
+				client.Cache(bgCtx, cacheKey, sealed)
+			}
 			apiData <- res.GetData()
 		} else if res.GetError() != nil {
 			client.logger.Errorf("Failed to get credentials from vault service: %s", res.GetError().HumanMessage)
@@ -136,3 +151,54 @@ func (client *vaultServiceClient) GetCredential(c context.Context, auth types.Si
 	client.logger.Benchmark("vaultServiceClient.GetCredential", time.Since(start))
 	return nil, errors.New("failed to get credentials from vault service")
 }
+
+// sealCredential encrypts a credential for the cache with AES-256-GCM under a
+// key derived from the application secret, the cache key is authenticated so
+// an entry only opens under its own key.
+func sealCredential(secret, cacheKey string, credential *vault_api.VaultCredential) (string, error) {
+	aead, err := cacheCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	plain, err := protojson.Marshal(credential)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal credential: %w", err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, plain, []byte(cacheKey))), nil
+}
+
+func openCredential(secret, cacheKey, sealed string) (*vault_api.VaultCredential, error) {
+	aead, err := cacheCipher(secret)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("illegal cached credential: %w", err)
+	}
+	if len(raw) < aead.NonceSize() {
+		return nil, fmt.Errorf("illegal cached credential")
+	}
+	plain, err := aead.Open(nil, raw[:aead.NonceSize()], raw[aead.NonceSize():], []byte(cacheKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cached credential: %w", err)
+	}
+	credential := &vault_api.VaultCredential{}
+	if err := protojson.Unmarshal(plain, credential); err != nil {
+		return nil, fmt.Errorf("illegal cached credential: %w", err)
+	}
+	return credential, nil
+}
+
+func cacheCipher(secret string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte("vault-credential-cache:" + secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
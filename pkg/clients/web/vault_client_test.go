@@ -0,0 +1,37 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package web_client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	vault_api "github.com/rapidaai/protos"
+)
+
+func TestSealCredential(t *testing.T) {
+	value, err := structpb.NewStruct(map[string]interface{}{"key": "sk-live-123"})
+	require.NoError(t, err)
+	credential := &vault_api.VaultCredential{Id: 7, Name: "openai", Value: value}
+
+	sealed, err := sealCredential("rpd_pks", "INTERNAL::GetCredential_1__vlt__7", credential)
+	require.NoError(t, err)
+	assert.NotContains(t, sealed, "sk-live-123")
+
+	opened, err := openCredential("rpd_pks", "INTERNAL::GetCredential_1__vlt__7", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), opened.GetId())
+	assert.Equal(t, "sk-live-123", opened.GetValue().AsMap()["key"])
+
+	// an entry only opens with its own secret and cache key
+	_, err = openCredential("other", "INTERNAL::GetCredential_1__vlt__7", sealed)
+	assert.Error(t, err)
+	_, err = openCredential("rpd_pks", "INTERNAL::GetCredential_2__vlt__7", sealed)
+	assert.Error(t, err)
+}
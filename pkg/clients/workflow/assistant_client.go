@@ -44,6 +44,10 @@ type AssistantServiceClient interface {
 		c context.Context,
 		auth types.SimplePrinciple,
 		assistantRequest *protos.GetAssistantConversationRequest) (*protos.GetAssistantConversationResponse, error)
+	GetAssistantEvaluationSummary(
+		c context.Context,
+		auth types.SimplePrinciple,
+		assistantRequest *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error)
 
 	CreateAssistantTag(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.CreateAssistantTagRequest) (*protos.GetAssistantResponse, error)
 	UpdateAssistantDetail(c context.Context, auth types.SimplePrinciple, assistantRequest *protos.UpdateAssistantDetailRequest) (*protos.GetAssistantResponse, error)
@@ -63,6 +67,7 @@ type AssistantServiceClient interface {
 
 	//
 	GetAssistantWebhookLog(ctx context.Context, auth types.SimplePrinciple, req *protos.GetAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error)
+	RedeliverAssistantWebhookLog(ctx context.Context, auth types.SimplePrinciple, req *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error)
 	GetAllAssistantWebhookLog(ctx context.Context, auth types.SimplePrinciple, projectId uint64, criteria []*protos.Criteria, paginate *protos.Paginate, ordering *protos.Ordering) (*protos.Paginated, []*protos.AssistantWebhookLog, error)
 
 	//
@@ -79,6 +84,18 @@ type AssistantServiceClient interface {
 	UpdateAssistantAnalysis(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantAnalysisRequest) (*protos.GetAssistantAnalysisResponse, error)
 	DeleteAssistantAnalysis(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantAnalysisRequest) (*protos.GetAssistantAnalysisResponse, error)
 
+	//
+	GetAllAssistantEvaluator(c context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantEvaluator, error)
+	CreateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+	UpdateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+	DeleteAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error)
+
+	//
+	GetAllAssistantModerator(c context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantModerator, error)
+	CreateAssistantModerator(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error)
+	UpdateAssistantModerator(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error)
+	DeleteAssistantModerator(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error)
+
 	//
 	GetAllAssistantTool(c context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantTool, error)
 	GetAssistantTool(c context.Context, auth types.SimplePrinciple, iRequest *protos.GetAssistantToolRequest) (*protos.GetAssistantToolResponse, error)
@@ -511,6 +528,22 @@ func (client *assistantServiceClient) GetAssistantWebhookLog(c context.Context,
 	return res, nil
 }
 
+func (client *assistantServiceClient) RedeliverAssistantWebhookLog(c context.Context,
+	auth types.SimplePrinciple, iRequest *protos.RedeliverAssistantWebhookLogRequest) (*protos.GetAssistantWebhookLogResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.RedeliverAssistantWebhookLog(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.RedeliverAssistantWebhookLog", time.Since(start))
+		client.logger.Errorf("error while calling RedeliverAssistantWebhookLog %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to redeliver RedeliverAssistantWebhookLog %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.RedeliverAssistantWebhookLog", time.Since(start))
+	return res, nil
+}
+
 func (client *assistantServiceClient) GetAssistantWebhook(c context.Context,
 	auth types.SimplePrinciple, iRequest *protos.GetAssistantWebhookRequest) (*protos.GetAssistantWebhookResponse, error) {
 	start := time.Now()
@@ -590,6 +623,24 @@ func (client *assistantServiceClient) GetAssistantConversation(
 	return res, nil
 }
 
+func (client *assistantServiceClient) GetAssistantEvaluationSummary(
+	c context.Context,
+	auth types.SimplePrinciple,
+	assistantRequest *protos.GetAssistantEvaluationSummaryRequest) (*protos.GetAssistantEvaluationSummaryResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAssistantEvaluationSummary(client.WithAuth(c, auth), assistantRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantEvaluationSummary", time.Since(start))
+		client.logger.Errorf("error while calling GetAssistantEvaluationSummary %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling to get evaluation summary %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAssistantEvaluationSummary", time.Since(start))
+	return res, nil
+}
+
 func (client *assistantServiceClient) GetAllAssistantAnalysis(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantAnalysis, error) {
 	start := time.Now()
 	res, err := client.assistantClient.GetAllAssistantAnalysis(client.WithAuth(ctx, auth), &protos.GetAllAssistantAnalysisRequest{
@@ -671,6 +722,134 @@ func (client *assistantServiceClient) UpdateAssistantAnalysis(c context.Context,
 	return res, nil
 }
 
+func (client *assistantServiceClient) GetAllAssistantModerator(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantModerator, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantModerator(client.WithAuth(ctx, auth), &protos.GetAllAssistantModeratorRequest{
+		Paginate:    paginate,
+		AssistantId: assistantId,
+		Criterias:   criteria,
+	})
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantModerator", time.Since(start))
+		client.logger.Errorf("error while calling GetAllAssistantModerator %v", err)
+		return nil, nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling GetAllAssistantModerator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantModerator", time.Since(start))
+	return res.GetPaginated(), res.GetData(), nil
+}
+
+func (client *assistantServiceClient) CreateAssistantModerator(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.CreateAssistantModerator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantModerator", time.Since(start))
+		client.logger.Errorf("error while calling CreateAssistantModerator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling CreateAssistantModerator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantModerator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) UpdateAssistantModerator(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.UpdateAssistantModerator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantModerator", time.Since(start))
+		client.logger.Errorf("error while calling UpdateAssistantModerator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling UpdateAssistantModerator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantModerator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) DeleteAssistantModerator(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantModeratorRequest) (*protos.GetAssistantModeratorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.DeleteAssistantModerator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantModerator", time.Since(start))
+		client.logger.Errorf("error while calling DeleteAssistantModerator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling DeleteAssistantModerator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantModerator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) GetAllAssistantEvaluator(ctx context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantEvaluator, error) {
+	start := time.Now()
+	res, err := client.assistantClient.GetAllAssistantEvaluator(client.WithAuth(ctx, auth), &protos.GetAllAssistantEvaluatorRequest{
+		Paginate:    paginate,
+		AssistantId: assistantId,
+		Criterias:   criteria,
+	})
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling GetAllAssistantEvaluator %v", err)
+		return nil, nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling GetAllAssistantEvaluator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.GetAllAssistantEvaluator", time.Since(start))
+	return res.GetPaginated(), res.GetData(), nil
+}
+
+func (client *assistantServiceClient) CreateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.CreateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.CreateAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling CreateAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling CreateAssistantEvaluator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.CreateAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) UpdateAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.UpdateAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.UpdateAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling UpdateAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling UpdateAssistantEvaluator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.UpdateAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
+func (client *assistantServiceClient) DeleteAssistantEvaluator(c context.Context, auth types.SimplePrinciple, iRequest *protos.DeleteAssistantEvaluatorRequest) (*protos.GetAssistantEvaluatorResponse, error) {
+	start := time.Now()
+	res, err := client.assistantClient.DeleteAssistantEvaluator(client.WithAuth(c, auth), iRequest)
+	if err != nil {
+		client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantEvaluator", time.Since(start))
+		client.logger.Errorf("error while calling DeleteAssistantEvaluator %v", err)
+		return nil, err
+	}
+	if !res.GetSuccess() {
+		client.logger.Errorf("error while calling DeleteAssistantEvaluator %v", res.GetError())
+	}
+	client.logger.Benchmark("Benchmarking: assistantClient.DeleteAssistantEvaluator", time.Since(start))
+	return res, nil
+}
+
 func (client *assistantServiceClient) GetAllAssistantTool(c context.Context, auth types.SimplePrinciple, assistantId uint64, criteria []*protos.Criteria, paginate *protos.Paginate) (*protos.Paginated, []*protos.AssistantTool, error) {
 	start := time.Now()
 	res, err := client.assistantClient.GetAllAssistantTool(client.WithAuth(c, auth), &protos.GetAllAssistantToolRequest{
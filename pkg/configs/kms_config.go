@@ -0,0 +1,44 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package configs
+
+type KMSType string
+
+const (
+	KMS_LOCAL KMSType = "local"
+	KMS_AWS   KMSType = "aws"
+)
+
+// KMSConfig configures the key management service holding the master key
+// that wraps data keys.
+//
+// The local provider reads master keys as comma separated id:base64 pairs of
+// 32 byte keys, from LocalKeys or one pair per line from LocalKeyFile. KeyId
+// is the id of the key used for wrapping, other keys are only used to unwrap
+// data keys wrapped before a rotation. The aws provider wraps with the KMS key
+// id or arn of KeyId.
+//
+// Without configured keys the local provider wraps with a key derived from the
+// application secret, kept under the id "default". Both providers keep
+// unwrapping with that key once keys are configured, so running the vault
+// encryption rewraps existing data keys with KeyId. The secret must not change
+// until then.
+type KMSConfig struct {
+	Provider     string     `mapstructure:"provider"`
+	KeyId        string     `mapstructure:"key_id"`
+	LocalKeys    string     `mapstructure:"local_keys"`
+	LocalKeyFile string     `mapstructure:"local_key_file"`
+	Auth         *AwsConfig `mapstructure:"auth"`
+}
+
+func (cfg *KMSConfig) Type() KMSType {
+	switch cfg.Provider {
+	case string(KMS_AWS):
+		return KMS_AWS
+	default:
+		return KMS_LOCAL
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package configs
+
+import "testing"
+
+func TestKMSConfig_Type(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  KMSConfig
+		want KMSType
+	}{
+		{"AWS", KMSConfig{Provider: "aws"}, KMS_AWS},
+		{"Local", KMSConfig{Provider: "local"}, KMS_LOCAL},
+		{"Default", KMSConfig{}, KMS_LOCAL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.Type(); got != tt.want {
+				t.Errorf("KMSConfig.Type() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package configs
+
+import "time"
+
+// RateLimitConfig configures the limits applied to every project, a limit of
+// zero is not enforced.
+//
+// Requests take a token from the bucket of their project and of their api
+// key, buckets refill at the rate per second up to the burst. Concurrent
+// sessions are capped per assistant deployment, quotas cap the tokens and
+// voice minutes of a project in a calendar month. Quotas are configured in
+// the web service only, it publishes them for every service to enforce.
+type RateLimitConfig struct {
+	ProjectRate           float64 `mapstructure:"project_rate"`
+	ProjectBurst          int     `mapstructure:"project_burst"`
+	ApiKeyRate            float64 `mapstructure:"api_key_rate"`
+	ApiKeyBurst           int     `mapstructure:"api_key_burst"`
+	MaxConcurrentSessions int     `mapstructure:"max_concurrent_sessions"`
+	MonthlyTokenQuota     int64   `mapstructure:"monthly_token_quota"`
+	MonthlyMinuteQuota    int64   `mapstructure:"monthly_minute_quota"`
+}
+
+// burst defaults to the rate rounded up, so a bucket holds at least a token.
+func burst(rate float64, burst int) int {
+	if burst > 0 {
+		return burst
+	}
+	if b := int(rate); float64(b) < rate {
+		return b + 1
+	} else if b > 0 {
+		return b
+	}
+	return 1
+}
+
+func (cfg *RateLimitConfig) GetProjectBurst() int {
+	return burst(cfg.ProjectRate, cfg.ProjectBurst)
+}
+
+func (cfg *RateLimitConfig) GetApiKeyBurst() int {
+	return burst(cfg.ApiKeyRate, cfg.ApiKeyBurst)
+}
+
+// MonthlyDurationQuota is the monthly voice minute quota as a duration.
+func (cfg *RateLimitConfig) MonthlyDurationQuota() time.Duration {
+	return time.Duration(cfg.MonthlyMinuteQuota) * time.Minute
+}
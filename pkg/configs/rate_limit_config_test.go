@@ -0,0 +1,38 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package configs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimitConfig_Burst(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  RateLimitConfig
+		want int
+	}{
+		{"Configured", RateLimitConfig{ProjectRate: 5, ProjectBurst: 20}, 20},
+		{"Rate", RateLimitConfig{ProjectRate: 5}, 5},
+		{"Fractional rate", RateLimitConfig{ProjectRate: 2.5}, 3},
+		{"Slow rate", RateLimitConfig{ProjectRate: 0.1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.GetProjectBurst(); got != tt.want {
+				t.Errorf("RateLimitConfig.GetProjectBurst() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitConfig_MonthlyDurationQuota(t *testing.T) {
+	cfg := RateLimitConfig{MonthlyMinuteQuota: 90}
+	if got := cfg.MonthlyDurationQuota(); got != 90*time.Minute {
+		t.Errorf("RateLimitConfig.MonthlyDurationQuota() = %v, want %v", got, 90*time.Minute)
+	}
+}
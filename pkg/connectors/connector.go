@@ -101,4 +101,88 @@ type VectorConnector interface {
 		query string,
 		filter map[string]interface{},
 		opts *VectorSearchOptions) ([]map[string]interface{}, error)
+	// ListDocuments pages through the documents of a collection whose
+	// metadata matches every field of filter. It returns the total number of
+	// matches and the page as hits with _index, _id and the full _source.
+	ListDocuments(ctx context.Context,
+		collectionName string,
+		filter map[string]interface{},
+		from, size int) (int64, []map[string]interface{}, error)
+	// UpdateDocument merges a partial document into a stored one, the
+	// metadata and entities objects are merged field by field.
+	UpdateDocument(ctx context.Context,
+		collectionName string,
+		id string,
+		doc map[string]interface{}) error
+}
+
+// documentHit shapes a listed document like an OpenSearch hit.
+func documentHit(collectionName, id string, fields map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"_index":  collectionName,
+		"_id":     id,
+		"_source": fields,
+	}
+}
+
+// vectorHit shapes a search result like an OpenSearch hit (_id, _score and
+// _source restricted to source) so callers can switch vector backends.
+func vectorHit(id string, score float64, fields map[string]interface{}, source []string) map[string]interface{} {
+	selected := make(map[string]interface{}, len(source))
+	for _, key := range source {
+		if v, ok := fields[key]; ok {
+			selected[key] = v
+		}
+	}
+	return map[string]interface{}{
+		"_id":     id,
+		"_score":  score,
+		"_source": selected,
+	}
+}
+
+// splitVectorDocument separates a partial document into the metadata and
+// entities to merge and the replacement text, nil when unchanged. Fields
+// other than text, metadata and entities are kept in metadata.
+func splitVectorDocument(doc map[string]interface{}) (map[string]interface{}, map[string]interface{}, *string) {
+	metadata := map[string]interface{}{}
+	entities := map[string]interface{}{}
+	var text *string
+	for key, value := range doc {
+		switch key {
+		case "metadata":
+			if m, ok := value.(map[string]interface{}); ok {
+				for k, v := range m {
+					metadata[k] = v
+				}
+			}
+		case "entities":
+			if m, ok := value.(map[string]interface{}); ok {
+				for k, v := range m {
+					entities[k] = v
+				}
+			}
+		case "text":
+			if t, ok := value.(string); ok {
+				text = &t
+			}
+		default:
+			metadata[key] = value
+		}
+	}
+	return metadata, entities, text
+}
+
+// isMetadataField reports whether key is a plain metadata field name,
+// lower case letters, digits and underscores.
+func isMetadataField(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
 }
@@ -466,6 +466,41 @@ func (osc *openSearchConnector) VectorSearch(ctx context.Context,
 	return result.Hits.Hits, result.Error()
 }
 
+// ListDocuments implements VectorConnector with term queries on metadata.
+func (osc *openSearchConnector) ListDocuments(ctx context.Context,
+	collectionName string,
+	filter map[string]interface{},
+	from, size int) (int64, []map[string]interface{}, error) {
+	must := make([]map[string]interface{}, 0, len(filter))
+	for key, value := range filter {
+		must = append(must, map[string]interface{}{
+			"term": map[string]interface{}{fmt.Sprintf("metadata.%s", key): value},
+		})
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{"bool": map[string]interface{}{"must": must}},
+		"from":  from,
+		"size":  size,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	result := osc.SearchWithCount(ctx, []string{collectionName}, string(body))
+	if result.Err != nil {
+		return 0, nil, result.Err
+	}
+	return int64(result.Hits.Total), result.Hits.Hits, nil
+}
+
+// UpdateDocument implements VectorConnector with a partial document update.
+func (osc *openSearchConnector) UpdateDocument(ctx context.Context, collectionName string, id string, doc map[string]interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"doc": doc})
+	if err != nil {
+		return err
+	}
+	return osc.Update(ctx, collectionName, id, string(body))
+}
+
 // return connector behavior for opensearch
 func NewOpenSearchConnector(config *configs.OpenSearchConfig, logger commons.Logger) OpenSearchConnector {
 	return &openSearchConnector{cfg: config, logger: logger}
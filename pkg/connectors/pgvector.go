@@ -0,0 +1,519 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	commons "github.com/rapidaai/pkg/commons"
+)
+
+const (
+	// pgVectorSchema holds one table per knowledge namespace, away from the
+	// application tables.
+	pgVectorSchema = "vector_store"
+
+	// pgVectorTextSearchConfig is language neutral, knowledge is multilingual.
+	pgVectorTextSearchConfig = "simple"
+
+	// rrfK dampens the weight of top ranks in reciprocal rank fusion.
+	rrfK = 60
+
+	// pgVectorMaxIdentifier is postgres' NAMEDATALEN - 1.
+	pgVectorMaxIdentifier = 63
+)
+
+var ErrPgVectorNamespace = errors.New("pgvector: namespace is required")
+
+// VectorDocument is a chunk stored in a vector namespace.
+type VectorDocument struct {
+	ID         string
+	DocumentID string
+	Text       string
+	Vector     []float64
+	Metadata   map[string]interface{}
+	Entities   map[string]interface{}
+}
+
+// PgVectorConnector stores knowledge chunks in Postgres with the pgvector
+// extension, one table per namespace:
+//
+//	id text primary key, document_id text, text text, metadata jsonb,
+//	entities jsonb, embedding vector(n), tsv tsvector (generated from text)
+//
+// Vector scores follow OpenSearch's cosinesimil space, (1 + cos) / 2, so
+// score thresholds carry over between the two backends.
+type PgVectorConnector interface {
+	VectorConnector
+	EnsureNamespace(ctx context.Context, namespace string, dimension int) error
+	Upsert(ctx context.Context, namespace string, documents []VectorDocument) error
+	DropNamespace(ctx context.Context, namespace string) error
+}
+
+type pgVectorConnector struct {
+	postgres PostgresConnector
+	logger   commons.Logger
+
+	// owned is set when Connect opened the postgres connection
+	owned bool
+}
+
+// NewPgVectorConnector returns a vector connector on top of a postgres
+// connector. A connection opened elsewhere is shared and left open on
+// Disconnect, one opened by Connect is closed with it.
+func NewPgVectorConnector(postgres PostgresConnector, logger commons.Logger) PgVectorConnector {
+	return &pgVectorConnector{postgres: postgres, logger: logger}
+}
+
+// Connect enables the extension and the vector schema.
+func (pg *pgVectorConnector) Connect(ctx context.Context) error {
+	if !pg.postgres.IsConnected(ctx) {
+		if err := pg.postgres.Connect(ctx); err != nil {
+			return err
+		}
+		pg.owned = true
+	}
+	db := pg.postgres.DB(ctx)
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS vector").Error; err != nil {
+		pg.logger.Errorf("unable to enable pgvector extension %v", err)
+		return err
+	}
+	return db.Exec(fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", pgVectorSchema)).Error
+}
+
+func (pg *pgVectorConnector) Name() string {
+	return fmt.Sprintf("PGVECTOR %s", pg.postgres.Name())
+}
+
+func (pg *pgVectorConnector) IsConnected(ctx context.Context) bool {
+	return pg.postgres.IsConnected(ctx)
+}
+
+func (pg *pgVectorConnector) Disconnect(ctx context.Context) error {
+	pg.logger.Debug("Disconnecting with pgvector client.")
+	if !pg.owned {
+		return nil
+	}
+	pg.owned = false
+	return pg.postgres.Disconnect(ctx)
+}
+
+// EnsureNamespace creates the namespace table and its indexes if missing.
+func (pg *pgVectorConnector) EnsureNamespace(ctx context.Context, namespace string, dimension int) error {
+	table, err := pgVectorTable(namespace)
+	if err != nil {
+		return err
+	}
+	if dimension <= 0 {
+		return fmt.Errorf("pgvector: invalid dimension %d", dimension)
+	}
+	name := pgVectorTableName(namespace)
+	statements := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			id text PRIMARY KEY,
+			document_id text NOT NULL DEFAULT '',
+			text text NOT NULL DEFAULT '',
+			metadata jsonb NOT NULL DEFAULT '{}',
+			entities jsonb NOT NULL DEFAULT '{}',
+			embedding vector(%d) NOT NULL,
+			tsv tsvector GENERATED ALWAYS AS (to_tsvector('%s', text)) STORED
+		)`, table, dimension, pgVectorTextSearchConfig),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (embedding vector_cosine_ops)`,
+			quoteIdentifier(pgVectorIndexName(name, "embedding")), table),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING gin (tsv)`,
+			quoteIdentifier(pgVectorIndexName(name, "tsv")), table),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING gin (metadata jsonb_path_ops)`,
+			quoteIdentifier(pgVectorIndexName(name, "metadata")), table),
+	}
+	db := pg.postgres.DB(ctx)
+	for _, stmt := range statements {
+		if err := db.Exec(stmt).Error; err != nil {
+			pg.logger.Errorf("unable to create pgvector namespace %s got error %v", namespace, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Upsert inserts or replaces documents by id.
+func (pg *pgVectorConnector) Upsert(ctx context.Context, namespace string, documents []VectorDocument) error {
+	table, err := pgVectorTable(namespace)
+	if err != nil {
+		return err
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(documents))
+	args := make([]interface{}, 0, len(documents)*6)
+	for _, doc := range documents {
+		metadata, err := json.Marshal(emptyIfNil(doc.Metadata))
+		if err != nil {
+			return err
+		}
+		entities, err := json.Marshal(emptyIfNil(doc.Entities))
+		if err != nil {
+			return err
+		}
+		values = append(values, "(?, ?, ?, ?::jsonb, ?::jsonb, ?::vector)")
+		args = append(args, doc.ID, doc.DocumentID, doc.Text, string(metadata), string(entities), vectorLiteral(doc.Vector))
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (id, document_id, text, metadata, entities, embedding) VALUES %s
+		ON CONFLICT (id) DO UPDATE SET
+			document_id = EXCLUDED.document_id,
+			text = EXCLUDED.text,
+			metadata = EXCLUDED.metadata,
+			entities = EXCLUDED.entities,
+			embedding = EXCLUDED.embedding`, table, strings.Join(values, ", "))
+	if err := pg.postgres.DB(ctx).Exec(query, args...).Error; err != nil {
+		pg.logger.Errorf("error persisting to pgvector namespace %s got error %v", namespace, err)
+		return err
+	}
+	return nil
+}
+
+func (pg *pgVectorConnector) DropNamespace(ctx context.Context, namespace string) error {
+	table, err := pgVectorTable(namespace)
+	if err != nil {
+		return err
+	}
+	return pg.postgres.DB(ctx).Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", table)).Error
+}
+
+// VectorSearch implements VectorConnector.
+func (pg *pgVectorConnector) VectorSearch(ctx context.Context,
+	collectionName string,
+	queryVector []float64,
+	entities map[string]interface{},
+	opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	table, err := pgVectorTable(collectionName)
+	if err != nil {
+		return nil, err
+	}
+	vector := vectorLiteral(queryVector)
+	filter, filterArgs := pgVectorFilter(entities)
+
+	query := fmt.Sprintf(`SELECT id, document_id, text, metadata, %s AS score
+		FROM %s
+		WHERE %s AND %s >= ?
+		ORDER BY embedding <=> ?::vector
+		LIMIT ?`, pgVectorScore, table, filter, pgVectorScore)
+	args := []interface{}{vector}
+	args = append(args, filterArgs...)
+	args = append(args, vector, opts.MinScore, vector, opts.TopK)
+	return pg.search(ctx, collectionName, query, args, opts)
+}
+
+// TextSearch implements VectorConnector. Text rank has no fixed range, so
+// opts.MinScore is not applied; a chunk must match the query terms.
+func (pg *pgVectorConnector) TextSearch(ctx context.Context,
+	collectionName string, query string,
+	entities map[string]interface{},
+	opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	table, err := pgVectorTable(collectionName)
+	if err != nil {
+		return nil, err
+	}
+	filter, filterArgs := pgVectorFilter(entities)
+
+	sql := fmt.Sprintf(`SELECT id, document_id, text, metadata, ts_rank_cd(tsv, q) AS score
+		FROM %s, plainto_tsquery('%s', ?) q
+		WHERE tsv @@ q AND %s
+		ORDER BY score DESC
+		LIMIT ?`, table, pgVectorTextSearchConfig, filter)
+	args := []interface{}{query}
+	args = append(args, filterArgs...)
+	args = append(args, opts.TopK)
+	return pg.search(ctx, collectionName, sql, args, opts)
+}
+
+// HybridSearch implements VectorConnector with reciprocal rank fusion of a
+// vector and a text search. opts.MinScore applies to the vector side,
+// opts.Alpha, when set, weighs the vector side against the text side.
+func (pg *pgVectorConnector) HybridSearch(ctx context.Context,
+	collectionName string,
+	query string,
+	queryVector []float64,
+	entities map[string]interface{},
+	opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	table, err := pgVectorTable(collectionName)
+	if err != nil {
+		return nil, err
+	}
+	vector := vectorLiteral(queryVector)
+	filter, filterArgs := pgVectorFilter(entities)
+	candidates := max(opts.TopK*4, 20)
+	semanticWeight, lexicalWeight := 1.0, 1.0
+	if opts.Alpha > 0 && opts.Alpha < 1 {
+		semanticWeight, lexicalWeight = float64(opts.Alpha), float64(1-opts.Alpha)
+	}
+
+	sql := fmt.Sprintf(`WITH semantic AS (
+			SELECT id, row_number() OVER (ORDER BY embedding <=> ?::vector) AS rank
+			FROM %[1]s
+			WHERE %[2]s AND %[3]s >= ?
+			ORDER BY embedding <=> ?::vector
+			LIMIT ?
+		), lexical AS (
+			SELECT id, row_number() OVER (ORDER BY ts_rank_cd(tsv, q) DESC) AS rank
+			FROM %[1]s, plainto_tsquery('%[4]s', ?) q
+			WHERE tsv @@ q AND %[2]s
+			ORDER BY ts_rank_cd(tsv, q) DESC
+			LIMIT ?
+		)
+		SELECT t.id, t.document_id, t.text, t.metadata,
+			COALESCE(?::float8 / (%[5]d + semantic.rank), 0) + COALESCE(?::float8 / (%[5]d + lexical.rank), 0) AS score
+		FROM semantic
+		FULL OUTER JOIN lexical ON semantic.id = lexical.id
+		JOIN %[1]s t ON t.id = COALESCE(semantic.id, lexical.id)
+		ORDER BY score DESC
+		LIMIT ?`, table, filter, pgVectorScore, pgVectorTextSearchConfig, rrfK)
+	args := []interface{}{vector}
+	args = append(args, filterArgs...)
+	args = append(args, vector, opts.MinScore, vector, candidates, query)
+	args = append(args, filterArgs...)
+	args = append(args, candidates, semanticWeight, lexicalWeight, opts.TopK)
+	return pg.search(ctx, collectionName, sql, args, opts)
+}
+
+// ListDocuments implements VectorConnector, documents are ordered by id so
+// pages are stable.
+func (pg *pgVectorConnector) ListDocuments(ctx context.Context,
+	collectionName string,
+	filter map[string]interface{},
+	from, size int) (int64, []map[string]interface{}, error) {
+	table, err := pgVectorTable(collectionName)
+	if err != nil {
+		return 0, nil, err
+	}
+	where, args, err := pgVectorMetadataFilter(filter)
+	if err != nil {
+		return 0, nil, err
+	}
+	db := pg.postgres.DB(ctx)
+	var total int64
+	if err := db.Raw(fmt.Sprintf("SELECT count(*) FROM %s WHERE %s", table, where), args...).Scan(&total).Error; err != nil {
+		pg.logger.Errorf("error while counting pgvector namespace %s got error %v", collectionName, err)
+		return 0, nil, err
+	}
+
+	rows, err := db.Raw(fmt.Sprintf(`SELECT id, document_id, text, metadata, entities
+		FROM %s
+		WHERE %s
+		ORDER BY id
+		LIMIT ? OFFSET ?`, table, where), append(args, size, from)...).Rows()
+	if err != nil {
+		pg.logger.Errorf("error while listing pgvector namespace %s got error %v", collectionName, err)
+		return 0, nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]map[string]interface{}, 0, size)
+	for rows.Next() {
+		var (
+			id, documentID, text string
+			metadata, entities   []byte
+		)
+		if err := rows.Scan(&id, &documentID, &text, &metadata, &entities); err != nil {
+			return 0, nil, err
+		}
+		meta, ents := map[string]interface{}{}, map[string]interface{}{}
+		if len(metadata) > 0 {
+			if err := json.Unmarshal(metadata, &meta); err != nil {
+				return 0, nil, err
+			}
+		}
+		if len(entities) > 0 {
+			if err := json.Unmarshal(entities, &ents); err != nil {
+				return 0, nil, err
+			}
+		}
+		hits = append(hits, documentHit(collectionName, id, map[string]interface{}{
+			"document_id": documentID,
+			"text":        text,
+			"metadata":    meta,
+			"entities":    ents,
+		}))
+	}
+	return total, hits, rows.Err()
+}
+
+// UpdateDocument implements VectorConnector. The table has no columns
+// besides text, metadata and entities, other fields of doc are kept in
+// metadata.
+func (pg *pgVectorConnector) UpdateDocument(ctx context.Context, collectionName string, id string, doc map[string]interface{}) error {
+	table, err := pgVectorTable(collectionName)
+	if err != nil {
+		return err
+	}
+	metadata, entities, text := splitVectorDocument(doc)
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	entitiesJSON, err := json.Marshal(entities)
+	if err != nil {
+		return err
+	}
+	sets := []string{"metadata = metadata || ?::jsonb", "entities = entities || ?::jsonb"}
+	args := []interface{}{string(metadataJSON), string(entitiesJSON)}
+	if text != nil {
+		sets = append(sets, "text = ?")
+		args = append(args, *text)
+	}
+	result := pg.postgres.DB(ctx).Exec(fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", table, strings.Join(sets, ", ")), append(args, id)...)
+	if result.Error != nil {
+		pg.logger.Errorf("error updating document %s in pgvector namespace %s got error %v", id, collectionName, result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("pgvector: document %s not found in %s", id, collectionName)
+	}
+	return nil
+}
+
+// pgVectorScore is the cosine similarity mapped to [0, 1]. It expects the
+// query vector as its single argument.
+const pgVectorScore = "((2 - (embedding <=> ?::vector)) / 2)"
+
+// search runs query and returns hits shaped like OpenSearch hits.
+func (pg *pgVectorConnector) search(ctx context.Context, collectionName, query string, args []interface{}, opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	rows, err := pg.postgres.DB(ctx).Raw(query, args...).Rows()
+	if err != nil {
+		pg.logger.Errorf("error while searching pgvector namespace %s got error %v", collectionName, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]map[string]interface{}, 0, opts.TopK)
+	for rows.Next() {
+		var (
+			id, documentID, text string
+			metadata             []byte
+			score                float64
+		)
+		if err := rows.Scan(&id, &documentID, &text, &metadata, &score); err != nil {
+			return nil, err
+		}
+		meta := map[string]interface{}{}
+		if len(metadata) > 0 {
+			if err := json.Unmarshal(metadata, &meta); err != nil {
+				return nil, err
+			}
+		}
+		hits = append(hits, vectorHit(id, score, map[string]interface{}{
+			"text":        text,
+			"document_id": documentID,
+			"metadata":    meta,
+		}, opts.Source))
+	}
+	return hits, rows.Err()
+}
+
+// pgVectorFilter mirrors the metadata filters of the OpenSearch connector.
+// Entity boosts have no equivalent and are ignored.
+func pgVectorFilter(entities map[string]interface{}) (string, []interface{}) {
+	clauses := []string{"TRUE"}
+	args := []interface{}{}
+	// sorted for stable statements
+	for _, key := range []string{"category", "document_id", "document_name", "knowledge_id", "organization_id", "project_id", "text"} {
+		val, ok := entities[key].(string)
+		if !ok || val == "" {
+			continue
+		}
+		if key == "text" {
+			clauses = append(clauses, fmt.Sprintf("tsv @@ plainto_tsquery('%s', ?)", pgVectorTextSearchConfig))
+		} else {
+			clauses = append(clauses, fmt.Sprintf("metadata->>'%s' = ?", key))
+		}
+		args = append(args, val)
+	}
+	return strings.Join(clauses, " AND "), args
+}
+
+// pgVectorMetadataFilter matches every field of filter against metadata as
+// text, the way search filters compare.
+func pgVectorMetadataFilter(filter map[string]interface{}) (string, []interface{}, error) {
+	keys := make([]string, 0, len(filter))
+	for key := range filter {
+		if !isMetadataField(key) {
+			return "", nil, fmt.Errorf("pgvector: invalid metadata field %q", key)
+		}
+		keys = append(keys, key)
+	}
+	// sorted for stable statements
+	sort.Strings(keys)
+	clauses := []string{"TRUE"}
+	args := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		clauses = append(clauses, fmt.Sprintf("metadata->>'%s' = ?", key))
+		args = append(args, fmt.Sprint(filter[key]))
+	}
+	return strings.Join(clauses, " AND "), args, nil
+}
+
+// pgVectorTableName maps a namespace to a table name within postgres'
+// identifier limit. Long namespaces keep a readable prefix and a hash.
+func pgVectorTableName(namespace string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, strings.ToLower(namespace))
+	if len(name) <= pgVectorMaxIdentifier {
+		return name
+	}
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	suffix := fmt.Sprintf("_%016x", h.Sum64())
+	return name[:pgVectorMaxIdentifier-len(suffix)] + suffix
+}
+
+func pgVectorTable(namespace string) (string, error) {
+	if namespace == "" {
+		return "", ErrPgVectorNamespace
+	}
+	return pgVectorSchema + "." + quoteIdentifier(pgVectorTableName(namespace)), nil
+}
+
+func pgVectorIndexName(table, column string) string {
+	h := fnv.New32a()
+	h.Write([]byte(table))
+	return fmt.Sprintf("vs_%08x_%s_idx", h.Sum32(), column)
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// vectorLiteral formats a vector in pgvector's text form, [1,2,3].
+func vectorLiteral(v []float64) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(f, 'f', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func emptyIfNil(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
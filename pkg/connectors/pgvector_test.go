@@ -0,0 +1,253 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package connectors
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	commons "github.com/rapidaai/pkg/commons"
+	configs "github.com/rapidaai/pkg/configs"
+)
+
+func newTestPgVector(t *testing.T) (*pgVectorConnector, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: db}), &gorm.Config{})
+	require.NoError(t, err)
+	logger, _ := commons.NewApplicationLogger()
+	return &pgVectorConnector{
+		postgres: &postgresConnector{
+			cfg:    &configs.PostgresConfig{Host: "localhost", Port: 5432},
+			logger: logger,
+			db:     gormDB,
+		},
+		logger: logger,
+	}, mock
+}
+
+func hitRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "document_id", "text", "metadata", "score"}).
+		AddRow("c1", "d1", "refunds take 5 days", []byte(`{"knowledge_id":"7"}`), 0.91).
+		AddRow("c2", "d2", "refund policy", []byte(`{}`), 0.72)
+}
+
+func TestPgVector_VectorSearch(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM vector_store."prod__vs__1__2__3"`)).
+		WithArgs("[0.5,0.25]", "7", "[0.5,0.25]", float32(0.5), "[0.5,0.25]", 2).
+		WillReturnRows(hitRows())
+
+	hits, err := pg.VectorSearch(context.Background(), "prod__vs__1__2__3", []float64{0.5, 0.25},
+		map[string]interface{}{"knowledge_id": "7", "people": "ignored"},
+		NewDefaultVectorSearchOptions(WithTopK(2), WithSource([]string{"text", "document_id", "metadata"})))
+	require.NoError(t, err)
+	require.Len(t, hits, 2)
+
+	assert.Equal(t, "c1", hits[0]["_id"])
+	assert.Equal(t, 0.91, hits[0]["_score"])
+	source := hits[0]["_source"].(map[string]interface{})
+	assert.Equal(t, "refunds take 5 days", source["text"])
+	assert.Equal(t, "d1", source["document_id"])
+	assert.Equal(t, map[string]interface{}{"knowledge_id": "7"}, source["metadata"])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgVector_SourceSelection(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectQuery("plainto_tsquery").
+		WithArgs("refund", 5).
+		WillReturnRows(hitRows())
+
+	hits, err := pg.TextSearch(context.Background(), "ns", "refund", nil, NewDefaultVectorSearchOptions())
+	require.NoError(t, err)
+
+	// default source is text and metadata
+	source := hits[1]["_source"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"text": "refund policy", "metadata": map[string]interface{}{}}, source)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgVector_HybridSearch(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectQuery("FULL OUTER JOIN lexical").
+		WithArgs("[1]", "d1", "[1]", float32(0.4), "[1]", 20, "refund", "d1", 20, 0.75, 0.25, 3).
+		WillReturnRows(hitRows())
+
+	hits, err := pg.HybridSearch(context.Background(), "ns", "refund", []float64{1},
+		map[string]interface{}{"document_id": "d1"},
+		NewDefaultVectorSearchOptions(WithTopK(3), WithMinScore(0.4), WithAlpha(0.75)))
+	require.NoError(t, err)
+	assert.Len(t, hits, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgVector_Upsert(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (id) DO UPDATE`)).
+		WithArgs("c1", "d1", "hello", `{"k":"v"}`, `{}`, "[0.1,0.2]").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := pg.Upsert(context.Background(), "ns", []VectorDocument{{
+		ID: "c1", DocumentID: "d1", Text: "hello",
+		Vector:   []float64{0.1, 0.2},
+		Metadata: map[string]interface{}{"k": "v"},
+	}})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgVector_ListDocuments(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM vector_store."ns" WHERE TRUE AND metadata->>'knowledge_id' = $1 AND metadata->>'project_id' = $2`)).
+		WithArgs("7", "3").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY id`)).
+		WithArgs("7", "3", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "document_id", "text", "metadata", "entities"}).
+			AddRow("c11", "d1", "refund policy", []byte(`{"knowledge_id":"7"}`), []byte(`{"people":["ann"]}`)))
+
+	total, hits, err := pg.ListDocuments(context.Background(), "ns",
+		map[string]interface{}{"knowledge_id": uint64(7), "project_id": "3"}, 10, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "ns", hits[0]["_index"])
+	assert.Equal(t, "c11", hits[0]["_id"])
+	assert.Equal(t, map[string]interface{}{
+		"document_id": "d1",
+		"text":        "refund policy",
+		"metadata":    map[string]interface{}{"knowledge_id": "7"},
+		"entities":    map[string]interface{}{"people": []interface{}{"ann"}},
+	}, hits[0]["_source"])
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	_, _, err = pg.ListDocuments(context.Background(), "ns", map[string]interface{}{"x' OR '1'='1": "1"}, 0, 10)
+	assert.Error(t, err)
+}
+
+func TestPgVector_UpdateDocument(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE vector_store."ns" SET metadata = metadata || $1::jsonb, entities = entities || $2::jsonb WHERE id = $3`)).
+		WithArgs(`{"document_name":"faq","status":"ARCHIEVE"}`, `{"people":["ann"]}`, "c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE vector_store."ns"`)).
+		WithArgs(`{}`, `{}`, "missing").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, pg.UpdateDocument(context.Background(), "ns", "c1", map[string]interface{}{
+		"metadata": map[string]interface{}{"document_name": "faq"},
+		"entities": map[string]interface{}{"people": []string{"ann"}},
+		"status":   "ARCHIEVE",
+	}))
+	assert.Error(t, pg.UpdateDocument(context.Background(), "ns", "missing", map[string]interface{}{}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPgVector_EnsureNamespace(t *testing.T) {
+	pg, mock := newTestPgVector(t)
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE IF NOT EXISTS vector_store."ns"`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`USING hnsw (embedding vector_cosine_ops)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`USING gin (tsv)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`USING gin (metadata jsonb_path_ops)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, pg.EnsureNamespace(context.Background(), "ns", 3))
+	assert.Error(t, pg.EnsureNamespace(context.Background(), "ns", 0))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPgVector_IndexThenRetrieve runs against a postgres with the vector
+// extension, e.g. PGVECTOR_TEST_DSN="host=localhost user=rapida_user
+// password=rapida_db_password dbname=assistant_db sslmode=disable".
+func TestPgVector_IndexThenRetrieve(t *testing.T) {
+	dsn := os.Getenv("PGVECTOR_TEST_DSN")
+	if dsn == "" {
+		t.Skip("PGVECTOR_TEST_DSN is not set")
+	}
+	gormDB, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	logger, _ := commons.NewApplicationLogger()
+	pg := NewPgVectorConnector(&postgresConnector{cfg: &configs.PostgresConfig{}, logger: logger, db: gormDB}, logger)
+
+	ctx := context.Background()
+	namespace := "test__vs__index_then_retrieve"
+	require.NoError(t, pg.Connect(ctx))
+	require.NoError(t, pg.DropNamespace(ctx, namespace))
+	t.Cleanup(func() { pg.DropNamespace(ctx, namespace) })
+
+	require.NoError(t, pg.EnsureNamespace(ctx, namespace, 3))
+	require.NoError(t, pg.Upsert(ctx, namespace, []VectorDocument{
+		{ID: "c1", DocumentID: "d1", Text: "refunds take five days", Vector: []float64{1, 0, 0},
+			Metadata: map[string]interface{}{"knowledge_id": "7"}},
+		{ID: "c2", DocumentID: "d2", Text: "shipping is free over fifty", Vector: []float64{0, 1, 0},
+			Metadata: map[string]interface{}{"knowledge_id": "7"}},
+		{ID: "c3", DocumentID: "d3", Text: "refunds for another knowledge", Vector: []float64{1, 0, 0},
+			Metadata: map[string]interface{}{"knowledge_id": "8"}},
+	}))
+	// upsert replaces by id
+	require.NoError(t, pg.Upsert(ctx, namespace, []VectorDocument{
+		{ID: "c1", DocumentID: "d1", Text: "refunds take five business days", Vector: []float64{1, 0, 0},
+			Metadata: map[string]interface{}{"knowledge_id": "7"}},
+	}))
+
+	filter := map[string]interface{}{"knowledge_id": "7"}
+	opts := NewDefaultVectorSearchOptions(WithTopK(2), WithSource([]string{"text", "document_id"}))
+
+	hits, err := pg.VectorSearch(ctx, namespace, []float64{0.9, 0.1, 0}, filter, opts)
+	require.NoError(t, err)
+	require.NotEmpty(t, hits)
+	assert.Equal(t, "c1", hits[0]["_id"])
+	assert.Equal(t, "refunds take five business days", hits[0]["_source"].(map[string]interface{})["text"])
+	for _, hit := range hits {
+		assert.NotEqual(t, "c3", hit["_id"])
+	}
+
+	hits, err = pg.TextSearch(ctx, namespace, "shipping", filter, opts)
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "c2", hits[0]["_id"])
+
+	hits, err = pg.HybridSearch(ctx, namespace, "refunds", []float64{1, 0, 0}, filter, opts)
+	require.NoError(t, err)
+	require.NotEmpty(t, hits)
+	assert.Equal(t, "c1", hits[0]["_id"])
+}
+
+func TestPgVector_EmptyNamespace(t *testing.T) {
+	pg, _ := newTestPgVector(t)
+	_, err := pg.VectorSearch(context.Background(), "", []float64{1}, nil, NewDefaultVectorSearchOptions())
+	assert.ErrorIs(t, err, ErrPgVectorNamespace)
+	assert.ErrorIs(t, pg.EnsureNamespace(context.Background(), "", 3), ErrPgVectorNamespace)
+}
+
+func TestPgVectorTableName(t *testing.T) {
+	assert.Equal(t, "dev__vs__1__2__3", pgVectorTableName("DEV__vs__1__2__3"))
+	assert.Equal(t, "a_b", pgVectorTableName(`a"b`))
+
+	long := "prod__vs__" + strings.Repeat("9", 20) + "__" + strings.Repeat("8", 20) + "__" + strings.Repeat("7", 20)
+	name := pgVectorTableName(long)
+	assert.Len(t, name, pgVectorMaxIdentifier)
+	assert.NotEqual(t, name, pgVectorTableName(long[:len(long)-1]+"6"))
+}
+
+func TestVectorLiteral(t *testing.T) {
+	assert.Equal(t, "[]", vectorLiteral(nil))
+	assert.Equal(t, "[1,-0.5,0.1]", vectorLiteral([]float64{1, -0.5, 0.1}))
+}
@@ -0,0 +1,775 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package connectors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	commons "github.com/rapidaai/pkg/commons"
+	configs "github.com/rapidaai/pkg/configs"
+)
+
+const (
+	// weaviateMetadataPrefix marks the flattened, filterable copies of
+	// metadata fields, metadata.knowledge_id is stored as metadata_knowledge_id.
+	weaviateMetadataPrefix = "metadata_"
+
+	weaviateDefaultAlpha = 0.5
+	weaviateTimeout      = 30 * time.Second
+)
+
+var ErrWeaviateNamespace = errors.New("weaviate: namespace is required")
+
+// weaviateFilterFields are the metadata fields that can be filtered on, the
+// same set the OpenSearch connector filters with.
+var weaviateFilterFields = []string{"category", "document_id", "document_name", "knowledge_document_id", "knowledge_id", "organization_id", "project_id"}
+
+// WeaviateConnector stores knowledge chunks in Weaviate, one class per
+// namespace with externally computed vectors (vectorizer none, cosine):
+//
+//	text text, document_id text, chunk_id text, metadata text (json),
+//	entities text (json), metadata_<field> text for each filter field
+//
+// Object ids are uuid v5 (DNS namespace) of the chunk id, which is kept in
+// chunk_id. Vector scores follow OpenSearch's cosinesimil space,
+// (1 + cos) / 2, so score thresholds carry over between backends.
+type WeaviateConnector interface {
+	VectorConnector
+	EnsureNamespace(ctx context.Context, namespace string, dimension int) error
+	Upsert(ctx context.Context, namespace string, documents []VectorDocument) error
+	DropNamespace(ctx context.Context, namespace string) error
+}
+
+type weaviateConnector struct {
+	cfg    *configs.WeaviateConfig
+	client *http.Client
+	logger commons.Logger
+}
+
+func NewWeaviateConnector(cfg *configs.WeaviateConfig, logger commons.Logger) WeaviateConnector {
+	return &weaviateConnector{
+		cfg:    cfg,
+		client: &http.Client{Timeout: weaviateTimeout},
+		logger: logger,
+	}
+}
+
+// Connect verifies the node is ready to serve.
+func (wc *weaviateConnector) Connect(ctx context.Context) error {
+	status, _, err := wc.do(ctx, http.MethodGet, "/v1/.well-known/ready", nil)
+	if err != nil {
+		wc.logger.Errorf("unable to reach weaviate %s got error %v", wc.cfg.Host, err)
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("weaviate: node is not ready, status %d", status)
+	}
+	return nil
+}
+
+func (wc *weaviateConnector) Name() string {
+	return fmt.Sprintf("WEAVIATE %s://%s", wc.scheme(), wc.cfg.Host)
+}
+
+func (wc *weaviateConnector) IsConnected(ctx context.Context) bool {
+	status, _, err := wc.do(ctx, http.MethodGet, "/v1/.well-known/live", nil)
+	return err == nil && status == http.StatusOK
+}
+
+func (wc *weaviateConnector) Disconnect(ctx context.Context) error {
+	wc.logger.Debug("Disconnecting with weaviate client.")
+	wc.client.CloseIdleConnections()
+	return nil
+}
+
+// EnsureNamespace creates the namespace class if missing. Weaviate infers
+// the vector dimension from the first object, dimension is not used.
+func (wc *weaviateConnector) EnsureNamespace(ctx context.Context, namespace string, dimension int) error {
+	class, err := weaviateClass(namespace)
+	if err != nil {
+		return err
+	}
+	status, body, err := wc.do(ctx, http.MethodGet, "/v1/schema/"+class, nil)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+	if status != http.StatusNotFound {
+		return weaviateStatusError(status, body)
+	}
+
+	properties := []map[string]interface{}{
+		{"name": "text", "dataType": []string{"text"}, "tokenization": "word"},
+		{"name": "document_id", "dataType": []string{"text"}, "tokenization": "field"},
+		{"name": "chunk_id", "dataType": []string{"text"}, "tokenization": "field"},
+		{"name": "metadata", "dataType": []string{"text"}, "indexFilterable": false, "indexSearchable": false},
+		{"name": "entities", "dataType": []string{"text"}, "indexFilterable": false, "indexSearchable": false},
+	}
+	for _, field := range weaviateFilterFields {
+		properties = append(properties, map[string]interface{}{
+			"name": weaviateMetadataPrefix + field, "dataType": []string{"text"}, "tokenization": "field",
+		})
+	}
+	status, body, err = wc.do(ctx, http.MethodPost, "/v1/schema", map[string]interface{}{
+		"class":             class,
+		"vectorizer":        "none",
+		"vectorIndexConfig": map[string]interface{}{"distance": "cosine"},
+		"properties":        properties,
+	})
+	if err != nil {
+		return err
+	}
+	// created concurrently by another writer
+	if status == http.StatusUnprocessableEntity && strings.Contains(string(body), "already exists") {
+		return nil
+	}
+	if status != http.StatusOK {
+		wc.logger.Errorf("unable to create weaviate namespace %s got error %s", namespace, body)
+		return weaviateStatusError(status, body)
+	}
+	return nil
+}
+
+// Upsert inserts or replaces documents by id with a batch request.
+func (wc *weaviateConnector) Upsert(ctx context.Context, namespace string, documents []VectorDocument) error {
+	class, err := weaviateClass(namespace)
+	if err != nil {
+		return err
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+	objects := make([]map[string]interface{}, 0, len(documents))
+	for _, doc := range documents {
+		metadata, err := json.Marshal(emptyIfNil(doc.Metadata))
+		if err != nil {
+			return err
+		}
+		entities, err := json.Marshal(emptyIfNil(doc.Entities))
+		if err != nil {
+			return err
+		}
+		properties := map[string]interface{}{
+			"text":        doc.Text,
+			"document_id": doc.DocumentID,
+			"chunk_id":    doc.ID,
+			"metadata":    string(metadata),
+			"entities":    string(entities),
+		}
+		for _, field := range weaviateFilterFields {
+			if v, ok := doc.Metadata[field]; ok && v != nil {
+				properties[weaviateMetadataPrefix+field] = fmt.Sprint(v)
+			}
+		}
+		objects = append(objects, map[string]interface{}{
+			"class":      class,
+			"id":         weaviateObjectID(doc.ID),
+			"properties": properties,
+			"vector":     doc.Vector,
+		})
+	}
+
+	status, body, err := wc.do(ctx, http.MethodPost, "/v1/batch/objects", map[string]interface{}{"objects": objects})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		wc.logger.Errorf("error persisting to weaviate namespace %s got error %s", namespace, body)
+		return weaviateStatusError(status, body)
+	}
+	// the batch succeeds as a whole, failures are reported per object
+	var results []struct {
+		ID     string `json:"id"`
+		Result struct {
+			Errors *weaviateErrors `json:"errors"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &results); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if r.Result.Errors != nil && len(r.Result.Errors.Error) > 0 {
+			wc.logger.Errorf("error persisting object %s to weaviate namespace %s got error %s", r.ID, namespace, r.Result.Errors.Error[0].Message)
+			return fmt.Errorf("weaviate: %s", r.Result.Errors.Error[0].Message)
+		}
+	}
+	return nil
+}
+
+func (wc *weaviateConnector) DropNamespace(ctx context.Context, namespace string) error {
+	class, err := weaviateClass(namespace)
+	if err != nil {
+		return err
+	}
+	status, body, err := wc.do(ctx, http.MethodDelete, "/v1/schema/"+class, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK && status != http.StatusNotFound {
+		return weaviateStatusError(status, body)
+	}
+	return nil
+}
+
+// VectorSearch implements VectorConnector.
+func (wc *weaviateConnector) VectorSearch(ctx context.Context,
+	collectionName string,
+	queryVector []float64,
+	entities map[string]interface{},
+	opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	nearVector := map[string]interface{}{"vector": queryVector}
+	if opts.MinScore > 0 {
+		// score = 1 - distance / 2
+		nearVector["distance"] = 2 * (1 - float64(opts.MinScore))
+	}
+	return wc.search(ctx, collectionName, map[string]interface{}{"nearVector": nearVector}, entities, "distance", opts)
+}
+
+// TextSearch implements VectorConnector with BM25 over the text property.
+// BM25 scores have no fixed range, so opts.MinScore is not applied.
+func (wc *weaviateConnector) TextSearch(ctx context.Context,
+	collectionName string, query string,
+	entities map[string]interface{},
+	opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	return wc.search(ctx, collectionName, map[string]interface{}{
+		"bm25": map[string]interface{}{"query": query, "properties": []string{"text"}},
+	}, entities, "score", opts)
+}
+
+// HybridSearch implements VectorConnector with Weaviate's relative score
+// fusion. opts.Alpha, when set, weighs the vector side against BM25, the
+// fused score has no per side threshold so opts.MinScore is not applied.
+func (wc *weaviateConnector) HybridSearch(ctx context.Context,
+	collectionName string,
+	query string,
+	queryVector []float64,
+	entities map[string]interface{},
+	opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	alpha := weaviateDefaultAlpha
+	if opts.Alpha > 0 && opts.Alpha < 1 {
+		alpha = float64(opts.Alpha)
+	}
+	return wc.search(ctx, collectionName, map[string]interface{}{
+		"hybrid": map[string]interface{}{
+			"query":      query,
+			"vector":     queryVector,
+			"alpha":      alpha,
+			"fusionType": graphqlEnum("relativeScoreFusion"),
+			"properties": []string{"text"},
+		},
+	}, entities, "score", opts)
+}
+
+// search runs a GraphQL Get on the namespace class and returns hits shaped
+// like OpenSearch hits. scoreField is the _additional field ranking the hits.
+func (wc *weaviateConnector) search(ctx context.Context, collectionName string, arguments map[string]interface{}, entities map[string]interface{}, scoreField string, opts *VectorSearchOptions) ([]map[string]interface{}, error) {
+	class, err := weaviateClass(collectionName)
+	if err != nil {
+		return nil, err
+	}
+	arguments["limit"] = opts.TopK
+	if where := weaviateFilter(entities); where != nil {
+		arguments["where"] = where
+	}
+	query := fmt.Sprintf("{ Get { %s(%s) { text document_id chunk_id metadata _additional { id %s } } } }",
+		class, graphqlArguments(arguments), scoreField)
+
+	status, body, err := wc.do(ctx, http.MethodPost, "/v1/graphql", map[string]string{"query": query})
+	if err != nil {
+		wc.logger.Errorf("error while searching weaviate namespace %s got error %v", collectionName, err)
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, weaviateStatusError(status, body)
+	}
+
+	var response struct {
+		Data struct {
+			Get map[string][]struct {
+				Text       string  `json:"text"`
+				DocumentID string  `json:"document_id"`
+				ChunkID    *string `json:"chunk_id"`
+				Metadata   *string `json:"metadata"`
+				Additional struct {
+					ID       string          `json:"id"`
+					Distance *float64        `json:"distance"`
+					Score    json.RawMessage `json:"score"`
+				} `json:"_additional"`
+			} `json:"Get"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Errors) > 0 {
+		wc.logger.Errorf("error while searching weaviate namespace %s got error %s", collectionName, response.Errors[0].Message)
+		return nil, fmt.Errorf("weaviate: %s", response.Errors[0].Message)
+	}
+
+	objects := response.Data.Get[class]
+	hits := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		id := object.Additional.ID
+		if object.ChunkID != nil && *object.ChunkID != "" {
+			id = *object.ChunkID
+		}
+		var score float64
+		if object.Additional.Distance != nil {
+			score = 1 - *object.Additional.Distance/2
+		} else if len(object.Additional.Score) > 0 {
+			// graphql returns the score as a string
+			raw := strings.Trim(string(object.Additional.Score), `"`)
+			if score, err = strconv.ParseFloat(raw, 64); err != nil {
+				return nil, fmt.Errorf("weaviate: invalid score %s", raw)
+			}
+		}
+		meta := map[string]interface{}{}
+		if object.Metadata != nil && *object.Metadata != "" {
+			if err := json.Unmarshal([]byte(*object.Metadata), &meta); err != nil {
+				return nil, err
+			}
+		}
+		hits = append(hits, vectorHit(id, score, map[string]interface{}{
+			"text":        object.Text,
+			"document_id": object.DocumentID,
+			"metadata":    meta,
+		}, opts.Source))
+	}
+	return hits, nil
+}
+
+// ListDocuments implements VectorConnector, filter fields are limited to the
+// filterable metadata fields.
+func (wc *weaviateConnector) ListDocuments(ctx context.Context,
+	collectionName string,
+	filter map[string]interface{},
+	from, size int) (int64, []map[string]interface{}, error) {
+	class, err := weaviateClass(collectionName)
+	if err != nil {
+		return 0, nil, err
+	}
+	where, err := weaviateMetadataFilter(filter)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	countArguments := map[string]interface{}{}
+	if where != nil {
+		countArguments["where"] = where
+	}
+	var counted struct {
+		Aggregate map[string][]struct {
+			Meta struct {
+				Count int64 `json:"count"`
+			} `json:"meta"`
+		} `json:"Aggregate"`
+	}
+	if err := wc.graphql(ctx, collectionName, fmt.Sprintf("{ Aggregate { %s%s { meta { count } } } }",
+		class, graphqlArgumentList(countArguments)), &counted); err != nil {
+		return 0, nil, err
+	}
+	var total int64
+	if aggregates := counted.Aggregate[class]; len(aggregates) > 0 {
+		total = aggregates[0].Meta.Count
+	}
+
+	getArguments := map[string]interface{}{"limit": size, "offset": from}
+	if where != nil {
+		getArguments["where"] = where
+	}
+	var listed struct {
+		Get map[string][]struct {
+			Text       string  `json:"text"`
+			DocumentID string  `json:"document_id"`
+			ChunkID    *string `json:"chunk_id"`
+			Metadata   *string `json:"metadata"`
+			Entities   *string `json:"entities"`
+			Additional struct {
+				ID string `json:"id"`
+			} `json:"_additional"`
+		} `json:"Get"`
+	}
+	if err := wc.graphql(ctx, collectionName, fmt.Sprintf("{ Get { %s%s { text document_id chunk_id metadata entities _additional { id } } } }",
+		class, graphqlArgumentList(getArguments)), &listed); err != nil {
+		return 0, nil, err
+	}
+
+	objects := listed.Get[class]
+	hits := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		id := object.Additional.ID
+		if object.ChunkID != nil && *object.ChunkID != "" {
+			id = *object.ChunkID
+		}
+		meta, err := weaviateJSONProperty(object.Metadata)
+		if err != nil {
+			return 0, nil, err
+		}
+		entities, err := weaviateJSONProperty(object.Entities)
+		if err != nil {
+			return 0, nil, err
+		}
+		hits = append(hits, documentHit(collectionName, id, map[string]interface{}{
+			"document_id": object.DocumentID,
+			"text":        object.Text,
+			"metadata":    meta,
+			"entities":    entities,
+		}))
+	}
+	return total, hits, nil
+}
+
+// UpdateDocument implements VectorConnector. Metadata and entities are
+// stored as json text, so the object is read, merged and patched; other
+// fields of doc are kept in metadata.
+func (wc *weaviateConnector) UpdateDocument(ctx context.Context, collectionName string, id string, doc map[string]interface{}) error {
+	class, err := weaviateClass(collectionName)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/v1/objects/%s/%s", class, weaviateObjectID(id))
+	status, body, err := wc.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return weaviateStatusError(status, body)
+	}
+	var object struct {
+		Properties struct {
+			Metadata *string `json:"metadata"`
+			Entities *string `json:"entities"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &object); err != nil {
+		return err
+	}
+	metadata, err := weaviateJSONProperty(object.Properties.Metadata)
+	if err != nil {
+		return err
+	}
+	entities, err := weaviateJSONProperty(object.Properties.Entities)
+	if err != nil {
+		return err
+	}
+
+	metadataUpdate, entitiesUpdate, text := splitVectorDocument(doc)
+	properties := map[string]interface{}{}
+	for k, v := range metadataUpdate {
+		metadata[k] = v
+	}
+	for k, v := range entitiesUpdate {
+		entities[k] = v
+	}
+	for _, field := range weaviateFilterFields {
+		if v, ok := metadataUpdate[field]; ok && v != nil {
+			properties[weaviateMetadataPrefix+field] = fmt.Sprint(v)
+		}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	entitiesJSON, err := json.Marshal(entities)
+	if err != nil {
+		return err
+	}
+	properties["metadata"] = string(metadataJSON)
+	properties["entities"] = string(entitiesJSON)
+	if text != nil {
+		properties["text"] = *text
+	}
+
+	status, body, err = wc.do(ctx, http.MethodPatch, path, map[string]interface{}{"class": class, "properties": properties})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNoContent && status != http.StatusOK {
+		wc.logger.Errorf("error updating object %s in weaviate namespace %s got error %s", id, collectionName, body)
+		return weaviateStatusError(status, body)
+	}
+	return nil
+}
+
+// graphql runs query and decodes its data into out.
+func (wc *weaviateConnector) graphql(ctx context.Context, collectionName, query string, out interface{}) error {
+	status, body, err := wc.do(ctx, http.MethodPost, "/v1/graphql", map[string]string{"query": query})
+	if err != nil {
+		wc.logger.Errorf("error while querying weaviate namespace %s got error %v", collectionName, err)
+		return err
+	}
+	if status != http.StatusOK {
+		return weaviateStatusError(status, body)
+	}
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		wc.logger.Errorf("error while querying weaviate namespace %s got error %s", collectionName, response.Errors[0].Message)
+		return fmt.Errorf("weaviate: %s", response.Errors[0].Message)
+	}
+	return json.Unmarshal(response.Data, out)
+}
+
+func (wc *weaviateConnector) do(ctx context.Context, method, path string, payload interface{}) (int, []byte, error) {
+	var reader io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return 0, nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s://%s%s", wc.scheme(), wc.cfg.Host, path), reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if wc.cfg.Auth.ApiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+wc.cfg.Auth.ApiKey)
+	}
+	resp, err := wc.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, body, err
+}
+
+func (wc *weaviateConnector) scheme() string {
+	if wc.cfg.Scheme == "" {
+		return "https"
+	}
+	return wc.cfg.Scheme
+}
+
+type weaviateErrors struct {
+	Error []struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func weaviateStatusError(status int, body []byte) error {
+	var errs weaviateErrors
+	if json.Unmarshal(body, &errs) == nil && len(errs.Error) > 0 {
+		return fmt.Errorf("weaviate: status %d: %s", status, errs.Error[0].Message)
+	}
+	return fmt.Errorf("weaviate: status %d: %s", status, strings.TrimSpace(string(body)))
+}
+
+// weaviateFilter mirrors the metadata filters of the OpenSearch connector.
+// Entity boosts have no equivalent and are ignored.
+func weaviateFilter(entities map[string]interface{}) map[string]interface{} {
+	operands := []interface{}{}
+	for _, key := range []string{"category", "document_id", "document_name", "knowledge_id", "organization_id", "project_id", "text"} {
+		val, ok := entities[key].(string)
+		if !ok || val == "" {
+			continue
+		}
+		if key == "text" {
+			terms := strings.Fields(val)
+			if len(terms) == 0 {
+				continue
+			}
+			operands = append(operands, map[string]interface{}{
+				"path":           []string{"text"},
+				"operator":       graphqlEnum("ContainsAny"),
+				"valueTextArray": terms,
+			})
+			continue
+		}
+		operands = append(operands, map[string]interface{}{
+			"path":      []string{weaviateMetadataPrefix + key},
+			"operator":  graphqlEnum("Equal"),
+			"valueText": val,
+		})
+	}
+	switch len(operands) {
+	case 0:
+		return nil
+	case 1:
+		return operands[0].(map[string]interface{})
+	}
+	return map[string]interface{}{"operator": graphqlEnum("And"), "operands": operands}
+}
+
+// weaviateMetadataFilter matches every field of filter, which must be one
+// of the filterable metadata fields.
+func weaviateMetadataFilter(filter map[string]interface{}) (map[string]interface{}, error) {
+	operands := []interface{}{}
+	for _, field := range weaviateFilterFields {
+		if v, ok := filter[field]; ok {
+			operands = append(operands, map[string]interface{}{
+				"path":      []string{weaviateMetadataPrefix + field},
+				"operator":  graphqlEnum("Equal"),
+				"valueText": fmt.Sprint(v),
+			})
+		}
+	}
+	if len(operands) != len(filter) {
+		for key := range filter {
+			if !slices.Contains(weaviateFilterFields, key) {
+				return nil, fmt.Errorf("weaviate: metadata field %q is not filterable", key)
+			}
+		}
+	}
+	switch len(operands) {
+	case 0:
+		return nil, nil
+	case 1:
+		return operands[0].(map[string]interface{}), nil
+	}
+	return map[string]interface{}{"operator": graphqlEnum("And"), "operands": operands}, nil
+}
+
+// weaviateJSONProperty decodes a json text property, empty when unset.
+func weaviateJSONProperty(value *string) (map[string]interface{}, error) {
+	decoded := map[string]interface{}{}
+	if value == nil || *value == "" {
+		return decoded, nil
+	}
+	if err := json.Unmarshal([]byte(*value), &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// weaviateClassName maps a namespace to a class name, which must match
+// [A-Z][_0-9A-Za-z]*: prod__vs__1__2__3 becomes Prod__vs__1__2__3.
+func weaviateClassName(namespace string) string {
+	name := []rune(strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, namespace))
+	switch first := name[0]; {
+	case first >= 'a' && first <= 'z':
+		name[0] = first - 'a' + 'A'
+	case first < 'A' || first > 'Z':
+		name = append([]rune("V"), name...)
+	}
+	return string(name)
+}
+
+func weaviateClass(namespace string) (string, error) {
+	if namespace == "" {
+		return "", ErrWeaviateNamespace
+	}
+	return weaviateClassName(namespace), nil
+}
+
+// weaviateObjectID is the object uuid of a chunk id, the id itself when it
+// is already a uuid.
+func weaviateObjectID(id string) string {
+	if u, err := uuid.Parse(id); err == nil {
+		return u.String()
+	}
+	return uuid.NewSHA1(uuid.NameSpaceDNS, []byte(id)).String()
+}
+
+// graphqlEnum is written unquoted in graphql arguments.
+type graphqlEnum string
+
+// graphqlArguments writes arguments as graphql input values, object keys
+// unquoted and sorted for stable queries.
+func graphqlArguments(arguments map[string]interface{}) string {
+	var b strings.Builder
+	writeGraphqlFields(&b, arguments)
+	return b.String()
+}
+
+// graphqlArgumentList writes arguments in parentheses, nothing when empty.
+func graphqlArgumentList(arguments map[string]interface{}) string {
+	if len(arguments) == 0 {
+		return ""
+	}
+	return "(" + graphqlArguments(arguments) + ")"
+}
+
+func writeGraphqlFields(b *strings.Builder, fields map[string]interface{}) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(k)
+		b.WriteString(": ")
+		writeGraphqlValue(b, fields[k])
+	}
+}
+
+func writeGraphqlValue(b *strings.Builder, v interface{}) {
+	switch val := v.(type) {
+	case graphqlEnum:
+		b.WriteString(string(val))
+	case map[string]interface{}:
+		b.WriteByte('{')
+		writeGraphqlFields(b, val)
+		b.WriteByte('}')
+	case []interface{}:
+		b.WriteByte('[')
+		for i, item := range val {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeGraphqlValue(b, item)
+		}
+		b.WriteByte(']')
+	case []string:
+		items := make([]interface{}, len(val))
+		for i, s := range val {
+			items[i] = s
+		}
+		writeGraphqlValue(b, items)
+	case []float64:
+		b.WriteByte('[')
+		for i, f := range val {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.FormatFloat(f, 'f', -1, 64))
+		}
+		b.WriteByte(']')
+	case float64:
+		b.WriteString(strconv.FormatFloat(val, 'f', -1, 64))
+	default:
+		// strings, numbers and booleans share json's literal syntax
+		encoded, _ := json.Marshal(val)
+		b.Write(encoded)
+	}
+}
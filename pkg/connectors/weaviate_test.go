@@ -0,0 +1,259 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commons "github.com/rapidaai/pkg/commons"
+	configs "github.com/rapidaai/pkg/configs"
+)
+
+type weaviateRequest struct {
+	method, path, auth string
+	body               []byte
+}
+
+// newTestWeaviate serves every request with handler and records it.
+func newTestWeaviate(t *testing.T, handler func(w http.ResponseWriter, r *weaviateRequest)) (*weaviateConnector, *[]weaviateRequest) {
+	requests := []weaviateRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		req := weaviateRequest{method: r.Method, path: r.URL.Path, auth: r.Header.Get("Authorization"), body: body}
+		requests = append(requests, req)
+		handler(w, &req)
+	}))
+	t.Cleanup(server.Close)
+	logger, _ := commons.NewApplicationLogger()
+	wc := NewWeaviateConnector(&configs.WeaviateConfig{
+		Host:   strings.TrimPrefix(server.URL, "http://"),
+		Scheme: "http",
+		Auth:   configs.ApiKeyAuth{ApiKey: "secret"},
+	}, logger).(*weaviateConnector)
+	return wc, &requests
+}
+
+func graphqlQuery(t *testing.T, body []byte) string {
+	var payload map[string]string
+	require.NoError(t, json.Unmarshal(body, &payload))
+	return payload["query"]
+}
+
+func TestWeaviate_VectorSearch(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		w.Write([]byte(`{"data":{"Get":{"Prod__vs__1__2__3":[
+			{"text":"refunds take 5 days","document_id":"d1","chunk_id":"c1","metadata":"{\"knowledge_id\":\"7\"}","_additional":{"id":"1b4e28ba-2fa1-11d2-883f-0016d3cca427","distance":0.2}},
+			{"text":"refund policy","document_id":"d2","chunk_id":null,"metadata":null,"_additional":{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","distance":0.5}}
+		]}}}`))
+	})
+
+	hits, err := wc.VectorSearch(context.Background(), "prod__vs__1__2__3", []float64{0.5, 0.25},
+		map[string]interface{}{"knowledge_id": "7", "people": "ignored"},
+		NewDefaultVectorSearchOptions(WithTopK(2), WithSource([]string{"text", "document_id", "metadata"})))
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/v1/graphql", req.path)
+	assert.Equal(t, "Bearer secret", req.auth)
+	assert.Equal(t,
+		`{ Get { Prod__vs__1__2__3(limit: 2, nearVector: {distance: 1, vector: [0.5,0.25]}, where: {operator: Equal, path: ["metadata_knowledge_id"], valueText: "7"}) { text document_id chunk_id metadata _additional { id distance } } } }`,
+		graphqlQuery(t, req.body))
+
+	require.Len(t, hits, 2)
+	assert.Equal(t, "c1", hits[0]["_id"])
+	assert.Equal(t, 0.9, hits[0]["_score"])
+	assert.Equal(t, map[string]interface{}{
+		"text":        "refunds take 5 days",
+		"document_id": "d1",
+		"metadata":    map[string]interface{}{"knowledge_id": "7"},
+	}, hits[0]["_source"])
+	// objects written elsewhere fall back to the weaviate id
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", hits[1]["_id"])
+	assert.Equal(t, 0.75, hits[1]["_score"])
+}
+
+func TestWeaviate_TextSearch(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		w.Write([]byte(`{"data":{"Get":{"Ns":[{"text":"refund policy","document_id":"d2","chunk_id":"c2","metadata":"{}","_additional":{"id":"x","score":"1.25"}}]}}}`))
+	})
+
+	hits, err := wc.TextSearch(context.Background(), "ns", `say "refund"`,
+		map[string]interface{}{"document_id": "d2", "text": "refund policy"}, NewDefaultVectorSearchOptions())
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`{ Get { Ns(bm25: {properties: ["text"], query: "say \"refund\""}, limit: 5, where: {operands: [{operator: Equal, path: ["metadata_document_id"], valueText: "d2"}, {operator: ContainsAny, path: ["text"], valueTextArray: ["refund", "policy"]}], operator: And}) { text document_id chunk_id metadata _additional { id score } } } }`,
+		graphqlQuery(t, (*requests)[0].body))
+	require.Len(t, hits, 1)
+	assert.Equal(t, 1.25, hits[0]["_score"])
+	// default source is text and metadata
+	assert.Equal(t, map[string]interface{}{"text": "refund policy", "metadata": map[string]interface{}{}}, hits[0]["_source"])
+}
+
+func TestWeaviate_HybridSearch(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		w.Write([]byte(`{"data":{"Get":{"Ns":[]}}}`))
+	})
+
+	hits, err := wc.HybridSearch(context.Background(), "ns", "refund", []float64{1}, nil,
+		NewDefaultVectorSearchOptions(WithTopK(3), WithAlpha(0.75)))
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+	assert.Equal(t,
+		`{ Get { Ns(hybrid: {alpha: 0.75, fusionType: relativeScoreFusion, properties: ["text"], query: "refund", vector: [1]}, limit: 3) { text document_id chunk_id metadata _additional { id score } } } }`,
+		graphqlQuery(t, (*requests)[0].body))
+}
+
+func TestWeaviate_GraphqlError(t *testing.T) {
+	wc, _ := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		w.Write([]byte(`{"data":{"Get":null},"errors":[{"message":"Cannot query field \"Ns\" on type \"GetObjectsObj\"."}]}`))
+	})
+
+	_, err := wc.TextSearch(context.Background(), "ns", "refund", nil, NewDefaultVectorSearchOptions())
+	assert.ErrorContains(t, err, "Cannot query field")
+
+	_, err = wc.VectorSearch(context.Background(), "", []float64{1}, nil, NewDefaultVectorSearchOptions())
+	assert.ErrorIs(t, err, ErrWeaviateNamespace)
+}
+
+func TestWeaviate_ListDocuments(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		if strings.Contains(graphqlQuery(t, r.body), "Aggregate") {
+			w.Write([]byte(`{"data":{"Aggregate":{"Ns":[{"meta":{"count":12}}]}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"Get":{"Ns":[
+			{"text":"refund policy","document_id":"d1","chunk_id":"c11","metadata":"{\"knowledge_id\":7}","entities":"{\"people\":[\"ann\"]}","_additional":{"id":"x"}}
+		]}}}`))
+	})
+
+	total, hits, err := wc.ListDocuments(context.Background(), "ns", map[string]interface{}{"knowledge_id": uint64(7)}, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, *requests, 2)
+	assert.Equal(t,
+		`{ Aggregate { Ns(where: {operator: Equal, path: ["metadata_knowledge_id"], valueText: "7"}) { meta { count } } } }`,
+		graphqlQuery(t, (*requests)[0].body))
+	assert.Equal(t,
+		`{ Get { Ns(limit: 10, offset: 10, where: {operator: Equal, path: ["metadata_knowledge_id"], valueText: "7"}) { text document_id chunk_id metadata entities _additional { id } } } }`,
+		graphqlQuery(t, (*requests)[1].body))
+
+	assert.Equal(t, int64(12), total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "ns", hits[0]["_index"])
+	assert.Equal(t, "c11", hits[0]["_id"])
+	assert.Equal(t, map[string]interface{}{
+		"document_id": "d1",
+		"text":        "refund policy",
+		"metadata":    map[string]interface{}{"knowledge_id": float64(7)},
+		"entities":    map[string]interface{}{"people": []interface{}{"ann"}},
+	}, hits[0]["_source"])
+
+	_, _, err = wc.ListDocuments(context.Background(), "ns", map[string]interface{}{"people": "ann"}, 0, 10)
+	assert.ErrorContains(t, err, "not filterable")
+}
+
+func TestWeaviate_UpdateDocument(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		if r.method == http.MethodGet {
+			w.Write([]byte(`{"properties":{"metadata":"{\"knowledge_id\":7,\"document_name\":\"old\"}","entities":"{\"dates\":[\"today\"]}"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	require.NoError(t, wc.UpdateDocument(context.Background(), "ns", "c1", map[string]interface{}{
+		"metadata": map[string]interface{}{"document_name": "faq"},
+		"entities": map[string]interface{}{"people": []string{"ann"}},
+	}))
+	require.Len(t, *requests, 2)
+	path := "/v1/objects/Ns/" + weaviateObjectID("c1")
+	assert.Equal(t, path, (*requests)[0].path)
+	assert.Equal(t, http.MethodPatch, (*requests)[1].method)
+	assert.Equal(t, path, (*requests)[1].path)
+
+	var patch struct {
+		Properties map[string]interface{} `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal((*requests)[1].body, &patch))
+	assert.Equal(t, `{"document_name":"faq","knowledge_id":7}`, patch.Properties["metadata"])
+	assert.Equal(t, `{"dates":["today"],"people":["ann"]}`, patch.Properties["entities"])
+	assert.Equal(t, "faq", patch.Properties["metadata_document_name"])
+	assert.NotContains(t, patch.Properties, "text")
+}
+
+func TestWeaviate_EnsureNamespace(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		if r.method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	require.NoError(t, wc.EnsureNamespace(context.Background(), "dev__vs__1__2__3", 3))
+	require.Len(t, *requests, 2)
+	assert.Equal(t, "/v1/schema/Dev__vs__1__2__3", (*requests)[0].path)
+
+	var class map[string]interface{}
+	require.NoError(t, json.Unmarshal((*requests)[1].body, &class))
+	assert.Equal(t, "Dev__vs__1__2__3", class["class"])
+	assert.Equal(t, "none", class["vectorizer"])
+	assert.Contains(t, string((*requests)[1].body), `"name":"metadata_knowledge_id"`)
+}
+
+func TestWeaviate_Upsert(t *testing.T) {
+	wc, requests := newTestWeaviate(t, func(w http.ResponseWriter, r *weaviateRequest) {
+		w.Write([]byte(`[{"id":"a","result":{}},{"id":"b","result":{"errors":{"error":[{"message":"vector lengths don't match"}]}}}]`))
+	})
+
+	err := wc.Upsert(context.Background(), "ns", []VectorDocument{{
+		ID: "c1", DocumentID: "d1", Text: "hello",
+		Vector:   []float64{0.1, 0.2},
+		Metadata: map[string]interface{}{"knowledge_id": 7, "other": "x"},
+	}})
+	assert.ErrorContains(t, err, "vector lengths don't match")
+
+	var batch struct {
+		Objects []struct {
+			Class      string                 `json:"class"`
+			ID         string                 `json:"id"`
+			Properties map[string]interface{} `json:"properties"`
+			Vector     []float64              `json:"vector"`
+		} `json:"objects"`
+	}
+	require.NoError(t, json.Unmarshal((*requests)[0].body, &batch))
+	require.Len(t, batch.Objects, 1)
+	object := batch.Objects[0]
+	assert.Equal(t, "Ns", object.Class)
+	assert.Equal(t, weaviateObjectID("c1"), object.ID)
+	assert.Equal(t, "c1", object.Properties["chunk_id"])
+	assert.Equal(t, "7", object.Properties["metadata_knowledge_id"])
+	assert.NotContains(t, object.Properties, "metadata_other")
+	assert.Equal(t, `{"knowledge_id":7,"other":"x"}`, object.Properties["metadata"])
+	assert.Equal(t, []float64{0.1, 0.2}, object.Vector)
+}
+
+func TestWeaviateClassName(t *testing.T) {
+	assert.Equal(t, "Prod__vs__1__2__3", weaviateClassName("prod__vs__1__2__3"))
+	assert.Equal(t, "A_b", weaviateClassName("a-b"))
+	assert.Equal(t, "V1_ns", weaviateClassName("1-ns"))
+}
+
+func TestWeaviateObjectID(t *testing.T) {
+	assert.Equal(t, "1b4e28ba-2fa1-11d2-883f-0016d3cca427", weaviateObjectID("1b4e28ba-2fa1-11d2-883f-0016d3cca427"))
+	assert.Equal(t, weaviateObjectID("c1"), weaviateObjectID("c1"))
+	assert.NotEqual(t, weaviateObjectID("c1"), weaviateObjectID("c2"))
+}
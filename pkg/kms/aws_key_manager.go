@@ -0,0 +1,108 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package kms
+
+import (
+	"context"
+	"crypto/cipher"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	aws_session "github.com/aws/aws-sdk-go/aws/session"
+	aws_kms "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+)
+
+type awsKeyManager struct {
+	keyId  string
+	client kmsiface.KMSAPI
+	// secretKey unwraps data keys wrapped with the key derived from the
+	// application secret before the aws provider was configured
+	secretKey *localKeyManager
+	// err is returned by every operation when the session can not be created
+	err error
+}
+
+// NewAwsKeyManager creates a key manager wrapping data keys with the AWS KMS
+// key of the configured key id. Data keys wrapped with the key derived from
+// secret are still unwrapped, so they can be rewrapped with the KMS key.
+func NewAwsKeyManager(cfg configs.KMSConfig, secret string, logger commons.Logger) KeyManager {
+	km := &awsKeyManager{keyId: cfg.KeyId}
+	if secret != "" {
+		km.secretKey = &localKeyManager{keyId: defaultKeyId, keys: make(map[string]cipher.AEAD)}
+		km.secretKey.err = km.secretKey.add(defaultKeyId, secretKey(secret))
+	}
+	if cfg.KeyId == "" {
+		km.err = fmt.Errorf("aws kms key id is not configured")
+		logger.Errorf("unable to create aws kms client: %v", km.err)
+		return km
+	}
+	config := aws.Config{}
+	if cfg.Auth != nil {
+		config.Region = aws.String(cfg.Auth.Region)
+		if cfg.Auth.AccessKeyId != "" && cfg.Auth.SecretKey != "" {
+			config.Credentials = credentials.NewStaticCredentials(
+				cfg.Auth.AccessKeyId,
+				cfg.Auth.SecretKey,
+				"",
+			)
+		}
+	}
+	session, err := aws_session.NewSessionWithOptions(aws_session.Options{
+		Config:            config,
+		SharedConfigState: aws_session.SharedConfigEnable,
+	})
+	if err != nil {
+		logger.Errorf("unable to create aws kms client: %v", err)
+		km.err = err
+		return km
+	}
+	km.client = aws_kms.New(session)
+	return km
+}
+
+func (km *awsKeyManager) Name() string {
+	return string(configs.KMS_AWS)
+}
+
+func (km *awsKeyManager) KeyId() string {
+	return km.keyId
+}
+
+func (km *awsKeyManager) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	if km.err != nil {
+		return nil, km.err
+	}
+	out, err := km.client.EncryptWithContext(ctx, &aws_kms.EncryptInput{
+		KeyId:     aws.String(km.keyId),
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	return out.CiphertextBlob, nil
+}
+
+func (km *awsKeyManager) Unwrap(ctx context.Context, keyId string, wrapped []byte) ([]byte, error) {
+	if keyId == defaultKeyId && km.secretKey != nil {
+		return km.secretKey.Unwrap(ctx, keyId, wrapped)
+	}
+	if km.err != nil {
+		return nil, km.err
+	}
+	out, err := km.client.DecryptWithContext(ctx, &aws_kms.DecryptInput{
+		KeyId:          aws.String(keyId),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	return out.Plaintext, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+
+// Package kms wraps and unwraps data keys with a master key held by a key
+// management service.
+package kms
+
+import (
+	"context"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+)
+
+type KeyManager interface {
+	Name() string
+
+	// KeyId returns the id of the master key new data keys are wrapped with.
+	KeyId() string
+
+	// Wrap encrypts a data key with the current master key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+
+	// Unwrap decrypts a data key wrapped with the master key of keyId.
+	Unwrap(ctx context.Context, keyId string, wrapped []byte) ([]byte, error)
+}
+
+// NewKeyManager returns the key manager of the configured provider. The local
+// provider falls back to a key derived from secret when no key is configured,
+// both providers keep unwrapping with that key so its data keys can be
+// rewrapped.
+func NewKeyManager(cfg configs.KMSConfig, secret string, logger commons.Logger) KeyManager {
+	switch cfg.Type() {
+	case configs.KMS_AWS:
+		return NewAwsKeyManager(cfg, secret, logger)
+	default:
+		return NewLocalKeyManager(cfg, secret, logger)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+)
+
+const (
+	masterKeySize = 32
+	// defaultKeyId is the id of the master key derived from the application
+	// secret. It wraps data keys when no key is configured and is kept for
+	// unwrapping once keys are configured, so those data keys can be rewrapped.
+	defaultKeyId = "default"
+)
+
+type localKeyManager struct {
+	keyId string
+	keys  map[string]cipher.AEAD
+	// err is returned by every operation when the configured keys are invalid
+	err error
+}
+
+// NewLocalKeyManager creates a key manager wrapping data keys with AES-256-GCM
+// master keys read from configuration.
+func NewLocalKeyManager(cfg configs.KMSConfig, secret string, logger commons.Logger) KeyManager {
+	km := &localKeyManager{keyId: cfg.KeyId, keys: make(map[string]cipher.AEAD)}
+	if err := km.load(cfg, secret, logger); err != nil {
+		logger.Errorf("unable to load local kms master keys: %v", err)
+		km.err = err
+	}
+	return km
+}
+
+func (km *localKeyManager) load(cfg configs.KMSConfig, secret string, logger commons.Logger) error {
+	pairs := strings.Split(cfg.LocalKeys, ",")
+	if cfg.LocalKeyFile != "" {
+		content, err := os.ReadFile(cfg.LocalKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read key file: %w", err)
+		}
+		pairs = append(pairs, strings.Split(string(content), "\n")...)
+	}
+	configured := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(pair, ":")
+		if !ok || id == "" {
+			return fmt.Errorf("illegal master key, expected id:base64")
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("illegal master key %s: %w", id, err)
+		}
+		if len(key) != masterKeySize {
+			return fmt.Errorf("illegal master key %s, expected %d bytes got %d", id, masterKeySize, len(key))
+		}
+		if err := km.add(id, key); err != nil {
+			return err
+		}
+		configured = append(configured, id)
+	}
+
+	// a configured key of the default id replaces the derived one
+	if _, ok := km.keys[defaultKeyId]; !ok && secret != "" {
+		if err := km.add(defaultKeyId, secretKey(secret)); err != nil {
+			return err
+		}
+	}
+	if len(configured) == 0 {
+		if secret == "" {
+			return fmt.Errorf("no master key is configured")
+		}
+		logger.Warnf("no kms master key is configured, deriving the master key from the application secret")
+		km.keyId = defaultKeyId
+		return nil
+	}
+	if km.keyId == "" && len(configured) == 1 {
+		km.keyId = configured[0]
+	}
+	if _, ok := km.keys[km.keyId]; !ok {
+		return fmt.Errorf("master key %q is not configured", km.keyId)
+	}
+	return nil
+}
+
+// secretKey derives the default master key from the application secret.
+func secretKey(secret string) []byte {
+	key := sha256.Sum256([]byte(secret))
+	return key[:]
+}
+
+func (km *localKeyManager) add(id string, key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("illegal master key %s: %w", id, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return fmt.Errorf("illegal master key %s: %w", id, err)
+	}
+	km.keys[id] = aead
+	return nil
+}
+
+func (km *localKeyManager) Name() string {
+	return string(configs.KMS_LOCAL)
+}
+
+func (km *localKeyManager) KeyId() string {
+	return km.keyId
+}
+
+// Wrap returns the nonce followed by the sealed data key, the key id is
+// authenticated so a wrapped key only unwraps with its own id.
+func (km *localKeyManager) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	if km.err != nil {
+		return nil, km.err
+	}
+	aead := km.keys[km.keyId]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(km.keyId)), nil
+}
+
+func (km *localKeyManager) Unwrap(ctx context.Context, keyId string, wrapped []byte) ([]byte, error) {
+	if km.err != nil {
+		return nil, km.err
+	}
+	aead, ok := km.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("master key %q is not configured", keyId)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("illegal wrapped key")
+	}
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, []byte(keyId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
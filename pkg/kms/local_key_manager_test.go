@@ -0,0 +1,124 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+)
+
+func masterKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, masterKeySize))
+}
+
+func TestLocalKeyManager_WrapUnwrap(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	km := NewLocalKeyManager(configs.KMSConfig{LocalKeys: "k1:" + masterKey(1)}, "", logger)
+	assert.Equal(t, "local", km.Name())
+	assert.Equal(t, "k1", km.KeyId())
+
+	dataKey := bytes.Repeat([]byte{9}, 32)
+	wrapped, err := km.Wrap(context.Background(), dataKey)
+	require.NoError(t, err)
+	assert.NotContains(t, string(wrapped), string(dataKey))
+
+	unwrapped, err := km.Unwrap(context.Background(), "k1", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	wrapped[len(wrapped)-1] ^= 1
+	_, err = km.Unwrap(context.Background(), "k1", wrapped)
+	assert.Error(t, err)
+}
+
+func TestLocalKeyManager_Rotation(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	before := NewLocalKeyManager(configs.KMSConfig{LocalKeys: "k1:" + masterKey(1)}, "", logger)
+	wrapped, err := before.Wrap(context.Background(), []byte("data key"))
+	require.NoError(t, err)
+
+	// the rotated key wraps, the previous key still unwraps
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("k2:"+masterKey(2)+"\n"), 0600))
+	after := NewLocalKeyManager(configs.KMSConfig{KeyId: "k2", LocalKeys: "k1:" + masterKey(1), LocalKeyFile: keyFile}, "", logger)
+	assert.Equal(t, "k2", after.KeyId())
+
+	unwrapped, err := after.Unwrap(context.Background(), "k1", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data key"), unwrapped)
+
+	// a wrapped key only unwraps with the id it was wrapped with
+	_, err = after.Unwrap(context.Background(), "k2", wrapped)
+	assert.Error(t, err)
+}
+
+func TestLocalKeyManager_SecretFallback(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	km := NewLocalKeyManager(configs.KMSConfig{}, "rpd_pks", logger)
+	assert.Equal(t, defaultKeyId, km.KeyId())
+	wrapped, err := km.Wrap(context.Background(), []byte("data key"))
+	require.NoError(t, err)
+
+	unwrapped, err := NewLocalKeyManager(configs.KMSConfig{}, "rpd_pks", logger).Unwrap(context.Background(), defaultKeyId, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data key"), unwrapped)
+}
+
+func TestLocalKeyManager_InvalidKeys(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	for _, cfg := range []configs.KMSConfig{
+		{LocalKeys: "k1:" + base64.StdEncoding.EncodeToString([]byte("short"))},
+		{LocalKeys: masterKey(1)},
+		{LocalKeys: "k1:" + masterKey(1) + ",k2:" + masterKey(2)},
+		{KeyId: "k3", LocalKeys: "k1:" + masterKey(1)},
+	} {
+		_, err := NewLocalKeyManager(cfg, "secret", logger).Wrap(context.Background(), []byte("data key"))
+		assert.Error(t, err, cfg.LocalKeys)
+	}
+}
+
+func TestNewKeyManager(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	assert.Equal(t, "local", NewKeyManager(configs.KMSConfig{}, "secret", logger).Name())
+
+	km := NewKeyManager(configs.KMSConfig{Provider: "aws"}, "secret", logger)
+	assert.Equal(t, "aws", km.Name())
+	_, err := km.Wrap(context.Background(), []byte("data key"))
+	assert.Error(t, err)
+}
+
+func TestKeyManager_SecretKeyUnwrapsAfterKeysAreConfigured(t *testing.T) {
+	logger, _ := commons.NewApplicationLogger()
+	wrapped, err := NewLocalKeyManager(configs.KMSConfig{}, "rpd_pks", logger).Wrap(context.Background(), []byte("data key"))
+	require.NoError(t, err)
+
+	// configured keys wrap, data keys wrapped before stay readable for rewrap
+	local := NewLocalKeyManager(configs.KMSConfig{LocalKeys: "k1:" + masterKey(1)}, "rpd_pks", logger)
+	assert.Equal(t, "k1", local.KeyId())
+	unwrapped, err := local.Unwrap(context.Background(), defaultKeyId, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data key"), unwrapped)
+
+	aws := NewKeyManager(configs.KMSConfig{Provider: "aws"}, "rpd_pks", logger)
+	unwrapped, err = aws.Unwrap(context.Background(), defaultKeyId, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data key"), unwrapped)
+
+	// a configured key of the default id replaces the derived one
+	replaced := NewLocalKeyManager(configs.KMSConfig{LocalKeys: "default:" + masterKey(1)}, "rpd_pks", logger)
+	_, err = replaced.Unwrap(context.Background(), defaultKeyId, wrapped)
+	assert.Error(t, err)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package limiters
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/types"
+)
+
+const (
+	// SessionLease is how long a session slot is held without renewal.
+	SessionLease = 2 * time.Minute
+	// SessionRenewInterval leaves a live session several renewals before
+	// its lease ends.
+	SessionRenewInterval = SessionLease / 4
+)
+
+var (
+	ErrRateLimited    = errors.New("rate limit exceeded")
+	ErrSessionLimited = errors.New("concurrent session limit reached")
+	ErrQuotaExceeded  = errors.New("monthly quota exceeded")
+)
+
+// LimitError is returned for a request refused by a limit, it wraps one of
+// ErrRateLimited, ErrSessionLimited or ErrQuotaExceeded.
+type LimitError struct {
+	err        error
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v: %s", e.err, e.Message)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.err
+}
+
+// Limiter applies the limits of a RateLimitConfig with state kept in redis,
+// limits are not enforced when redis can not be reached.
+type Limiter interface {
+	// Allow takes a request from the buckets of the project and api key of auth.
+	Allow(ctx context.Context, auth types.SimplePrinciple) error
+	// CheckQuota refuses projects that used up a monthly quota.
+	CheckQuota(ctx context.Context, auth types.SimplePrinciple) error
+	// AcquireSession takes a session slot of an assistant deployment, the
+	// slot is held until released or until its lease of SessionLease ends.
+	AcquireSession(ctx context.Context, deployment string, sessionId string) error
+	// RenewSession extends the lease of a live session, it is called every
+	// SessionRenewInterval so the slot of a crashed instance frees quickly.
+	RenewSession(ctx context.Context, deployment string, sessionId string)
+	ReleaseSession(ctx context.Context, deployment string, sessionId string)
+	// Record adds usage to the monthly usage of the project of auth, usage is
+	// recorded even when no quota is configured.
+	Record(ctx context.Context, auth types.SimplePrinciple, usage Usage)
+	// Quota returns the usage of a project in the current month.
+	Quota(ctx context.Context, projectId uint64) (*QuotaStatus, error)
+	// PublishQuota stores the monthly quotas of the config as the quotas of
+	// every service, it is called by the web service when it starts.
+	PublishQuota(ctx context.Context) error
+}
+
+type limiter struct {
+	logger  commons.Logger
+	redis   connectors.RedisConnector
+	cfg     *configs.RateLimitConfig
+	now     func() time.Time
+	session time.Duration
+}
+
+// NewLimiter creates a limiter for cfg, a nil cfg enforces no limit.
+func NewLimiter(logger commons.Logger, redis connectors.RedisConnector, cfg *configs.RateLimitConfig) Limiter {
+	if cfg == nil {
+		cfg = &configs.RateLimitConfig{}
+	}
+	return &limiter{
+		logger:  logger,
+		redis:   redis,
+		cfg:     cfg,
+		now:     time.Now,
+		session: SessionLease,
+	}
+}
+
+func (l *limiter) Allow(ctx context.Context, auth types.SimplePrinciple) error {
+	if projectId := auth.GetCurrentProjectId(); projectId != nil && l.cfg.ProjectRate > 0 {
+		if err := l.take(ctx, fmt.Sprintf("ratelimit:project:%d", *projectId), l.cfg.ProjectRate, l.cfg.GetProjectBurst(), "project"); err != nil {
+			return err
+		}
+	}
+	if apiKey := apiKeyOf(auth); apiKey != "" && l.cfg.ApiKeyRate > 0 {
+		if err := l.take(ctx, "ratelimit:key:"+apiKey, l.cfg.ApiKeyRate, l.cfg.GetApiKeyBurst(), "api key"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *limiter) take(ctx context.Context, key string, rate float64, burst int, subject string) error {
+	decision, err := l.bucket(ctx, key, rate, burst)
+	if err != nil {
+		l.logger.Errorf("unable to take a request from bucket %s, allowing request %v", key, err)
+		return nil
+	}
+	if decision.Allowed {
+		return nil
+	}
+	return &LimitError{
+		err:        ErrRateLimited,
+		Message:    fmt.Sprintf("the %s is limited to %g requests per second", subject, rate),
+		RetryAfter: decision.RetryAfter,
+	}
+}
+
+func (l *limiter) AcquireSession(ctx context.Context, deployment string, sessionId string) error {
+	if l.cfg.MaxConcurrentSessions <= 0 {
+		return nil
+	}
+	acquired, err := l.acquire(ctx, sessionKey(deployment), sessionId, l.cfg.MaxConcurrentSessions)
+	if err != nil {
+		l.logger.Errorf("unable to acquire session of deployment %s, allowing session %v", deployment, err)
+		return nil
+	}
+	if acquired {
+		return nil
+	}
+	return &LimitError{
+		err:     ErrSessionLimited,
+		Message: fmt.Sprintf("the assistant deployment is limited to %d concurrent sessions", l.cfg.MaxConcurrentSessions),
+	}
+}
+
+func (l *limiter) RenewSession(ctx context.Context, deployment string, sessionId string) {
+	if l.cfg.MaxConcurrentSessions <= 0 {
+		return
+	}
+	if err := l.renew(ctx, sessionKey(deployment), sessionId); err != nil {
+		l.logger.Errorf("unable to renew session of deployment %s %v", deployment, err)
+	}
+}
+
+func (l *limiter) ReleaseSession(ctx context.Context, deployment string, sessionId string) {
+	if l.cfg.MaxConcurrentSessions <= 0 {
+		return
+	}
+	if err := l.release(ctx, sessionKey(deployment), sessionId); err != nil {
+		l.logger.Errorf("unable to release session of deployment %s %v", deployment, err)
+	}
+}
+
+func (l *limiter) CheckQuota(ctx context.Context, auth types.SimplePrinciple) error {
+	projectId := auth.GetCurrentProjectId()
+	if projectId == nil {
+		return nil
+	}
+	status, err := l.Quota(ctx, *projectId)
+	if err != nil {
+		l.logger.Errorf("unable to get quota of project %d, allowing request %v", *projectId, err)
+		return nil
+	}
+	if !status.Exceeded {
+		return nil
+	}
+	return &LimitError{
+		err:        ErrQuotaExceeded,
+		Message:    fmt.Sprintf("the project used its quota of %s, it resets at %s", status.exceededQuota(), status.ResetAt.Format(time.RFC3339)),
+		RetryAfter: status.ResetAt.Sub(l.now()),
+	}
+}
+
+func (l *limiter) Record(ctx context.Context, auth types.SimplePrinciple, usage Usage) {
+	projectId := auth.GetCurrentProjectId()
+	if projectId == nil || usage.IsZero() {
+		return
+	}
+	if err := l.record(ctx, *projectId, usage); err != nil {
+		l.logger.Errorf("unable to record usage of project %d %v", *projectId, err)
+	}
+}
+
+// apiKeyOf identifies the api key of project and organization scoped
+// principals, the key itself is never stored.
+func apiKeyOf(auth types.SimplePrinciple) string {
+	switch auth.Type() {
+	case "project", "organization":
+	default:
+		return ""
+	}
+	token := auth.GetCurrentToken()
+	if token == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+func sessionKey(deployment string) string {
+	return "ratelimit:sessions:" + deployment
+}
+
+// MeteredMethods are the gRPC methods refused once a project used up a
+// monthly quota, they start provider calls or voice sessions.
+var MeteredMethods = map[string]bool{
+	"/endpoint_api.Deployment/Invoke":           true,
+	"/endpoint_api.Deployment/InvokeStream":     true,
+	"/talk_api.TalkService/AssistantTalk":       true,
+	"/talk_api.TalkService/CreatePhoneCall":     true,
+	"/talk_api.TalkService/CreateBulkPhoneCall": true,
+	"/talk_api.TalkService/CreateCampaign":      true,
+	"/talk_api.TalkService/AddCampaignContacts": true,
+	"/talk_api.WebRTC/WebTalk":                  true,
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package limiters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	redismock "github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/configs"
+	"github.com/rapidaai/pkg/connectors"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+)
+
+type mockRedis struct {
+	connectors.RedisConnector
+	client *redis.Client
+}
+
+func (m *mockRedis) GetConnection() *redis.Client {
+	return m.client
+}
+
+var now = time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+
+func newTestLimiter(t *testing.T, cfg *configs.RateLimitConfig) (*limiter, redismock.ClientMock) {
+	logger, _ := commons.NewApplicationLogger()
+	client, mock := redismock.NewClientMock()
+	l := NewLimiter(logger, &mockRedis{client: client}, cfg).(*limiter)
+	l.now = func() time.Time { return now }
+	return l, mock
+}
+
+func project(id uint64) types.SimplePrinciple {
+	return &types.ProjectScope{ProjectId: &id, CurrentToken: "api-key"}
+}
+
+func TestTokenUsage(t *testing.T) {
+	assert.Equal(t, int64(30), TokenUsage([]*protos.Metric{
+		{Name: "INPUT_TOKEN", Value: "10"}, {Name: "OUTPUT_TOKEN", Value: "20"},
+	}).Tokens)
+	assert.Equal(t, int64(42), TokenUsage([]*protos.Metric{
+		{Name: "INPUT_TOKEN", Value: "10"}, {Name: "TOTAL_TOKEN", Value: "42"},
+	}).Tokens)
+	assert.True(t, TokenUsage([]*protos.Metric{{Name: "TOTAL_TOKEN", Value: "illegal"}}).IsZero())
+}
+
+func TestConversationUsage(t *testing.T) {
+	usage := ConversationUsage([]*protos.Metric{
+		{Name: "STATUS", Value: "completed"},
+		{Name: "TIME_TAKEN", Value: "90000000000"},
+	})
+	assert.Equal(t, 90*time.Second, usage.Duration)
+	assert.True(t, ConversationUsage(nil).IsZero())
+}
+
+func TestLimiter_NoLimits(t *testing.T) {
+	l, mock := newTestLimiter(t, nil)
+	ctx := context.Background()
+	mock.ExpectHMGet(quotaKey, "tokens", "minutes").SetVal([]interface{}{nil, nil})
+	mock.ExpectHMGet("quota:1:2026-10", "tokens", "duration_ms").SetVal([]interface{}{"400", nil})
+	assert.NoError(t, l.Allow(ctx, project(1)))
+	assert.NoError(t, l.CheckQuota(ctx, project(1)))
+	assert.NoError(t, l.AcquireSession(ctx, "1:sdk", "session"))
+	l.RenewSession(ctx, "1:sdk", "session")
+	l.ReleaseSession(ctx, "1:sdk", "session")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLimiter_Quota(t *testing.T) {
+	// the quotas published by the web service, not the config of this one
+	l, mock := newTestLimiter(t, &configs.RateLimitConfig{MonthlyTokenQuota: 10})
+	mock.ExpectHMGet(quotaKey, "tokens", "minutes").SetVal([]interface{}{"1000", "10"})
+	mock.ExpectHMGet("quota:1:2026-10", "tokens", "duration_ms").SetVal([]interface{}{"400", "61000"})
+
+	status, err := l.Quota(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", status.Period)
+	assert.Equal(t, time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), status.ResetAt)
+	assert.Equal(t, int64(400), status.TokensUsed)
+	assert.Equal(t, int64(1000), status.TokenQuota)
+	assert.Equal(t, int64(2), status.MinutesUsed)
+	assert.Equal(t, int64(10), status.MinuteQuota)
+	assert.False(t, status.Exceeded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLimiter_CheckQuota(t *testing.T) {
+	l, mock := newTestLimiter(t, nil)
+	mock.ExpectHMGet(quotaKey, "tokens", "minutes").SetVal([]interface{}{"1000", nil})
+	mock.ExpectHMGet("quota:1:2026-10", "tokens", "duration_ms").SetVal([]interface{}{"1000", nil})
+
+	err := l.CheckQuota(context.Background(), project(1))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+	assert.Contains(t, limitErr.Message, "1000 tokens")
+	assert.Equal(t, time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC).Sub(now), limitErr.RetryAfter)
+}
+
+func TestLimiter_CheckQuotaFailsOpen(t *testing.T) {
+	l, mock := newTestLimiter(t, nil)
+	mock.ExpectHMGet(quotaKey, "tokens", "minutes").SetErr(errors.New("connection refused"))
+	mock.ExpectHMGet("quota:1:2026-10", "tokens", "duration_ms").SetErr(errors.New("connection refused"))
+	assert.NoError(t, l.CheckQuota(context.Background(), project(1)))
+}
+
+func TestLimiter_PublishQuota(t *testing.T) {
+	l, mock := newTestLimiter(t, &configs.RateLimitConfig{MonthlyTokenQuota: 1000, MonthlyMinuteQuota: 10})
+	mock.ExpectHSet(quotaKey, "tokens", int64(1000), "minutes", int64(10)).SetVal(2)
+	assert.NoError(t, l.PublishQuota(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLimiter_Record(t *testing.T) {
+	l, mock := newTestLimiter(t, nil)
+	mock.ExpectTxPipeline()
+	mock.ExpectHIncrBy("quota:1:2026-10", "tokens", 42).SetVal(42)
+	mock.ExpectHIncrBy("quota:1:2026-10", "duration_ms", 1500).SetVal(1500)
+	mock.ExpectExpire("quota:1:2026-10", usageExpiry).SetVal(true)
+	mock.ExpectTxPipelineExec()
+
+	l.Record(context.Background(), project(1), Usage{Tokens: 42, Duration: 1500 * time.Millisecond})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, mock := newTestLimiter(t, &configs.RateLimitConfig{ProjectRate: 2})
+	mock.ExpectEvalSha(bucketLuaScript.Hash(), []string{"ratelimit:project:1"}, float64(2), 2, now.UnixMilli()).
+		SetVal([]interface{}{int64(1), int64(1), int64(0)})
+	assert.NoError(t, l.Allow(context.Background(), project(1)))
+
+	mock.ExpectEvalSha(bucketLuaScript.Hash(), []string{"ratelimit:project:1"}, float64(2), 2, now.UnixMilli()).
+		SetVal([]interface{}{int64(0), int64(0), int64(500)})
+	err := l.Allow(context.Background(), project(1))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+	assert.Equal(t, 500*time.Millisecond, limitErr.RetryAfter)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLimiter_AcquireSession(t *testing.T) {
+	l, mock := newTestLimiter(t, &configs.RateLimitConfig{MaxConcurrentSessions: 1})
+	args := func(session string) []interface{} {
+		return []interface{}{now.UnixMilli(), l.session.Milliseconds(), 1, session}
+	}
+	mock.ExpectEvalSha(acquireLuaScript.Hash(), []string{"ratelimit:sessions:1:sdk"}, args("first")...).SetVal(int64(1))
+	mock.ExpectEvalSha(acquireLuaScript.Hash(), []string{"ratelimit:sessions:1:sdk"}, args("second")...).SetVal(int64(0))
+	mock.ExpectEvalSha(renewLuaScript.Hash(), []string{"ratelimit:sessions:1:sdk"}, now.UnixMilli(), SessionLease.Milliseconds(), "first").SetVal(int64(1))
+	mock.ExpectZRem("ratelimit:sessions:1:sdk", "first").SetVal(1)
+
+	ctx := context.Background()
+	assert.NoError(t, l.AcquireSession(ctx, "1:sdk", "first"))
+	assert.True(t, errors.Is(l.AcquireSession(ctx, "1:sdk", "second"), ErrSessionLimited))
+	l.RenewSession(ctx, "1:sdk", "first")
+	l.ReleaseSession(ctx, "1:sdk", "first")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApiKeyOf(t *testing.T) {
+	assert.NotEmpty(t, apiKeyOf(project(1)))
+	assert.NotContains(t, apiKeyOf(project(1)), "api-key")
+	assert.Empty(t, apiKeyOf(&types.ServiceScope{CurrentToken: "service-key"}))
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package limiters
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	type_enums "github.com/rapidaai/pkg/types/enums"
+	"github.com/rapidaai/protos"
+)
+
+const (
+	// usage is kept for two months so the previous month can still be read.
+	usageExpiry = 62 * 24 * time.Hour
+	// quotaKey holds the monthly quotas published by the web service, every
+	// service enforces and reports the same quotas.
+	quotaKey = "quota:limits"
+)
+
+// Usage is the metered usage of a request or conversation.
+type Usage struct {
+	Tokens   int64
+	Duration time.Duration
+}
+
+func (u Usage) IsZero() bool {
+	return u.Tokens == 0 && u.Duration == 0
+}
+
+// TokenUsage returns the tokens of the metrics of a provider call, the total
+// when reported else the sum of input and output tokens.
+func TokenUsage(metrics []*protos.Metric) Usage {
+	var total, input, output int64
+	for _, metric := range metrics {
+		value, err := strconv.ParseInt(metric.GetValue(), 10, 64)
+		if err != nil || value < 0 {
+			continue
+		}
+		switch metric.GetName() {
+		case type_enums.TOTAL_TOKEN.String():
+			total = value
+		case type_enums.INPUT_TOKEN.String():
+			input = value
+		case type_enums.OUTPUT_TOKEN.String():
+			output = value
+		}
+	}
+	if total == 0 {
+		total = input + output
+	}
+	return Usage{Tokens: total}
+}
+
+// ConversationUsage returns the duration of a conversation from the time
+// taken metric emitted when it ends.
+func ConversationUsage(metrics []*protos.Metric) Usage {
+	for _, metric := range metrics {
+		if metric.GetName() != type_enums.TIME_TAKEN.String() {
+			continue
+		}
+		if value, err := strconv.ParseInt(metric.GetValue(), 10, 64); err == nil && value > 0 {
+			return Usage{Duration: time.Duration(value)}
+		}
+	}
+	return Usage{}
+}
+
+// QuotaStatus is the usage of a project in a calendar month (UTC), a quota
+// of zero is not enforced.
+type QuotaStatus struct {
+	ProjectId   uint64    `json:"projectId"`
+	Period      string    `json:"period"`
+	ResetAt     time.Time `json:"resetAt"`
+	TokensUsed  int64     `json:"tokensUsed"`
+	TokenQuota  int64     `json:"tokenQuota"`
+	MinutesUsed int64     `json:"minutesUsed"`
+	MinuteQuota int64     `json:"minuteQuota"`
+	Exceeded    bool      `json:"exceeded"`
+}
+
+func (qs *QuotaStatus) exceededQuota() string {
+	if qs.TokenQuota > 0 && qs.TokensUsed >= qs.TokenQuota {
+		return fmt.Sprintf("%d tokens", qs.TokenQuota)
+	}
+	return fmt.Sprintf("%d minutes", qs.MinuteQuota)
+}
+
+func usageKey(projectId uint64, period string) string {
+	return fmt.Sprintf("quota:%d:%s", projectId, period)
+}
+
+func (l *limiter) period() (string, time.Time) {
+	now := l.now().UTC()
+	return now.Format("2006-01"), time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (l *limiter) record(ctx context.Context, projectId uint64, usage Usage) error {
+	period, _ := l.period()
+	key := usageKey(projectId, period)
+	_, err := l.redis.GetConnection().TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if usage.Tokens > 0 {
+			pipe.HIncrBy(ctx, key, "tokens", usage.Tokens)
+		}
+		if usage.Duration > 0 {
+			pipe.HIncrBy(ctx, key, "duration_ms", usage.Duration.Milliseconds())
+		}
+		pipe.Expire(ctx, key, usageExpiry)
+		return nil
+	})
+	return err
+}
+
+func (l *limiter) PublishQuota(ctx context.Context) error {
+	return l.redis.GetConnection().HSet(ctx, quotaKey,
+		"tokens", l.cfg.MonthlyTokenQuota,
+		"minutes", l.cfg.MonthlyMinuteQuota,
+	).Err()
+}
+
+func (l *limiter) Quota(ctx context.Context, projectId uint64) (*QuotaStatus, error) {
+	period, resetAt := l.period()
+	var quota, usage *redis.SliceCmd
+	if _, err := l.redis.GetConnection().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		quota = pipe.HMGet(ctx, quotaKey, "tokens", "minutes")
+		usage = pipe.HMGet(ctx, usageKey(projectId, period), "tokens", "duration_ms")
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	durationMs := parseCounter(usage.Val()[1])
+	status := &QuotaStatus{
+		ProjectId:   projectId,
+		Period:      period,
+		ResetAt:     resetAt,
+		TokensUsed:  parseCounter(usage.Val()[0]),
+		TokenQuota:  parseCounter(quota.Val()[0]),
+		MinutesUsed: (durationMs + time.Minute.Milliseconds() - 1) / time.Minute.Milliseconds(),
+		MinuteQuota: parseCounter(quota.Val()[1]),
+	}
+	status.Exceeded = (status.TokenQuota > 0 && status.TokensUsed >= status.TokenQuota) ||
+		(status.MinuteQuota > 0 && time.Duration(durationMs)*time.Millisecond >= time.Duration(status.MinuteQuota)*time.Minute)
+	return status, nil
+}
+
+func parseCounter(value interface{}) int64 {
+	str, ok := value.(string)
+	if !ok {
+		return 0
+	}
+	counter, _ := strconv.ParseInt(str, 10, 64)
+	return counter
+}
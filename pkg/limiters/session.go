@@ -0,0 +1,57 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package limiters
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireLuaScript holds sessions in a sorted set scored by the end of their
+// lease, leases of sessions never released expire so a crashed instance does
+// not hold slots forever. A session acquired again renews its lease.
+var acquireLuaScript = redis.NewScript(`
+	local now = tonumber(ARGV[1])
+	local lease = tonumber(ARGV[2])
+	local max = tonumber(ARGV[3])
+	redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+	if redis.call('ZSCORE', KEYS[1], ARGV[4]) == false and redis.call('ZCARD', KEYS[1]) >= max then
+		return 0
+	end
+	redis.call('ZADD', KEYS[1], now + lease, ARGV[4])
+	redis.call('PEXPIRE', KEYS[1], lease)
+	return 1
+`)
+
+func (l *limiter) acquire(ctx context.Context, key string, sessionId string, max int) (bool, error) {
+	acquired, err := acquireLuaScript.Run(ctx, l.redis.GetConnection(), []string{key},
+		l.now().UnixMilli(), l.session.Milliseconds(), max, sessionId).Int()
+	if err != nil {
+		return false, err
+	}
+	return acquired == 1, nil
+}
+
+// renewLuaScript extends the lease of a session, a live session whose lease
+// already ended takes its slot back even when the deployment is full.
+var renewLuaScript = redis.NewScript(`
+	local now = tonumber(ARGV[1])
+	local lease = tonumber(ARGV[2])
+	redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+	redis.call('ZADD', KEYS[1], now + lease, ARGV[3])
+	redis.call('PEXPIRE', KEYS[1], lease)
+	return 1
+`)
+
+func (l *limiter) renew(ctx context.Context, key string, sessionId string) error {
+	return renewLuaScript.Run(ctx, l.redis.GetConnection(), []string{key},
+		l.now().UnixMilli(), l.session.Milliseconds(), sessionId).Err()
+}
+
+func (l *limiter) release(ctx context.Context, key string, sessionId string) error {
+	return l.redis.GetConnection().ZRem(ctx, key, sessionId).Err()
+}
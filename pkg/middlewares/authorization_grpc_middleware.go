@@ -0,0 +1,51 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package middlewares
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rapidaai/pkg/authorizers"
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/types"
+)
+
+// authorize checks the permission of the authenticated principal for a
+// method, unauthenticated requests are left to the handlers.
+func authorize(ctx context.Context, authorizer authorizers.Authorizer, method string) error {
+	auth, ok := types.GetSimplePrincipleGRPC(ctx)
+	if !ok || auth == nil || !auth.IsAuthenticated() {
+		return nil
+	}
+	if err := authorizer.Authorize(ctx, auth, method); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+func NewAuthorizationUnaryServerMiddleware(authorizer authorizers.Authorizer, logger commons.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, authorizer, info.FullMethod); err != nil {
+			logger.Debugf("denied request to %s", info.FullMethod)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func NewAuthorizationStreamServerMiddleware(authorizer authorizers.Authorizer, logger commons.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(stream.Context(), authorizer, info.FullMethod); err != nil {
+			logger.Debugf("denied stream to %s", info.FullMethod)
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
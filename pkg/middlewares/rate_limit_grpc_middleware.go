@@ -0,0 +1,125 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/limiters"
+	"github.com/rapidaai/pkg/types"
+	"github.com/rapidaai/protos"
+)
+
+const RETRY_AFTER_KEY = "retry-after"
+
+// limit applies the request limits and, for metered methods, the quotas of
+// the authenticated project. Internal services are not limited.
+func limit(ctx context.Context, limiter limiters.Limiter, method string) (metadata.MD, error) {
+	auth, ok := types.GetSimplePrincipleGRPC(ctx)
+	if !ok || auth == nil || auth.Type() == "service" {
+		return nil, nil
+	}
+	err := limiter.Allow(ctx, auth)
+	if err == nil && limiters.MeteredMethods[method] {
+		err = limiter.CheckQuota(ctx, auth)
+	}
+	return limitStatus(err)
+}
+
+// limitStatus maps a limit error to a ResourceExhausted status, with the
+// seconds to wait before retrying in the retry-after header.
+func limitStatus(err error) (metadata.MD, error) {
+	var limitErr *limiters.LimitError
+	if !errors.As(err, &limitErr) {
+		return nil, err
+	}
+	var md metadata.MD
+	if limitErr.RetryAfter > 0 {
+		md = metadata.Pairs(RETRY_AFTER_KEY, fmt.Sprintf("%d", int64(math.Ceil(limitErr.RetryAfter.Seconds()))))
+	}
+	return md, status.Error(codes.ResourceExhausted, limitErr.Error())
+}
+
+func NewRateLimitUnaryServerMiddleware(limiter limiters.Limiter, logger commons.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, err := limit(ctx, limiter, info.FullMethod)
+		if err != nil {
+			logger.Debugf("limited request to %s %v", info.FullMethod, err)
+			if md != nil {
+				grpc.SetHeader(ctx, md)
+			}
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func NewRateLimitStreamServerMiddleware(limiter limiters.Limiter, logger commons.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, err := limit(stream.Context(), limiter, info.FullMethod)
+		if err != nil {
+			logger.Debugf("limited stream to %s %v", info.FullMethod, err)
+			if md != nil {
+				stream.SetHeader(md)
+			}
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// metered is a response carrying the metrics of a provider call.
+type metered interface {
+	GetMetrics() []*protos.Metric
+}
+
+// NewUsageUnaryServerMiddleware records the tokens reported by the metrics of
+// responses in the monthly usage of the project.
+func NewUsageUnaryServerMiddleware(limiter limiters.Limiter, logger commons.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if auth, ok := types.GetSimplePrincipleGRPC(ctx); ok && auth != nil {
+			if m, ok := resp.(metered); ok {
+				limiter.Record(ctx, auth, limiters.TokenUsage(m.GetMetrics()))
+			}
+		}
+		return resp, err
+	}
+}
+
+func NewUsageStreamServerMiddleware(limiter limiters.Limiter, logger commons.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		auth, ok := types.GetSimplePrincipleGRPC(stream.Context())
+		if !ok || auth == nil {
+			return handler(srv, stream)
+		}
+		return handler(srv, &usageServerStream{ServerStream: stream, limiter: limiter, auth: auth})
+	}
+}
+
+// usageServerStream records the usage of every message sent on a stream,
+// providers report metrics once with the final message of a call.
+type usageServerStream struct {
+	grpc.ServerStream
+	limiter limiters.Limiter
+	auth    types.SimplePrinciple
+}
+
+func (s *usageServerStream) SendMsg(m any) error {
+	if msg, ok := m.(metered); ok {
+		s.limiter.Record(s.Context(), s.auth, limiters.TokenUsage(msg.GetMetrics()))
+	}
+	return s.ServerStream.SendMsg(m)
+}
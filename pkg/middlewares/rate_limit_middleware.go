@@ -0,0 +1,46 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/rapidaai/pkg/commons"
+	"github.com/rapidaai/pkg/limiters"
+	"github.com/rapidaai/pkg/types"
+)
+
+// NewRateLimitMiddleware applies the request limits of the authenticated
+// project, limited requests are answered with 429 Too Many Requests.
+func NewRateLimitMiddleware(limiter limiters.Limiter, logger commons.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth, ok := types.GetAuthPrinciple(c)
+		if !ok || auth == nil || auth.Type() == "service" {
+			c.Next()
+			return
+		}
+		err := limiter.Allow(c, auth)
+		var limitErr *limiters.LimitError
+		if !errors.As(err, &limitErr) {
+			c.Next()
+			return
+		}
+		logger.Debugf("limited request to %s %v", c.FullPath(), err)
+		if limitErr.RetryAfter > 0 {
+			c.Header("Retry-After", fmt.Sprintf("%d", int64(math.Ceil(limitErr.RetryAfter.Seconds()))))
+		}
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, commons.Response{
+			Code:    http.StatusTooManyRequests,
+			Success: false,
+			Data:    limitErr.Error(),
+		})
+	}
+}
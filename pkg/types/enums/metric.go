@@ -23,6 +23,26 @@ var (
 	TIME_TO_FIRST_TOKEN    MetricName = "TIME_TO_FIRST_TOKEN"
 	PROVIDER_TOTAL_TIME    MetricName = "PROVIDER_TOTAL_TIME"
 	PROVIDER_GENERATE_TIME MetricName = "PROVIDER_GENERATE_TIME"
+	//
+	CACHE_HIT      MetricName = "CACHE_HIT"
+	CACHE_SCORE    MetricName = "CACHE_SCORE"
+	RETRY_ATTEMPTS MetricName = "RETRY_ATTEMPTS"
+	//
+	TIME_TO_FIRST_AUDIO     MetricName = "TIME_TO_FIRST_AUDIO"
+	TIME_TO_FIRST_AUDIO_MAX MetricName = "TIME_TO_FIRST_AUDIO_MAX"
+	INTERRUPTION_COUNT      MetricName = "INTERRUPTION_COUNT"
+	//
+	RTP_PACKETS_SENT        MetricName = "RTP_PACKETS_SENT"
+	RTP_PACKETS_RECEIVED    MetricName = "RTP_PACKETS_RECEIVED"
+	RTP_PACKETS_LOST        MetricName = "RTP_PACKETS_LOST"
+	RTP_PACKET_LOSS_RATE    MetricName = "RTP_PACKET_LOSS_RATE"
+	RTP_PACKETS_LATE        MetricName = "RTP_PACKETS_LATE"
+	RTP_PACKETS_CONCEALED   MetricName = "RTP_PACKETS_CONCEALED"
+	RTP_JITTER              MetricName = "RTP_JITTER"
+	RTP_JITTER_BUFFER_DELAY MetricName = "RTP_JITTER_BUFFER_DELAY"
+	RTP_REMOTE_PACKETS_LOST MetricName = "RTP_REMOTE_PACKETS_LOST"
+	RTP_REMOTE_JITTER       MetricName = "RTP_REMOTE_JITTER"
+	RTP_ROUND_TRIP_TIME     MetricName = "RTP_ROUND_TRIP_TIME"
 )
 
 func (m *MetricName) String() string {
@@ -0,0 +1,62 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	HEADER_SIGNATURE_KEY = "x-rapida-signature"
+	HEADER_TIMESTAMP_KEY = "x-rapida-timestamp"
+)
+
+const signatureVersion = "v1"
+
+var (
+	ErrSignatureMismatch  = errors.New("signature does not match the payload")
+	ErrSignatureTimestamp = errors.New("signature timestamp is outside the tolerance")
+)
+
+// SignPayload signs timestamp and payload with HMAC-SHA256, the result is sent
+// as HEADER_SIGNATURE_KEY alongside the unix timestamp as HEADER_TIMESTAMP_KEY.
+// The signed content is "<unix timestamp>.<payload>" so a captured request
+// can not be replayed with a fresh timestamp.
+func SignPayload(secret []byte, timestamp time.Time, payload []byte) string {
+	return fmt.Sprintf("%s=%s", signatureVersion, signature(secret, strconv.FormatInt(timestamp.Unix(), 10), payload))
+}
+
+// VerifyPayload checks a signature produced by SignPayload and rejects
+// timestamps further than tolerance from now.
+func VerifyPayload(secret []byte, timestamp, sig string, payload []byte, tolerance time.Duration) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSignatureTimestamp, err)
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return ErrSignatureTimestamp
+	}
+	expected, ok := strings.CutPrefix(sig, signatureVersion+"=")
+	if !ok || !hmac.Equal([]byte(expected), []byte(signature(secret, timestamp, payload))) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+func signature(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
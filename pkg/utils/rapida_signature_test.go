@@ -0,0 +1,54 @@
+// Copyright (c) 2023-2025 RapidaAI
+// Author: Prashant Srivastav <prashant@rapida.ai>
+//
+// Licensed under GPL-2.0 with Rapida Additional Terms.
+// See LICENSE.md or contact sales@rapida.ai for commercial usage.
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignPayload(t *testing.T) {
+	secret := []byte("whsec")
+	payload := []byte(`{"event":"conversation.completed"}`)
+
+	// echo -n '1700000000.{"event":"conversation.completed"}' | openssl dgst -sha256 -hmac whsec
+	sig := SignPayload(secret, time.Unix(1700000000, 0), payload)
+	if sig != "v1=0751b050d5be70cce7a083a614d89d008a5d6e775e6746f10e144b3479cb89dc" {
+		t.Errorf("unexpected signature %s", sig)
+	}
+	if SignPayload(secret, time.Unix(1700000001, 0), payload) == sig {
+		t.Error("signature should change with the timestamp")
+	}
+}
+
+func TestVerifyPayload(t *testing.T) {
+	secret := []byte("whsec")
+	payload := []byte(`{"a":1}`)
+	now := time.Now()
+	ts := strconv.FormatInt(now.Unix(), 10)
+	sig := SignPayload(secret, now, payload)
+
+	if err := VerifyPayload(secret, ts, sig, payload, 5*time.Minute); err != nil {
+		t.Errorf("expected valid signature, got %v", err)
+	}
+	if err := VerifyPayload(secret, ts, sig, []byte(`{"a":2}`), 5*time.Minute); !errors.Is(err, ErrSignatureMismatch) {
+		t.Errorf("expected mismatch for a changed payload, got %v", err)
+	}
+	if err := VerifyPayload([]byte("other"), ts, sig, payload, 5*time.Minute); !errors.Is(err, ErrSignatureMismatch) {
+		t.Errorf("expected mismatch for another secret, got %v", err)
+	}
+
+	old := now.Add(-10 * time.Minute)
+	oldSig := SignPayload(secret, old, payload)
+	if err := VerifyPayload(secret, strconv.FormatInt(old.Unix(), 10), oldSig, payload, 5*time.Minute); !errors.Is(err, ErrSignatureTimestamp) {
+		t.Errorf("expected replayed request to be rejected, got %v", err)
+	}
+	if err := VerifyPayload(secret, "yesterday", sig, payload, 5*time.Minute); !errors.Is(err, ErrSignatureTimestamp) {
+		t.Errorf("expected invalid timestamp to be rejected, got %v", err)
+	}
+}
@@ -19,45 +19,55 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AssistantService_GetAssistant_FullMethodName                = "/assistant_api.AssistantService/GetAssistant"
-	AssistantService_GetAllAssistant_FullMethodName             = "/assistant_api.AssistantService/GetAllAssistant"
-	AssistantService_CreateAssistant_FullMethodName             = "/assistant_api.AssistantService/CreateAssistant"
-	AssistantService_DeleteAssistant_FullMethodName             = "/assistant_api.AssistantService/DeleteAssistant"
-	AssistantService_GetAllAssistantProvider_FullMethodName     = "/assistant_api.AssistantService/GetAllAssistantProvider"
-	AssistantService_CreateAssistantProvider_FullMethodName     = "/assistant_api.AssistantService/CreateAssistantProvider"
-	AssistantService_CreateAssistantTag_FullMethodName          = "/assistant_api.AssistantService/CreateAssistantTag"
-	AssistantService_UpdateAssistantVersion_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantVersion"
-	AssistantService_UpdateAssistantDetail_FullMethodName       = "/assistant_api.AssistantService/UpdateAssistantDetail"
-	AssistantService_GetAllAssistantMessage_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantMessage"
-	AssistantService_GetAllConversationMessage_FullMethodName   = "/assistant_api.AssistantService/GetAllConversationMessage"
-	AssistantService_GetAllMessage_FullMethodName               = "/assistant_api.AssistantService/GetAllMessage"
-	AssistantService_GetAllAssistantTelemetry_FullMethodName    = "/assistant_api.AssistantService/GetAllAssistantTelemetry"
-	AssistantService_GetAllAssistantConversation_FullMethodName = "/assistant_api.AssistantService/GetAllAssistantConversation"
-	AssistantService_GetAssistantConversation_FullMethodName    = "/assistant_api.AssistantService/GetAssistantConversation"
-	AssistantService_GetAssistantWebhookLog_FullMethodName      = "/assistant_api.AssistantService/GetAssistantWebhookLog"
-	AssistantService_GetAllAssistantWebhookLog_FullMethodName   = "/assistant_api.AssistantService/GetAllAssistantWebhookLog"
-	AssistantService_GetAllAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantWebhook"
-	AssistantService_GetAssistantWebhook_FullMethodName         = "/assistant_api.AssistantService/GetAssistantWebhook"
-	AssistantService_CreateAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/CreateAssistantWebhook"
-	AssistantService_UpdateAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantWebhook"
-	AssistantService_DeleteAssistantWebhook_FullMethodName      = "/assistant_api.AssistantService/DeleteAssistantWebhook"
-	AssistantService_GetAssistantToolLog_FullMethodName         = "/assistant_api.AssistantService/GetAssistantToolLog"
-	AssistantService_GetAllAssistantToolLog_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantToolLog"
-	AssistantService_GetAssistantAnalysis_FullMethodName        = "/assistant_api.AssistantService/GetAssistantAnalysis"
-	AssistantService_UpdateAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/UpdateAssistantAnalysis"
-	AssistantService_CreateAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/CreateAssistantAnalysis"
-	AssistantService_DeleteAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/DeleteAssistantAnalysis"
-	AssistantService_GetAllAssistantAnalysis_FullMethodName     = "/assistant_api.AssistantService/GetAllAssistantAnalysis"
-	AssistantService_CreateAssistantTool_FullMethodName         = "/assistant_api.AssistantService/CreateAssistantTool"
-	AssistantService_GetAssistantTool_FullMethodName            = "/assistant_api.AssistantService/GetAssistantTool"
-	AssistantService_GetAllAssistantTool_FullMethodName         = "/assistant_api.AssistantService/GetAllAssistantTool"
-	AssistantService_DeleteAssistantTool_FullMethodName         = "/assistant_api.AssistantService/DeleteAssistantTool"
-	AssistantService_UpdateAssistantTool_FullMethodName         = "/assistant_api.AssistantService/UpdateAssistantTool"
-	AssistantService_CreateAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/CreateAssistantKnowledge"
-	AssistantService_GetAssistantKnowledge_FullMethodName       = "/assistant_api.AssistantService/GetAssistantKnowledge"
-	AssistantService_GetAllAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/GetAllAssistantKnowledge"
-	AssistantService_DeleteAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/DeleteAssistantKnowledge"
-	AssistantService_UpdateAssistantKnowledge_FullMethodName    = "/assistant_api.AssistantService/UpdateAssistantKnowledge"
+	AssistantService_GetAssistant_FullMethodName                  = "/assistant_api.AssistantService/GetAssistant"
+	AssistantService_GetAllAssistant_FullMethodName               = "/assistant_api.AssistantService/GetAllAssistant"
+	AssistantService_CreateAssistant_FullMethodName               = "/assistant_api.AssistantService/CreateAssistant"
+	AssistantService_DeleteAssistant_FullMethodName               = "/assistant_api.AssistantService/DeleteAssistant"
+	AssistantService_GetAllAssistantProvider_FullMethodName       = "/assistant_api.AssistantService/GetAllAssistantProvider"
+	AssistantService_CreateAssistantProvider_FullMethodName       = "/assistant_api.AssistantService/CreateAssistantProvider"
+	AssistantService_CreateAssistantTag_FullMethodName            = "/assistant_api.AssistantService/CreateAssistantTag"
+	AssistantService_UpdateAssistantVersion_FullMethodName        = "/assistant_api.AssistantService/UpdateAssistantVersion"
+	AssistantService_UpdateAssistantDetail_FullMethodName         = "/assistant_api.AssistantService/UpdateAssistantDetail"
+	AssistantService_GetAllAssistantMessage_FullMethodName        = "/assistant_api.AssistantService/GetAllAssistantMessage"
+	AssistantService_GetAllConversationMessage_FullMethodName     = "/assistant_api.AssistantService/GetAllConversationMessage"
+	AssistantService_GetAllMessage_FullMethodName                 = "/assistant_api.AssistantService/GetAllMessage"
+	AssistantService_GetAllAssistantTelemetry_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantTelemetry"
+	AssistantService_GetAllAssistantConversation_FullMethodName   = "/assistant_api.AssistantService/GetAllAssistantConversation"
+	AssistantService_GetAssistantConversation_FullMethodName      = "/assistant_api.AssistantService/GetAssistantConversation"
+	AssistantService_GetAssistantEvaluationSummary_FullMethodName = "/assistant_api.AssistantService/GetAssistantEvaluationSummary"
+	AssistantService_CreateAssistantEvaluator_FullMethodName      = "/assistant_api.AssistantService/CreateAssistantEvaluator"
+	AssistantService_UpdateAssistantEvaluator_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantEvaluator"
+	AssistantService_DeleteAssistantEvaluator_FullMethodName      = "/assistant_api.AssistantService/DeleteAssistantEvaluator"
+	AssistantService_GetAllAssistantEvaluator_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantEvaluator"
+	AssistantService_GetAssistantWebhookLog_FullMethodName        = "/assistant_api.AssistantService/GetAssistantWebhookLog"
+	AssistantService_GetAllAssistantWebhookLog_FullMethodName     = "/assistant_api.AssistantService/GetAllAssistantWebhookLog"
+	AssistantService_RedeliverAssistantWebhookLog_FullMethodName  = "/assistant_api.AssistantService/RedeliverAssistantWebhookLog"
+	AssistantService_GetAllAssistantWebhook_FullMethodName        = "/assistant_api.AssistantService/GetAllAssistantWebhook"
+	AssistantService_GetAssistantWebhook_FullMethodName           = "/assistant_api.AssistantService/GetAssistantWebhook"
+	AssistantService_CreateAssistantWebhook_FullMethodName        = "/assistant_api.AssistantService/CreateAssistantWebhook"
+	AssistantService_UpdateAssistantWebhook_FullMethodName        = "/assistant_api.AssistantService/UpdateAssistantWebhook"
+	AssistantService_DeleteAssistantWebhook_FullMethodName        = "/assistant_api.AssistantService/DeleteAssistantWebhook"
+	AssistantService_GetAssistantToolLog_FullMethodName           = "/assistant_api.AssistantService/GetAssistantToolLog"
+	AssistantService_GetAllAssistantToolLog_FullMethodName        = "/assistant_api.AssistantService/GetAllAssistantToolLog"
+	AssistantService_GetAssistantAnalysis_FullMethodName          = "/assistant_api.AssistantService/GetAssistantAnalysis"
+	AssistantService_UpdateAssistantAnalysis_FullMethodName       = "/assistant_api.AssistantService/UpdateAssistantAnalysis"
+	AssistantService_CreateAssistantAnalysis_FullMethodName       = "/assistant_api.AssistantService/CreateAssistantAnalysis"
+	AssistantService_DeleteAssistantAnalysis_FullMethodName       = "/assistant_api.AssistantService/DeleteAssistantAnalysis"
+	AssistantService_GetAllAssistantAnalysis_FullMethodName       = "/assistant_api.AssistantService/GetAllAssistantAnalysis"
+	AssistantService_CreateAssistantModerator_FullMethodName      = "/assistant_api.AssistantService/CreateAssistantModerator"
+	AssistantService_UpdateAssistantModerator_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantModerator"
+	AssistantService_DeleteAssistantModerator_FullMethodName      = "/assistant_api.AssistantService/DeleteAssistantModerator"
+	AssistantService_GetAllAssistantModerator_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantModerator"
+	AssistantService_CreateAssistantTool_FullMethodName           = "/assistant_api.AssistantService/CreateAssistantTool"
+	AssistantService_GetAssistantTool_FullMethodName              = "/assistant_api.AssistantService/GetAssistantTool"
+	AssistantService_GetAllAssistantTool_FullMethodName           = "/assistant_api.AssistantService/GetAllAssistantTool"
+	AssistantService_DeleteAssistantTool_FullMethodName           = "/assistant_api.AssistantService/DeleteAssistantTool"
+	AssistantService_UpdateAssistantTool_FullMethodName           = "/assistant_api.AssistantService/UpdateAssistantTool"
+	AssistantService_CreateAssistantKnowledge_FullMethodName      = "/assistant_api.AssistantService/CreateAssistantKnowledge"
+	AssistantService_GetAssistantKnowledge_FullMethodName         = "/assistant_api.AssistantService/GetAssistantKnowledge"
+	AssistantService_GetAllAssistantKnowledge_FullMethodName      = "/assistant_api.AssistantService/GetAllAssistantKnowledge"
+	AssistantService_DeleteAssistantKnowledge_FullMethodName      = "/assistant_api.AssistantService/DeleteAssistantKnowledge"
+	AssistantService_UpdateAssistantKnowledge_FullMethodName      = "/assistant_api.AssistantService/UpdateAssistantKnowledge"
 )
 
 // AssistantServiceClient is the client API for AssistantService service.
@@ -79,9 +89,17 @@ type AssistantServiceClient interface {
 	GetAllAssistantTelemetry(ctx context.Context, in *GetAllAssistantTelemetryRequest, opts ...grpc.CallOption) (*GetAllAssistantTelemetryResponse, error)
 	GetAllAssistantConversation(ctx context.Context, in *GetAllAssistantConversationRequest, opts ...grpc.CallOption) (*GetAllAssistantConversationResponse, error)
 	GetAssistantConversation(ctx context.Context, in *GetAssistantConversationRequest, opts ...grpc.CallOption) (*GetAssistantConversationResponse, error)
+	// evaluation scores per assistant version
+	GetAssistantEvaluationSummary(ctx context.Context, in *GetAssistantEvaluationSummaryRequest, opts ...grpc.CallOption) (*GetAssistantEvaluationSummaryResponse, error)
+	// evaluator
+	CreateAssistantEvaluator(ctx context.Context, in *CreateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	UpdateAssistantEvaluator(ctx context.Context, in *UpdateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	DeleteAssistantEvaluator(ctx context.Context, in *DeleteAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error)
+	GetAllAssistantEvaluator(ctx context.Context, in *GetAllAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAllAssistantEvaluatorResponse, error)
 	// webhook log
 	GetAssistantWebhookLog(ctx context.Context, in *GetAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAssistantWebhookLogResponse, error)
 	GetAllAssistantWebhookLog(ctx context.Context, in *GetAllAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAllAssistantWebhookLogResponse, error)
+	RedeliverAssistantWebhookLog(ctx context.Context, in *RedeliverAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAssistantWebhookLogResponse, error)
 	GetAllAssistantWebhook(ctx context.Context, in *GetAllAssistantWebhookRequest, opts ...grpc.CallOption) (*GetAllAssistantWebhookResponse, error)
 	GetAssistantWebhook(ctx context.Context, in *GetAssistantWebhookRequest, opts ...grpc.CallOption) (*GetAssistantWebhookResponse, error)
 	CreateAssistantWebhook(ctx context.Context, in *CreateAssistantWebhookRequest, opts ...grpc.CallOption) (*GetAssistantWebhookResponse, error)
@@ -96,6 +114,11 @@ type AssistantServiceClient interface {
 	CreateAssistantAnalysis(ctx context.Context, in *CreateAssistantAnalysisRequest, opts ...grpc.CallOption) (*GetAssistantAnalysisResponse, error)
 	DeleteAssistantAnalysis(ctx context.Context, in *DeleteAssistantAnalysisRequest, opts ...grpc.CallOption) (*GetAssistantAnalysisResponse, error)
 	GetAllAssistantAnalysis(ctx context.Context, in *GetAllAssistantAnalysisRequest, opts ...grpc.CallOption) (*GetAllAssistantAnalysisResponse, error)
+	// moderator
+	CreateAssistantModerator(ctx context.Context, in *CreateAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAssistantModeratorResponse, error)
+	UpdateAssistantModerator(ctx context.Context, in *UpdateAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAssistantModeratorResponse, error)
+	DeleteAssistantModerator(ctx context.Context, in *DeleteAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAssistantModeratorResponse, error)
+	GetAllAssistantModerator(ctx context.Context, in *GetAllAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAllAssistantModeratorResponse, error)
 	// assistant tool
 	CreateAssistantTool(ctx context.Context, in *CreateAssistantToolRequest, opts ...grpc.CallOption) (*GetAssistantToolResponse, error)
 	GetAssistantTool(ctx context.Context, in *GetAssistantToolRequest, opts ...grpc.CallOption) (*GetAssistantToolResponse, error)
@@ -268,6 +291,56 @@ func (c *assistantServiceClient) GetAssistantConversation(ctx context.Context, i
 	return out, nil
 }
 
+func (c *assistantServiceClient) GetAssistantEvaluationSummary(ctx context.Context, in *GetAssistantEvaluationSummaryRequest, opts ...grpc.CallOption) (*GetAssistantEvaluationSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluationSummaryResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAssistantEvaluationSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) CreateAssistantEvaluator(ctx context.Context, in *CreateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_CreateAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) UpdateAssistantEvaluator(ctx context.Context, in *UpdateAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_UpdateAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) DeleteAssistantEvaluator(ctx context.Context, in *DeleteAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_DeleteAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantEvaluator(ctx context.Context, in *GetAllAssistantEvaluatorRequest, opts ...grpc.CallOption) (*GetAllAssistantEvaluatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantEvaluatorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantEvaluator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assistantServiceClient) GetAssistantWebhookLog(ctx context.Context, in *GetAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAssistantWebhookLogResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAssistantWebhookLogResponse)
@@ -288,6 +361,16 @@ func (c *assistantServiceClient) GetAllAssistantWebhookLog(ctx context.Context,
 	return out, nil
 }
 
+func (c *assistantServiceClient) RedeliverAssistantWebhookLog(ctx context.Context, in *RedeliverAssistantWebhookLogRequest, opts ...grpc.CallOption) (*GetAssistantWebhookLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantWebhookLogResponse)
+	err := c.cc.Invoke(ctx, AssistantService_RedeliverAssistantWebhookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assistantServiceClient) GetAllAssistantWebhook(ctx context.Context, in *GetAllAssistantWebhookRequest, opts ...grpc.CallOption) (*GetAllAssistantWebhookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllAssistantWebhookResponse)
@@ -408,6 +491,46 @@ func (c *assistantServiceClient) GetAllAssistantAnalysis(ctx context.Context, in
 	return out, nil
 }
 
+func (c *assistantServiceClient) CreateAssistantModerator(ctx context.Context, in *CreateAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAssistantModeratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantModeratorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_CreateAssistantModerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) UpdateAssistantModerator(ctx context.Context, in *UpdateAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAssistantModeratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantModeratorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_UpdateAssistantModerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) DeleteAssistantModerator(ctx context.Context, in *DeleteAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAssistantModeratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssistantModeratorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_DeleteAssistantModerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assistantServiceClient) GetAllAssistantModerator(ctx context.Context, in *GetAllAssistantModeratorRequest, opts ...grpc.CallOption) (*GetAllAssistantModeratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAssistantModeratorResponse)
+	err := c.cc.Invoke(ctx, AssistantService_GetAllAssistantModerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assistantServiceClient) CreateAssistantTool(ctx context.Context, in *CreateAssistantToolRequest, opts ...grpc.CallOption) (*GetAssistantToolResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAssistantToolResponse)
@@ -527,9 +650,17 @@ type AssistantServiceServer interface {
 	GetAllAssistantTelemetry(context.Context, *GetAllAssistantTelemetryRequest) (*GetAllAssistantTelemetryResponse, error)
 	GetAllAssistantConversation(context.Context, *GetAllAssistantConversationRequest) (*GetAllAssistantConversationResponse, error)
 	GetAssistantConversation(context.Context, *GetAssistantConversationRequest) (*GetAssistantConversationResponse, error)
+	// evaluation scores per assistant version
+	GetAssistantEvaluationSummary(context.Context, *GetAssistantEvaluationSummaryRequest) (*GetAssistantEvaluationSummaryResponse, error)
+	// evaluator
+	CreateAssistantEvaluator(context.Context, *CreateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	UpdateAssistantEvaluator(context.Context, *UpdateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	DeleteAssistantEvaluator(context.Context, *DeleteAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error)
+	GetAllAssistantEvaluator(context.Context, *GetAllAssistantEvaluatorRequest) (*GetAllAssistantEvaluatorResponse, error)
 	// webhook log
 	GetAssistantWebhookLog(context.Context, *GetAssistantWebhookLogRequest) (*GetAssistantWebhookLogResponse, error)
 	GetAllAssistantWebhookLog(context.Context, *GetAllAssistantWebhookLogRequest) (*GetAllAssistantWebhookLogResponse, error)
+	RedeliverAssistantWebhookLog(context.Context, *RedeliverAssistantWebhookLogRequest) (*GetAssistantWebhookLogResponse, error)
 	GetAllAssistantWebhook(context.Context, *GetAllAssistantWebhookRequest) (*GetAllAssistantWebhookResponse, error)
 	GetAssistantWebhook(context.Context, *GetAssistantWebhookRequest) (*GetAssistantWebhookResponse, error)
 	CreateAssistantWebhook(context.Context, *CreateAssistantWebhookRequest) (*GetAssistantWebhookResponse, error)
@@ -544,6 +675,11 @@ type AssistantServiceServer interface {
 	CreateAssistantAnalysis(context.Context, *CreateAssistantAnalysisRequest) (*GetAssistantAnalysisResponse, error)
 	DeleteAssistantAnalysis(context.Context, *DeleteAssistantAnalysisRequest) (*GetAssistantAnalysisResponse, error)
 	GetAllAssistantAnalysis(context.Context, *GetAllAssistantAnalysisRequest) (*GetAllAssistantAnalysisResponse, error)
+	// moderator
+	CreateAssistantModerator(context.Context, *CreateAssistantModeratorRequest) (*GetAssistantModeratorResponse, error)
+	UpdateAssistantModerator(context.Context, *UpdateAssistantModeratorRequest) (*GetAssistantModeratorResponse, error)
+	DeleteAssistantModerator(context.Context, *DeleteAssistantModeratorRequest) (*GetAssistantModeratorResponse, error)
+	GetAllAssistantModerator(context.Context, *GetAllAssistantModeratorRequest) (*GetAllAssistantModeratorResponse, error)
 	// assistant tool
 	CreateAssistantTool(context.Context, *CreateAssistantToolRequest) (*GetAssistantToolResponse, error)
 	GetAssistantTool(context.Context, *GetAssistantToolRequest) (*GetAssistantToolResponse, error)
@@ -610,12 +746,30 @@ func (UnimplementedAssistantServiceServer) GetAllAssistantConversation(context.C
 func (UnimplementedAssistantServiceServer) GetAssistantConversation(context.Context, *GetAssistantConversationRequest) (*GetAssistantConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantConversation not implemented")
 }
+func (UnimplementedAssistantServiceServer) GetAssistantEvaluationSummary(context.Context, *GetAssistantEvaluationSummaryRequest) (*GetAssistantEvaluationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantEvaluationSummary not implemented")
+}
+func (UnimplementedAssistantServiceServer) CreateAssistantEvaluator(context.Context, *CreateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) UpdateAssistantEvaluator(context.Context, *UpdateAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) DeleteAssistantEvaluator(context.Context, *DeleteAssistantEvaluatorRequest) (*GetAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssistantEvaluator not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantEvaluator(context.Context, *GetAllAssistantEvaluatorRequest) (*GetAllAssistantEvaluatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantEvaluator not implemented")
+}
 func (UnimplementedAssistantServiceServer) GetAssistantWebhookLog(context.Context, *GetAssistantWebhookLogRequest) (*GetAssistantWebhookLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssistantWebhookLog not implemented")
 }
 func (UnimplementedAssistantServiceServer) GetAllAssistantWebhookLog(context.Context, *GetAllAssistantWebhookLogRequest) (*GetAllAssistantWebhookLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantWebhookLog not implemented")
 }
+func (UnimplementedAssistantServiceServer) RedeliverAssistantWebhookLog(context.Context, *RedeliverAssistantWebhookLogRequest) (*GetAssistantWebhookLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverAssistantWebhookLog not implemented")
+}
 func (UnimplementedAssistantServiceServer) GetAllAssistantWebhook(context.Context, *GetAllAssistantWebhookRequest) (*GetAllAssistantWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantWebhook not implemented")
 }
@@ -652,6 +806,18 @@ func (UnimplementedAssistantServiceServer) DeleteAssistantAnalysis(context.Conte
 func (UnimplementedAssistantServiceServer) GetAllAssistantAnalysis(context.Context, *GetAllAssistantAnalysisRequest) (*GetAllAssistantAnalysisResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantAnalysis not implemented")
 }
+func (UnimplementedAssistantServiceServer) CreateAssistantModerator(context.Context, *CreateAssistantModeratorRequest) (*GetAssistantModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantModerator not implemented")
+}
+func (UnimplementedAssistantServiceServer) UpdateAssistantModerator(context.Context, *UpdateAssistantModeratorRequest) (*GetAssistantModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssistantModerator not implemented")
+}
+func (UnimplementedAssistantServiceServer) DeleteAssistantModerator(context.Context, *DeleteAssistantModeratorRequest) (*GetAssistantModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssistantModerator not implemented")
+}
+func (UnimplementedAssistantServiceServer) GetAllAssistantModerator(context.Context, *GetAllAssistantModeratorRequest) (*GetAllAssistantModeratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssistantModerator not implemented")
+}
 func (UnimplementedAssistantServiceServer) CreateAssistantTool(context.Context, *CreateAssistantToolRequest) (*GetAssistantToolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAssistantTool not implemented")
 }
@@ -972,6 +1138,96 @@ func _AssistantService_GetAssistantConversation_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssistantService_GetAssistantEvaluationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssistantEvaluationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAssistantEvaluationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAssistantEvaluationSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAssistantEvaluationSummary(ctx, req.(*GetAssistantEvaluationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_CreateAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).CreateAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_CreateAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).CreateAssistantEvaluator(ctx, req.(*CreateAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_UpdateAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).UpdateAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_UpdateAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).UpdateAssistantEvaluator(ctx, req.(*UpdateAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_DeleteAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).DeleteAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_DeleteAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).DeleteAssistantEvaluator(ctx, req.(*DeleteAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantEvaluator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantEvaluatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantEvaluator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantEvaluator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantEvaluator(ctx, req.(*GetAllAssistantEvaluatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssistantService_GetAssistantWebhookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAssistantWebhookLogRequest)
 	if err := dec(in); err != nil {
@@ -1008,6 +1264,24 @@ func _AssistantService_GetAllAssistantWebhookLog_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssistantService_RedeliverAssistantWebhookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverAssistantWebhookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).RedeliverAssistantWebhookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_RedeliverAssistantWebhookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).RedeliverAssistantWebhookLog(ctx, req.(*RedeliverAssistantWebhookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssistantService_GetAllAssistantWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllAssistantWebhookRequest)
 	if err := dec(in); err != nil {
@@ -1224,6 +1498,78 @@ func _AssistantService_GetAllAssistantAnalysis_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssistantService_CreateAssistantModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssistantModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).CreateAssistantModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_CreateAssistantModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).CreateAssistantModerator(ctx, req.(*CreateAssistantModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_UpdateAssistantModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssistantModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).UpdateAssistantModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_UpdateAssistantModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).UpdateAssistantModerator(ctx, req.(*UpdateAssistantModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_DeleteAssistantModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssistantModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).DeleteAssistantModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_DeleteAssistantModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).DeleteAssistantModerator(ctx, req.(*DeleteAssistantModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssistantService_GetAllAssistantModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssistantModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssistantServiceServer).GetAllAssistantModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssistantService_GetAllAssistantModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssistantServiceServer).GetAllAssistantModerator(ctx, req.(*GetAllAssistantModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssistantService_CreateAssistantTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAssistantToolRequest)
 	if err := dec(in); err != nil {
@@ -1471,6 +1817,26 @@ var AssistantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAssistantConversation",
 			Handler:    _AssistantService_GetAssistantConversation_Handler,
 		},
+		{
+			MethodName: "GetAssistantEvaluationSummary",
+			Handler:    _AssistantService_GetAssistantEvaluationSummary_Handler,
+		},
+		{
+			MethodName: "CreateAssistantEvaluator",
+			Handler:    _AssistantService_CreateAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "UpdateAssistantEvaluator",
+			Handler:    _AssistantService_UpdateAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "DeleteAssistantEvaluator",
+			Handler:    _AssistantService_DeleteAssistantEvaluator_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantEvaluator",
+			Handler:    _AssistantService_GetAllAssistantEvaluator_Handler,
+		},
 		{
 			MethodName: "GetAssistantWebhookLog",
 			Handler:    _AssistantService_GetAssistantWebhookLog_Handler,
@@ -1479,6 +1845,10 @@ var AssistantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllAssistantWebhookLog",
 			Handler:    _AssistantService_GetAllAssistantWebhookLog_Handler,
 		},
+		{
+			MethodName: "RedeliverAssistantWebhookLog",
+			Handler:    _AssistantService_RedeliverAssistantWebhookLog_Handler,
+		},
 		{
 			MethodName: "GetAllAssistantWebhook",
 			Handler:    _AssistantService_GetAllAssistantWebhook_Handler,
@@ -1527,6 +1897,22 @@ var AssistantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllAssistantAnalysis",
 			Handler:    _AssistantService_GetAllAssistantAnalysis_Handler,
 		},
+		{
+			MethodName: "CreateAssistantModerator",
+			Handler:    _AssistantService_CreateAssistantModerator_Handler,
+		},
+		{
+			MethodName: "UpdateAssistantModerator",
+			Handler:    _AssistantService_UpdateAssistantModerator_Handler,
+		},
+		{
+			MethodName: "DeleteAssistantModerator",
+			Handler:    _AssistantService_DeleteAssistantModerator_Handler,
+		},
+		{
+			MethodName: "GetAllAssistantModerator",
+			Handler:    _AssistantService_GetAllAssistantModerator_Handler,
+		},
 		{
 			MethodName: "CreateAssistantTool",
 			Handler:    _AssistantService_CreateAssistantTool_Handler,
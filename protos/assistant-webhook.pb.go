@@ -1115,6 +1115,61 @@ func (x *GetAssistantWebhookLogRequest) GetId() uint64 {
 	return 0
 }
 
+type RedeliverAssistantWebhookLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,2,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Id        uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RedeliverAssistantWebhookLogRequest) Reset() {
+	*x = RedeliverAssistantWebhookLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assistant_webhook_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverAssistantWebhookLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverAssistantWebhookLogRequest) ProtoMessage() {}
+
+func (x *RedeliverAssistantWebhookLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assistant_webhook_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverAssistantWebhookLogRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverAssistantWebhookLogRequest) Descriptor() ([]byte, []int) {
+	return file_assistant_webhook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RedeliverAssistantWebhookLogRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RedeliverAssistantWebhookLogRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type GetAssistantWebhookLogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1129,7 +1184,7 @@ type GetAssistantWebhookLogResponse struct {
 func (x *GetAssistantWebhookLogResponse) Reset() {
 	*x = GetAssistantWebhookLogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_webhook_proto_msgTypes[11]
+		mi := &file_assistant_webhook_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1142,7 +1197,7 @@ func (x *GetAssistantWebhookLogResponse) String() string {
 func (*GetAssistantWebhookLogResponse) ProtoMessage() {}
 
 func (x *GetAssistantWebhookLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_webhook_proto_msgTypes[11]
+	mi := &file_assistant_webhook_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1210,7 @@ func (x *GetAssistantWebhookLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssistantWebhookLogResponse.ProtoReflect.Descriptor instead.
 func (*GetAssistantWebhookLogResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_webhook_proto_rawDescGZIP(), []int{11}
+	return file_assistant_webhook_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetAssistantWebhookLogResponse) GetCode() int32 {
@@ -1201,7 +1256,7 @@ type GetAllAssistantWebhookLogResponse struct {
 func (x *GetAllAssistantWebhookLogResponse) Reset() {
 	*x = GetAllAssistantWebhookLogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assistant_webhook_proto_msgTypes[12]
+		mi := &file_assistant_webhook_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1214,7 +1269,7 @@ func (x *GetAllAssistantWebhookLogResponse) String() string {
 func (*GetAllAssistantWebhookLogResponse) ProtoMessage() {}
 
 func (x *GetAllAssistantWebhookLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assistant_webhook_proto_msgTypes[12]
+	mi := &file_assistant_webhook_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,7 +1282,7 @@ func (x *GetAllAssistantWebhookLogResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAllAssistantWebhookLogResponse.ProtoReflect.Descriptor instead.
 func (*GetAllAssistantWebhookLogResponse) Descriptor() ([]byte, []int) {
-	return file_assistant_webhook_proto_rawDescGZIP(), []int{12}
+	return file_assistant_webhook_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAllAssistantWebhookLogResponse) GetCode() int32 {
@@ -1530,32 +1585,38 @@ var file_assistant_webhook_proto_rawDesc = []byte{
 	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
 	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0xa4, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c,
-	0x6f, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
-	0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52,
-	0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61,
-	0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5b, 0x0a, 0x23, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa4, 0x01, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f, 0x67, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1570,66 +1631,67 @@ func file_assistant_webhook_proto_rawDescGZIP() []byte {
 	return file_assistant_webhook_proto_rawDescData
 }
 
-var file_assistant_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_assistant_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_assistant_webhook_proto_goTypes = []any{
-	(*AssistantWebhook)(nil),                  // 0: assistant_api.AssistantWebhook
-	(*AssistantWebhookLog)(nil),               // 1: assistant_api.AssistantWebhookLog
-	(*CreateAssistantWebhookRequest)(nil),     // 2: assistant_api.CreateAssistantWebhookRequest
-	(*UpdateAssistantWebhookRequest)(nil),     // 3: assistant_api.UpdateAssistantWebhookRequest
-	(*GetAssistantWebhookRequest)(nil),        // 4: assistant_api.GetAssistantWebhookRequest
-	(*DeleteAssistantWebhookRequest)(nil),     // 5: assistant_api.DeleteAssistantWebhookRequest
-	(*GetAssistantWebhookResponse)(nil),       // 6: assistant_api.GetAssistantWebhookResponse
-	(*GetAllAssistantWebhookRequest)(nil),     // 7: assistant_api.GetAllAssistantWebhookRequest
-	(*GetAllAssistantWebhookResponse)(nil),    // 8: assistant_api.GetAllAssistantWebhookResponse
-	(*GetAllAssistantWebhookLogRequest)(nil),  // 9: assistant_api.GetAllAssistantWebhookLogRequest
-	(*GetAssistantWebhookLogRequest)(nil),     // 10: assistant_api.GetAssistantWebhookLogRequest
-	(*GetAssistantWebhookLogResponse)(nil),    // 11: assistant_api.GetAssistantWebhookLogResponse
-	(*GetAllAssistantWebhookLogResponse)(nil), // 12: assistant_api.GetAllAssistantWebhookLogResponse
-	nil,                           // 13: assistant_api.AssistantWebhook.HttpHeadersEntry
-	nil,                           // 14: assistant_api.AssistantWebhook.HttpBodyEntry
-	nil,                           // 15: assistant_api.CreateAssistantWebhookRequest.HttpHeadersEntry
-	nil,                           // 16: assistant_api.CreateAssistantWebhookRequest.HttpBodyEntry
-	nil,                           // 17: assistant_api.UpdateAssistantWebhookRequest.HttpHeadersEntry
-	nil,                           // 18: assistant_api.UpdateAssistantWebhookRequest.HttpBodyEntry
-	(*User)(nil),                  // 19: User
-	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 21: google.protobuf.Struct
-	(*Error)(nil),                 // 22: Error
-	(*Paginate)(nil),              // 23: Paginate
-	(*Criteria)(nil),              // 24: Criteria
-	(*Paginated)(nil),             // 25: Paginated
-	(*Ordering)(nil),              // 26: Ordering
+	(*AssistantWebhook)(nil),                    // 0: assistant_api.AssistantWebhook
+	(*AssistantWebhookLog)(nil),                 // 1: assistant_api.AssistantWebhookLog
+	(*CreateAssistantWebhookRequest)(nil),       // 2: assistant_api.CreateAssistantWebhookRequest
+	(*UpdateAssistantWebhookRequest)(nil),       // 3: assistant_api.UpdateAssistantWebhookRequest
+	(*GetAssistantWebhookRequest)(nil),          // 4: assistant_api.GetAssistantWebhookRequest
+	(*DeleteAssistantWebhookRequest)(nil),       // 5: assistant_api.DeleteAssistantWebhookRequest
+	(*GetAssistantWebhookResponse)(nil),         // 6: assistant_api.GetAssistantWebhookResponse
+	(*GetAllAssistantWebhookRequest)(nil),       // 7: assistant_api.GetAllAssistantWebhookRequest
+	(*GetAllAssistantWebhookResponse)(nil),      // 8: assistant_api.GetAllAssistantWebhookResponse
+	(*GetAllAssistantWebhookLogRequest)(nil),    // 9: assistant_api.GetAllAssistantWebhookLogRequest
+	(*GetAssistantWebhookLogRequest)(nil),       // 10: assistant_api.GetAssistantWebhookLogRequest
+	(*RedeliverAssistantWebhookLogRequest)(nil), // 11: assistant_api.RedeliverAssistantWebhookLogRequest
+	(*GetAssistantWebhookLogResponse)(nil),      // 12: assistant_api.GetAssistantWebhookLogResponse
+	(*GetAllAssistantWebhookLogResponse)(nil),   // 13: assistant_api.GetAllAssistantWebhookLogResponse
+	nil,                           // 14: assistant_api.AssistantWebhook.HttpHeadersEntry
+	nil,                           // 15: assistant_api.AssistantWebhook.HttpBodyEntry
+	nil,                           // 16: assistant_api.CreateAssistantWebhookRequest.HttpHeadersEntry
+	nil,                           // 17: assistant_api.CreateAssistantWebhookRequest.HttpBodyEntry
+	nil,                           // 18: assistant_api.UpdateAssistantWebhookRequest.HttpHeadersEntry
+	nil,                           // 19: assistant_api.UpdateAssistantWebhookRequest.HttpBodyEntry
+	(*User)(nil),                  // 20: User
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 22: google.protobuf.Struct
+	(*Error)(nil),                 // 23: Error
+	(*Paginate)(nil),              // 24: Paginate
+	(*Criteria)(nil),              // 25: Criteria
+	(*Paginated)(nil),             // 26: Paginated
+	(*Ordering)(nil),              // 27: Ordering
 }
 var file_assistant_webhook_proto_depIdxs = []int32{
-	13, // 0: assistant_api.AssistantWebhook.httpHeaders:type_name -> assistant_api.AssistantWebhook.HttpHeadersEntry
-	14, // 1: assistant_api.AssistantWebhook.httpBody:type_name -> assistant_api.AssistantWebhook.HttpBodyEntry
-	19, // 2: assistant_api.AssistantWebhook.createdUser:type_name -> User
-	19, // 3: assistant_api.AssistantWebhook.updatedUser:type_name -> User
-	20, // 4: assistant_api.AssistantWebhook.createdDate:type_name -> google.protobuf.Timestamp
-	20, // 5: assistant_api.AssistantWebhook.updatedDate:type_name -> google.protobuf.Timestamp
-	21, // 6: assistant_api.AssistantWebhookLog.request:type_name -> google.protobuf.Struct
-	21, // 7: assistant_api.AssistantWebhookLog.response:type_name -> google.protobuf.Struct
-	20, // 8: assistant_api.AssistantWebhookLog.createdDate:type_name -> google.protobuf.Timestamp
-	20, // 9: assistant_api.AssistantWebhookLog.updatedDate:type_name -> google.protobuf.Timestamp
-	15, // 10: assistant_api.CreateAssistantWebhookRequest.httpHeaders:type_name -> assistant_api.CreateAssistantWebhookRequest.HttpHeadersEntry
-	16, // 11: assistant_api.CreateAssistantWebhookRequest.httpBody:type_name -> assistant_api.CreateAssistantWebhookRequest.HttpBodyEntry
-	17, // 12: assistant_api.UpdateAssistantWebhookRequest.httpHeaders:type_name -> assistant_api.UpdateAssistantWebhookRequest.HttpHeadersEntry
-	18, // 13: assistant_api.UpdateAssistantWebhookRequest.httpBody:type_name -> assistant_api.UpdateAssistantWebhookRequest.HttpBodyEntry
+	14, // 0: assistant_api.AssistantWebhook.httpHeaders:type_name -> assistant_api.AssistantWebhook.HttpHeadersEntry
+	15, // 1: assistant_api.AssistantWebhook.httpBody:type_name -> assistant_api.AssistantWebhook.HttpBodyEntry
+	20, // 2: assistant_api.AssistantWebhook.createdUser:type_name -> User
+	20, // 3: assistant_api.AssistantWebhook.updatedUser:type_name -> User
+	21, // 4: assistant_api.AssistantWebhook.createdDate:type_name -> google.protobuf.Timestamp
+	21, // 5: assistant_api.AssistantWebhook.updatedDate:type_name -> google.protobuf.Timestamp
+	22, // 6: assistant_api.AssistantWebhookLog.request:type_name -> google.protobuf.Struct
+	22, // 7: assistant_api.AssistantWebhookLog.response:type_name -> google.protobuf.Struct
+	21, // 8: assistant_api.AssistantWebhookLog.createdDate:type_name -> google.protobuf.Timestamp
+	21, // 9: assistant_api.AssistantWebhookLog.updatedDate:type_name -> google.protobuf.Timestamp
+	16, // 10: assistant_api.CreateAssistantWebhookRequest.httpHeaders:type_name -> assistant_api.CreateAssistantWebhookRequest.HttpHeadersEntry
+	17, // 11: assistant_api.CreateAssistantWebhookRequest.httpBody:type_name -> assistant_api.CreateAssistantWebhookRequest.HttpBodyEntry
+	18, // 12: assistant_api.UpdateAssistantWebhookRequest.httpHeaders:type_name -> assistant_api.UpdateAssistantWebhookRequest.HttpHeadersEntry
+	19, // 13: assistant_api.UpdateAssistantWebhookRequest.httpBody:type_name -> assistant_api.UpdateAssistantWebhookRequest.HttpBodyEntry
 	0,  // 14: assistant_api.GetAssistantWebhookResponse.data:type_name -> assistant_api.AssistantWebhook
-	22, // 15: assistant_api.GetAssistantWebhookResponse.error:type_name -> Error
-	23, // 16: assistant_api.GetAllAssistantWebhookRequest.paginate:type_name -> Paginate
-	24, // 17: assistant_api.GetAllAssistantWebhookRequest.criterias:type_name -> Criteria
+	23, // 15: assistant_api.GetAssistantWebhookResponse.error:type_name -> Error
+	24, // 16: assistant_api.GetAllAssistantWebhookRequest.paginate:type_name -> Paginate
+	25, // 17: assistant_api.GetAllAssistantWebhookRequest.criterias:type_name -> Criteria
 	0,  // 18: assistant_api.GetAllAssistantWebhookResponse.data:type_name -> assistant_api.AssistantWebhook
-	22, // 19: assistant_api.GetAllAssistantWebhookResponse.error:type_name -> Error
-	25, // 20: assistant_api.GetAllAssistantWebhookResponse.paginated:type_name -> Paginated
-	23, // 21: assistant_api.GetAllAssistantWebhookLogRequest.paginate:type_name -> Paginate
-	24, // 22: assistant_api.GetAllAssistantWebhookLogRequest.criterias:type_name -> Criteria
-	26, // 23: assistant_api.GetAllAssistantWebhookLogRequest.order:type_name -> Ordering
+	23, // 19: assistant_api.GetAllAssistantWebhookResponse.error:type_name -> Error
+	26, // 20: assistant_api.GetAllAssistantWebhookResponse.paginated:type_name -> Paginated
+	24, // 21: assistant_api.GetAllAssistantWebhookLogRequest.paginate:type_name -> Paginate
+	25, // 22: assistant_api.GetAllAssistantWebhookLogRequest.criterias:type_name -> Criteria
+	27, // 23: assistant_api.GetAllAssistantWebhookLogRequest.order:type_name -> Ordering
 	1,  // 24: assistant_api.GetAssistantWebhookLogResponse.data:type_name -> assistant_api.AssistantWebhookLog
-	22, // 25: assistant_api.GetAssistantWebhookLogResponse.error:type_name -> Error
+	23, // 25: assistant_api.GetAssistantWebhookLogResponse.error:type_name -> Error
 	1,  // 26: assistant_api.GetAllAssistantWebhookLogResponse.data:type_name -> assistant_api.AssistantWebhookLog
-	22, // 27: assistant_api.GetAllAssistantWebhookLogResponse.error:type_name -> Error
-	25, // 28: assistant_api.GetAllAssistantWebhookLogResponse.paginated:type_name -> Paginated
+	23, // 27: assistant_api.GetAllAssistantWebhookLogResponse.error:type_name -> Error
+	26, // 28: assistant_api.GetAllAssistantWebhookLogResponse.paginated:type_name -> Paginated
 	29, // [29:29] is the sub-list for method output_type
 	29, // [29:29] is the sub-list for method input_type
 	29, // [29:29] is the sub-list for extension type_name
@@ -1777,7 +1839,7 @@ func file_assistant_webhook_proto_init() {
 			}
 		}
 		file_assistant_webhook_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAssistantWebhookLogResponse); i {
+			switch v := v.(*RedeliverAssistantWebhookLogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1789,6 +1851,18 @@ func file_assistant_webhook_proto_init() {
 			}
 		}
 		file_assistant_webhook_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAssistantWebhookLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assistant_webhook_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*GetAllAssistantWebhookLogResponse); i {
 			case 0:
 				return &v.state
@@ -1807,7 +1881,7 @@ func file_assistant_webhook_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_assistant_webhook_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -592,6 +592,379 @@ func (x *CreateMetadataResponse) GetError() *Error {
 	return nil
 }
 
+// A request refused by the authorization middleware of a service for a
+// missing permission.
+type AuthorizationDenial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Method           string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Permission       string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	PrincipalType    string                 `protobuf:"bytes,4,opt,name=principalType,proto3" json:"principalType,omitempty"`
+	UserId           uint64                 `protobuf:"varint,5,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrganizationId   uint64                 `protobuf:"varint,6,opt,name=organizationId,proto3" json:"organizationId,omitempty"`
+	ProjectId        uint64                 `protobuf:"varint,7,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	OrganizationRole string                 `protobuf:"bytes,8,opt,name=organizationRole,proto3" json:"organizationRole,omitempty"`
+	ProjectRole      string                 `protobuf:"bytes,9,opt,name=projectRole,proto3" json:"projectRole,omitempty"`
+	CreatedDate      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+}
+
+func (x *AuthorizationDenial) Reset() {
+	*x = AuthorizationDenial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_audit_logging_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationDenial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationDenial) ProtoMessage() {}
+
+func (x *AuthorizationDenial) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_logging_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationDenial.ProtoReflect.Descriptor instead.
+func (*AuthorizationDenial) Descriptor() ([]byte, []int) {
+	return file_audit_logging_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuthorizationDenial) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuthorizationDenial) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuthorizationDenial) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AuthorizationDenial) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *AuthorizationDenial) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuthorizationDenial) GetOrganizationId() uint64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *AuthorizationDenial) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AuthorizationDenial) GetOrganizationRole() string {
+	if x != nil {
+		return x.OrganizationRole
+	}
+	return ""
+}
+
+func (x *AuthorizationDenial) GetProjectRole() string {
+	if x != nil {
+		return x.ProjectRole
+	}
+	return ""
+}
+
+func (x *AuthorizationDenial) GetCreatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedDate
+	}
+	return nil
+}
+
+type CreateAuthorizationDenialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denial *AuthorizationDenial `protobuf:"bytes,1,opt,name=denial,proto3" json:"denial,omitempty"`
+}
+
+func (x *CreateAuthorizationDenialRequest) Reset() {
+	*x = CreateAuthorizationDenialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_audit_logging_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAuthorizationDenialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorizationDenialRequest) ProtoMessage() {}
+
+func (x *CreateAuthorizationDenialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_logging_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorizationDenialRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuthorizationDenialRequest) Descriptor() ([]byte, []int) {
+	return file_audit_logging_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateAuthorizationDenialRequest) GetDenial() *AuthorizationDenial {
+	if x != nil {
+		return x.Denial
+	}
+	return nil
+}
+
+type CreateAuthorizationDenialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool                 `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *AuthorizationDenial `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CreateAuthorizationDenialResponse) Reset() {
+	*x = CreateAuthorizationDenialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_audit_logging_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAuthorizationDenialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorizationDenialResponse) ProtoMessage() {}
+
+func (x *CreateAuthorizationDenialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_logging_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorizationDenialResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuthorizationDenialResponse) Descriptor() ([]byte, []int) {
+	return file_audit_logging_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateAuthorizationDenialResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateAuthorizationDenialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateAuthorizationDenialResponse) GetData() *AuthorizationDenial {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateAuthorizationDenialResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetAllAuthorizationDenialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paginate  *Paginate   `protobuf:"bytes,1,opt,name=paginate,proto3" json:"paginate,omitempty"`
+	Criterias []*Criteria `protobuf:"bytes,2,rep,name=criterias,proto3" json:"criterias,omitempty"`
+}
+
+func (x *GetAllAuthorizationDenialRequest) Reset() {
+	*x = GetAllAuthorizationDenialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_audit_logging_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAuthorizationDenialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAuthorizationDenialRequest) ProtoMessage() {}
+
+func (x *GetAllAuthorizationDenialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_logging_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAuthorizationDenialRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAuthorizationDenialRequest) Descriptor() ([]byte, []int) {
+	return file_audit_logging_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAllAuthorizationDenialRequest) GetPaginate() *Paginate {
+	if x != nil {
+		return x.Paginate
+	}
+	return nil
+}
+
+func (x *GetAllAuthorizationDenialRequest) GetCriterias() []*Criteria {
+	if x != nil {
+		return x.Criterias
+	}
+	return nil
+}
+
+type GetAllAuthorizationDenialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data      []*AuthorizationDenial `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Error     *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Paginated *Paginated             `protobuf:"bytes,5,opt,name=paginated,proto3" json:"paginated,omitempty"`
+}
+
+func (x *GetAllAuthorizationDenialResponse) Reset() {
+	*x = GetAllAuthorizationDenialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_audit_logging_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllAuthorizationDenialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAuthorizationDenialResponse) ProtoMessage() {}
+
+func (x *GetAllAuthorizationDenialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_logging_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAuthorizationDenialResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAuthorizationDenialResponse) Descriptor() ([]byte, []int) {
+	return file_audit_logging_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAllAuthorizationDenialResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAllAuthorizationDenialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllAuthorizationDenialResponse) GetData() []*AuthorizationDenial {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetAllAuthorizationDenialResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetAllAuthorizationDenialResponse) GetPaginated() *Paginated {
+	if x != nil {
+		return x.Paginated
+	}
+	return nil
+}
+
 var File_audit_logging_api_proto protoreflect.FileDescriptor
 
 var file_audit_logging_api_proto_rawDesc = []byte{
@@ -706,28 +1079,106 @@ var file_audit_logging_api_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x64, 0x61,
 	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x32, 0xb5, 0x02, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
-	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xfd, 0x02, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x22, 0x60, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x69,
+	0x61, 0x6c, 0x22, 0xa9, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x72,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x09, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x32, 0xbf, 0x04, 0x0a, 0x13, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x74,
-	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x74,
 	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70,
+	0x41, 0x6c, 0x6c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x12, 0x23, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x26, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70,
 	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x82, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x31,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e,
+	0x69, 0x61, 0x6c, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61,
+	0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -742,53 +1193,71 @@ func file_audit_logging_api_proto_rawDescGZIP() []byte {
 	return file_audit_logging_api_proto_rawDescData
 }
 
-var file_audit_logging_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_audit_logging_api_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_audit_logging_api_proto_goTypes = []any{
-	(*AuditLog)(nil),               // 0: integration_api.AuditLog
-	(*GetAllAuditLogRequest)(nil),  // 1: integration_api.GetAllAuditLogRequest
-	(*GetAllAuditLogResponse)(nil), // 2: integration_api.GetAllAuditLogResponse
-	(*GetAuditLogRequest)(nil),     // 3: integration_api.GetAuditLogRequest
-	(*GetAuditLogResponse)(nil),    // 4: integration_api.GetAuditLogResponse
-	(*CreateMetadataRequest)(nil),  // 5: integration_api.CreateMetadataRequest
-	(*CreateMetadataResponse)(nil), // 6: integration_api.CreateMetadataResponse
-	nil,                            // 7: integration_api.CreateMetadataRequest.AdditionalDataEntry
-	(*Metadata)(nil),               // 8: Metadata
-	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),        // 10: google.protobuf.Struct
-	(*Metric)(nil),                 // 11: Metric
-	(*Paginate)(nil),               // 12: Paginate
-	(*Criteria)(nil),               // 13: Criteria
-	(*Error)(nil),                  // 14: Error
-	(*Paginated)(nil),              // 15: Paginated
+	(*AuditLog)(nil),                          // 0: integration_api.AuditLog
+	(*GetAllAuditLogRequest)(nil),             // 1: integration_api.GetAllAuditLogRequest
+	(*GetAllAuditLogResponse)(nil),            // 2: integration_api.GetAllAuditLogResponse
+	(*GetAuditLogRequest)(nil),                // 3: integration_api.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),               // 4: integration_api.GetAuditLogResponse
+	(*CreateMetadataRequest)(nil),             // 5: integration_api.CreateMetadataRequest
+	(*CreateMetadataResponse)(nil),            // 6: integration_api.CreateMetadataResponse
+	(*AuthorizationDenial)(nil),               // 7: integration_api.AuthorizationDenial
+	(*CreateAuthorizationDenialRequest)(nil),  // 8: integration_api.CreateAuthorizationDenialRequest
+	(*CreateAuthorizationDenialResponse)(nil), // 9: integration_api.CreateAuthorizationDenialResponse
+	(*GetAllAuthorizationDenialRequest)(nil),  // 10: integration_api.GetAllAuthorizationDenialRequest
+	(*GetAllAuthorizationDenialResponse)(nil), // 11: integration_api.GetAllAuthorizationDenialResponse
+	nil,                           // 12: integration_api.CreateMetadataRequest.AdditionalDataEntry
+	(*Metadata)(nil),              // 13: Metadata
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 15: google.protobuf.Struct
+	(*Metric)(nil),                // 16: Metric
+	(*Paginate)(nil),              // 17: Paginate
+	(*Criteria)(nil),              // 18: Criteria
+	(*Error)(nil),                 // 19: Error
+	(*Paginated)(nil),             // 20: Paginated
 }
 var file_audit_logging_api_proto_depIdxs = []int32{
-	8,  // 0: integration_api.AuditLog.externalAuditMetadatas:type_name -> Metadata
-	9,  // 1: integration_api.AuditLog.createdDate:type_name -> google.protobuf.Timestamp
-	9,  // 2: integration_api.AuditLog.updatedDate:type_name -> google.protobuf.Timestamp
-	10, // 3: integration_api.AuditLog.request:type_name -> google.protobuf.Struct
-	10, // 4: integration_api.AuditLog.response:type_name -> google.protobuf.Struct
-	11, // 5: integration_api.AuditLog.metrics:type_name -> Metric
-	12, // 6: integration_api.GetAllAuditLogRequest.paginate:type_name -> Paginate
-	13, // 7: integration_api.GetAllAuditLogRequest.criterias:type_name -> Criteria
+	13, // 0: integration_api.AuditLog.externalAuditMetadatas:type_name -> Metadata
+	14, // 1: integration_api.AuditLog.createdDate:type_name -> google.protobuf.Timestamp
+	14, // 2: integration_api.AuditLog.updatedDate:type_name -> google.protobuf.Timestamp
+	15, // 3: integration_api.AuditLog.request:type_name -> google.protobuf.Struct
+	15, // 4: integration_api.AuditLog.response:type_name -> google.protobuf.Struct
+	16, // 5: integration_api.AuditLog.metrics:type_name -> Metric
+	17, // 6: integration_api.GetAllAuditLogRequest.paginate:type_name -> Paginate
+	18, // 7: integration_api.GetAllAuditLogRequest.criterias:type_name -> Criteria
 	0,  // 8: integration_api.GetAllAuditLogResponse.data:type_name -> integration_api.AuditLog
-	14, // 9: integration_api.GetAllAuditLogResponse.error:type_name -> Error
-	15, // 10: integration_api.GetAllAuditLogResponse.paginated:type_name -> Paginated
+	19, // 9: integration_api.GetAllAuditLogResponse.error:type_name -> Error
+	20, // 10: integration_api.GetAllAuditLogResponse.paginated:type_name -> Paginated
 	0,  // 11: integration_api.GetAuditLogResponse.data:type_name -> integration_api.AuditLog
-	14, // 12: integration_api.GetAuditLogResponse.error:type_name -> Error
-	7,  // 13: integration_api.CreateMetadataRequest.additionalData:type_name -> integration_api.CreateMetadataRequest.AdditionalDataEntry
+	19, // 12: integration_api.GetAuditLogResponse.error:type_name -> Error
+	12, // 13: integration_api.CreateMetadataRequest.additionalData:type_name -> integration_api.CreateMetadataRequest.AdditionalDataEntry
 	0,  // 14: integration_api.CreateMetadataResponse.data:type_name -> integration_api.AuditLog
-	14, // 15: integration_api.CreateMetadataResponse.error:type_name -> Error
-	1,  // 16: integration_api.AuditLoggingService.GetAllAuditLog:input_type -> integration_api.GetAllAuditLogRequest
-	3,  // 17: integration_api.AuditLoggingService.GetAuditLog:input_type -> integration_api.GetAuditLogRequest
-	5,  // 18: integration_api.AuditLoggingService.CreateMetadata:input_type -> integration_api.CreateMetadataRequest
-	2,  // 19: integration_api.AuditLoggingService.GetAllAuditLog:output_type -> integration_api.GetAllAuditLogResponse
-	4,  // 20: integration_api.AuditLoggingService.GetAuditLog:output_type -> integration_api.GetAuditLogResponse
-	6,  // 21: integration_api.AuditLoggingService.CreateMetadata:output_type -> integration_api.CreateMetadataResponse
-	19, // [19:22] is the sub-list for method output_type
-	16, // [16:19] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	19, // 15: integration_api.CreateMetadataResponse.error:type_name -> Error
+	14, // 16: integration_api.AuthorizationDenial.createdDate:type_name -> google.protobuf.Timestamp
+	7,  // 17: integration_api.CreateAuthorizationDenialRequest.denial:type_name -> integration_api.AuthorizationDenial
+	7,  // 18: integration_api.CreateAuthorizationDenialResponse.data:type_name -> integration_api.AuthorizationDenial
+	19, // 19: integration_api.CreateAuthorizationDenialResponse.error:type_name -> Error
+	17, // 20: integration_api.GetAllAuthorizationDenialRequest.paginate:type_name -> Paginate
+	18, // 21: integration_api.GetAllAuthorizationDenialRequest.criterias:type_name -> Criteria
+	7,  // 22: integration_api.GetAllAuthorizationDenialResponse.data:type_name -> integration_api.AuthorizationDenial
+	19, // 23: integration_api.GetAllAuthorizationDenialResponse.error:type_name -> Error
+	20, // 24: integration_api.GetAllAuthorizationDenialResponse.paginated:type_name -> Paginated
+	1,  // 25: integration_api.AuditLoggingService.GetAllAuditLog:input_type -> integration_api.GetAllAuditLogRequest
+	3,  // 26: integration_api.AuditLoggingService.GetAuditLog:input_type -> integration_api.GetAuditLogRequest
+	5,  // 27: integration_api.AuditLoggingService.CreateMetadata:input_type -> integration_api.CreateMetadataRequest
+	8,  // 28: integration_api.AuditLoggingService.CreateAuthorizationDenial:input_type -> integration_api.CreateAuthorizationDenialRequest
+	10, // 29: integration_api.AuditLoggingService.GetAllAuthorizationDenial:input_type -> integration_api.GetAllAuthorizationDenialRequest
+	2,  // 30: integration_api.AuditLoggingService.GetAllAuditLog:output_type -> integration_api.GetAllAuditLogResponse
+	4,  // 31: integration_api.AuditLoggingService.GetAuditLog:output_type -> integration_api.GetAuditLogResponse
+	6,  // 32: integration_api.AuditLoggingService.CreateMetadata:output_type -> integration_api.CreateMetadataResponse
+	9,  // 33: integration_api.AuditLoggingService.CreateAuthorizationDenial:output_type -> integration_api.CreateAuthorizationDenialResponse
+	11, // 34: integration_api.AuditLoggingService.GetAllAuthorizationDenial:output_type -> integration_api.GetAllAuthorizationDenialResponse
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_audit_logging_api_proto_init() }
@@ -882,6 +1351,66 @@ func file_audit_logging_api_proto_init() {
 				return nil
 			}
 		}
+		file_audit_logging_api_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*AuthorizationDenial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_audit_logging_api_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAuthorizationDenialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_audit_logging_api_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAuthorizationDenialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_audit_logging_api_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAuthorizationDenialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_audit_logging_api_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllAuthorizationDenialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -889,7 +1418,7 @@ func file_audit_logging_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_audit_logging_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuditLoggingService_GetAllAuditLog_FullMethodName = "/integration_api.AuditLoggingService/GetAllAuditLog"
-	AuditLoggingService_GetAuditLog_FullMethodName    = "/integration_api.AuditLoggingService/GetAuditLog"
-	AuditLoggingService_CreateMetadata_FullMethodName = "/integration_api.AuditLoggingService/CreateMetadata"
+	AuditLoggingService_GetAllAuditLog_FullMethodName            = "/integration_api.AuditLoggingService/GetAllAuditLog"
+	AuditLoggingService_GetAuditLog_FullMethodName               = "/integration_api.AuditLoggingService/GetAuditLog"
+	AuditLoggingService_CreateMetadata_FullMethodName            = "/integration_api.AuditLoggingService/CreateMetadata"
+	AuditLoggingService_CreateAuthorizationDenial_FullMethodName = "/integration_api.AuditLoggingService/CreateAuthorizationDenial"
+	AuditLoggingService_GetAllAuthorizationDenial_FullMethodName = "/integration_api.AuditLoggingService/GetAllAuthorizationDenial"
 )
 
 // AuditLoggingServiceClient is the client API for AuditLoggingService service.
@@ -31,6 +33,10 @@ type AuditLoggingServiceClient interface {
 	GetAllAuditLog(ctx context.Context, in *GetAllAuditLogRequest, opts ...grpc.CallOption) (*GetAllAuditLogResponse, error)
 	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
 	CreateMetadata(ctx context.Context, in *CreateMetadataRequest, opts ...grpc.CallOption) (*CreateMetadataResponse, error)
+	// Record a denied request, called by the services enforcing permissions
+	CreateAuthorizationDenial(ctx context.Context, in *CreateAuthorizationDenialRequest, opts ...grpc.CallOption) (*CreateAuthorizationDenialResponse, error)
+	// Get the denied requests of the organization
+	GetAllAuthorizationDenial(ctx context.Context, in *GetAllAuthorizationDenialRequest, opts ...grpc.CallOption) (*GetAllAuthorizationDenialResponse, error)
 }
 
 type auditLoggingServiceClient struct {
@@ -71,6 +77,26 @@ func (c *auditLoggingServiceClient) CreateMetadata(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *auditLoggingServiceClient) CreateAuthorizationDenial(ctx context.Context, in *CreateAuthorizationDenialRequest, opts ...grpc.CallOption) (*CreateAuthorizationDenialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAuthorizationDenialResponse)
+	err := c.cc.Invoke(ctx, AuditLoggingService_CreateAuthorizationDenial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditLoggingServiceClient) GetAllAuthorizationDenial(ctx context.Context, in *GetAllAuthorizationDenialRequest, opts ...grpc.CallOption) (*GetAllAuthorizationDenialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllAuthorizationDenialResponse)
+	err := c.cc.Invoke(ctx, AuditLoggingService_GetAllAuthorizationDenial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuditLoggingServiceServer is the server API for AuditLoggingService service.
 // All implementations should embed UnimplementedAuditLoggingServiceServer
 // for forward compatibility.
@@ -78,6 +104,10 @@ type AuditLoggingServiceServer interface {
 	GetAllAuditLog(context.Context, *GetAllAuditLogRequest) (*GetAllAuditLogResponse, error)
 	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
 	CreateMetadata(context.Context, *CreateMetadataRequest) (*CreateMetadataResponse, error)
+	// Record a denied request, called by the services enforcing permissions
+	CreateAuthorizationDenial(context.Context, *CreateAuthorizationDenialRequest) (*CreateAuthorizationDenialResponse, error)
+	// Get the denied requests of the organization
+	GetAllAuthorizationDenial(context.Context, *GetAllAuthorizationDenialRequest) (*GetAllAuthorizationDenialResponse, error)
 }
 
 // UnimplementedAuditLoggingServiceServer should be embedded to have
@@ -96,6 +126,12 @@ func (UnimplementedAuditLoggingServiceServer) GetAuditLog(context.Context, *GetA
 func (UnimplementedAuditLoggingServiceServer) CreateMetadata(context.Context, *CreateMetadataRequest) (*CreateMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMetadata not implemented")
 }
+func (UnimplementedAuditLoggingServiceServer) CreateAuthorizationDenial(context.Context, *CreateAuthorizationDenialRequest) (*CreateAuthorizationDenialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuthorizationDenial not implemented")
+}
+func (UnimplementedAuditLoggingServiceServer) GetAllAuthorizationDenial(context.Context, *GetAllAuthorizationDenialRequest) (*GetAllAuthorizationDenialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAuthorizationDenial not implemented")
+}
 func (UnimplementedAuditLoggingServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAuditLoggingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +206,42 @@ func _AuditLoggingService_CreateMetadata_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditLoggingService_CreateAuthorizationDenial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthorizationDenialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLoggingServiceServer).CreateAuthorizationDenial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLoggingService_CreateAuthorizationDenial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLoggingServiceServer).CreateAuthorizationDenial(ctx, req.(*CreateAuthorizationDenialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditLoggingService_GetAllAuthorizationDenial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAuthorizationDenialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLoggingServiceServer).GetAllAuthorizationDenial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLoggingService_GetAllAuthorizationDenial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLoggingServiceServer).GetAllAuthorizationDenial(ctx, req.(*GetAllAuthorizationDenialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuditLoggingService_ServiceDesc is the grpc.ServiceDesc for AuditLoggingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +261,14 @@ var AuditLoggingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMetadata",
 			Handler:    _AuditLoggingService_CreateMetadata_Handler,
 		},
+		{
+			MethodName: "CreateAuthorizationDenial",
+			Handler:    _AuditLoggingService_CreateAuthorizationDenial_Handler,
+		},
+		{
+			MethodName: "GetAllAuthorizationDenial",
+			Handler:    _AuditLoggingService_GetAllAuthorizationDenial_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "audit-logging-api.proto",
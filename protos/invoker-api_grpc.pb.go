@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Deployment_Invoke_FullMethodName = "/endpoint_api.Deployment/Invoke"
-	Deployment_Update_FullMethodName = "/endpoint_api.Deployment/Update"
-	Deployment_Probe_FullMethodName  = "/endpoint_api.Deployment/Probe"
+	Deployment_Invoke_FullMethodName       = "/endpoint_api.Deployment/Invoke"
+	Deployment_InvokeStream_FullMethodName = "/endpoint_api.Deployment/InvokeStream"
+	Deployment_Update_FullMethodName       = "/endpoint_api.Deployment/Update"
+	Deployment_Probe_FullMethodName        = "/endpoint_api.Deployment/Probe"
 )
 
 // DeploymentClient is the client API for Deployment service.
@@ -29,6 +30,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeploymentClient interface {
 	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
+	InvokeStream(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InvokeResponse], error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Probe(ctx context.Context, in *ProbeRequest, opts ...grpc.CallOption) (*ProbeResponse, error)
 }
@@ -51,6 +53,25 @@ func (c *deploymentClient) Invoke(ctx context.Context, in *InvokeRequest, opts .
 	return out, nil
 }
 
+func (c *deploymentClient) InvokeStream(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InvokeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Deployment_ServiceDesc.Streams[0], Deployment_InvokeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[InvokeRequest, InvokeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Deployment_InvokeStreamClient = grpc.ServerStreamingClient[InvokeResponse]
+
 func (c *deploymentClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateResponse)
@@ -76,6 +97,7 @@ func (c *deploymentClient) Probe(ctx context.Context, in *ProbeRequest, opts ...
 // for forward compatibility.
 type DeploymentServer interface {
 	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
+	InvokeStream(*InvokeRequest, grpc.ServerStreamingServer[InvokeResponse]) error
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
 }
@@ -90,6 +112,9 @@ type UnimplementedDeploymentServer struct{}
 func (UnimplementedDeploymentServer) Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
 }
+func (UnimplementedDeploymentServer) InvokeStream(*InvokeRequest, grpc.ServerStreamingServer[InvokeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeStream not implemented")
+}
 func (UnimplementedDeploymentServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -134,6 +159,17 @@ func _Deployment_Invoke_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Deployment_InvokeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeploymentServer).InvokeStream(m, &grpc.GenericServerStream[InvokeRequest, InvokeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Deployment_InvokeStreamServer = grpc.ServerStreamingServer[InvokeResponse]
+
 func _Deployment_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRequest)
 	if err := dec(in); err != nil {
@@ -190,6 +226,12 @@ var Deployment_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Deployment_Probe_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InvokeStream",
+			Handler:       _Deployment_InvokeStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "invoker-api.proto",
 }
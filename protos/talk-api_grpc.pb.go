@@ -26,6 +26,15 @@ const (
 	TalkService_CreateConversationMetric_FullMethodName    = "/talk_api.TalkService/CreateConversationMetric"
 	TalkService_CreatePhoneCall_FullMethodName             = "/talk_api.TalkService/CreatePhoneCall"
 	TalkService_CreateBulkPhoneCall_FullMethodName         = "/talk_api.TalkService/CreateBulkPhoneCall"
+	TalkService_PauseCampaign_FullMethodName               = "/talk_api.TalkService/PauseCampaign"
+	TalkService_ResumeCampaign_FullMethodName              = "/talk_api.TalkService/ResumeCampaign"
+	TalkService_CancelCampaign_FullMethodName              = "/talk_api.TalkService/CancelCampaign"
+	TalkService_CreateCampaign_FullMethodName              = "/talk_api.TalkService/CreateCampaign"
+	TalkService_GetCampaign_FullMethodName                 = "/talk_api.TalkService/GetCampaign"
+	TalkService_AddCampaignContacts_FullMethodName         = "/talk_api.TalkService/AddCampaignContacts"
+	TalkService_AddDoNotCallNumbers_FullMethodName         = "/talk_api.TalkService/AddDoNotCallNumbers"
+	TalkService_RemoveDoNotCallNumbers_FullMethodName      = "/talk_api.TalkService/RemoveDoNotCallNumbers"
+	TalkService_SetCredentialLimit_FullMethodName          = "/talk_api.TalkService/SetCredentialLimit"
 )
 
 // TalkServiceClient is the client API for TalkService service.
@@ -48,6 +57,24 @@ type TalkServiceClient interface {
 	CreatePhoneCall(ctx context.Context, in *CreatePhoneCallRequest, opts ...grpc.CallOption) (*CreatePhoneCallResponse, error)
 	// Create bulk phone calls
 	CreateBulkPhoneCall(ctx context.Context, in *CreateBulkPhoneCallRequest, opts ...grpc.CallOption) (*CreateBulkPhoneCallResponse, error)
+	// Pause an outbound call campaign, calls in flight finish
+	PauseCampaign(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*UpdateCampaignStatusResponse, error)
+	// Resume a paused outbound call campaign
+	ResumeCampaign(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*UpdateCampaignStatusResponse, error)
+	// Cancel an outbound call campaign and its pending contacts
+	CancelCampaign(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*UpdateCampaignStatusResponse, error)
+	// Create an outbound call campaign, its contacts are dialed within the calling window
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	// Get an outbound call campaign and its progress
+	GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	// Add contacts to an outbound call campaign, a completed campaign runs again
+	AddCampaignContacts(ctx context.Context, in *AddCampaignContactsRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error)
+	// Add numbers to the do-not-call list of the project
+	AddDoNotCallNumbers(ctx context.Context, in *DoNotCallRequest, opts ...grpc.CallOption) (*DoNotCallResponse, error)
+	// Remove numbers from the do-not-call list of the project
+	RemoveDoNotCallNumbers(ctx context.Context, in *DoNotCallRequest, opts ...grpc.CallOption) (*DoNotCallResponse, error)
+	// Set the concurrency and calls per second campaigns use a provider credential with
+	SetCredentialLimit(ctx context.Context, in *CredentialLimitRequest, opts ...grpc.CallOption) (*CredentialLimitResponse, error)
 }
 
 type talkServiceClient struct {
@@ -131,6 +158,96 @@ func (c *talkServiceClient) CreateBulkPhoneCall(ctx context.Context, in *CreateB
 	return out, nil
 }
 
+func (c *talkServiceClient) PauseCampaign(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*UpdateCampaignStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCampaignStatusResponse)
+	err := c.cc.Invoke(ctx, TalkService_PauseCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) ResumeCampaign(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*UpdateCampaignStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCampaignStatusResponse)
+	err := c.cc.Invoke(ctx, TalkService_ResumeCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) CancelCampaign(ctx context.Context, in *UpdateCampaignStatusRequest, opts ...grpc.CallOption) (*UpdateCampaignStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCampaignStatusResponse)
+	err := c.cc.Invoke(ctx, TalkService_CancelCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) GetCampaign(ctx context.Context, in *GetCampaignRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_GetCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) AddCampaignContacts(ctx context.Context, in *AddCampaignContactsRequest, opts ...grpc.CallOption) (*GetCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCampaignResponse)
+	err := c.cc.Invoke(ctx, TalkService_AddCampaignContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) AddDoNotCallNumbers(ctx context.Context, in *DoNotCallRequest, opts ...grpc.CallOption) (*DoNotCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoNotCallResponse)
+	err := c.cc.Invoke(ctx, TalkService_AddDoNotCallNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) RemoveDoNotCallNumbers(ctx context.Context, in *DoNotCallRequest, opts ...grpc.CallOption) (*DoNotCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoNotCallResponse)
+	err := c.cc.Invoke(ctx, TalkService_RemoveDoNotCallNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *talkServiceClient) SetCredentialLimit(ctx context.Context, in *CredentialLimitRequest, opts ...grpc.CallOption) (*CredentialLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CredentialLimitResponse)
+	err := c.cc.Invoke(ctx, TalkService_SetCredentialLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TalkServiceServer is the server API for TalkService service.
 // All implementations should embed UnimplementedTalkServiceServer
 // for forward compatibility.
@@ -151,6 +268,24 @@ type TalkServiceServer interface {
 	CreatePhoneCall(context.Context, *CreatePhoneCallRequest) (*CreatePhoneCallResponse, error)
 	// Create bulk phone calls
 	CreateBulkPhoneCall(context.Context, *CreateBulkPhoneCallRequest) (*CreateBulkPhoneCallResponse, error)
+	// Pause an outbound call campaign, calls in flight finish
+	PauseCampaign(context.Context, *UpdateCampaignStatusRequest) (*UpdateCampaignStatusResponse, error)
+	// Resume a paused outbound call campaign
+	ResumeCampaign(context.Context, *UpdateCampaignStatusRequest) (*UpdateCampaignStatusResponse, error)
+	// Cancel an outbound call campaign and its pending contacts
+	CancelCampaign(context.Context, *UpdateCampaignStatusRequest) (*UpdateCampaignStatusResponse, error)
+	// Create an outbound call campaign, its contacts are dialed within the calling window
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*GetCampaignResponse, error)
+	// Get an outbound call campaign and its progress
+	GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error)
+	// Add contacts to an outbound call campaign, a completed campaign runs again
+	AddCampaignContacts(context.Context, *AddCampaignContactsRequest) (*GetCampaignResponse, error)
+	// Add numbers to the do-not-call list of the project
+	AddDoNotCallNumbers(context.Context, *DoNotCallRequest) (*DoNotCallResponse, error)
+	// Remove numbers from the do-not-call list of the project
+	RemoveDoNotCallNumbers(context.Context, *DoNotCallRequest) (*DoNotCallResponse, error)
+	// Set the concurrency and calls per second campaigns use a provider credential with
+	SetCredentialLimit(context.Context, *CredentialLimitRequest) (*CredentialLimitResponse, error)
 }
 
 // UnimplementedTalkServiceServer should be embedded to have
@@ -181,6 +316,33 @@ func (UnimplementedTalkServiceServer) CreatePhoneCall(context.Context, *CreatePh
 func (UnimplementedTalkServiceServer) CreateBulkPhoneCall(context.Context, *CreateBulkPhoneCallRequest) (*CreateBulkPhoneCallResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBulkPhoneCall not implemented")
 }
+func (UnimplementedTalkServiceServer) PauseCampaign(context.Context, *UpdateCampaignStatusRequest) (*UpdateCampaignStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) ResumeCampaign(context.Context, *UpdateCampaignStatusRequest) (*UpdateCampaignStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) CancelCampaign(context.Context, *UpdateCampaignStatusRequest) (*UpdateCampaignStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) GetCampaign(context.Context, *GetCampaignRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaign not implemented")
+}
+func (UnimplementedTalkServiceServer) AddCampaignContacts(context.Context, *AddCampaignContactsRequest) (*GetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCampaignContacts not implemented")
+}
+func (UnimplementedTalkServiceServer) AddDoNotCallNumbers(context.Context, *DoNotCallRequest) (*DoNotCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDoNotCallNumbers not implemented")
+}
+func (UnimplementedTalkServiceServer) RemoveDoNotCallNumbers(context.Context, *DoNotCallRequest) (*DoNotCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDoNotCallNumbers not implemented")
+}
+func (UnimplementedTalkServiceServer) SetCredentialLimit(context.Context, *CredentialLimitRequest) (*CredentialLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCredentialLimit not implemented")
+}
 func (UnimplementedTalkServiceServer) testEmbeddedByValue() {}
 
 // UnsafeTalkServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -316,6 +478,168 @@ func _TalkService_CreateBulkPhoneCall_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TalkService_PauseCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampaignStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).PauseCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_PauseCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).PauseCampaign(ctx, req.(*UpdateCampaignStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_ResumeCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampaignStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).ResumeCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_ResumeCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).ResumeCampaign(ctx, req.(*UpdateCampaignStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_CancelCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCampaignStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).CancelCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_CancelCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).CancelCampaign(ctx, req.(*UpdateCampaignStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_GetCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).GetCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_GetCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).GetCampaign(ctx, req.(*GetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_AddCampaignContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCampaignContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).AddCampaignContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_AddCampaignContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).AddCampaignContacts(ctx, req.(*AddCampaignContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_AddDoNotCallNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoNotCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).AddDoNotCallNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_AddDoNotCallNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).AddDoNotCallNumbers(ctx, req.(*DoNotCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_RemoveDoNotCallNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoNotCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).RemoveDoNotCallNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_RemoveDoNotCallNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).RemoveDoNotCallNumbers(ctx, req.(*DoNotCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TalkService_SetCredentialLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CredentialLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TalkServiceServer).SetCredentialLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TalkService_SetCredentialLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TalkServiceServer).SetCredentialLimit(ctx, req.(*CredentialLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TalkService_ServiceDesc is the grpc.ServiceDesc for TalkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +671,42 @@ var TalkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBulkPhoneCall",
 			Handler:    _TalkService_CreateBulkPhoneCall_Handler,
 		},
+		{
+			MethodName: "PauseCampaign",
+			Handler:    _TalkService_PauseCampaign_Handler,
+		},
+		{
+			MethodName: "ResumeCampaign",
+			Handler:    _TalkService_ResumeCampaign_Handler,
+		},
+		{
+			MethodName: "CancelCampaign",
+			Handler:    _TalkService_CancelCampaign_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _TalkService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaign",
+			Handler:    _TalkService_GetCampaign_Handler,
+		},
+		{
+			MethodName: "AddCampaignContacts",
+			Handler:    _TalkService_AddCampaignContacts_Handler,
+		},
+		{
+			MethodName: "AddDoNotCallNumbers",
+			Handler:    _TalkService_AddDoNotCallNumbers_Handler,
+		},
+		{
+			MethodName: "RemoveDoNotCallNumbers",
+			Handler:    _TalkService_RemoveDoNotCallNumbers_Handler,
+		},
+		{
+			MethodName: "SetCredentialLimit",
+			Handler:    _TalkService_SetCredentialLimit_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
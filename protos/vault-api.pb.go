@@ -495,6 +495,115 @@ func (x *GetCredentialRequest) GetVaultId() uint64 {
 	return 0
 }
 
+type RotateCredentialKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RotateCredentialKeyRequest) Reset() {
+	*x = RotateCredentialKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vault_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateCredentialKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialKeyRequest) ProtoMessage() {}
+
+func (x *RotateCredentialKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateCredentialKeyRequest) Descriptor() ([]byte, []int) {
+	return file_vault_api_proto_rawDescGZIP(), []int{7}
+}
+
+type RotateCredentialKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    uint64 `protobuf:"varint,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RotateCredentialKeyResponse) Reset() {
+	*x = RotateCredentialKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vault_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateCredentialKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialKeyResponse) ProtoMessage() {}
+
+func (x *RotateCredentialKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vault_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateCredentialKeyResponse) Descriptor() ([]byte, []int) {
+	return file_vault_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RotateCredentialKeyResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RotateCredentialKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RotateCredentialKeyResponse) GetData() uint64 {
+	if x != nil {
+		return x.Data
+	}
+	return 0
+}
+
+func (x *RotateCredentialKeyResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 var File_vault_api_proto protoreflect.FileDescriptor
 
 var file_vault_api_proto_rawDesc = []byte{
@@ -574,42 +683,58 @@ var file_vault_api_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x76, 0x61,
 	0x75, 0x6c, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
-	0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x32, 0x81, 0x04, 0x0a, 0x0c, 0x56, 0x61, 0x75,
-	0x6c, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x18, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x12, 0x2e, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
-	0x1f, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x07, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x1b, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xe7, 0x04, 0x0a, 0x0c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x2e, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
 	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x76, 0x61, 0x75, 0x6c,
+	0x1a, 0x2f, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x58, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x61, 0x75, 0x6c,
 	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a, 0x1a,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64,
-	0x61, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x32, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x12, 0x25, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61,
+	0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -624,7 +749,7 @@ func file_vault_api_proto_rawDescGZIP() []byte {
 	return file_vault_api_proto_rawDescData
 }
 
-var file_vault_api_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_vault_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_vault_api_proto_goTypes = []any{
 	(*VaultCredential)(nil),                      // 0: vault_api.VaultCredential
 	(*CreateProviderCredentialRequest)(nil),      // 1: vault_api.CreateProviderCredentialRequest
@@ -633,41 +758,46 @@ var file_vault_api_proto_goTypes = []any{
 	(*GetAllOrganizationCredentialResponse)(nil), // 4: vault_api.GetAllOrganizationCredentialResponse
 	(*GetCredentialResponse)(nil),                // 5: vault_api.GetCredentialResponse
 	(*GetCredentialRequest)(nil),                 // 6: vault_api.GetCredentialRequest
-	(*structpb.Struct)(nil),                      // 7: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),                // 8: google.protobuf.Timestamp
-	(*Paginate)(nil),                             // 9: Paginate
-	(*Criteria)(nil),                             // 10: Criteria
-	(*Error)(nil),                                // 11: Error
-	(*Paginated)(nil),                            // 12: Paginated
+	(*RotateCredentialKeyRequest)(nil),           // 7: vault_api.RotateCredentialKeyRequest
+	(*RotateCredentialKeyResponse)(nil),          // 8: vault_api.RotateCredentialKeyResponse
+	(*structpb.Struct)(nil),                      // 9: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                // 10: google.protobuf.Timestamp
+	(*Paginate)(nil),                             // 11: Paginate
+	(*Criteria)(nil),                             // 12: Criteria
+	(*Error)(nil),                                // 13: Error
+	(*Paginated)(nil),                            // 14: Paginated
 }
 var file_vault_api_proto_depIdxs = []int32{
-	7,  // 0: vault_api.VaultCredential.value:type_name -> google.protobuf.Struct
-	8,  // 1: vault_api.VaultCredential.createdDate:type_name -> google.protobuf.Timestamp
-	8,  // 2: vault_api.VaultCredential.updatedDate:type_name -> google.protobuf.Timestamp
-	8,  // 3: vault_api.VaultCredential.lastUsedDate:type_name -> google.protobuf.Timestamp
-	7,  // 4: vault_api.CreateProviderCredentialRequest.credential:type_name -> google.protobuf.Struct
-	9,  // 5: vault_api.GetAllOrganizationCredentialRequest.paginate:type_name -> Paginate
-	10, // 6: vault_api.GetAllOrganizationCredentialRequest.criterias:type_name -> Criteria
+	9,  // 0: vault_api.VaultCredential.value:type_name -> google.protobuf.Struct
+	10, // 1: vault_api.VaultCredential.createdDate:type_name -> google.protobuf.Timestamp
+	10, // 2: vault_api.VaultCredential.updatedDate:type_name -> google.protobuf.Timestamp
+	10, // 3: vault_api.VaultCredential.lastUsedDate:type_name -> google.protobuf.Timestamp
+	9,  // 4: vault_api.CreateProviderCredentialRequest.credential:type_name -> google.protobuf.Struct
+	11, // 5: vault_api.GetAllOrganizationCredentialRequest.paginate:type_name -> Paginate
+	12, // 6: vault_api.GetAllOrganizationCredentialRequest.criterias:type_name -> Criteria
 	0,  // 7: vault_api.GetAllOrganizationCredentialResponse.data:type_name -> vault_api.VaultCredential
-	11, // 8: vault_api.GetAllOrganizationCredentialResponse.error:type_name -> Error
-	12, // 9: vault_api.GetAllOrganizationCredentialResponse.paginated:type_name -> Paginated
+	13, // 8: vault_api.GetAllOrganizationCredentialResponse.error:type_name -> Error
+	14, // 9: vault_api.GetAllOrganizationCredentialResponse.paginated:type_name -> Paginated
 	0,  // 10: vault_api.GetCredentialResponse.data:type_name -> vault_api.VaultCredential
-	11, // 11: vault_api.GetCredentialResponse.error:type_name -> Error
-	1,  // 12: vault_api.VaultService.CreateProviderCredential:input_type -> vault_api.CreateProviderCredentialRequest
-	3,  // 13: vault_api.VaultService.GetAllOrganizationCredential:input_type -> vault_api.GetAllOrganizationCredentialRequest
-	2,  // 14: vault_api.VaultService.DeleteCredential:input_type -> vault_api.DeleteCredentialRequest
-	6,  // 15: vault_api.VaultService.GetCredential:input_type -> vault_api.GetCredentialRequest
-	6,  // 16: vault_api.VaultService.GetOauth2Credential:input_type -> vault_api.GetCredentialRequest
-	5,  // 17: vault_api.VaultService.CreateProviderCredential:output_type -> vault_api.GetCredentialResponse
-	4,  // 18: vault_api.VaultService.GetAllOrganizationCredential:output_type -> vault_api.GetAllOrganizationCredentialResponse
-	5,  // 19: vault_api.VaultService.DeleteCredential:output_type -> vault_api.GetCredentialResponse
-	5,  // 20: vault_api.VaultService.GetCredential:output_type -> vault_api.GetCredentialResponse
-	5,  // 21: vault_api.VaultService.GetOauth2Credential:output_type -> vault_api.GetCredentialResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	13, // 11: vault_api.GetCredentialResponse.error:type_name -> Error
+	13, // 12: vault_api.RotateCredentialKeyResponse.error:type_name -> Error
+	1,  // 13: vault_api.VaultService.CreateProviderCredential:input_type -> vault_api.CreateProviderCredentialRequest
+	3,  // 14: vault_api.VaultService.GetAllOrganizationCredential:input_type -> vault_api.GetAllOrganizationCredentialRequest
+	2,  // 15: vault_api.VaultService.DeleteCredential:input_type -> vault_api.DeleteCredentialRequest
+	6,  // 16: vault_api.VaultService.GetCredential:input_type -> vault_api.GetCredentialRequest
+	6,  // 17: vault_api.VaultService.GetOauth2Credential:input_type -> vault_api.GetCredentialRequest
+	7,  // 18: vault_api.VaultService.RotateCredentialKey:input_type -> vault_api.RotateCredentialKeyRequest
+	5,  // 19: vault_api.VaultService.CreateProviderCredential:output_type -> vault_api.GetCredentialResponse
+	4,  // 20: vault_api.VaultService.GetAllOrganizationCredential:output_type -> vault_api.GetAllOrganizationCredentialResponse
+	5,  // 21: vault_api.VaultService.DeleteCredential:output_type -> vault_api.GetCredentialResponse
+	5,  // 22: vault_api.VaultService.GetCredential:output_type -> vault_api.GetCredentialResponse
+	5,  // 23: vault_api.VaultService.GetOauth2Credential:output_type -> vault_api.GetCredentialResponse
+	8,  // 24: vault_api.VaultService.RotateCredentialKey:output_type -> vault_api.RotateCredentialKeyResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_vault_api_proto_init() }
@@ -761,6 +891,30 @@ func file_vault_api_proto_init() {
 				return nil
 			}
 		}
+		file_vault_api_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RotateCredentialKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vault_api_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RotateCredentialKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -768,7 +922,7 @@ func file_vault_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vault_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 	VaultService_DeleteCredential_FullMethodName             = "/vault_api.VaultService/DeleteCredential"
 	VaultService_GetCredential_FullMethodName                = "/vault_api.VaultService/GetCredential"
 	VaultService_GetOauth2Credential_FullMethodName          = "/vault_api.VaultService/GetOauth2Credential"
+	VaultService_RotateCredentialKey_FullMethodName          = "/vault_api.VaultService/RotateCredentialKey"
 )
 
 // VaultServiceClient is the client API for VaultService service.
@@ -35,6 +36,7 @@ type VaultServiceClient interface {
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
 	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
 	GetOauth2Credential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	RotateCredentialKey(ctx context.Context, in *RotateCredentialKeyRequest, opts ...grpc.CallOption) (*RotateCredentialKeyResponse, error)
 }
 
 type vaultServiceClient struct {
@@ -95,6 +97,16 @@ func (c *vaultServiceClient) GetOauth2Credential(ctx context.Context, in *GetCre
 	return out, nil
 }
 
+func (c *vaultServiceClient) RotateCredentialKey(ctx context.Context, in *RotateCredentialKeyRequest, opts ...grpc.CallOption) (*RotateCredentialKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateCredentialKeyResponse)
+	err := c.cc.Invoke(ctx, VaultService_RotateCredentialKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VaultServiceServer is the server API for VaultService service.
 // All implementations should embed UnimplementedVaultServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type VaultServiceServer interface {
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*GetCredentialResponse, error)
 	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
 	GetOauth2Credential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
+	RotateCredentialKey(context.Context, *RotateCredentialKeyRequest) (*RotateCredentialKeyResponse, error)
 }
 
 // UnimplementedVaultServiceServer should be embedded to have
@@ -128,6 +141,9 @@ func (UnimplementedVaultServiceServer) GetCredential(context.Context, *GetCreden
 func (UnimplementedVaultServiceServer) GetOauth2Credential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOauth2Credential not implemented")
 }
+func (UnimplementedVaultServiceServer) RotateCredentialKey(context.Context, *RotateCredentialKeyRequest) (*RotateCredentialKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredentialKey not implemented")
+}
 func (UnimplementedVaultServiceServer) testEmbeddedByValue() {}
 
 // UnsafeVaultServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +254,24 @@ func _VaultService_GetOauth2Credential_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VaultService_RotateCredentialKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RotateCredentialKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RotateCredentialKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RotateCredentialKey(ctx, req.(*RotateCredentialKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +299,10 @@ var VaultService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOauth2Credential",
 			Handler:    _VaultService_GetOauth2Credential_Handler,
 		},
+		{
+			MethodName: "RotateCredentialKey",
+			Handler:    _VaultService_RotateCredentialKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vault-api.proto",
@@ -3436,6 +3436,221 @@ func (x *GetAllProjectCredentialResponse) GetPaginated() *Paginated {
 	return nil
 }
 
+// Usage and quotas of a project in the current month, a quota of zero is not
+// enforced.
+type QuotaStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId   uint64                 `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	Period      string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	ResetAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=resetAt,proto3" json:"resetAt,omitempty"`
+	TokensUsed  int64                  `protobuf:"varint,4,opt,name=tokensUsed,proto3" json:"tokensUsed,omitempty"`
+	TokenQuota  int64                  `protobuf:"varint,5,opt,name=tokenQuota,proto3" json:"tokenQuota,omitempty"`
+	MinutesUsed int64                  `protobuf:"varint,6,opt,name=minutesUsed,proto3" json:"minutesUsed,omitempty"`
+	MinuteQuota int64                  `protobuf:"varint,7,opt,name=minuteQuota,proto3" json:"minuteQuota,omitempty"`
+	Exceeded    bool                   `protobuf:"varint,8,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+}
+
+func (x *QuotaStatus) Reset() {
+	*x = QuotaStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_web_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaStatus) ProtoMessage() {}
+
+func (x *QuotaStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_web_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaStatus.ProtoReflect.Descriptor instead.
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return file_web_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *QuotaStatus) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *QuotaStatus) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+func (x *QuotaStatus) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetTokenQuota() int64 {
+	if x != nil {
+		return x.TokenQuota
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetMinutesUsed() int64 {
+	if x != nil {
+		return x.MinutesUsed
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetMinuteQuota() int64 {
+	if x != nil {
+		return x.MinuteQuota
+	}
+	return 0
+}
+
+func (x *QuotaStatus) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+// Quota of the current project of the request.
+type GetProjectQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProjectQuotaRequest) Reset() {
+	*x = GetProjectQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_web_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectQuotaRequest) ProtoMessage() {}
+
+func (x *GetProjectQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_web_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_web_api_proto_rawDescGZIP(), []int{53}
+}
+
+type GetProjectQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32        `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Success bool         `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Data    *QuotaStatus `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error   *Error       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetProjectQuotaResponse) Reset() {
+	*x = GetProjectQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_web_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectQuotaResponse) ProtoMessage() {}
+
+func (x *GetProjectQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_web_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_web_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetProjectQuotaResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetProjectQuotaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetProjectQuotaResponse) GetData() *QuotaStatus {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetProjectQuotaResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 var File_web_api_proto protoreflect.FileDescriptor
 
 var file_web_api_proto_rawDesc = []byte{
@@ -3880,147 +4095,181 @@ var file_web_api_proto_rawDesc = []byte{
 	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
 	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x69,
 	0x6e, 0x61, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
-	0x32, 0xcf, 0x08, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x65, 0x62,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x12, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77,
-	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x2e,
-	0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
-	0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x17, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x77, 0x65,
-	0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x77, 0x65, 0x62,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x69, 0x6e, 0x12,
-	0x24, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x12, 0x24,
-	0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x22, 0x9d, 0x02, 0x0a, 0x0b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x55, 0x73,
+	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xcf, 0x08, 0x0a,
+	0x15, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
 	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0x24, 0x2e,
-	0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xfe, 0x02, 0x0a, 0x13, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x77,
-	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x2e,
+	0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x77, 0x65, 0x62, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1b, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77,
+	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x77,
+	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77,
+	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17,
+	0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1a, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65,
+	0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x77, 0x65,
+	0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x06, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x77, 0x65, 0x62,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0x24, 0x2e, 0x77, 0x65, 0x62, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfe,
+	0x02, 0x0a, 0x13, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x77,
+	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x65, 0x62, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x65, 0x62, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x77, 0x65, 0x62,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xd2, 0x05, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d,
-	0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
-	0x11, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x21, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x77, 0x65,
-	0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65,
-	0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61, 0x69, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xa8, 0x06, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x1a, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77,
+	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x65, 0x62,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21,
+	0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77,
+	0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x65, 0x62,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x65, 0x62, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a, 0x1a, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x61, 0x61,
+	0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4036,7 +4285,7 @@ func file_web_api_proto_rawDescGZIP() []byte {
 }
 
 var file_web_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_web_api_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_web_api_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_web_api_proto_goTypes = []any{
 	(UpdateBillingInformationRequest_BillingInterval)(0), // 0: web_api.UpdateBillingInformationRequest.BillingInterval
 	(*AuthenticateRequest)(nil),                          // 1: web_api.AuthenticateRequest
@@ -4091,127 +4340,135 @@ var file_web_api_proto_goTypes = []any{
 	(*GetAllProjectCredentialRequest)(nil),               // 50: web_api.GetAllProjectCredentialRequest
 	(*CreateProjectCredentialResponse)(nil),              // 51: web_api.CreateProjectCredentialResponse
 	(*GetAllProjectCredentialResponse)(nil),              // 52: web_api.GetAllProjectCredentialResponse
-	(*User)(nil),                                         // 53: User
-	(*Error)(nil),                                        // 54: Error
-	(*Paginate)(nil),                                     // 55: Paginate
-	(*Criteria)(nil),                                     // 56: Criteria
-	(*Paginated)(nil),                                    // 57: Paginated
-	(*Organization)(nil),                                 // 58: Organization
-	(*timestamppb.Timestamp)(nil),                        // 59: google.protobuf.Timestamp
-	(*BaseResponse)(nil),                                 // 60: BaseResponse
+	(*QuotaStatus)(nil),                                  // 53: web_api.QuotaStatus
+	(*GetProjectQuotaRequest)(nil),                       // 54: web_api.GetProjectQuotaRequest
+	(*GetProjectQuotaResponse)(nil),                      // 55: web_api.GetProjectQuotaResponse
+	(*User)(nil),                                         // 56: User
+	(*Error)(nil),                                        // 57: Error
+	(*Paginate)(nil),                                     // 58: Paginate
+	(*Criteria)(nil),                                     // 59: Criteria
+	(*Paginated)(nil),                                    // 60: Paginated
+	(*Organization)(nil),                                 // 61: Organization
+	(*timestamppb.Timestamp)(nil),                        // 62: google.protobuf.Timestamp
+	(*BaseResponse)(nil),                                 // 63: BaseResponse
 }
 var file_web_api_proto_depIdxs = []int32{
-	53, // 0: web_api.Authentication.user:type_name -> User
+	56, // 0: web_api.Authentication.user:type_name -> User
 	3,  // 1: web_api.Authentication.token:type_name -> web_api.Token
 	4,  // 2: web_api.Authentication.organizationRole:type_name -> web_api.OrganizationRole
 	5,  // 3: web_api.Authentication.projectRoles:type_name -> web_api.ProjectRole
 	6,  // 4: web_api.Authentication.featurePermissions:type_name -> web_api.FeaturePermission
 	7,  // 5: web_api.AuthenticateResponse.data:type_name -> web_api.Authentication
-	54, // 6: web_api.AuthenticateResponse.error:type_name -> Error
-	54, // 7: web_api.ForgotPasswordResponse.error:type_name -> Error
-	54, // 8: web_api.ChangePasswordResponse.error:type_name -> Error
-	54, // 9: web_api.CreatePasswordResponse.error:type_name -> Error
+	57, // 6: web_api.AuthenticateResponse.error:type_name -> Error
+	57, // 7: web_api.ForgotPasswordResponse.error:type_name -> Error
+	57, // 8: web_api.ChangePasswordResponse.error:type_name -> Error
+	57, // 9: web_api.CreatePasswordResponse.error:type_name -> Error
 	3,  // 10: web_api.VerifyTokenResponse.data:type_name -> web_api.Token
 	8,  // 11: web_api.ScopedAuthenticationResponse.data:type_name -> web_api.ScopedAuthentication
-	54, // 12: web_api.ScopedAuthenticationResponse.error:type_name -> Error
-	53, // 13: web_api.GetUserResponse.data:type_name -> User
-	53, // 14: web_api.UpdateUserResponse.data:type_name -> User
-	55, // 15: web_api.GetAllUserRequest.paginate:type_name -> Paginate
-	56, // 16: web_api.GetAllUserRequest.criterias:type_name -> Criteria
-	53, // 17: web_api.GetAllUserResponse.data:type_name -> User
-	54, // 18: web_api.GetAllUserResponse.error:type_name -> Error
-	57, // 19: web_api.GetAllUserResponse.paginated:type_name -> Paginated
-	58, // 20: web_api.GetOrganizationResponse.data:type_name -> Organization
+	57, // 12: web_api.ScopedAuthenticationResponse.error:type_name -> Error
+	56, // 13: web_api.GetUserResponse.data:type_name -> User
+	56, // 14: web_api.UpdateUserResponse.data:type_name -> User
+	58, // 15: web_api.GetAllUserRequest.paginate:type_name -> Paginate
+	59, // 16: web_api.GetAllUserRequest.criterias:type_name -> Criteria
+	56, // 17: web_api.GetAllUserResponse.data:type_name -> User
+	57, // 18: web_api.GetAllUserResponse.error:type_name -> Error
+	60, // 19: web_api.GetAllUserResponse.paginated:type_name -> Paginated
+	61, // 20: web_api.GetOrganizationResponse.data:type_name -> Organization
 	4,  // 21: web_api.GetOrganizationResponse.role:type_name -> web_api.OrganizationRole
-	54, // 22: web_api.GetOrganizationResponse.error:type_name -> Error
-	58, // 23: web_api.CreateOrganizationResponse.data:type_name -> Organization
+	57, // 22: web_api.GetOrganizationResponse.error:type_name -> Error
+	61, // 23: web_api.CreateOrganizationResponse.data:type_name -> Organization
 	4,  // 24: web_api.CreateOrganizationResponse.role:type_name -> web_api.OrganizationRole
-	54, // 25: web_api.CreateOrganizationResponse.error:type_name -> Error
-	54, // 26: web_api.UpdateOrganizationResponse.error:type_name -> Error
+	57, // 25: web_api.CreateOrganizationResponse.error:type_name -> Error
+	57, // 26: web_api.UpdateOrganizationResponse.error:type_name -> Error
 	0,  // 27: web_api.UpdateBillingInformationRequest.billingInterval:type_name -> web_api.UpdateBillingInformationRequest.BillingInterval
-	53, // 28: web_api.Project.members:type_name -> User
-	59, // 29: web_api.Project.createdDate:type_name -> google.protobuf.Timestamp
+	56, // 28: web_api.Project.members:type_name -> User
+	62, // 29: web_api.Project.createdDate:type_name -> google.protobuf.Timestamp
 	35, // 30: web_api.CreateProjectResponse.data:type_name -> web_api.Project
-	54, // 31: web_api.CreateProjectResponse.error:type_name -> Error
+	57, // 31: web_api.CreateProjectResponse.error:type_name -> Error
 	35, // 32: web_api.UpdateProjectResponse.data:type_name -> web_api.Project
-	54, // 33: web_api.UpdateProjectResponse.error:type_name -> Error
+	57, // 33: web_api.UpdateProjectResponse.error:type_name -> Error
 	35, // 34: web_api.GetProjectResponse.data:type_name -> web_api.Project
-	54, // 35: web_api.GetProjectResponse.error:type_name -> Error
-	55, // 36: web_api.GetAllProjectRequest.paginate:type_name -> Paginate
-	56, // 37: web_api.GetAllProjectRequest.criterias:type_name -> Criteria
+	57, // 35: web_api.GetProjectResponse.error:type_name -> Error
+	58, // 36: web_api.GetAllProjectRequest.paginate:type_name -> Paginate
+	59, // 37: web_api.GetAllProjectRequest.criterias:type_name -> Criteria
 	35, // 38: web_api.GetAllProjectResponse.data:type_name -> web_api.Project
-	54, // 39: web_api.GetAllProjectResponse.error:type_name -> Error
-	57, // 40: web_api.GetAllProjectResponse.paginated:type_name -> Paginated
-	54, // 41: web_api.ArchiveProjectResponse.error:type_name -> Error
+	57, // 39: web_api.GetAllProjectResponse.error:type_name -> Error
+	60, // 40: web_api.GetAllProjectResponse.paginated:type_name -> Paginated
+	57, // 41: web_api.ArchiveProjectResponse.error:type_name -> Error
 	35, // 42: web_api.AddUsersToProjectResponse.data:type_name -> web_api.Project
-	54, // 43: web_api.AddUsersToProjectResponse.error:type_name -> Error
-	59, // 44: web_api.ProjectCredential.createdDate:type_name -> google.protobuf.Timestamp
-	59, // 45: web_api.ProjectCredential.updatedDate:type_name -> google.protobuf.Timestamp
-	53, // 46: web_api.ProjectCredential.createdUser:type_name -> User
-	55, // 47: web_api.GetAllProjectCredentialRequest.paginate:type_name -> Paginate
-	56, // 48: web_api.GetAllProjectCredentialRequest.criterias:type_name -> Criteria
+	57, // 43: web_api.AddUsersToProjectResponse.error:type_name -> Error
+	62, // 44: web_api.ProjectCredential.createdDate:type_name -> google.protobuf.Timestamp
+	62, // 45: web_api.ProjectCredential.updatedDate:type_name -> google.protobuf.Timestamp
+	56, // 46: web_api.ProjectCredential.createdUser:type_name -> User
+	58, // 47: web_api.GetAllProjectCredentialRequest.paginate:type_name -> Paginate
+	59, // 48: web_api.GetAllProjectCredentialRequest.criterias:type_name -> Criteria
 	48, // 49: web_api.CreateProjectCredentialResponse.data:type_name -> web_api.ProjectCredential
-	54, // 50: web_api.CreateProjectCredentialResponse.error:type_name -> Error
+	57, // 50: web_api.CreateProjectCredentialResponse.error:type_name -> Error
 	48, // 51: web_api.GetAllProjectCredentialResponse.data:type_name -> web_api.ProjectCredential
-	54, // 52: web_api.GetAllProjectCredentialResponse.error:type_name -> Error
-	57, // 53: web_api.GetAllProjectCredentialResponse.paginated:type_name -> Paginated
-	1,  // 54: web_api.AuthenticationService.Authenticate:input_type -> web_api.AuthenticateRequest
-	2,  // 55: web_api.AuthenticationService.RegisterUser:input_type -> web_api.RegisterUserRequest
-	18, // 56: web_api.AuthenticationService.Authorize:input_type -> web_api.AuthorizeRequest
-	19, // 57: web_api.AuthenticationService.ScopeAuthorize:input_type -> web_api.ScopeAuthorizeRequest
-	16, // 58: web_api.AuthenticationService.VerifyToken:input_type -> web_api.VerifyTokenRequest
-	10, // 59: web_api.AuthenticationService.ForgotPassword:input_type -> web_api.ForgotPasswordRequest
-	14, // 60: web_api.AuthenticationService.CreatePassword:input_type -> web_api.CreatePasswordRequest
-	12, // 61: web_api.AuthenticationService.ChangePassword:input_type -> web_api.ChangePasswordRequest
-	21, // 62: web_api.AuthenticationService.GetUser:input_type -> web_api.GetUserRequest
-	23, // 63: web_api.AuthenticationService.UpdateUser:input_type -> web_api.UpdateUserRequest
-	26, // 64: web_api.AuthenticationService.GetAllUser:input_type -> web_api.GetAllUserRequest
-	25, // 65: web_api.AuthenticationService.Linkedin:input_type -> web_api.SocialAuthenticationRequest
-	25, // 66: web_api.AuthenticationService.Google:input_type -> web_api.SocialAuthenticationRequest
-	25, // 67: web_api.AuthenticationService.Github:input_type -> web_api.SocialAuthenticationRequest
-	28, // 68: web_api.OrganizationService.CreateOrganization:input_type -> web_api.CreateOrganizationRequest
-	30, // 69: web_api.OrganizationService.GetOrganization:input_type -> web_api.GetOrganizationRequest
-	29, // 70: web_api.OrganizationService.UpdateOrganization:input_type -> web_api.UpdateOrganizationRequest
-	34, // 71: web_api.OrganizationService.UpdateBillingInformation:input_type -> web_api.UpdateBillingInformationRequest
-	36, // 72: web_api.ProjectService.CreateProject:input_type -> web_api.CreateProjectRequest
-	38, // 73: web_api.ProjectService.UpdateProject:input_type -> web_api.UpdateProjectRequest
-	40, // 74: web_api.ProjectService.GetProject:input_type -> web_api.GetProjectRequest
-	42, // 75: web_api.ProjectService.GetAllProject:input_type -> web_api.GetAllProjectRequest
-	44, // 76: web_api.ProjectService.AddUsersToProject:input_type -> web_api.AddUsersToProjectRequest
-	45, // 77: web_api.ProjectService.ArchiveProject:input_type -> web_api.ArchiveProjectRequest
-	49, // 78: web_api.ProjectService.CreateProjectCredential:input_type -> web_api.CreateProjectCredentialRequest
-	50, // 79: web_api.ProjectService.GetAllProjectCredential:input_type -> web_api.GetAllProjectCredentialRequest
-	9,  // 80: web_api.AuthenticationService.Authenticate:output_type -> web_api.AuthenticateResponse
-	9,  // 81: web_api.AuthenticationService.RegisterUser:output_type -> web_api.AuthenticateResponse
-	9,  // 82: web_api.AuthenticationService.Authorize:output_type -> web_api.AuthenticateResponse
-	20, // 83: web_api.AuthenticationService.ScopeAuthorize:output_type -> web_api.ScopedAuthenticationResponse
-	17, // 84: web_api.AuthenticationService.VerifyToken:output_type -> web_api.VerifyTokenResponse
-	11, // 85: web_api.AuthenticationService.ForgotPassword:output_type -> web_api.ForgotPasswordResponse
-	15, // 86: web_api.AuthenticationService.CreatePassword:output_type -> web_api.CreatePasswordResponse
-	13, // 87: web_api.AuthenticationService.ChangePassword:output_type -> web_api.ChangePasswordResponse
-	22, // 88: web_api.AuthenticationService.GetUser:output_type -> web_api.GetUserResponse
-	24, // 89: web_api.AuthenticationService.UpdateUser:output_type -> web_api.UpdateUserResponse
-	27, // 90: web_api.AuthenticationService.GetAllUser:output_type -> web_api.GetAllUserResponse
-	9,  // 91: web_api.AuthenticationService.Linkedin:output_type -> web_api.AuthenticateResponse
-	9,  // 92: web_api.AuthenticationService.Google:output_type -> web_api.AuthenticateResponse
-	9,  // 93: web_api.AuthenticationService.Github:output_type -> web_api.AuthenticateResponse
-	32, // 94: web_api.OrganizationService.CreateOrganization:output_type -> web_api.CreateOrganizationResponse
-	31, // 95: web_api.OrganizationService.GetOrganization:output_type -> web_api.GetOrganizationResponse
-	33, // 96: web_api.OrganizationService.UpdateOrganization:output_type -> web_api.UpdateOrganizationResponse
-	60, // 97: web_api.OrganizationService.UpdateBillingInformation:output_type -> BaseResponse
-	37, // 98: web_api.ProjectService.CreateProject:output_type -> web_api.CreateProjectResponse
-	39, // 99: web_api.ProjectService.UpdateProject:output_type -> web_api.UpdateProjectResponse
-	41, // 100: web_api.ProjectService.GetProject:output_type -> web_api.GetProjectResponse
-	43, // 101: web_api.ProjectService.GetAllProject:output_type -> web_api.GetAllProjectResponse
-	47, // 102: web_api.ProjectService.AddUsersToProject:output_type -> web_api.AddUsersToProjectResponse
-	46, // 103: web_api.ProjectService.ArchiveProject:output_type -> web_api.ArchiveProjectResponse
-	51, // 104: web_api.ProjectService.CreateProjectCredential:output_type -> web_api.CreateProjectCredentialResponse
-	52, // 105: web_api.ProjectService.GetAllProjectCredential:output_type -> web_api.GetAllProjectCredentialResponse
-	80, // [80:106] is the sub-list for method output_type
-	54, // [54:80] is the sub-list for method input_type
-	54, // [54:54] is the sub-list for extension type_name
-	54, // [54:54] is the sub-list for extension extendee
-	0,  // [0:54] is the sub-list for field type_name
+	57, // 52: web_api.GetAllProjectCredentialResponse.error:type_name -> Error
+	60, // 53: web_api.GetAllProjectCredentialResponse.paginated:type_name -> Paginated
+	62, // 54: web_api.QuotaStatus.resetAt:type_name -> google.protobuf.Timestamp
+	53, // 55: web_api.GetProjectQuotaResponse.data:type_name -> web_api.QuotaStatus
+	57, // 56: web_api.GetProjectQuotaResponse.error:type_name -> Error
+	1,  // 57: web_api.AuthenticationService.Authenticate:input_type -> web_api.AuthenticateRequest
+	2,  // 58: web_api.AuthenticationService.RegisterUser:input_type -> web_api.RegisterUserRequest
+	18, // 59: web_api.AuthenticationService.Authorize:input_type -> web_api.AuthorizeRequest
+	19, // 60: web_api.AuthenticationService.ScopeAuthorize:input_type -> web_api.ScopeAuthorizeRequest
+	16, // 61: web_api.AuthenticationService.VerifyToken:input_type -> web_api.VerifyTokenRequest
+	10, // 62: web_api.AuthenticationService.ForgotPassword:input_type -> web_api.ForgotPasswordRequest
+	14, // 63: web_api.AuthenticationService.CreatePassword:input_type -> web_api.CreatePasswordRequest
+	12, // 64: web_api.AuthenticationService.ChangePassword:input_type -> web_api.ChangePasswordRequest
+	21, // 65: web_api.AuthenticationService.GetUser:input_type -> web_api.GetUserRequest
+	23, // 66: web_api.AuthenticationService.UpdateUser:input_type -> web_api.UpdateUserRequest
+	26, // 67: web_api.AuthenticationService.GetAllUser:input_type -> web_api.GetAllUserRequest
+	25, // 68: web_api.AuthenticationService.Linkedin:input_type -> web_api.SocialAuthenticationRequest
+	25, // 69: web_api.AuthenticationService.Google:input_type -> web_api.SocialAuthenticationRequest
+	25, // 70: web_api.AuthenticationService.Github:input_type -> web_api.SocialAuthenticationRequest
+	28, // 71: web_api.OrganizationService.CreateOrganization:input_type -> web_api.CreateOrganizationRequest
+	30, // 72: web_api.OrganizationService.GetOrganization:input_type -> web_api.GetOrganizationRequest
+	29, // 73: web_api.OrganizationService.UpdateOrganization:input_type -> web_api.UpdateOrganizationRequest
+	34, // 74: web_api.OrganizationService.UpdateBillingInformation:input_type -> web_api.UpdateBillingInformationRequest
+	36, // 75: web_api.ProjectService.CreateProject:input_type -> web_api.CreateProjectRequest
+	38, // 76: web_api.ProjectService.UpdateProject:input_type -> web_api.UpdateProjectRequest
+	40, // 77: web_api.ProjectService.GetProject:input_type -> web_api.GetProjectRequest
+	42, // 78: web_api.ProjectService.GetAllProject:input_type -> web_api.GetAllProjectRequest
+	44, // 79: web_api.ProjectService.AddUsersToProject:input_type -> web_api.AddUsersToProjectRequest
+	45, // 80: web_api.ProjectService.ArchiveProject:input_type -> web_api.ArchiveProjectRequest
+	49, // 81: web_api.ProjectService.CreateProjectCredential:input_type -> web_api.CreateProjectCredentialRequest
+	50, // 82: web_api.ProjectService.GetAllProjectCredential:input_type -> web_api.GetAllProjectCredentialRequest
+	54, // 83: web_api.ProjectService.GetProjectQuota:input_type -> web_api.GetProjectQuotaRequest
+	9,  // 84: web_api.AuthenticationService.Authenticate:output_type -> web_api.AuthenticateResponse
+	9,  // 85: web_api.AuthenticationService.RegisterUser:output_type -> web_api.AuthenticateResponse
+	9,  // 86: web_api.AuthenticationService.Authorize:output_type -> web_api.AuthenticateResponse
+	20, // 87: web_api.AuthenticationService.ScopeAuthorize:output_type -> web_api.ScopedAuthenticationResponse
+	17, // 88: web_api.AuthenticationService.VerifyToken:output_type -> web_api.VerifyTokenResponse
+	11, // 89: web_api.AuthenticationService.ForgotPassword:output_type -> web_api.ForgotPasswordResponse
+	15, // 90: web_api.AuthenticationService.CreatePassword:output_type -> web_api.CreatePasswordResponse
+	13, // 91: web_api.AuthenticationService.ChangePassword:output_type -> web_api.ChangePasswordResponse
+	22, // 92: web_api.AuthenticationService.GetUser:output_type -> web_api.GetUserResponse
+	24, // 93: web_api.AuthenticationService.UpdateUser:output_type -> web_api.UpdateUserResponse
+	27, // 94: web_api.AuthenticationService.GetAllUser:output_type -> web_api.GetAllUserResponse
+	9,  // 95: web_api.AuthenticationService.Linkedin:output_type -> web_api.AuthenticateResponse
+	9,  // 96: web_api.AuthenticationService.Google:output_type -> web_api.AuthenticateResponse
+	9,  // 97: web_api.AuthenticationService.Github:output_type -> web_api.AuthenticateResponse
+	32, // 98: web_api.OrganizationService.CreateOrganization:output_type -> web_api.CreateOrganizationResponse
+	31, // 99: web_api.OrganizationService.GetOrganization:output_type -> web_api.GetOrganizationResponse
+	33, // 100: web_api.OrganizationService.UpdateOrganization:output_type -> web_api.UpdateOrganizationResponse
+	63, // 101: web_api.OrganizationService.UpdateBillingInformation:output_type -> BaseResponse
+	37, // 102: web_api.ProjectService.CreateProject:output_type -> web_api.CreateProjectResponse
+	39, // 103: web_api.ProjectService.UpdateProject:output_type -> web_api.UpdateProjectResponse
+	41, // 104: web_api.ProjectService.GetProject:output_type -> web_api.GetProjectResponse
+	43, // 105: web_api.ProjectService.GetAllProject:output_type -> web_api.GetAllProjectResponse
+	47, // 106: web_api.ProjectService.AddUsersToProject:output_type -> web_api.AddUsersToProjectResponse
+	46, // 107: web_api.ProjectService.ArchiveProject:output_type -> web_api.ArchiveProjectResponse
+	51, // 108: web_api.ProjectService.CreateProjectCredential:output_type -> web_api.CreateProjectCredentialResponse
+	52, // 109: web_api.ProjectService.GetAllProjectCredential:output_type -> web_api.GetAllProjectCredentialResponse
+	55, // 110: web_api.ProjectService.GetProjectQuota:output_type -> web_api.GetProjectQuotaResponse
+	84, // [84:111] is the sub-list for method output_type
+	57, // [57:84] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_web_api_proto_init() }
@@ -4845,6 +5102,42 @@ func file_web_api_proto_init() {
 				return nil
 			}
 		}
+		file_web_api_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*QuotaStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_web_api_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProjectQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_web_api_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProjectQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_web_api_proto_msgTypes[6].OneofWrappers = []any{}
 	file_web_api_proto_msgTypes[8].OneofWrappers = []any{}
@@ -4863,7 +5156,7 @@ func file_web_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_web_api_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   52,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
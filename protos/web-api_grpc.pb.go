@@ -835,6 +835,7 @@ const (
 	ProjectService_ArchiveProject_FullMethodName          = "/web_api.ProjectService/ArchiveProject"
 	ProjectService_CreateProjectCredential_FullMethodName = "/web_api.ProjectService/CreateProjectCredential"
 	ProjectService_GetAllProjectCredential_FullMethodName = "/web_api.ProjectService/GetAllProjectCredential"
+	ProjectService_GetProjectQuota_FullMethodName         = "/web_api.ProjectService/GetProjectQuota"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -849,6 +850,7 @@ type ProjectServiceClient interface {
 	ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error)
 	CreateProjectCredential(ctx context.Context, in *CreateProjectCredentialRequest, opts ...grpc.CallOption) (*CreateProjectCredentialResponse, error)
 	GetAllProjectCredential(ctx context.Context, in *GetAllProjectCredentialRequest, opts ...grpc.CallOption) (*GetAllProjectCredentialResponse, error)
+	GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*GetProjectQuotaResponse, error)
 }
 
 type projectServiceClient struct {
@@ -939,6 +941,16 @@ func (c *projectServiceClient) GetAllProjectCredential(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *projectServiceClient) GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*GetProjectQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectQuotaResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations should embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -951,6 +963,7 @@ type ProjectServiceServer interface {
 	ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
 	CreateProjectCredential(context.Context, *CreateProjectCredentialRequest) (*CreateProjectCredentialResponse, error)
 	GetAllProjectCredential(context.Context, *GetAllProjectCredentialRequest) (*GetAllProjectCredentialResponse, error)
+	GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*GetProjectQuotaResponse, error)
 }
 
 // UnimplementedProjectServiceServer should be embedded to have
@@ -984,6 +997,9 @@ func (UnimplementedProjectServiceServer) CreateProjectCredential(context.Context
 func (UnimplementedProjectServiceServer) GetAllProjectCredential(context.Context, *GetAllProjectCredentialRequest) (*GetAllProjectCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProjectCredential not implemented")
 }
+func (UnimplementedProjectServiceServer) GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*GetProjectQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectQuota not implemented")
+}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue() {}
 
 // UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1148,6 +1164,24 @@ func _ProjectService_GetAllProjectCredential_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectQuota(ctx, req.(*GetProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1187,6 +1221,10 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProjectCredential",
 			Handler:    _ProjectService_GetAllProjectCredential_Handler,
 		},
+		{
+			MethodName: "GetProjectQuota",
+			Handler:    _ProjectService_GetProjectQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "web-api.proto",
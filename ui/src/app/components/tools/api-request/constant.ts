@@ -1,14 +1,32 @@
 import { Metadata } from '@rapidaai/react';
 import { SetMetadata } from '@/utils/metadata';
+import { TOOL_POLICY_KEYS } from '../common/types';
 
 // ============================================================================
 // Constants
 // ============================================================================
 
 const REQUIRED_KEYS = ['tool.method', 'tool.endpoint', 'tool.parameters'];
-const ALL_KEYS = [...REQUIRED_KEYS, 'tool.headers'];
+const AUTH_KEYS = [
+  'tool.auth.type',
+  'tool.auth.credential_id',
+  'tool.auth.token_url',
+  'tool.auth.scopes',
+  'tool.auth.audience',
+];
+const ALL_KEYS = [
+  ...REQUIRED_KEYS,
+  'tool.headers',
+  ...AUTH_KEYS,
+  ...TOOL_POLICY_KEYS,
+];
 const VALID_HTTP_METHODS = ['GET', 'POST', 'PUT', 'DELETE', 'PATCH'];
 
+export const API_REQUEST_AUTH_OPTIONS = [
+  { name: 'None', value: '' },
+  { name: 'OAuth2 Client Credentials', value: 'oauth2_client_credentials' },
+];
+
 // ============================================================================
 // Default Options
 // ============================================================================
@@ -27,6 +45,8 @@ export const GetAPIRequestDefaultOptions = (
   addMetadata('tool.endpoint');
   addMetadata('tool.headers');
   addMetadata('tool.parameters');
+  AUTH_KEYS.forEach(key => addMetadata(key));
+  TOOL_POLICY_KEYS.forEach(key => addMetadata(key));
 
   return metadata.filter(m => ALL_KEYS.includes(m.getKey()));
 };
@@ -141,6 +161,28 @@ const validateParameters = (params: string | undefined): string | undefined => {
   return undefined;
 };
 
+const validateAuth = (options: Metadata[]): string | undefined => {
+  const authType = getOptionValue(options, 'tool.auth.type');
+  if (!authType) return undefined;
+
+  if (authType !== 'oauth2_client_credentials') {
+    return `Please provide a supported authentication type, ${authType} is not supported.`;
+  }
+  if (!getOptionValue(options, 'tool.auth.credential_id')) {
+    return 'Please select the OAuth2 client credential.';
+  }
+  const tokenUrl = getOptionValue(options, 'tool.auth.token_url');
+  if (!tokenUrl) {
+    return 'Please provide the token URL of the authorization server.';
+  }
+  try {
+    new URL(tokenUrl);
+  } catch {
+    return 'Please provide a valid URL for the token URL.';
+  }
+  return undefined;
+};
+
 export const ValidateAPIRequestDefaultOptions = (
   options: Metadata[],
 ): string | undefined => {
@@ -150,6 +192,7 @@ export const ValidateAPIRequestDefaultOptions = (
     validateHttpMethod(getOptionValue(options, 'tool.method')) ||
     validateEndpoint(getOptionValue(options, 'tool.endpoint')) ||
     validateHeaders(getOptionValue(options, 'tool.headers')) ||
-    validateParameters(getOptionValue(options, 'tool.parameters'))
+    validateParameters(getOptionValue(options, 'tool.parameters')) ||
+    validateAuth(options)
   );
 };
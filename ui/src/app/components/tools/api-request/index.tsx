@@ -1,4 +1,5 @@
 import { FC, useState, useCallback } from 'react';
+import { VaultCredential } from '@rapidaai/react';
 import { ArrowRight, Plus, Trash2 } from 'lucide-react';
 import { cn } from '@/utils';
 import { FormLabel } from '@/app/components/form-label';
@@ -11,9 +12,11 @@ import { Input } from '@/app/components/form/input';
 import { Select } from '@/app/components/form/select';
 import { InputGroup } from '@/app/components/input-group';
 import { APiStringHeader } from '@/app/components/external-api/api-header';
+import { CredentialDropdown } from '@/app/components/dropdown/credential-dropdown';
 import {
   ConfigureToolProps,
   ToolDefinitionForm,
+  ToolPolicyForm,
   TypeKeySelector,
   useParameterManager,
   parseJsonParameters,
@@ -23,6 +26,7 @@ import {
   ParameterType,
   KeyValueParameter,
 } from '../common';
+import { API_REQUEST_AUTH_OPTIONS } from './constant';
 
 // ============================================================================
 // Main Component
@@ -39,6 +43,7 @@ export const ConfigureAPIRequest: FC<ConfigureToolProps> = ({
     parameters,
     onParameterChange,
   );
+  const authType = getParamValue('tool.auth.type');
 
   return (
     <>
@@ -84,6 +89,73 @@ export const ConfigureAPIRequest: FC<ConfigureToolProps> = ({
         </div>
       </InputGroup>
 
+      <InputGroup title="Authentication">
+        <div className="flex flex-col gap-8 max-w-6xl">
+          <FieldSet className="relative w-full">
+            <FormLabel>Type</FormLabel>
+            <Select
+              value={authType}
+              onChange={e => updateParameter('tool.auth.type', e.target.value)}
+              className={cn('bg-light-background', inputClass)}
+              options={API_REQUEST_AUTH_OPTIONS}
+            />
+          </FieldSet>
+          {authType === 'oauth2_client_credentials' && (
+            <>
+              <CredentialDropdown
+                className={cn('bg-light-background max-w-full', inputClass)}
+                onChangeCredential={(c: VaultCredential) =>
+                  updateParameter('tool.auth.credential_id', c.getId())
+                }
+                currentCredential={getParamValue('tool.auth.credential_id')}
+                provider="oauth2"
+              />
+              <FieldSet className="relative w-full">
+                <FormLabel>Token Url</FormLabel>
+                <Input
+                  value={getParamValue('tool.auth.token_url')}
+                  onChange={e =>
+                    updateParameter('tool.auth.token_url', e.target.value)
+                  }
+                  placeholder="https://auth.your-domain.com/oauth2/token"
+                  className={cn('bg-light-background', inputClass)}
+                />
+              </FieldSet>
+              <div className="grid grid-cols-2 gap-4">
+                <FieldSet className="relative w-full">
+                  <FormLabel>Scopes</FormLabel>
+                  <Input
+                    value={getParamValue('tool.auth.scopes')}
+                    onChange={e =>
+                      updateParameter('tool.auth.scopes', e.target.value)
+                    }
+                    placeholder="orders.read, orders.write"
+                    className={cn('bg-light-background', inputClass)}
+                  />
+                </FieldSet>
+                <FieldSet className="relative w-full">
+                  <FormLabel>Audience</FormLabel>
+                  <Input
+                    value={getParamValue('tool.auth.audience')}
+                    onChange={e =>
+                      updateParameter('tool.auth.audience', e.target.value)
+                    }
+                    placeholder="Optional"
+                    className={cn('bg-light-background', inputClass)}
+                  />
+                </FieldSet>
+              </div>
+            </>
+          )}
+        </div>
+      </InputGroup>
+
+      <ToolPolicyForm
+        getParamValue={getParamValue}
+        updateParameter={updateParameter}
+        inputClass={inputClass}
+      />
+
       {toolDefinition && onChangeToolDefinition && (
         <ToolDefinitionForm
           toolDefinition={toolDefinition}
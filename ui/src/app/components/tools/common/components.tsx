@@ -174,3 +174,102 @@ export const TypeKeySelector: FC<TypeKeySelectorProps> = ({
       );
   }
 };
+
+// ============================================================================
+// Tool Execution Policy
+// ============================================================================
+
+interface ToolPolicyFormProps {
+  getParamValue: (key: string) => string;
+  updateParameter: (key: string, value: string) => void;
+  inputClass?: string;
+}
+
+export const ToolPolicyForm: FC<ToolPolicyFormProps> = ({
+  getParamValue,
+  updateParameter,
+  inputClass,
+}) => {
+  return (
+    <InputGroup title="Execution Policy">
+      <div className={cn('flex flex-col gap-8 max-w-6xl')}>
+        <div className="grid grid-cols-2 gap-4">
+          <FieldSet className="relative w-full">
+            <FormLabel>Timeout (seconds)</FormLabel>
+            <Input
+              type="number"
+              min={0}
+              value={getParamValue('tool.timeout')}
+              onChange={e => updateParameter('tool.timeout', e.target.value)}
+              placeholder="No timeout"
+              className={cn('bg-light-background', inputClass)}
+            />
+          </FieldSet>
+          <FieldSet className="relative w-full">
+            <FormLabel>Filler after (milliseconds)</FormLabel>
+            <Input
+              type="number"
+              min={0}
+              value={getParamValue('tool.filler.after')}
+              onChange={e =>
+                updateParameter('tool.filler.after', e.target.value)
+              }
+              placeholder="1000"
+              className={cn('bg-light-background', inputClass)}
+            />
+          </FieldSet>
+        </div>
+
+        <FieldSet className="relative w-full">
+          <FormLabel>Filler message</FormLabel>
+          <Input
+            value={getParamValue('tool.filler.message')}
+            onChange={e =>
+              updateParameter('tool.filler.message', e.target.value)
+            }
+            placeholder="Spoken while the tool is still running, e.g. Let me check that for you."
+            className={cn('bg-light-background', inputClass)}
+          />
+        </FieldSet>
+
+        <FieldSet className="relative w-full">
+          <FormLabel>Response path</FormLabel>
+          <Input
+            value={getParamValue('tool.response.path')}
+            onChange={e =>
+              updateParameter('tool.response.path', e.target.value)
+            }
+            placeholder="Select part of the response, e.g. data.order"
+            className={cn('bg-light-background', inputClass)}
+          />
+        </FieldSet>
+
+        <FieldSet className="relative w-full">
+          <FormLabel>Response template</FormLabel>
+          <Textarea
+            value={getParamValue('tool.response.template')}
+            onChange={e =>
+              updateParameter('tool.response.template', e.target.value)
+            }
+            placeholder="Order {{ response.id }} is {{ response.status }}."
+            className={cn('bg-light-background', inputClass)}
+            rows={2}
+          />
+        </FieldSet>
+
+        <FieldSet className="relative w-full">
+          <FormLabel>Error template</FormLabel>
+          <Textarea
+            value={getParamValue('tool.error.template')}
+            onChange={e =>
+              updateParameter('tool.error.template', e.target.value)
+            }
+            placeholder="The service is unavailable: {{ error }}"
+            className={cn('bg-light-background', inputClass)}
+            rows={2}
+          />
+        </FieldSet>
+      </div>
+    </InputGroup>
+  );
+};
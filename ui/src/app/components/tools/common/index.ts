@@ -12,6 +12,7 @@ export {
   ASSISTANT_KEY_OPTIONS,
   CONVERSATION_KEY_OPTIONS,
   TOOL_KEY_OPTIONS,
+  TOOL_POLICY_KEYS,
 } from './types';
 
 // Hooks
@@ -27,4 +28,5 @@ export {
   DocumentationNotice,
   ToolDefinitionForm,
   TypeKeySelector,
+  ToolPolicyForm,
 } from './components';
@@ -73,3 +73,13 @@ export const TOOL_KEY_OPTIONS = [
   { name: 'Argument', value: 'argument' },
   { name: 'Name', value: 'name' },
 ] as const;
+
+// Optional execution policy keys understood by every tool executor.
+export const TOOL_POLICY_KEYS = [
+  'tool.timeout',
+  'tool.filler.message',
+  'tool.filler.after',
+  'tool.response.path',
+  'tool.response.template',
+  'tool.error.template',
+];
@@ -1,11 +1,13 @@
 import { Metadata } from '@rapidaai/react';
 import { SetMetadata } from '@/utils/metadata';
+import { TOOL_POLICY_KEYS } from '../common/types';
 
 // ============================================================================
 // Constants
 // ============================================================================
 
 const REQUIRED_KEYS = ['tool.endpoint_id', 'tool.parameters'];
+const ALL_KEYS = [...REQUIRED_KEYS, ...TOOL_POLICY_KEYS];
 
 // ============================================================================
 // Default Options
@@ -21,8 +23,9 @@ export const GetEndpointDefaultOptions = (current: Metadata[]): Metadata[] => {
 
   addMetadata('tool.endpoint_id');
   addMetadata('tool.parameters');
+  TOOL_POLICY_KEYS.forEach(key => addMetadata(key));
 
-  return metadata.filter(m => REQUIRED_KEYS.includes(m.getKey()));
+  return metadata.filter(m => ALL_KEYS.includes(m.getKey()));
 };
 
 // ============================================================================
@@ -15,6 +15,7 @@ import { InputGroup } from '@/app/components/input-group';
 import {
   ConfigureToolProps,
   ToolDefinitionForm,
+  ToolPolicyForm,
   TypeKeySelector,
   useParameterManager,
   parseJsonParameters,
@@ -76,6 +77,12 @@ export const ConfigureEndpoint: FC<ConfigureToolProps> = ({
         </div>
       </InputGroup>
 
+      <ToolPolicyForm
+        getParamValue={getParamValue}
+        updateParameter={updateParameter}
+        inputClass={inputClass}
+      />
+
       {toolDefinition && onChangeToolDefinition && (
         <ToolDefinitionForm
           toolDefinition={toolDefinition}
@@ -12,6 +12,10 @@ const REQUIRED_KEYS = [
   'tool.score_threshold',
 ];
 
+// Optional rerank stage, enabled when tool.rerank.provider is set. Reranker
+// model parameters are stored as tool.rerank.model.*
+const RERANK_KEY_PREFIX = 'tool.rerank.';
+
 const ALLOWED_SEARCH_TYPES = ['semantic', 'fullText', 'hybrid'];
 
 const DEFAULTS = {
@@ -39,7 +43,10 @@ export const GetKnowledgeRetrievalDefaultOptions = (
   addMetadata('tool.score_threshold', DEFAULTS.score_threshold);
   addMetadata('tool.knowledge_id');
 
-  return metadata.filter(m => REQUIRED_KEYS.includes(m.getKey()));
+  return [
+    ...metadata.filter(m => REQUIRED_KEYS.includes(m.getKey())),
+    ...current.filter(m => m.getKey().startsWith(RERANK_KEY_PREFIX)),
+  ];
 };
 
 // ============================================================================
@@ -91,6 +98,23 @@ const validateScoreThreshold = (
   return undefined;
 };
 
+const validateRerank = (options: Metadata[]): string | undefined => {
+  if (!getOptionValue(options, 'tool.rerank.provider')) {
+    return undefined;
+  }
+  if (!getOptionValue(options, 'tool.rerank.credential_id')) {
+    return 'Please select a credential for the rerank model.';
+  }
+  const candidates = getOptionValue(options, 'tool.rerank.candidates');
+  if (candidates !== undefined) {
+    const value = Number(candidates);
+    if (isNaN(value) || value < 1 || value > 50) {
+      return 'Please provide a valid rerank candidates value. It must be a number between 1 and 50.';
+    }
+  }
+  return undefined;
+};
+
 export const ValidateKnowledgeRetrievalDefaultOptions = (
   options: Metadata[],
 ): string | undefined => {
@@ -99,6 +123,7 @@ export const ValidateKnowledgeRetrievalDefaultOptions = (
     validateRequiredKeys(options) ||
     validateSearchType(getOptionValue(options, 'tool.search_type')) ||
     validateTopK(getOptionValue(options, 'tool.top_k')) ||
-    validateScoreThreshold(getOptionValue(options, 'tool.score_threshold'))
+    validateScoreThreshold(getOptionValue(options, 'tool.score_threshold')) ||
+    validateRerank(options)
   );
 };
@@ -1,5 +1,5 @@
 import { FC } from 'react';
-import { Knowledge } from '@rapidaai/react';
+import { Knowledge, Metadata, VaultCredential } from '@rapidaai/react';
 import { InfoIcon } from 'lucide-react';
 import { cn } from '@/utils';
 import { Card } from '@/app/components/base/cards';
@@ -9,6 +9,12 @@ import CheckboxCard from '@/app/components/form/checkbox-card';
 import { FieldSet } from '@/app/components/form/fieldset';
 import { Input } from '@/app/components/form/input';
 import { Slider } from '@/app/components/form/slider';
+import { Switch } from '@/app/components/form/switch';
+import { CredentialDropdown } from '@/app/components/dropdown/credential-dropdown';
+import {
+  GetDefaultRerankerConfigIfInvalid,
+  RerankerProvider,
+} from '@/app/components/providers/reranker';
 import { HybridSearchIcon } from '@/app/components/Icon/hybrid-search';
 import { TextSearchIcon } from '@/app/components/Icon/text-search';
 import { VectorSearchIcon } from '@/app/components/Icon/vector-search';
@@ -52,6 +58,11 @@ const SEARCH_TYPE_CONFIG = [
   },
 ] as const;
 
+const RERANK_KEY_PREFIX = 'tool.rerank.';
+const RERANK_MODEL_KEY_PREFIX = 'tool.rerank.model.';
+const DEFAULT_RERANK_PROVIDER = 'cohere';
+const DEFAULT_RERANK_CANDIDATES = '20';
+
 // ============================================================================
 // Main Component
 // ============================================================================
@@ -68,6 +79,51 @@ export const ConfigureKnowledgeRetrieval: FC<ConfigureToolProps> = ({
     onParameterChange,
   );
 
+  // Reranker model parameters are stored as tool.rerank.model.* and edited
+  // as model.* by the reranker provider components.
+  const rerankProvider = getParamValue('tool.rerank.provider');
+  const rerankModelParameters = (parameters || [])
+    .filter(p => p.getKey().startsWith(RERANK_MODEL_KEY_PREFIX))
+    .map(p => {
+      const m = new Metadata();
+      m.setKey(p.getKey().slice(RERANK_KEY_PREFIX.length));
+      m.setValue(p.getValue());
+      return m;
+    });
+
+  const setRerank = (provider: string, modelParameters: Metadata[]) => {
+    const kept = (parameters || []).filter(
+      p =>
+        !p.getKey().startsWith(RERANK_MODEL_KEY_PREFIX) &&
+        p.getKey() !== 'tool.rerank.provider',
+    );
+    if (!provider) {
+      onParameterChange(
+        kept.filter(p => !p.getKey().startsWith(RERANK_KEY_PREFIX)),
+      );
+      return;
+    }
+    const providerParam = new Metadata();
+    providerParam.setKey('tool.rerank.provider');
+    providerParam.setValue(provider);
+    const modelParams = GetDefaultRerankerConfigIfInvalid(
+      provider,
+      modelParameters,
+    ).map(p => {
+      const m = new Metadata();
+      m.setKey(RERANK_KEY_PREFIX + p.getKey());
+      m.setValue(p.getValue());
+      return m;
+    });
+    if (!kept.some(p => p.getKey() === 'tool.rerank.candidates')) {
+      const candidates = new Metadata();
+      candidates.setKey('tool.rerank.candidates');
+      candidates.setValue(DEFAULT_RERANK_CANDIDATES);
+      kept.push(candidates);
+    }
+    onParameterChange([...kept, providerParam, ...modelParams]);
+  };
+
   return (
     <>
       <InputGroup title="Action Definition">
@@ -130,6 +186,66 @@ export const ConfigureKnowledgeRetrieval: FC<ConfigureToolProps> = ({
         </div>
       </InputGroup>
 
+      <InputGroup title="Reranking">
+        <div className="flex flex-col gap-8 max-w-6xl">
+          <FieldSet className="justify-between flex">
+            <FormLabel>
+              Reranking Enable
+              <Tooltip icon={<InfoIcon className="w-4 h-4 ml-1" />}>
+                <p className={cn('font-normal text-sm p-1 w-64')}>
+                  Fetch more candidates than Top K and reorder them with a
+                  rerank model before keeping the Top K most relevant.
+                </p>
+              </Tooltip>
+            </FormLabel>
+            <Switch
+              enable={!!rerankProvider}
+              setEnable={(enable: boolean) =>
+                setRerank(enable ? DEFAULT_RERANK_PROVIDER : '', [])
+              }
+            />
+          </FieldSet>
+
+          {rerankProvider && (
+            <>
+              <FieldSet>
+                <FormLabel>Rerank Model</FormLabel>
+                <RerankerProvider
+                  provider={rerankProvider}
+                  onChangeProvider={provider => setRerank(provider, [])}
+                  parameters={rerankModelParameters}
+                  onChangeParameter={modelParameters =>
+                    setRerank(rerankProvider, modelParameters)
+                  }
+                />
+              </FieldSet>
+              <CredentialDropdown
+                onChangeCredential={(c: VaultCredential) => {
+                  updateParameter('tool.rerank.credential_id', c.getId());
+                }}
+                provider={rerankProvider}
+                currentCredential={getParamValue('tool.rerank.credential_id')}
+              />
+              <div className="grid grid-cols-2 w-full gap-4">
+                <SliderField
+                  id="rerank_candidates"
+                  label="Candidates"
+                  tooltip="Number of chunks retrieved for the rerank model to choose the Top K from."
+                  min={1}
+                  max={50}
+                  step={1}
+                  value={getParamValue('tool.rerank.candidates')}
+                  onChange={value =>
+                    updateParameter('tool.rerank.candidates', value)
+                  }
+                  inputClass={inputClass}
+                />
+              </div>
+            </>
+          )}
+        </div>
+      </InputGroup>
+
       {toolDefinition && onChangeToolDefinition && (
         <ToolDefinitionForm
           toolDefinition={toolDefinition}
@@ -1,5 +1,6 @@
 import { Metadata } from '@rapidaai/react';
 import { SetMetadata } from '@/utils/metadata';
+import { TOOL_POLICY_KEYS } from '../common/types';
 
 // ============================================================================
 // Constants
@@ -11,6 +12,7 @@ const OPTIONAL_KEYS = [
   'mcp.protocol',
   'mcp.timeout',
   'mcp.headers',
+  ...TOOL_POLICY_KEYS,
 ];
 const ALL_KEYS = [...REQUIRED_KEYS, ...OPTIONAL_KEYS];
 
@@ -37,6 +39,7 @@ export const GetMCPDefaultOptions = (current: Metadata[]): Metadata[] => {
   addMetadata('mcp.protocol', 'sse');
   addMetadata('mcp.timeout', '30');
   addMetadata('mcp.headers');
+  TOOL_POLICY_KEYS.forEach(key => addMetadata(key));
 
   return metadata.filter(m => ALL_KEYS.includes(m.getKey()));
 };
@@ -5,7 +5,11 @@ import { FieldSet } from '@/app/components/form/fieldset';
 import { Input } from '@/app/components/form/input';
 import { Textarea } from '@/app/components/form/textarea';
 import { InputGroup } from '@/app/components/input-group';
-import { ConfigureToolProps, useParameterManager } from '../common';
+import {
+  ConfigureToolProps,
+  ToolPolicyForm,
+  useParameterManager,
+} from '../common';
 import { BlueNoticeBlock } from '@/app/components/container/message/notice-block';
 import { Select } from '@/app/components/form/select';
 import { APiStringHeader } from '@/app/components/external-api/api-header';
@@ -121,6 +125,12 @@ export const ConfigureMCP: FC<ConfigureToolProps> = ({
           </BlueNoticeBlock>
         </div>
       </InputGroup>
+
+      <ToolPolicyForm
+        getParamValue={getParamValue}
+        updateParameter={updateParameter}
+        inputClass={inputClass}
+      />
     </>
   );
 };
@@ -26,6 +26,7 @@ import { useRapidaStore } from '@/hooks';
 import { connectionConfig } from '@/configs';
 import { PageHeaderBlock } from '@/app/components/blocks/page-header-block';
 import { PageTitleBlock } from '@/app/components/blocks/page-title-block';
+import { WebhookSigningField, withWebhookSigning } from './webhook-signing';
 
 const webhookEvents = [
   {
@@ -61,6 +62,8 @@ export const CreateAssistantWebhook: FC<{ assistantId: string }> = ({
   const [maxRetries, setMaxRetries] = useState(3);
   const [requestTimeout, setRequestTimeout] = useState(180);
   const [headers, setHeaders] = useState<{ key: string; value: string }[]>([]);
+  const [signingEnabled, setSigningEnabled] = useState(false);
+  const [signingCredentialId, setSigningCredentialId] = useState('');
   const [priority, setPriority] = useState<number>(0);
   const [parameters, setParameters] = useState<
     {
@@ -88,6 +91,10 @@ export const CreateAssistantWebhook: FC<{ assistantId: string }> = ({
       setErrorMessage('Please provide a server url for the webhook.');
       return false;
     }
+    if (signingEnabled && !signingCredentialId) {
+      setErrorMessage('Please select the signing secret for the webhook.');
+      return false;
+    }
     if (!/^https?:\/\/.+/.test(endpoint)) {
       setErrorMessage('Please provide a valid server url for the webhook.');
       return false;
@@ -148,7 +155,7 @@ export const CreateAssistantWebhook: FC<{ assistantId: string }> = ({
         assistantId,
         method,
         endpoint,
-        headers,
+        withWebhookSigning(headers, signingCredentialId),
         parameterKeyValuePairs,
         events,
         retryOnStatus,
@@ -473,6 +480,12 @@ export const CreateAssistantWebhook: FC<{ assistantId: string }> = ({
                 className=""
               />
             </FieldSet>
+            <WebhookSigningField
+              enabled={signingEnabled}
+              onChangeEnabled={setSigningEnabled}
+              signingCredentialId={signingCredentialId}
+              onChangeSigningCredentialId={setSigningCredentialId}
+            />
           </div>
         </InputGroup>
 
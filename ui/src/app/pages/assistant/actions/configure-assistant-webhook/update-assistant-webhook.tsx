@@ -26,6 +26,11 @@ import { useRapidaStore } from '@/hooks';
 import { connectionConfig } from '@/configs';
 import { PageHeaderBlock } from '@/app/components/blocks/page-header-block';
 import { PageTitleBlock } from '@/app/components/blocks/page-title-block';
+import {
+  WebhookSigningField,
+  splitWebhookSigning,
+  withWebhookSigning,
+} from './webhook-signing';
 
 const webhookEvents = [
   {
@@ -64,6 +69,8 @@ export const UpdateAssistantWebhook: FC<{ assistantId: string }> = ({
   const [maxRetries, setMaxRetries] = useState(3);
   const [requestTimeout, setRequestTimeout] = useState(180);
   const [headers, setHeaders] = useState<{ key: string; value: string }[]>([]);
+  const [signingEnabled, setSigningEnabled] = useState(false);
+  const [signingCredentialId, setSigningCredentialId] = useState('');
   const [events, setEvents] = useState<string[]>([]);
   const [priority, setPriority] = useState<number>(0);
   const [parameters, setParameters] = useState<
@@ -120,12 +127,15 @@ export const UpdateAssistantWebhook: FC<{ assistantId: string }> = ({
           setRequestTimeout(wb.getTimeoutsecond());
           setPriority(wb.getExecutionpriority());
           const headersMap = wb.getHttpheadersMap();
-          setHeaders(
+          const signing = splitWebhookSigning(
             Array.from(headersMap.entries()).map(([key, value]) => ({
               key,
               value,
             })),
           );
+          setHeaders(signing.headers);
+          setSigningEnabled(signing.signingCredentialId !== '');
+          setSigningCredentialId(signing.signingCredentialId);
           const parametersMap = wb.getHttpbodyMap();
           setParameters(
             Array.from(parametersMap.entries()).map(([key, value]) => {
@@ -164,6 +174,10 @@ export const UpdateAssistantWebhook: FC<{ assistantId: string }> = ({
       setErrorMessage('Please provide a server url for the webhook.');
       return false;
     }
+    if (signingEnabled && !signingCredentialId) {
+      setErrorMessage('Please select the signing secret for the webhook.');
+      return false;
+    }
     if (!/^https?:\/\/.+/.test(endpoint)) {
       setErrorMessage('Please provide a valid server url for the webhook.');
       return false;
@@ -226,7 +240,7 @@ export const UpdateAssistantWebhook: FC<{ assistantId: string }> = ({
         webhookId!,
         method,
         endpoint,
-        headers,
+        withWebhookSigning(headers, signingCredentialId),
         parameterKeyValuePairs,
         events,
         retryOnStatus,
@@ -523,6 +537,12 @@ export const UpdateAssistantWebhook: FC<{ assistantId: string }> = ({
                 onChange={e => setPriority(Number(e.target.value))}
               />
             </FieldSet>
+            <WebhookSigningField
+              enabled={signingEnabled}
+              onChangeEnabled={setSigningEnabled}
+              signingCredentialId={signingCredentialId}
+              onChangeSigningCredentialId={setSigningCredentialId}
+            />
           </div>
         </InputGroup>
         {/* Events */}
@@ -0,0 +1,93 @@
+import { FC } from 'react';
+import { VaultCredential } from '@rapidaai/react';
+import { FieldSet } from '@/app/components/form/fieldset';
+import { FormLabel } from '@/app/components/form-label';
+import { InputCheckbox } from '@/app/components/form/checkbox';
+import { InputHelper } from '@/app/components/input-helper';
+import { CredentialDropdown } from '@/app/components/dropdown/credential-dropdown';
+
+// Reserved header keys read by the assistant when delivering the webhook,
+// they are never sent to the receiver.
+const WEBHOOK_AUTH_TYPE = 'rapida.auth.type';
+const WEBHOOK_AUTH_CREDENTIAL_ID = 'rapida.auth.credential_id';
+const WEBHOOK_AUTH_HMAC_SHA256 = 'hmac_sha256';
+
+type Header = { key: string; value: string };
+
+/**
+ * Separates the signing credential from the headers of a saved webhook
+ */
+export const splitWebhookSigning = (
+  headers: Header[],
+): { headers: Header[]; signingCredentialId: string } => {
+  const signed =
+    headers.find(h => h.key === WEBHOOK_AUTH_TYPE)?.value ===
+    WEBHOOK_AUTH_HMAC_SHA256;
+  return {
+    headers: headers.filter(h => !h.key.startsWith('rapida.auth.')),
+    signingCredentialId: signed
+      ? headers.find(h => h.key === WEBHOOK_AUTH_CREDENTIAL_ID)?.value || ''
+      : '',
+  };
+};
+
+/**
+ * Adds the signing configuration to the headers of a webhook
+ */
+export const withWebhookSigning = (
+  headers: Header[],
+  signingCredentialId: string,
+): Header[] => {
+  if (!signingCredentialId) return headers;
+  return [
+    ...headers,
+    { key: WEBHOOK_AUTH_TYPE, value: WEBHOOK_AUTH_HMAC_SHA256 },
+    { key: WEBHOOK_AUTH_CREDENTIAL_ID, value: signingCredentialId },
+  ];
+};
+
+interface WebhookSigningFieldProps {
+  enabled: boolean;
+  onChangeEnabled: (enabled: boolean) => void;
+  signingCredentialId: string;
+  onChangeSigningCredentialId: (id: string) => void;
+}
+
+export const WebhookSigningField: FC<WebhookSigningFieldProps> = ({
+  enabled,
+  onChangeEnabled,
+  signingCredentialId,
+  onChangeSigningCredentialId,
+}) => {
+  return (
+    <FieldSet>
+      <FormLabel className="normal-case">Request signing</FormLabel>
+      <label className="flex items-center space-x-2">
+        <InputCheckbox
+          checked={enabled}
+          onChange={e => {
+            onChangeEnabled(e.target.checked);
+            if (!e.target.checked) onChangeSigningCredentialId('');
+          }}
+        />
+        <span>Sign requests with HMAC-SHA256</span>
+      </label>
+      {enabled && (
+        <CredentialDropdown
+          className="bg-light-background max-w-full dark:bg-gray-950"
+          onChangeCredential={(c: VaultCredential) =>
+            onChangeSigningCredentialId(c.getId())
+          }
+          currentCredential={signingCredentialId}
+          provider="webhook-signing"
+        />
+      )}
+      <InputHelper>
+        Each request carries x-rapida-timestamp and x-rapida-signature headers.
+        The signature is v1=HMAC-SHA256(secret, "timestamp.body") in hex,
+        receivers should recompute it and reject old timestamps to prevent
+        replays.
+      </InputHelper>
+    </FieldSet>
+  );
+};